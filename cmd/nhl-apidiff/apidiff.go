@@ -0,0 +1,367 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// apiSnapshot maps the identity of an exported declaration, e.g.
+// "nhl.Client.Boxscore", to its description. Two versions are compatible
+// for a key when the descriptions are equal.
+type apiSnapshot map[string]string
+
+// skippedDirs are never part of the public API.
+var skippedDirs = map[string]bool{"cmd": true, "internal": true, "testdata": true}
+
+// snapshot collects the exported API of every package under root.
+func snapshot(root string) (apiSnapshot, error) {
+	api := make(apiSnapshot)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != root {
+			name := d.Name()
+			if skippedDirs[name] || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		return addPackage(api, path, filepath.ToSlash(rel))
+	})
+	return api, err
+}
+
+// addPackage adds the exported declarations of the non-test Go files in
+// dir. Keys are prefixed with the directory relative to the module root,
+// or the package name at the root itself.
+func addPackage(api apiSnapshot, dir, rel string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	fset := token.NewFileSet()
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		if file.Name.Name == "main" {
+			return nil
+		}
+		prefix := file.Name.Name
+		if rel != "." {
+			prefix = rel
+		}
+		addFile(api, prefix, file)
+	}
+	return nil
+}
+
+// addFile adds the exported declarations of one file.
+func addFile(api apiSnapshot, pkg string, file *ast.File) {
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			addFunc(api, pkg, decl)
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					addType(api, pkg, spec)
+				case *ast.ValueSpec:
+					addValue(api, pkg, decl.Tok, spec)
+				}
+			}
+		}
+	}
+}
+
+func addFunc(api apiSnapshot, pkg string, fn *ast.FuncDecl) {
+	if !fn.Name.IsExported() {
+		return
+	}
+	sig := signature(fn.Type)
+	if fn.Recv == nil {
+		api[pkg+"."+fn.Name.Name] = "func " + fn.Name.Name + typeParams(fn.Type.TypeParams) + sig
+		return
+	}
+	recv := fn.Recv.List[0].Type
+	base := receiverName(recv)
+	if !ast.IsExported(base) {
+		return
+	}
+	api[pkg+"."+base+"."+fn.Name.Name] = fmt.Sprintf("method (%s) %s%s", types.ExprString(recv), fn.Name.Name, sig)
+}
+
+// receiverName returns the type name of a method receiver such as *T or
+// T[K].
+func receiverName(expr ast.Expr) string {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.IndexListExpr:
+			expr = e.X
+		case *ast.Ident:
+			return e.Name
+		default:
+			return ""
+		}
+	}
+}
+
+func addType(api apiSnapshot, pkg string, spec *ast.TypeSpec) {
+	if !spec.Name.IsExported() {
+		return
+	}
+	name := spec.Name.Name
+	key := pkg + "." + name
+	decl := "type " + name + typeParams(spec.TypeParams)
+	if spec.Assign.IsValid() {
+		decl += " ="
+	}
+
+	switch t := spec.Type.(type) {
+	case *ast.StructType:
+		api[key] = decl + " struct"
+		for _, field := range t.Fields.List {
+			addField(api, key, field)
+		}
+	case *ast.InterfaceType:
+		api[key] = decl + " interface"
+		for _, m := range t.Methods.List {
+			if len(m.Names) == 0 {
+				api[key+"."+types.ExprString(m.Type)] = "embedded " + types.ExprString(m.Type)
+				continue
+			}
+			for _, n := range m.Names {
+				if !n.IsExported() {
+					continue
+				}
+				if ft, ok := m.Type.(*ast.FuncType); ok {
+					api[key+"."+n.Name] = "method " + n.Name + signature(ft)
+				}
+			}
+		}
+	default:
+		api[key] = decl + " " + types.ExprString(spec.Type)
+	}
+}
+
+// addField adds the exported fields of a struct, with their JSON tags.
+func addField(api apiSnapshot, typeKey string, field *ast.Field) {
+	fieldType := types.ExprString(field.Type)
+	tag := ""
+	if field.Tag != nil {
+		if raw, err := strconv.Unquote(field.Tag.Value); err == nil {
+			if name, ok := reflect.StructTag(raw).Lookup("json"); ok {
+				tag = fmt.Sprintf(" json:%q", name)
+			}
+		}
+	}
+	if len(field.Names) == 0 {
+		name := receiverName(field.Type)
+		if ast.IsExported(name) {
+			api[typeKey+"."+name] = "embedded " + fieldType + tag
+		}
+		return
+	}
+	for _, n := range field.Names {
+		if n.IsExported() {
+			api[typeKey+"."+n.Name] = "field " + fieldType + tag
+		}
+	}
+}
+
+func addValue(api apiSnapshot, pkg string, tok token.Token, spec *ast.ValueSpec) {
+	kind := "var"
+	if tok == token.CONST {
+		kind = "const"
+	}
+	for _, n := range spec.Names {
+		if !n.IsExported() {
+			continue
+		}
+		desc := kind + " " + n.Name
+		if spec.Type != nil {
+			desc += " " + types.ExprString(spec.Type)
+		}
+		api[pkg+"."+n.Name] = desc
+	}
+}
+
+// signature formats a function's parameters and results without names,
+// which callers cannot depend on.
+func signature(ft *ast.FuncType) string {
+	sig := "(" + fieldTypes(ft.Params) + ")"
+	results := fieldTypes(ft.Results)
+	switch {
+	case results == "":
+	case ft.Results.NumFields() == 1:
+		sig += " " + results
+	default:
+		sig += " (" + results + ")"
+	}
+	return sig
+}
+
+// typeParams formats type parameters with their names, e.g. "[K, V any]".
+func typeParams(list *ast.FieldList) string {
+	if list == nil || len(list.List) == 0 {
+		return ""
+	}
+	var parts []string
+	for _, f := range list.List {
+		var names []string
+		for _, n := range f.Names {
+			names = append(names, n.Name)
+		}
+		parts = append(parts, strings.Join(names, ", ")+" "+types.ExprString(f.Type))
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// fieldTypes lists the type of each field, repeated for grouped names.
+func fieldTypes(list *ast.FieldList) string {
+	if list == nil {
+		return ""
+	}
+	var parts []string
+	for _, f := range list.List {
+		n := max(1, len(f.Names))
+		for range n {
+			parts = append(parts, types.ExprString(f.Type))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// write prints the snapshot sorted by key, one tab-separated entry per
+// line.
+func (api apiSnapshot) write(w io.Writer) error {
+	keys := make([]string, 0, len(api))
+	for k := range api {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	bw := bufio.NewWriter(w)
+	for _, k := range keys {
+		fmt.Fprintf(bw, "%s\t%s\n", k, api[k])
+	}
+	return bw.Flush()
+}
+
+// readSnapshot parses the output of write.
+func readSnapshot(r io.Reader) (apiSnapshot, error) {
+	api := make(apiSnapshot)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if text == "" {
+			continue
+		}
+		key, desc, ok := strings.Cut(text, "\t")
+		if !ok {
+			return nil, fmt.Errorf("line %d: missing tab separator", line)
+		}
+		api[key] = desc
+	}
+	return api, scanner.Err()
+}
+
+// change is one entry of a diff report.
+type change struct {
+	key      string
+	old, new string
+}
+
+// report lists the differences between two snapshots, each sorted by key.
+type report struct {
+	added   []change
+	removed []change
+	changed []change
+}
+
+// diff compares two snapshots.
+func diff(old, cur apiSnapshot) report {
+	var r report
+	for k, desc := range old {
+		newDesc, ok := cur[k]
+		switch {
+		case !ok:
+			r.removed = append(r.removed, change{key: k, old: desc})
+		case newDesc != desc:
+			r.changed = append(r.changed, change{key: k, old: desc, new: newDesc})
+		}
+	}
+	for k, desc := range cur {
+		if _, ok := old[k]; !ok {
+			r.added = append(r.added, change{key: k, new: desc})
+		}
+	}
+	byKey := func(a, b change) int { return strings.Compare(a.key, b.key) }
+	slices.SortFunc(r.added, byKey)
+	slices.SortFunc(r.removed, byKey)
+	slices.SortFunc(r.changed, byKey)
+	return r
+}
+
+// breaking reports whether code built against the old API may no longer
+// compile.
+func (r report) breaking() bool {
+	return len(r.removed) > 0 || len(r.changed) > 0
+}
+
+// write prints the report grouped by kind of change.
+func (r report) write(w io.Writer) {
+	if len(r.added)+len(r.removed)+len(r.changed) == 0 {
+		fmt.Fprintln(w, "no API changes")
+		return
+	}
+	if len(r.removed) > 0 {
+		fmt.Fprintf(w, "Removed (%d):\n", len(r.removed))
+		for _, c := range r.removed {
+			fmt.Fprintf(w, "- %s\t%s\n", c.key, c.old)
+		}
+	}
+	if len(r.changed) > 0 {
+		fmt.Fprintf(w, "Changed (%d):\n", len(r.changed))
+		for _, c := range r.changed {
+			fmt.Fprintf(w, "~ %s\n\t- %s\n\t+ %s\n", c.key, c.old, c.new)
+		}
+	}
+	if len(r.added) > 0 {
+		fmt.Fprintf(w, "Added (%d):\n", len(r.added))
+		for _, c := range r.added {
+			fmt.Fprintf(w, "+ %s\t%s\n", c.key, c.new)
+		}
+	}
+}
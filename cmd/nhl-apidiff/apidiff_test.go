@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+const modelSource = `package nhl
+
+import "context"
+
+const MaxItems = 10
+
+var ErrGone error
+
+type GameID int64
+
+type Client struct{ base string }
+
+func NewClient(base string, opts ...Option) *Client { return nil }
+
+func (c *Client) Boxscore(ctx context.Context, id GameID) (*Boxscore, error) { return nil, nil }
+
+func (c *Client) fetch() {}
+
+type Option func(*Client)
+
+type Boxscore struct {
+	ID        GameID ` + "`json:\"id\"`" + `
+	Away, Home int
+	hidden    string
+	Inner
+}
+
+type Inner struct{}
+
+type Source interface {
+	Next() (GameID, bool)
+}
+
+type Page[T any] struct{ Items []T }
+
+func Map[T, U any](in []T, f func(T) U) []U { return nil }
+
+type unexported struct{ Visible int }
+`
+
+func TestSnapshot(t *testing.T) {
+	root := writeFiles(t, map[string]string{
+		"nhl/model.go":            modelSource,
+		"nhl/model_test.go":       "package nhl\n\nfunc TestOnly() {}\n",
+		"nhl/sub/sub.go":          "package sub\n\nfunc Helper() {}\n",
+		"cmd/tool/main.go":        "package main\n\nfunc Exported() {}\n",
+		"internal/gen/gen.go":     "package gen\n\nfunc Gen() {}\n",
+		"nested/go.mod":           "module example.com/nested\n",
+		"nested/nested.go":        "package nested\n\nfunc Nested() {}\n",
+		"nhl/testdata/fixture.go": "package testdata\n\nfunc Fixture() {}\n",
+	})
+
+	api, err := snapshot(root)
+	if err != nil {
+		t.Fatalf("snapshot() error = %v", err)
+	}
+	want := apiSnapshot{
+		"nhl.MaxItems":        "const MaxItems",
+		"nhl.ErrGone":         "var ErrGone error",
+		"nhl.GameID":          "type GameID int64",
+		"nhl.Client":          "type Client struct",
+		"nhl.NewClient":       "func NewClient(string, ...Option) *Client",
+		"nhl.Client.Boxscore": "method (*Client) Boxscore(context.Context, GameID) (*Boxscore, error)",
+		"nhl.Option":          "type Option func(*Client)",
+		"nhl.Boxscore":        "type Boxscore struct",
+		"nhl.Boxscore.ID":     `field GameID json:"id"`,
+		"nhl.Boxscore.Away":   "field int",
+		"nhl.Boxscore.Home":   "field int",
+		"nhl.Boxscore.Inner":  "embedded Inner",
+		"nhl.Inner":           "type Inner struct",
+		"nhl.Source":          "type Source interface",
+		"nhl.Source.Next":     "method Next() (GameID, bool)",
+		"nhl.Page":            "type Page[T any] struct",
+		"nhl.Page.Items":      "field []T",
+		"nhl.Map":             "func Map[T, U any]([]T, func(T) U) []U",
+		"nhl/sub.Helper":      "func Helper()",
+	}
+	for key, desc := range want {
+		if got, ok := api[key]; !ok || got != desc {
+			t.Errorf("api[%q] = %q, %v; want %q", key, got, ok, desc)
+		}
+	}
+	if len(api) != len(want) {
+		var extra []string
+		for key := range api {
+			if _, ok := want[key]; !ok {
+				extra = append(extra, key)
+			}
+		}
+		t.Errorf("unexpected entries: %v", extra)
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	api := apiSnapshot{"nhl.B": "func B()", "nhl.A": "type A struct"}
+	var buf bytes.Buffer
+	if err := api.write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "nhl.A\ttype A struct\nnhl.B\tfunc B()\n" {
+		t.Errorf("write() = %q", buf.String())
+	}
+	got, err := readSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("readSnapshot() error = %v", err)
+	}
+	if len(got) != 2 || got["nhl.B"] != "func B()" {
+		t.Errorf("readSnapshot() = %v", got)
+	}
+	if _, err := readSnapshot(strings.NewReader("no separator\n")); err == nil {
+		t.Error("readSnapshot() should reject a line without a tab")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := apiSnapshot{
+		"nhl.Kept":    "func Kept()",
+		"nhl.Gone":    "func Gone()",
+		"nhl.Model.X": `field int json:"x"`,
+	}
+	cur := apiSnapshot{
+		"nhl.Kept":    "func Kept()",
+		"nhl.New":     "func New()",
+		"nhl.Model.X": `field int json:"xValue"`,
+	}
+	r := diff(old, cur)
+	if len(r.added) != 1 || r.added[0].key != "nhl.New" {
+		t.Errorf("added = %v", r.added)
+	}
+	if len(r.removed) != 1 || r.removed[0].key != "nhl.Gone" {
+		t.Errorf("removed = %v", r.removed)
+	}
+	if len(r.changed) != 1 || r.changed[0].key != "nhl.Model.X" {
+		t.Errorf("changed = %v", r.changed)
+	}
+	if !r.breaking() {
+		t.Error("removals should be breaking")
+	}
+
+	var buf bytes.Buffer
+	r.write(&buf)
+	for _, want := range []string{"Removed (1):", "- nhl.Gone", "~ nhl.Model.X", `+ field int json:"xValue"`, "Added (1):", "+ nhl.New"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("report missing %q:\n%s", want, buf.String())
+		}
+	}
+
+	same := diff(old, old)
+	if same.breaking() {
+		t.Error("identical snapshots should not be breaking")
+	}
+	buf.Reset()
+	same.write(&buf)
+	if buf.String() != "no API changes\n" {
+		t.Errorf("report = %q", buf.String())
+	}
+	if additive := diff(old, merge(old, apiSnapshot{"nhl.Extra": "func Extra()"})); additive.breaking() {
+		t.Error("additions alone should not be breaking")
+	}
+}
+
+func merge(a, b apiSnapshot) apiSnapshot {
+	out := make(apiSnapshot)
+	for k, v := range a {
+		out[k] = v
+	}
+	for k, v := range b {
+		out[k] = v
+	}
+	return out
+}
+
+func TestSnapshotRepository(t *testing.T) {
+	api, err := snapshot("../..")
+	if err != nil {
+		t.Fatalf("snapshot() error = %v", err)
+	}
+	if got := api["nhl.Client.Boxscore"]; got != "method (*Client) Boxscore(context.Context, GameID) (*Boxscore, error)" {
+		t.Errorf("nhl.Client.Boxscore = %q", got)
+	}
+	if got := api["nhl/model.Boxscore.ID"]; got != `field GameID json:"id"` {
+		t.Errorf("nhl/model.Boxscore.ID = %q", got)
+	}
+	if got := api["nhl.Boxscore"]; got != "type Boxscore = model.Boxscore" {
+		t.Errorf("nhl.Boxscore = %q", got)
+	}
+	for key := range api {
+		if strings.HasPrefix(key, "cmd/") || strings.HasPrefix(key, "nhlpb") {
+			t.Errorf("unexpected entry %q", key)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Command nhl-apidiff reports changes to the library's exported API between
+// two versions.
+//
+// The snapshot subcommand walks the packages under a module root (skipping
+// cmd, internal, testdata and nested modules) and writes one line per
+// exported constant, variable, function, type, method and struct field,
+// plus the JSON name of every model field:
+//
+//	nhl.Client.Boxscore	method (*Client) Boxscore(context.Context, GameID) (*Boxscore, error)
+//	nhl.Boxscore.ID	field GameID json:"id"
+//
+// The diff subcommand compares two snapshots and lists what was added,
+// removed or changed. It exits with status 1 when anything was removed or
+// changed, so it can gate a release in CI.
+//
+// Usage:
+//
+//	nhl-apidiff snapshot -root . > api-v1.txt
+//	nhl-apidiff diff api-v1.txt api-v2.txt
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+)
+
+func main() {
+	logger := log.New(os.Stderr, "nhl-apidiff: ", 0)
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "snapshot":
+		flags := flag.NewFlagSet("snapshot", flag.ExitOnError)
+		root := flags.String("root", ".", "module root to scan")
+		out := flags.String("out", "", "output file (default: stdout)")
+		flags.Parse(os.Args[2:])
+
+		api, err := snapshot(*root)
+		if err != nil {
+			logger.Fatal(err)
+		}
+		var w io.Writer = os.Stdout
+		if *out != "" {
+			f, err := os.Create(*out)
+			if err != nil {
+				logger.Fatal(err)
+			}
+			defer f.Close()
+			w = f
+		}
+		if err := api.write(w); err != nil {
+			logger.Fatal(err)
+		}
+	case "diff":
+		if len(os.Args) != 4 {
+			usage()
+			os.Exit(2)
+		}
+		old, err := readSnapshotFile(os.Args[2])
+		if err != nil {
+			logger.Fatal(err)
+		}
+		cur, err := readSnapshotFile(os.Args[3])
+		if err != nil {
+			logger.Fatal(err)
+		}
+		report := diff(old, cur)
+		report.write(os.Stdout)
+		if report.breaking() {
+			os.Exit(1)
+		}
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: nhl-apidiff snapshot [-root dir] [-out file]")
+	fmt.Fprintln(os.Stderr, "       nhl-apidiff diff old.txt new.txt")
+}
+
+func readSnapshotFile(path string) (apiSnapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readSnapshot(f)
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl"
+	"github.com/sperano/nhl-api-go/nhl/checkpoint"
+)
+
+// archiveStats counts the outcome of each stored file. Files that already
+// existed or whose content was unchanged count as skipped.
+type archiveStats struct {
+	fetched int
+	skipped int
+	failed  int
+}
+
+// gameResource is one per-game payload stored in the archive.
+type gameResource struct {
+	name  string
+	fetch func(ctx context.Context, c *nhl.Client, id nhl.GameID) (any, error)
+}
+
+var gameResources = []gameResource{
+	{"boxscore", func(ctx context.Context, c *nhl.Client, id nhl.GameID) (any, error) {
+		return c.Boxscore(ctx, id)
+	}},
+	{"play-by-play", func(ctx context.Context, c *nhl.Client, id nhl.GameID) (any, error) {
+		return c.PlayByPlay(ctx, id)
+	}},
+	{"shiftcharts", func(ctx context.Context, c *nhl.Client, id nhl.GameID) (any, error) {
+		return c.ShiftChart(ctx, id)
+	}},
+}
+
+// archiver downloads a season into a directory tree.
+type archiver struct {
+	client *nhl.Client
+	root   string
+	pace   *pacer
+	logger *log.Logger
+
+	// done records games whose payloads are all stored. Completed games
+	// are skipped without touching the filesystem.
+	done checkpoint.Checkpoint
+}
+
+func newArchiver(client *nhl.Client, root string, delay time.Duration, done checkpoint.Checkpoint, logger *log.Logger) *archiver {
+	if done == nil {
+		done = &checkpoint.Memory{}
+	}
+	return &archiver{
+		client: client,
+		root:   root,
+		pace:   newPacer(delay),
+		logger: logger,
+		done:   done,
+	}
+}
+
+// archiveSeason stores the schedules and completed-game payloads for season.
+// Individual game failures are logged and counted rather than aborting the
+// run; a non-nil error is returned if anything failed.
+func (a *archiver) archiveSeason(ctx context.Context, season nhl.Season) (archiveStats, error) {
+	var stats archiveStats
+	seasonDir := filepath.Join(a.root, season.APIString())
+
+	games, err := a.archiveSchedules(ctx, season, seasonDir, &stats)
+	if err != nil {
+		return stats, err
+	}
+
+	for _, game := range games {
+		if !game.GameState.IsFinal() {
+			continue
+		}
+		if a.done.IsDone(game.ID) {
+			stats.skipped += len(gameResources)
+			continue
+		}
+		gameDir := filepath.Join(seasonDir, "games", game.ID.String())
+		complete := true
+		for _, res := range gameResources {
+			path := filepath.Join(gameDir, res.name+".json.gz")
+			if fileExists(path) {
+				stats.skipped++
+				continue
+			}
+			if err := a.pace.wait(ctx); err != nil {
+				return stats, err
+			}
+			payload, err := res.fetch(ctx, a.client, game.ID)
+			if err == nil {
+				_, err = writeJSONGz(path, payload)
+			}
+			if err != nil {
+				if ctx.Err() != nil {
+					return stats, ctx.Err()
+				}
+				a.logger.Printf("game %s %s: %v", game.ID, res.name, err)
+				stats.failed++
+				complete = false
+				continue
+			}
+			stats.fetched++
+		}
+		if complete {
+			if err := a.done.MarkDone(game.ID); err != nil {
+				return stats, err
+			}
+		}
+	}
+
+	if stats.failed > 0 {
+		return stats, fmt.Errorf("%d downloads failed; re-run to retry them", stats.failed)
+	}
+	return stats, nil
+}
+
+// archiveSchedules fetches every team's schedule for season, stores each one,
+// and returns the de-duplicated list of games ordered by game ID. Schedules
+// are always re-fetched so that a resumed run picks up newly completed games.
+func (a *archiver) archiveSchedules(ctx context.Context, season nhl.Season, seasonDir string, stats *archiveStats) ([]nhl.ScheduleGame, error) {
+	if err := a.pace.wait(ctx); err != nil {
+		return nil, err
+	}
+	standings, err := a.client.LeagueStandingsForSeason(ctx, season)
+	if err != nil {
+		return nil, fmt.Errorf("fetching teams for %s: %w", season, err)
+	}
+
+	seen := make(map[nhl.GameID]bool)
+	var games []nhl.ScheduleGame
+	for _, standing := range standings {
+		abbrev := standing.TeamAbbrev.Default
+		if abbrev == "" {
+			continue
+		}
+		if err := a.pace.wait(ctx); err != nil {
+			return nil, err
+		}
+		schedule, err := a.client.ClubScheduleSeason(ctx, abbrev, season)
+		if err != nil {
+			return nil, fmt.Errorf("fetching %s schedule: %w", abbrev, err)
+		}
+		written, err := writeJSONGz(filepath.Join(seasonDir, "schedules", abbrev+".json.gz"), schedule)
+		if err != nil {
+			return nil, err
+		}
+		if written {
+			stats.fetched++
+		} else {
+			stats.skipped++
+		}
+
+		for _, game := range schedule.Games {
+			if !seen[game.ID] {
+				seen[game.ID] = true
+				games = append(games, game)
+			}
+		}
+	}
+
+	sort.Slice(games, func(i, j int) bool { return games[i].ID < games[j].ID })
+	return games, nil
+}
+
+// pacer enforces a minimum delay between successive requests.
+type pacer struct {
+	delay time.Duration
+	last  time.Time
+}
+
+func newPacer(delay time.Duration) *pacer {
+	return &pacer{delay: delay}
+}
+
+// wait blocks until at least delay has passed since the previous call.
+func (p *pacer) wait(ctx context.Context) error {
+	if p.delay > 0 && !p.last.IsZero() {
+		if remaining := p.delay - time.Since(p.last); remaining > 0 {
+			timer := time.NewTimer(remaining)
+			defer timer.Stop()
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	p.last = time.Now()
+	return nil
+}
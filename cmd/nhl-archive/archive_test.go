@@ -0,0 +1,287 @@
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl"
+	"github.com/sperano/nhl-api-go/nhl/checkpoint"
+)
+
+// fakeAPI serves a two-team season with one final and one future game.
+type fakeAPI struct {
+	mu       sync.Mutex
+	requests map[string]int
+	failPath string
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests[r.URL.Path]++
+	f.mu.Unlock()
+
+	if r.URL.Path == f.failPath {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/standings-season":
+		io.WriteString(w, `{"seasons":[{"id":20232024,"standingsStart":"2023-10-10","standingsEnd":"2024-04-18"}]}`)
+	case "/standings/2024-04-18":
+		io.WriteString(w, `{"standings":[{"teamAbbrev":{"default":"TOR"}},{"teamAbbrev":{"default":"MTL"}}]}`)
+	case "/club-schedule-season/TOR/20232024", "/club-schedule-season/MTL/20232024":
+		io.WriteString(w, `{"games":[
+			{"id":2023020002,"gameType":2,"gameState":"FUT","awayTeam":{"abbrev":"TOR"},"homeTeam":{"abbrev":"MTL"}},
+			{"id":2023020001,"gameType":2,"gameState":"OFF","awayTeam":{"abbrev":"MTL"},"homeTeam":{"abbrev":"TOR"}}
+		]}`)
+	case "/gamecenter/2023020001/boxscore":
+		io.WriteString(w, `{"id":2023020001,"season":20232024,"gameType":2,"gameState":"OFF","gameScheduleState":"OK"}`)
+	case "/gamecenter/2023020001/play-by-play":
+		io.WriteString(w, `{"id":2023020001,"season":20232024,"gameType":2,"gameState":"OFF","gameScheduleState":"OK","plays":[]}`)
+	case "/en/shiftcharts":
+		io.WriteString(w, `{"data":[],"total":0}`)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *fakeAPI) count(path string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[path]
+}
+
+func newTestArchiver(t *testing.T, api *fakeAPI, done checkpoint.Checkpoint) (*archiver, string) {
+	t.Helper()
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	root := t.TempDir()
+	logger := log.New(io.Discard, "", 0)
+	return newArchiver(nhl.NewClientWithBaseURL(server.URL), root, 0, done, logger), root
+}
+
+func readJSONGz(t *testing.T, path string) map[string]any {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open %s: %v", path, err)
+	}
+	defer f.Close()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("gzip %s: %v", path, err)
+	}
+	var v map[string]any
+	if err := json.NewDecoder(zr).Decode(&v); err != nil {
+		t.Fatalf("decode %s: %v", path, err)
+	}
+	return v
+}
+
+func TestArchiveSeason(t *testing.T) {
+	api := &fakeAPI{requests: map[string]int{}}
+	a, root := newTestArchiver(t, api, nil)
+
+	stats, err := a.archiveSeason(context.Background(), nhl.NewSeason(2023))
+	if err != nil {
+		t.Fatalf("archiveSeason() error = %v", err)
+	}
+
+	// Two schedules plus three payloads for the single completed game.
+	if stats.fetched != 5 || stats.skipped != 0 || stats.failed != 0 {
+		t.Errorf("stats = %+v, want 5 fetched", stats)
+	}
+
+	seasonDir := filepath.Join(root, "20232024")
+	for _, rel := range []string{
+		"schedules/TOR.json.gz",
+		"schedules/MTL.json.gz",
+		"games/2023020001/boxscore.json.gz",
+		"games/2023020001/play-by-play.json.gz",
+		"games/2023020001/shiftcharts.json.gz",
+	} {
+		if !fileExists(filepath.Join(seasonDir, rel)) {
+			t.Errorf("missing %s", rel)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(seasonDir, "games", "2023020002")); !os.IsNotExist(err) {
+		t.Error("future game should not be archived")
+	}
+
+	box := readJSONGz(t, filepath.Join(seasonDir, "games/2023020001/boxscore.json.gz"))
+	if box["id"] != float64(2023020001) {
+		t.Errorf("boxscore id = %v, want 2023020001", box["id"])
+	}
+	if api.count("/gamecenter/2023020001/boxscore") != 1 {
+		t.Errorf("boxscore fetched %d times, want 1", api.count("/gamecenter/2023020001/boxscore"))
+	}
+}
+
+func TestArchiveSeason_Resume(t *testing.T) {
+	api := &fakeAPI{requests: map[string]int{}, failPath: "/gamecenter/2023020001/play-by-play"}
+	a, root := newTestArchiver(t, api, nil)
+	season := nhl.NewSeason(2023)
+
+	stats, err := a.archiveSeason(context.Background(), season)
+	if err == nil {
+		t.Fatal("archiveSeason() expected error for failed download")
+	}
+	if stats.failed != 1 {
+		t.Errorf("failed = %d, want 1", stats.failed)
+	}
+
+	// The second run only fetches what is missing.
+	api.failPath = ""
+	stats, err = a.archiveSeason(context.Background(), season)
+	if err != nil {
+		t.Fatalf("archiveSeason() resume error = %v", err)
+	}
+	// Two unchanged schedules and the two game files from the first run.
+	if stats.skipped != 4 {
+		t.Errorf("skipped = %d, want 4", stats.skipped)
+	}
+	if got := api.count("/gamecenter/2023020001/boxscore"); got != 1 {
+		t.Errorf("boxscore fetched %d times, want 1", got)
+	}
+	if got := api.count("/gamecenter/2023020001/play-by-play"); got != 2 {
+		t.Errorf("play-by-play fetched %d times, want 2", got)
+	}
+	if !fileExists(filepath.Join(root, "20232024/games/2023020001/play-by-play.json.gz")) {
+		t.Error("play-by-play missing after resume")
+	}
+}
+
+func TestArchiveSeason_Checkpoint(t *testing.T) {
+	api := &fakeAPI{requests: map[string]int{}}
+	done := &checkpoint.Memory{}
+	a, root := newTestArchiver(t, api, done)
+	season := nhl.NewSeason(2023)
+
+	if _, err := a.archiveSeason(context.Background(), season); err != nil {
+		t.Fatalf("archiveSeason() error = %v", err)
+	}
+	if !done.IsDone(2023020001) {
+		t.Fatal("completed game not checkpointed")
+	}
+	if done.IsDone(2023020002) {
+		t.Error("future game checkpointed")
+	}
+
+	// Checkpointed games are skipped even if their files were removed.
+	if err := os.RemoveAll(filepath.Join(root, "20232024", "games")); err != nil {
+		t.Fatal(err)
+	}
+	stats, err := a.archiveSeason(context.Background(), season)
+	if err != nil {
+		t.Fatalf("archiveSeason() resume error = %v", err)
+	}
+	if stats.skipped != 5 {
+		t.Errorf("skipped = %d, want 5", stats.skipped)
+	}
+	if got := api.count("/gamecenter/2023020001/boxscore"); got != 1 {
+		t.Errorf("boxscore fetched %d times, want 1", got)
+	}
+}
+
+func TestArchiveSeason_FailedGameNotCheckpointed(t *testing.T) {
+	api := &fakeAPI{requests: map[string]int{}, failPath: "/en/shiftcharts"}
+	done := &checkpoint.Memory{}
+	a, _ := newTestArchiver(t, api, done)
+
+	if _, err := a.archiveSeason(context.Background(), nhl.NewSeason(2023)); err == nil {
+		t.Fatal("archiveSeason() expected error")
+	}
+	if done.IsDone(2023020001) {
+		t.Error("partially archived game should not be checkpointed")
+	}
+}
+
+func TestArchiveSeason_Canceled(t *testing.T) {
+	api := &fakeAPI{requests: map[string]int{}}
+	a, _ := newTestArchiver(t, api, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := a.archiveSeason(ctx, nhl.NewSeason(2023)); err != context.Canceled {
+		t.Errorf("archiveSeason() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestPacer(t *testing.T) {
+	p := newPacer(20 * time.Millisecond)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := p.wait(ctx); err != nil {
+			t.Fatalf("wait() error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("three waits took %v, want at least 40ms", elapsed)
+	}
+}
+
+func TestWriteJSONGz_NoTempFilesLeft(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "nested", "value.json.gz")
+	written, err := writeJSONGz(path, map[string]int{"b": 2, "a": 1})
+	if err != nil || !written {
+		t.Fatalf("writeJSONGz() = %v, %v, want true, nil", written, err)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "value.json.gz" {
+		t.Errorf("directory entries = %v, want only value.json.gz", entries)
+	}
+	if got := readJSONGz(t, path); got["a"] != float64(1) {
+		t.Errorf("decoded = %v", got)
+	}
+}
+
+func TestWriteJSONGz_SkipsUnchanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "value.json.gz")
+	if _, err := writeJSONGz(path, map[string]int{"a": 1, "b": 2}); err != nil {
+		t.Fatalf("writeJSONGz() error = %v", err)
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	written, err := writeJSONGz(path, map[string]int{"b": 2, "a": 1})
+	if err != nil {
+		t.Fatalf("writeJSONGz() error = %v", err)
+	}
+	if written {
+		t.Error("writeJSONGz() rewrote unchanged content")
+	}
+	if info, _ := os.Stat(path); !info.ModTime().Equal(old) {
+		t.Error("unchanged file was modified")
+	}
+
+	written, err = writeJSONGz(path, map[string]int{"a": 1, "b": 3})
+	if err != nil || !written {
+		t.Fatalf("writeJSONGz() changed = %v, %v, want true, nil", written, err)
+	}
+	if got := readJSONGz(t, path); got["b"] != float64(3) {
+		t.Errorf("decoded = %v, want b=3", got)
+	}
+}
@@ -0,0 +1,84 @@
+// Command nhl-archive downloads a whole NHL season to disk.
+//
+// For the requested season it stores every team's schedule and, for each
+// completed game, the boxscore, play-by-play and shift chart as gzipped
+// canonical JSON:
+//
+//	<out>/<season>/schedules/<TEAM>.json.gz
+//	<out>/<season>/games/<gameID>/boxscore.json.gz
+//	<out>/<season>/games/<gameID>/play-by-play.json.gz
+//	<out>/<season>/games/<gameID>/shiftcharts.json.gz
+//
+// Completed games are recorded in <out>/<season>/checkpoint.txt and game
+// files already present on disk are skipped without a request, so an
+// interrupted run can simply be restarted with the same arguments; a final
+// game's payloads do not change. Schedules are fetched on every run, and a
+// schedule file whose content fingerprint is unchanged is not rewritten.
+// Files are written atomically, which means a crash never leaves a partial
+// file behind.
+//
+// Usage:
+//
+//	nhl-archive -season 20232024 -out ./archive
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl"
+	"github.com/sperano/nhl-api-go/nhl/checkpoint"
+)
+
+func main() {
+	var (
+		seasonFlag     = flag.String("season", "", "season to archive, as YYYYYYYY or YYYY-YYYY (default: current season)")
+		outDir         = flag.String("out", "archive", "output directory")
+		checkpointPath = flag.String("checkpoint", "", "checkpoint file (default: <out>/<season>/checkpoint.txt)")
+		delay          = flag.Duration("delay", 250*time.Millisecond, "minimum delay between API requests")
+		timeout        = flag.Duration("timeout", 30*time.Second, "per-request timeout")
+	)
+	flag.Parse()
+
+	logger := log.New(os.Stderr, "nhl-archive: ", 0)
+
+	season := nhl.CurrentSeason()
+	if *seasonFlag != "" {
+		var err error
+		season, err = nhl.Parse(*seasonFlag)
+		if err != nil {
+			logger.Fatalf("invalid -season: %v", err)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if *checkpointPath == "" {
+		seasonDir := filepath.Join(*outDir, season.APIString())
+		if err := os.MkdirAll(seasonDir, 0o755); err != nil {
+			logger.Fatal(err)
+		}
+		*checkpointPath = filepath.Join(seasonDir, "checkpoint.txt")
+	}
+	done, err := checkpoint.OpenFile(*checkpointPath)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	defer done.Close()
+
+	client := nhl.NewClientWithConfig(nhl.NewClientConfig(nhl.WithConfigTimeout(*timeout)))
+	a := newArchiver(client, *outDir, *delay, done, logger)
+
+	stats, err := a.archiveSeason(ctx, season)
+	logger.Printf("%s: %d fetched, %d skipped, %d failed", season, stats.fetched, stats.skipped, stats.failed)
+	if err != nil {
+		done.Close()
+		logger.Fatal(err)
+	}
+}
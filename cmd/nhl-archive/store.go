@@ -0,0 +1,94 @@
+package main
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/sperano/nhl-api-go/nhl/export"
+)
+
+// fileExists reports whether path exists as a regular file.
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// storedFingerprint returns the fingerprint of the gzipped JSON stored at
+// path, or "" if it is missing or unreadable.
+func storedFingerprint(path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return ""
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return ""
+	}
+	fp, err := export.FingerprintJSON(data)
+	if err != nil {
+		return ""
+	}
+	return fp
+}
+
+// writeJSONGz stores v as gzipped canonical JSON at path and reports whether
+// the file was written. If path already holds the same content, as judged by
+// export.FingerprintJSON, the existing file is left untouched. New content is
+// written to a temporary name and renamed into place so readers never see a
+// partially written archive entry.
+func writeJSONGz(path string, v any) (bool, error) {
+	data, err := export.MarshalCanonical(v)
+	if err != nil {
+		return false, err
+	}
+	if fileExists(path) {
+		if fp, err := export.FingerprintJSON(data); err == nil && storedFingerprint(path) == fp {
+			return false, nil
+		}
+	}
+	if err := writeFileGz(path, data); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// writeFileGz atomically writes data gzipped to path.
+func writeFileGz(path string, data []byte) error {
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating %s: %w", dir, err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("creating temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	zw := gzip.NewWriter(tmp)
+	if _, err := zw.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	if err := zw.Close(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("renaming %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Command nhl-proxy serves the NHL API through a single local egress point.
+//
+// Each route calls the corresponding client method, so responses are
+// decoded strictly into the library's models (unknown enum values are
+// rejected) and re-encoded before they are returned. Successful responses
+// are cached in memory for -ttl and upstream requests are spaced at least
+// -delay apart. Routes mirror the upstream api-web paths under /v1:
+//
+//	/v1/standings/now
+//	/v1/standings/{date}
+//	/v1/schedule/{date}
+//	/v1/score/{date}
+//	/v1/gamecenter/{gameID}/boxscore
+//	/v1/gamecenter/{gameID}/play-by-play
+//	/v1/gamecenter/{gameID}/landing
+//	/v1/gamecenter/{gameID}/shiftcharts
+//	/v1/player/{playerID}/landing
+//	/v1/player/{playerID}/game-log/{season}/{gameType}
+//	/v1/roster/{team}/current
+//	/v1/roster/{team}/{season}
+//	/v1/club-schedule-season/{team}/{season}
+//	/v1/club-stats/{team}/{season}/{gameType}
+//
+// Any route accepts ?lang=fr (or another supported language code) to
+// request localized content.
+//
+// Usage:
+//
+//	nhl-proxy -addr :8080 -ttl 1m
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl"
+)
+
+func main() {
+	var (
+		addr    = flag.String("addr", ":8080", "listen address")
+		ttl     = flag.Duration("ttl", time.Minute, "how long successful responses are cached (0 disables caching)")
+		delay   = flag.Duration("delay", 100*time.Millisecond, "minimum delay between upstream API requests")
+		timeout = flag.Duration("timeout", 10*time.Second, "per-request upstream timeout")
+	)
+	flag.Parse()
+
+	logger := log.New(os.Stderr, "nhl-proxy: ", log.LstdFlags)
+
+	client := nhl.NewClientWithConfig(nhl.NewClientConfig(nhl.WithConfigTimeout(*timeout)))
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           newProxy(client, *ttl, *delay, logger),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	logger.Printf("listening on %s", *addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Fatal(err)
+	}
+}
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl"
+)
+
+// fetchFunc calls the NHL API for one route. Errors returned as badRequest
+// are reported to the caller as 400s.
+type fetchFunc func(ctx context.Context, c *nhl.Client, r *http.Request) (any, error)
+
+// badRequest marks an error caused by invalid route parameters.
+type badRequest struct {
+	err error
+}
+
+func (e badRequest) Error() string { return e.err.Error() }
+
+// proxy re-exposes client methods as local HTTP routes.
+type proxy struct {
+	client *nhl.Client
+	cache  *cache
+	pace   *pacer
+	logger *log.Logger
+	mux    *http.ServeMux
+}
+
+func newProxy(client *nhl.Client, ttl, delay time.Duration, logger *log.Logger) *proxy {
+	p := &proxy{
+		client: client,
+		cache:  newCache(ttl),
+		pace:   newPacer(delay),
+		logger: logger,
+		mux:    http.NewServeMux(),
+	}
+
+	p.handle("/v1/standings/now", func(ctx context.Context, c *nhl.Client, _ *http.Request) (any, error) {
+		return c.CurrentLeagueStandings(ctx)
+	})
+	p.handle("/v1/standings/{date}", func(ctx context.Context, c *nhl.Client, r *http.Request) (any, error) {
+		date, err := dateParam(r)
+		if err != nil {
+			return nil, err
+		}
+		return c.LeagueStandingsForDate(ctx, date)
+	})
+	p.handle("/v1/schedule/{date}", func(ctx context.Context, c *nhl.Client, r *http.Request) (any, error) {
+		date, err := dateParam(r)
+		if err != nil {
+			return nil, err
+		}
+		return c.DailySchedule(ctx, date)
+	})
+	p.handle("/v1/score/{date}", func(ctx context.Context, c *nhl.Client, r *http.Request) (any, error) {
+		date, err := dateParam(r)
+		if err != nil {
+			return nil, err
+		}
+		return c.DailyScores(ctx, date)
+	})
+
+	gamecenter := map[string]func(context.Context, *nhl.Client, nhl.GameID) (any, error){
+		"boxscore":     func(ctx context.Context, c *nhl.Client, id nhl.GameID) (any, error) { return c.Boxscore(ctx, id) },
+		"play-by-play": func(ctx context.Context, c *nhl.Client, id nhl.GameID) (any, error) { return c.PlayByPlay(ctx, id) },
+		"landing":      func(ctx context.Context, c *nhl.Client, id nhl.GameID) (any, error) { return c.Landing(ctx, id) },
+		"shiftcharts":  func(ctx context.Context, c *nhl.Client, id nhl.GameID) (any, error) { return c.ShiftChart(ctx, id) },
+	}
+	for resource, fetch := range gamecenter {
+		p.handle("/v1/gamecenter/{gameID}/"+resource, func(ctx context.Context, c *nhl.Client, r *http.Request) (any, error) {
+			id, err := idParam(r, "gameID")
+			if err != nil {
+				return nil, err
+			}
+			return fetch(ctx, c, nhl.GameID(id))
+		})
+	}
+
+	p.handle("/v1/player/{playerID}/landing", func(ctx context.Context, c *nhl.Client, r *http.Request) (any, error) {
+		id, err := idParam(r, "playerID")
+		if err != nil {
+			return nil, err
+		}
+		return c.PlayerLanding(ctx, nhl.PlayerID(id))
+	})
+	p.handle("/v1/player/{playerID}/game-log/{season}/{gameType}", func(ctx context.Context, c *nhl.Client, r *http.Request) (any, error) {
+		id, err := idParam(r, "playerID")
+		if err != nil {
+			return nil, err
+		}
+		season, gameType, err := seasonAndGameTypeParams(r)
+		if err != nil {
+			return nil, err
+		}
+		return c.PlayerGameLog(ctx, nhl.PlayerID(id), season, gameType)
+	})
+	p.handle("/v1/roster/{team}/current", func(ctx context.Context, c *nhl.Client, r *http.Request) (any, error) {
+		return c.RosterCurrent(ctx, r.PathValue("team"))
+	})
+	p.handle("/v1/roster/{team}/{season}", func(ctx context.Context, c *nhl.Client, r *http.Request) (any, error) {
+		season, err := seasonParam(r)
+		if err != nil {
+			return nil, err
+		}
+		return c.RosterSeason(ctx, r.PathValue("team"), season)
+	})
+	p.handle("/v1/club-schedule-season/{team}/{season}", func(ctx context.Context, c *nhl.Client, r *http.Request) (any, error) {
+		season, err := seasonParam(r)
+		if err != nil {
+			return nil, err
+		}
+		return c.ClubScheduleSeason(ctx, r.PathValue("team"), season)
+	})
+	p.handle("/v1/club-stats/{team}/{season}/{gameType}", func(ctx context.Context, c *nhl.Client, r *http.Request) (any, error) {
+		season, gameType, err := seasonAndGameTypeParams(r)
+		if err != nil {
+			return nil, err
+		}
+		return c.ClubStats(ctx, r.PathValue("team"), season, gameType)
+	})
+	return p
+}
+
+func (p *proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mux.ServeHTTP(w, r)
+}
+
+// handle registers a GET route. Responses are cached by path and query.
+func (p *proxy) handle(pattern string, fetch fetchFunc) {
+	p.mux.HandleFunc("GET "+pattern, func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if id := r.Header.Get(nhl.RequestIDHeader); id != "" {
+			ctx = nhl.WithRequestID(ctx, id)
+			w.Header().Set(nhl.RequestIDHeader, id)
+		}
+		if code := r.URL.Query().Get("lang"); code != "" {
+			lang, err := nhl.LanguageFromString(code)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err)
+				return
+			}
+			ctx = nhl.WithLanguage(ctx, lang)
+		}
+
+		key := r.URL.RequestURI()
+		if body, ok := p.cache.get(key); ok {
+			w.Header().Set("X-Cache", "HIT")
+			writeBody(w, http.StatusOK, body)
+			return
+		}
+
+		if err := p.pace.wait(ctx); err != nil {
+			writeError(w, http.StatusServiceUnavailable, err)
+			return
+		}
+		v, err := fetch(ctx, p.client, r)
+		if err != nil {
+			status := statusFor(err)
+			if status >= 500 {
+				p.logger.Printf("%s: %v", key, err)
+			}
+			writeError(w, status, err)
+			return
+		}
+		body, err := json.Marshal(v)
+		if err != nil {
+			p.logger.Printf("%s: encoding response: %v", key, err)
+			writeError(w, http.StatusBadGateway, err)
+			return
+		}
+
+		p.cache.set(key, body)
+		w.Header().Set("X-Cache", "MISS")
+		writeBody(w, http.StatusOK, body)
+	})
+}
+
+// statusFor maps a fetch error to the status returned to the caller.
+// Upstream 4xx responses are passed through; everything else from the
+// upstream side is a bad gateway.
+func statusFor(err error) int {
+	var bad badRequest
+	if errors.As(err, &bad) {
+		return http.StatusBadRequest
+	}
+	var apiErr *nhl.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode >= 400 && apiErr.StatusCode < 500 {
+		return apiErr.StatusCode
+	}
+	return http.StatusBadGateway
+}
+
+func writeBody(w http.ResponseWriter, status int, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	body, _ := json.Marshal(map[string]any{"error": err.Error(), "status": status})
+	writeBody(w, status, body)
+}
+
+// ===== Route parameters =====
+
+func dateParam(r *http.Request) (nhl.GameDate, error) {
+	s := r.PathValue("date")
+	d, err := nhl.ParseDate(s)
+	if err != nil {
+		return nhl.GameDate{}, badRequest{fmt.Errorf("invalid date %q: expected YYYY-MM-DD", s)}
+	}
+	return nhl.FromDate(d.Time), nil
+}
+
+func idParam(r *http.Request, name string) (int64, error) {
+	s := r.PathValue(name)
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, badRequest{fmt.Errorf("invalid %s %q", name, s)}
+	}
+	return id, nil
+}
+
+func seasonParam(r *http.Request) (nhl.Season, error) {
+	season, err := nhl.Parse(r.PathValue("season"))
+	if err != nil {
+		return nhl.Season{}, badRequest{err}
+	}
+	return season, nil
+}
+
+func seasonAndGameTypeParams(r *http.Request) (nhl.Season, nhl.GameType, error) {
+	season, err := seasonParam(r)
+	if err != nil {
+		return nhl.Season{}, 0, err
+	}
+	gameType, err := nhl.GameTypeFromString(r.PathValue("gameType"))
+	if err != nil {
+		return nhl.Season{}, 0, badRequest{err}
+	}
+	return season, gameType, nil
+}
+
+// ===== Cache and pacing =====
+
+// cache holds encoded responses for a fixed time-to-live.
+type cache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{ttl: ttl, entries: make(map[string]cacheEntry)}
+}
+
+func (c *cache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.body, true
+}
+
+func (c *cache) set(key string, body []byte) {
+	if c.ttl <= 0 {
+		return
+	}
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Drop expired entries opportunistically so the map stays bounded by
+	// the number of distinct routes requested within one TTL.
+	for k, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = cacheEntry{body: body, expires: now.Add(c.ttl)}
+}
+
+// pacer enforces a minimum delay between upstream requests. It is safe for
+// concurrent use: each caller reserves the next free slot.
+type pacer struct {
+	delay time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newPacer(delay time.Duration) *pacer {
+	return &pacer{delay: delay}
+}
+
+// wait blocks until the caller's slot arrives or ctx is done.
+func (p *pacer) wait(ctx context.Context) error {
+	if p.delay <= 0 {
+		return ctx.Err()
+	}
+	p.mu.Lock()
+	now := time.Now()
+	slot := p.next
+	if slot.Before(now) {
+		slot = now
+	}
+	p.next = slot.Add(p.delay)
+	p.mu.Unlock()
+
+	if remaining := time.Until(slot); remaining > 0 {
+		timer := time.NewTimer(remaining)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl"
+)
+
+// fakeAPI serves a handful of upstream routes and counts requests per path.
+type fakeAPI struct {
+	mu            sync.Mutex
+	requests      map[string]int
+	lastRequestID string
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests[r.URL.Path]++
+	f.lastRequestID = r.Header.Get(nhl.RequestIDHeader)
+	f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/gamecenter/2023020204/boxscore":
+		io.WriteString(w, `{"id":2023020204,"season":20232024,"gameType":2,"gameState":"OFF","gameScheduleState":"OK",
+			"periodDescriptor":{"number":3,"periodType":"REG"},"awayTeam":{"abbrev":"TOR","score":3},"homeTeam":{"abbrev":"MTL","score":2}}`)
+	case "/gamecenter/2023020205/boxscore":
+		// An unknown game state fails strict decoding.
+		io.WriteString(w, `{"id":2023020205,"gameType":2,"gameState":"WAT","gameScheduleState":"OK"}`)
+	case "/roster/TOR/20232024":
+		io.WriteString(w, `{"forwards":[{"id":8478483,"firstName":{"default":"Mitch","fr":"Mitchell"},"lastName":{"default":"Marner"},"position":"RW"}],"defensemen":[],"goalies":[]}`)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *fakeAPI) count(path string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[path]
+}
+
+func newTestProxy(t *testing.T, ttl, delay time.Duration) (*proxy, *fakeAPI) {
+	t.Helper()
+	api := &fakeAPI{requests: make(map[string]int)}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	logger := log.New(io.Discard, "", 0)
+	return newProxy(nhl.NewClientWithBaseURL(server.URL), ttl, delay, logger), api
+}
+
+func get(t *testing.T, h http.Handler, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func TestProxyCachesResponses(t *testing.T) {
+	p, api := newTestProxy(t, time.Minute, 0)
+
+	first := get(t, p, "/v1/gamecenter/2023020204/boxscore")
+	if first.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", first.Code, first.Body)
+	}
+	if got := first.Header().Get("X-Cache"); got != "MISS" {
+		t.Errorf("first X-Cache = %q, want MISS", got)
+	}
+	if !strings.Contains(first.Body.String(), `"gameState":"OFF"`) {
+		t.Errorf("unexpected body %s", first.Body)
+	}
+
+	second := get(t, p, "/v1/gamecenter/2023020204/boxscore")
+	if got := second.Header().Get("X-Cache"); got != "HIT" {
+		t.Errorf("second X-Cache = %q, want HIT", got)
+	}
+	if second.Body.String() != first.Body.String() {
+		t.Error("cached body differs from original")
+	}
+	if n := api.count("/gamecenter/2023020204/boxscore"); n != 1 {
+		t.Errorf("upstream called %d times, want 1", n)
+	}
+}
+
+func TestProxyCacheDisabled(t *testing.T) {
+	p, api := newTestProxy(t, 0, 0)
+	get(t, p, "/v1/gamecenter/2023020204/boxscore")
+	get(t, p, "/v1/gamecenter/2023020204/boxscore")
+	if n := api.count("/gamecenter/2023020204/boxscore"); n != 2 {
+		t.Errorf("upstream called %d times, want 2", n)
+	}
+}
+
+func TestProxyLanguage(t *testing.T) {
+	p, _ := newTestProxy(t, time.Minute, 0)
+
+	en := get(t, p, "/v1/roster/TOR/20232024")
+	fr := get(t, p, "/v1/roster/TOR/20232024?lang=fr")
+	if !strings.Contains(en.Body.String(), `"default":"Mitch"`) {
+		t.Errorf("en body = %s", en.Body)
+	}
+	if !strings.Contains(fr.Body.String(), `"default":"Mitch","fr":"Mitchell"`) {
+		t.Errorf("fr body = %s", fr.Body)
+	}
+	if fr.Header().Get("X-Cache") != "MISS" {
+		t.Error("languages should be cached separately")
+	}
+}
+
+func TestProxyErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want int
+	}{
+		{"upstream not found", "/v1/gamecenter/2023020999/boxscore", http.StatusNotFound},
+		{"strict decoding", "/v1/gamecenter/2023020205/boxscore", http.StatusBadGateway},
+		{"bad game id", "/v1/gamecenter/abc/boxscore", http.StatusBadRequest},
+		{"bad date", "/v1/schedule/11-10-2023", http.StatusBadRequest},
+		{"bad season", "/v1/roster/TOR/2023", http.StatusBadRequest},
+		{"bad game type", "/v1/club-stats/TOR/20232024/5", http.StatusBadRequest},
+		{"bad language", "/v1/standings/now?lang=xx", http.StatusBadRequest},
+		{"unknown route", "/v1/nope", http.StatusNotFound},
+	}
+	p, _ := newTestProxy(t, time.Minute, 0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := get(t, p, tt.path)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d (body %s)", rec.Code, tt.want, rec.Body)
+			}
+		})
+	}
+}
+
+func TestProxyRequestID(t *testing.T) {
+	p, api := newTestProxy(t, time.Minute, 0)
+	req := httptest.NewRequest(http.MethodGet, "/v1/gamecenter/2023020999/boxscore", nil)
+	req.Header.Set(nhl.RequestIDHeader, "req-42")
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get(nhl.RequestIDHeader); got != "req-42" {
+		t.Errorf("response %s = %q, want req-42", nhl.RequestIDHeader, got)
+	}
+	if !strings.Contains(rec.Body.String(), "[request req-42]") {
+		t.Errorf("error body should carry the request ID: %s", rec.Body)
+	}
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	if api.lastRequestID != "req-42" {
+		t.Errorf("upstream %s = %q, want req-42", nhl.RequestIDHeader, api.lastRequestID)
+	}
+}
+
+func TestProxyDoesNotCacheErrors(t *testing.T) {
+	p, api := newTestProxy(t, time.Minute, 0)
+	get(t, p, "/v1/gamecenter/2023020999/boxscore")
+	get(t, p, "/v1/gamecenter/2023020999/boxscore")
+	if n := api.count("/gamecenter/2023020999/boxscore"); n != 2 {
+		t.Errorf("upstream called %d times, want 2", n)
+	}
+}
+
+func TestPacerSpacesConcurrentCallers(t *testing.T) {
+	const delay = 20 * time.Millisecond
+	p := newPacer(delay)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := p.wait(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if elapsed := time.Since(start); elapsed < 2*delay {
+		t.Errorf("three calls took %v, want at least %v", elapsed, 2*delay)
+	}
+}
+
+func TestPacerCanceled(t *testing.T) {
+	p := newPacer(time.Hour)
+	p.wait(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := p.wait(ctx); err != context.Canceled {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,216 @@
+// aliasgen re-exports package model from package nhl.
+//
+// It produces a type alias for every exported type of nhl/model, the same
+// for every exported constant and variable, and a wrapper for every
+// exported function, so code written against nhl before the models moved
+// keeps compiling. Methods come with the aliased types.
+//
+// The generated file is written to model_generated.go in the current
+// directory, which must be the nhl/ package directory when invoked via
+// go:generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const modelImport = "github.com/sperano/nhl-api-go/nhl/model"
+
+// function is an exported function of package model and the imports its
+// signature needs.
+type function struct {
+	decl    *ast.FuncDecl
+	imports map[string]string // package name to import path
+}
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, "model", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatalf("parse model: %v", err)
+	}
+	pkg, ok := pkgs["model"]
+	if !ok {
+		log.Fatal("no package model in model/")
+	}
+
+	var types, consts, vars []string
+	var funcs []function
+	files := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		files = append(files, name)
+	}
+	slices.Sort(files)
+	for _, name := range files {
+		file := pkg.Files[name]
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					switch s := spec.(type) {
+					case *ast.TypeSpec:
+						if s.Name.IsExported() {
+							if s.TypeParams != nil {
+								log.Fatalf("generic type %s is not supported", s.Name.Name)
+							}
+							types = append(types, s.Name.Name)
+						}
+					case *ast.ValueSpec:
+						for _, n := range s.Names {
+							if !n.IsExported() {
+								continue
+							}
+							if d.Tok == token.CONST {
+								consts = append(consts, n.Name)
+							} else {
+								vars = append(vars, n.Name)
+							}
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Recv == nil && d.Name.IsExported() {
+					funcs = append(funcs, function{d, fileImports(file)})
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	writeHeader(&buf, fset, funcs)
+	writeBlock(&buf, "type", "Model types, aliased so that both package names refer to the same types.", types)
+	writeBlock(&buf, "const", "Constants of package model.", consts)
+	writeBlock(&buf, "var", "Variables of package model. They share their values with it.", vars)
+	for _, f := range funcs {
+		writeFunc(&buf, fset, f.decl)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		// Write unformatted for debugging
+		os.WriteFile("model_generated.go", buf.Bytes(), 0644)
+		log.Fatalf("gofmt failed (wrote unformatted output for debugging): %v", err)
+	}
+	if err := os.WriteFile("model_generated.go", formatted, 0644); err != nil {
+		log.Fatalf("write model_generated.go: %v", err)
+	}
+}
+
+// fileImports maps the package names a file uses to their import paths.
+func fileImports(file *ast.File) map[string]string {
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		name := path.Base(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = p
+	}
+	return imports
+}
+
+func writeHeader(w *bytes.Buffer, fset *token.FileSet, funcs []function) {
+	needed := map[string]bool{modelImport: true}
+	for _, f := range funcs {
+		ast.Inspect(f.decl.Type, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if id, ok := sel.X.(*ast.Ident); ok {
+					if p, ok := f.imports[id.Name]; ok {
+						needed[p] = true
+					}
+				}
+			}
+			return true
+		})
+	}
+	imports := make([]string, 0, len(needed))
+	for p := range needed {
+		imports = append(imports, p)
+	}
+	slices.Sort(imports)
+
+	fmt.Fprintf(w, "// Code generated by internal/aliasgen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(w, "package nhl\n\n")
+	fmt.Fprintf(w, "import (\n")
+	for _, p := range imports {
+		if p != modelImport {
+			fmt.Fprintf(w, "\t%q\n", p)
+		}
+	}
+	fmt.Fprintf(w, "\n\t%q\n)\n\n", modelImport)
+}
+
+func writeBlock(w *bytes.Buffer, tok, doc string, names []string) {
+	if len(names) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "// %s\n%s (\n", doc, tok)
+	for _, n := range names {
+		fmt.Fprintf(w, "\t%s = model.%s\n", n, n)
+	}
+	fmt.Fprintf(w, ")\n\n")
+}
+
+// writeFunc writes a wrapper with the signature of d that calls the model
+// function, keeping a Deprecated paragraph of its doc comment.
+func writeFunc(w *bytes.Buffer, fset *token.FileSet, d *ast.FuncDecl) {
+	name := d.Name.Name
+	fmt.Fprintf(w, "// %s calls model.%s.\n", name, name)
+	if d.Doc != nil {
+		text := d.Doc.Text()
+		if i := strings.Index(text, "Deprecated:"); i >= 0 {
+			fmt.Fprintf(w, "//\n")
+			for _, line := range strings.Split(strings.TrimSpace(text[i:]), "\n") {
+				fmt.Fprintf(w, "// %s\n", line)
+			}
+		}
+	}
+
+	sig := &ast.FuncDecl{Name: d.Name, Type: d.Type}
+	var decl bytes.Buffer
+	printer.Fprint(&decl, fset, sig)
+
+	var args []string
+	variadic := false
+	for _, field := range d.Type.Params.List {
+		_, variadic = field.Type.(*ast.Ellipsis)
+		for _, n := range field.Names {
+			args = append(args, n.Name)
+		}
+	}
+	if variadic {
+		args[len(args)-1] += "..."
+	}
+	var typeArgs []string
+	if d.Type.TypeParams != nil {
+		for _, field := range d.Type.TypeParams.List {
+			for _, n := range field.Names {
+				typeArgs = append(typeArgs, n.Name)
+			}
+		}
+	}
+	call := "model." + name
+	if len(typeArgs) > 0 {
+		call += "[" + strings.Join(typeArgs, ", ") + "]"
+	}
+	call += "(" + strings.Join(args, ", ") + ")"
+	if d.Type.Results != nil {
+		call = "return " + call
+	}
+	fmt.Fprintf(w, "%s {\n\t%s\n}\n\n", decl.String(), call)
+}
@@ -9,6 +9,7 @@ type EnumDef struct {
 	HasName               bool   // generate Name() method, String() returns Name()
 	HasDisplayName        bool   // String() uses switch with display names (when HasName is false)
 	AllowEmpty            bool   // allow empty string in UnmarshalJSON/MarshalJSON
+	EmptyReason           string // why empty is allowed; documented on the JSON methods (requires AllowEmpty)
 	SkipMarshalValidation bool   // don't validate in MarshalJSON (e.g., Handedness)
 	Values                []ValueDef
 }
@@ -75,11 +76,13 @@ var enums = []EnumDef{
 		},
 	},
 	{
-		TypeName:   "PeriodType",
-		Doc:        "PeriodType represents the type of period in a hockey game.",
-		ErrorLabel: "period type",
-		HasCode:    true,
-		HasName:    true,
+		TypeName:    "PeriodType",
+		Doc:         "PeriodType represents the type of period in a hockey game.",
+		ErrorLabel:  "period type",
+		HasCode:     true,
+		HasName:     true,
+		AllowEmpty:  true,
+		EmptyReason: "the NHL API omits periodType for unplayed games",
 		Values: []ValueDef{
 			{Name: "PeriodTypeRegulation", Value: "REG", DisplayName: "Regulation", Aliases: []string{"REG", "Regulation"}, Doc: "PeriodTypeRegulation represents a regulation period."},
 			{Name: "PeriodTypeOvertime", Value: "OT", DisplayName: "Overtime", Aliases: []string{"OT", "Overtime"}, Doc: "PeriodTypeOvertime represents an overtime period."},
@@ -173,4 +176,21 @@ var enums = []EnumDef{
 			{Name: "GameStateCritical", Value: "CRIT", Doc: "GameStateCritical represents a game in critical state."},
 		},
 	},
+	{
+		TypeName:   "Language",
+		Doc:        "Language represents a content language supported by the NHL API.",
+		ErrorLabel: "language",
+		HasCode:    true,
+		HasName:    true,
+		Values: []ValueDef{
+			{Name: "LanguageEnglish", Value: "en", DisplayName: "English", Aliases: []string{"en", "en-us", "en-ca", "English"}, Doc: "LanguageEnglish represents English content (the API default)."},
+			{Name: "LanguageFrench", Value: "fr", DisplayName: "French", Aliases: []string{"fr", "fr-ca", "fr-fr", "French"}, Doc: "LanguageFrench represents French content."},
+			{Name: "LanguageCzech", Value: "cs", DisplayName: "Czech", Aliases: []string{"cs", "cs-cz", "Czech"}, Doc: "LanguageCzech represents Czech content."},
+			{Name: "LanguageGerman", Value: "de", DisplayName: "German", Aliases: []string{"de", "de-de", "German"}, Doc: "LanguageGerman represents German content."},
+			{Name: "LanguageSpanish", Value: "es", DisplayName: "Spanish", Aliases: []string{"es", "es-es", "Spanish"}, Doc: "LanguageSpanish represents Spanish content."},
+			{Name: "LanguageFinnish", Value: "fi", DisplayName: "Finnish", Aliases: []string{"fi", "fi-fi", "Finnish"}, Doc: "LanguageFinnish represents Finnish content."},
+			{Name: "LanguageSlovak", Value: "sk", DisplayName: "Slovak", Aliases: []string{"sk", "sk-sk", "Slovak"}, Doc: "LanguageSlovak represents Slovak content."},
+			{Name: "LanguageSwedish", Value: "sv", DisplayName: "Swedish", Aliases: []string{"sv", "sv-se", "Swedish"}, Doc: "LanguageSwedish represents Swedish content."},
+		},
+	},
 }
@@ -37,7 +37,7 @@ func main() {
 
 func writeHeader(w *bytes.Buffer) {
 	fmt.Fprintf(w, "// Code generated by internal/enumgen; DO NOT EDIT.\n\n")
-	fmt.Fprintf(w, "package nhl\n\n")
+	fmt.Fprintf(w, "package model\n\n")
 	fmt.Fprintf(w, "import (\n")
 	fmt.Fprintf(w, "\t\"encoding/json\"\n")
 	fmt.Fprintf(w, "\t\"fmt\"\n")
@@ -153,6 +153,9 @@ func writeEnum(w *bytes.Buffer, e EnumDef) {
 
 	// UnmarshalJSON()
 	fmt.Fprintf(w, "// UnmarshalJSON implements custom JSON unmarshaling for %s.\n", e.TypeName)
+	if e.EmptyReason != "" {
+		fmt.Fprintf(w, "// Empty strings are accepted because %s.\n", e.EmptyReason)
+	}
 	fmt.Fprintf(w, "func (v *%s) UnmarshalJSON(data []byte) error {\n", e.TypeName)
 	fmt.Fprintf(w, "\tvar s string\n")
 	fmt.Fprintf(w, "\tif err := json.Unmarshal(data, &s); err != nil {\n\t\treturn err\n\t}\n")
@@ -167,6 +170,10 @@ func writeEnum(w *bytes.Buffer, e EnumDef) {
 
 	// MarshalJSON()
 	fmt.Fprintf(w, "// MarshalJSON implements custom JSON marshaling for %s.\n", e.TypeName)
+	if e.EmptyReason != "" {
+		fmt.Fprintf(w, "// Empty strings are allowed because %s,\n", e.EmptyReason)
+		fmt.Fprintf(w, "// leaving the Go zero value which must round-trip through JSON.\n")
+	}
 	fmt.Fprintf(w, "func (v %s) MarshalJSON() ([]byte, error) {\n", e.TypeName)
 	if e.SkipMarshalValidation {
 		fmt.Fprintf(w, "\treturn json.Marshal(string(v))\n")
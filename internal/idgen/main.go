@@ -4,7 +4,7 @@
 // MarshalJSON(), UnmarshalJSON(), XxxFromInt(), XxxFromString(), MustXxxFromString().
 //
 // The generated file is written to ids_generated.go in the current directory,
-// which must be the nhl/ package directory when invoked via go:generate.
+// which must be the nhl/model/ package directory when invoked via go:generate.
 package main
 
 import (
@@ -37,7 +37,7 @@ func main() {
 
 func writeHeader(w *bytes.Buffer) {
 	fmt.Fprintf(w, "// Code generated by internal/idgen; DO NOT EDIT.\n\n")
-	fmt.Fprintf(w, "package nhl\n\n")
+	fmt.Fprintf(w, "package model\n\n")
 	fmt.Fprintf(w, "import (\n")
 	fmt.Fprintf(w, "\t\"encoding/json\"\n")
 	fmt.Fprintf(w, "\t\"strconv\"\n")
@@ -0,0 +1,62 @@
+package nhl
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestModelAliases checks that model_generated.go re-exports every
+// exported name of package model; run go generate when it fails.
+func TestModelAliases(t *testing.T) {
+	exported := func(path string) map[string]bool {
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			t.Fatal(err)
+		}
+		names := make(map[string]bool)
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil && decl.Name.IsExported() {
+					names[decl.Name.Name] = true
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						if spec.Name.IsExported() {
+							names[spec.Name.Name] = true
+						}
+					case *ast.ValueSpec:
+						for _, n := range spec.Names {
+							if n.IsExported() {
+								names[n.Name] = true
+							}
+						}
+					}
+				}
+			}
+		}
+		return names
+	}
+
+	aliases := exported("model_generated.go")
+	files, err := filepath.Glob(filepath.Join("model", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		for name := range exported(path) {
+			if !aliases[name] {
+				t.Errorf("model.%s (%s) is not re-exported by package nhl", name, filepath.Base(path))
+			}
+		}
+	}
+}
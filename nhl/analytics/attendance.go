@@ -0,0 +1,171 @@
+package analytics
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// VenueCapacity is the hockey seating capacity of each club's current home
+// arena, used by AttendanceReport to count sellouts. Capacities change with
+// renovations and standing-room sales; replace an entry to use another
+// figure, e.g. for a season played in a former arena.
+var VenueCapacity = map[model.TeamAbbrev]int{
+	model.TeamANA: 17174,
+	model.TeamBOS: 17850,
+	model.TeamBUF: 19070,
+	model.TeamCAR: 18700,
+	model.TeamCBJ: 18144,
+	model.TeamCGY: 19289,
+	model.TeamCHI: 19717,
+	model.TeamCOL: 18007,
+	model.TeamDAL: 18532,
+	model.TeamDET: 19515,
+	model.TeamEDM: 18347,
+	model.TeamFLA: 19250,
+	model.TeamLAK: 18230,
+	model.TeamMIN: 17954,
+	model.TeamMTL: 21105,
+	model.TeamNJD: 16514,
+	model.TeamNSH: 17159,
+	model.TeamNYI: 17255,
+	model.TeamNYR: 18006,
+	model.TeamOTT: 18652,
+	model.TeamPHI: 19537,
+	model.TeamPIT: 18387,
+	model.TeamSEA: 17151,
+	model.TeamSJS: 17562,
+	model.TeamSTL: 18096,
+	model.TeamTBL: 19092,
+	model.TeamTOR: 18800,
+	model.TeamUTA: 11131,
+	model.TeamVAN: 18910,
+	model.TeamVGK: 17500,
+	model.TeamWPG: 15225,
+	model.TeamWSH: 18573,
+}
+
+// GameAttendance is the crowd at one home game.
+type GameAttendance struct {
+	GameID   model.GameID
+	GameDate string
+	Opponent string
+	// Attendance is nil when the boxscore does not report it.
+	Attendance *int
+	Sellout    bool
+}
+
+// TeamAttendance is a club's home attendance over a season.
+type TeamAttendance struct {
+	Team   model.TeamAbbrev
+	Season model.Season
+	// Venue is the home arena: the venue of most of the club's home games.
+	Venue string
+	// Capacity is the arena capacity from VenueCapacity, or 0 when unknown,
+	// in which case no game counts as a sellout.
+	Capacity int
+	// Games lists the home games played at Venue, in date order.
+	Games []GameAttendance
+	// Elsewhere lists the home games played at another venue, such as
+	// outdoor and Global Series games, which are left out of the totals.
+	Elsewhere []model.GameID
+	// Reported counts the games with an attendance figure, and Total and
+	// Average are over those games.
+	Reported int
+	Total    int
+	Average  float64
+	// CapacityPctg is Average over Capacity, as a fraction; it exceeds 1
+	// when standing room is sold.
+	CapacityPctg float64
+	Sellouts     int
+	// LongestSelloutStreak and CurrentSelloutStreak count consecutive
+	// sellouts among the games with an attendance figure.
+	LongestSelloutStreak int
+	CurrentSelloutStreak int
+}
+
+// AttendanceReport totals a club's regular-season home attendance for a
+// season from the boxscores of its final home games. A game sells out when
+// its attendance reaches the arena's VenueCapacity; games whose boxscore
+// has no attendance are listed but neither counted nor breaking a streak.
+// Home games at a neutral site, outdoors or abroad are not played in the
+// home arena, so they are listed in Elsewhere and left out.
+//
+// The call makes one request per schedule week plus one boxscore request
+// per home game.
+func AttendanceReport(ctx context.Context, src TeamScheduleSource, team model.TeamAbbrev, season model.Season) (*TeamAttendance, error) {
+	schedule, err := src.TeamFullSeasonSchedule(ctx, team.String(), season)
+	if err != nil {
+		return nil, err
+	}
+	var home []model.ScheduleGame
+	for _, g := range schedule {
+		if g.GameType == model.GameTypeRegularSeason && g.GameState.IsFinal() && strings.EqualFold(g.HomeTeam.Abbrev, string(team)) {
+			home = append(home, g)
+		}
+	}
+	slices.SortStableFunc(home, func(a, b model.ScheduleGame) int { return strings.Compare(a.StartTimeUTC, b.StartTimeUTC) })
+
+	report := &TeamAttendance{Team: team, Season: season, Venue: homeVenue(home), Capacity: VenueCapacity[team]}
+	streak := 0
+	for _, g := range home {
+		if v := venueName(g); v != "" && v != report.Venue {
+			report.Elsewhere = append(report.Elsewhere, g.ID)
+			continue
+		}
+		box, err := src.Boxscore(ctx, g.ID)
+		if err != nil {
+			return nil, err
+		}
+		game := GameAttendance{GameID: g.ID, GameDate: box.GameDate, Opponent: g.AwayTeam.Abbrev, Attendance: box.Attendance}
+		if box.Attendance != nil {
+			report.Reported++
+			report.Total += *box.Attendance
+			game.Sellout = report.Capacity > 0 && *box.Attendance >= report.Capacity
+			if game.Sellout {
+				report.Sellouts++
+				streak++
+				report.LongestSelloutStreak = max(report.LongestSelloutStreak, streak)
+			} else {
+				streak = 0
+			}
+		}
+		report.Games = append(report.Games, game)
+	}
+	report.CurrentSelloutStreak = streak
+	if report.Reported > 0 {
+		report.Average = float64(report.Total) / float64(report.Reported)
+		if report.Capacity > 0 {
+			report.CapacityPctg = report.Average / float64(report.Capacity)
+		}
+	}
+	return report, nil
+}
+
+// homeVenue returns the venue most of the games were played at, or "" when
+// the schedule names none.
+func homeVenue(games []model.ScheduleGame) string {
+	counts := make(map[string]int)
+	venue := ""
+	for _, g := range games {
+		v := venueName(g)
+		if v == "" {
+			continue
+		}
+		counts[v]++
+		if counts[v] > counts[venue] {
+			venue = v
+		}
+	}
+	return venue
+}
+
+// venueName returns the name of a game's venue, or "" when it is unknown.
+func venueName(g model.ScheduleGame) string {
+	if g.Venue == nil {
+		return ""
+	}
+	return g.Venue.Default
+}
@@ -0,0 +1,80 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl"
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func TestAttendanceReport(t *testing.T) {
+	game := func(id int, start, state, away, home string) map[string]any {
+		return map[string]any{
+			"id": id, "gameType": 2, "startTimeUTC": start, "gameState": state,
+			"awayTeam": map[string]any{"abbrev": away}, "homeTeam": map[string]any{"abbrev": home},
+			"venue": map[string]any{"default": "Centre Bell"},
+		}
+	}
+	globalSeries := game(2024020005, "2024-10-17T17:00:00Z", "OFF", "CHI", "MTL")
+	globalSeries["venue"] = map[string]any{"default": "Avicii Arena"}
+	attendance := map[string]any{
+		"2024020001": 21105,
+		"2024020003": 21105,
+		"2024020004": 20911,
+		"2024020005": 13000,
+		"2024020006": nil,
+		"2024020007": 21105,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/club-schedule/MTL/week/"):
+			json.NewEncoder(w).Encode(map[string]any{"games": []any{
+				game(2024020004, "2024-10-15T23:00:00Z", "OFF", "PIT", "MTL"),
+				game(2024020001, "2024-10-09T23:00:00Z", "OFF", "TOR", "MTL"),
+				game(2024020002, "2024-10-12T23:00:00Z", "OFF", "MTL", "OTT"),
+				game(2024020003, "2024-10-13T23:00:00Z", "OFF", "BOS", "MTL"),
+				globalSeries,
+				game(2024020006, "2024-10-18T23:00:00Z", "OFF", "NYR", "MTL"),
+				game(2024020007, "2024-10-20T23:00:00Z", "OFF", "SEA", "MTL"),
+				game(2024020008, "2024-10-22T23:00:00Z", "FUT", "BUF", "MTL"),
+			}})
+		case strings.HasSuffix(r.URL.Path, "/boxscore"):
+			id := strings.Split(r.URL.Path, "/")[2]
+			box := map[string]any{"id": json.Number(id)}
+			if a := attendance[id]; a != nil {
+				box["attendance"] = a
+			}
+			json.NewEncoder(w).Encode(box)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	report, err := AttendanceReport(context.Background(), nhl.NewClientWithBaseURL(server.URL), model.TeamMTL, model.NewSeason(2024))
+	if err != nil {
+		t.Fatalf("AttendanceReport() error = %v", err)
+	}
+	if len(report.Games) != 5 || report.Games[0].GameID != 2024020001 || report.Games[3].Attendance != nil {
+		t.Fatalf("Games = %+v", report.Games)
+	}
+	if report.Venue != "Centre Bell" || len(report.Elsewhere) != 1 || report.Elsewhere[0] != 2024020005 {
+		t.Errorf("Venue = %q, Elsewhere = %v; want the Global Series game left out", report.Venue, report.Elsewhere)
+	}
+	if report.Reported != 4 || report.Total != 84226 || report.Sellouts != 3 {
+		t.Errorf("Reported = %d, Total = %d, Sellouts = %d", report.Reported, report.Total, report.Sellouts)
+	}
+	if report.LongestSelloutStreak != 2 || report.CurrentSelloutStreak != 1 {
+		t.Errorf("streaks = %d longest, %d current, want 2 and 1", report.LongestSelloutStreak, report.CurrentSelloutStreak)
+	}
+	if report.Average != 21056.5 || report.CapacityPctg <= 0.99 || report.CapacityPctg >= 1 {
+		t.Errorf("Average = %v, CapacityPctg = %v", report.Average, report.CapacityPctg)
+	}
+}
@@ -0,0 +1,109 @@
+package analytics
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// ComebackRecord is a team's resilience and collapses across games, read
+// from each game's score timeline.
+type ComebackRecord struct {
+	Team  string
+	Games int
+
+	// ComebackWins counts wins after trailing by two goals or more, and
+	// LargestComeback is the largest deficit overcome in a win.
+	ComebackWins    int
+	LargestComeback int
+
+	// BlownLeads counts losses after leading by two goals or more, and
+	// LargestBlownLead is the largest lead lost.
+	BlownLeads       int
+	LargestBlownLead int
+
+	// ThirdPeriodComebacks counts wins when trailing after two periods,
+	// and BlownThirdPeriodLeads losses when leading after two periods.
+	ThirdPeriodComebacks  int
+	BlownThirdPeriodLeads int
+}
+
+// Comebacks replays the goals in the play-by-play of final games and
+// reports, per team, multi-goal comebacks and blown leads, and results
+// after trailing or leading entering the third period. Losses include
+// overtime and shootout losses; shootout goals do not change the score
+// timeline. Nil play-by-plays and games that are not final are skipped.
+//
+// Records are ordered by comeback wins, then fewer blown leads, then team.
+func Comebacks(games []*model.PlayByPlay) []ComebackRecord {
+	records := make(map[string]*ComebackRecord)
+	record := func(team string) *ComebackRecord {
+		r := records[team]
+		if r == nil {
+			r = &ComebackRecord{Team: team}
+			records[team] = r
+		}
+		return r
+	}
+
+	for _, pbp := range games {
+		if pbp == nil {
+			continue
+		}
+		winner, loser, _, ok := finalResult(pbp)
+		if !ok {
+			continue
+		}
+		homeWon := winner == pbp.HomeTeam.Abbrev
+
+		// Track the home margin: its low point is the home team's largest
+		// deficit and its high point the away team's.
+		var low, high, afterTwo int
+		goals := pbp.Goals()
+		slices.SortStableFunc(goals, func(a, b *model.PlayEvent) int { return cmp.Compare(a.SortOrder, b.SortOrder) })
+		for _, g := range goals {
+			if g.PeriodDescriptor.PeriodType == model.PeriodTypeShootout || g.Details == nil ||
+				g.Details.AwayScore == nil || g.Details.HomeScore == nil {
+				continue
+			}
+			margin := *g.Details.HomeScore - *g.Details.AwayScore
+			low, high = min(low, margin), max(high, margin)
+			if g.PeriodDescriptor.Number <= 2 {
+				afterTwo = margin
+			}
+		}
+
+		w, l := record(winner), record(loser)
+		w.Games++
+		l.Games++
+		// The winner's deficit and the loser's lead are the same swing.
+		swing := high
+		if homeWon {
+			swing = -low
+		}
+		if swing >= 2 {
+			w.ComebackWins++
+			l.BlownLeads++
+		}
+		w.LargestComeback = max(w.LargestComeback, swing)
+		l.LargestBlownLead = max(l.LargestBlownLead, swing)
+		if (homeWon && afterTwo < 0) || (!homeWon && afterTwo > 0) {
+			w.ThirdPeriodComebacks++
+			l.BlownThirdPeriodLeads++
+		}
+	}
+
+	result := make([]ComebackRecord, 0, len(records))
+	for _, r := range records {
+		result = append(result, *r)
+	}
+	slices.SortFunc(result, func(a, b ComebackRecord) int {
+		return cmp.Or(
+			cmp.Compare(b.ComebackWins, a.ComebackWins),
+			cmp.Compare(a.BlownLeads, b.BlownLeads),
+			cmp.Compare(a.Team, b.Team),
+		)
+	})
+	return result
+}
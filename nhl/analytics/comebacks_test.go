@@ -0,0 +1,63 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// scoreChange builds a TOR (away) or MTL (home) goal in a period that
+// leaves the score at away-home.
+func scoreChange(period int, team model.TeamID, away, home int) model.PlayEvent {
+	play := scoredGoal(model.PeriodTypeRegulation, team, 1)
+	if period > 3 {
+		play.PeriodDescriptor.PeriodType = model.PeriodTypeOvertime
+	}
+	play.PeriodDescriptor.Number = period
+	play.Details.AwayScore, play.Details.HomeScore = &away, &home
+	return play
+}
+
+func TestComebacks(t *testing.T) {
+	games := []*model.PlayByPlay{
+		// MTL leads 2-0 after two periods; TOR wins 3-2 in overtime.
+		finalAfter(shootoutGame(
+			scoreChange(1, 8, 0, 1),
+			scoreChange(2, 8, 0, 2),
+			scoreChange(3, 10, 1, 2),
+			scoreChange(3, 10, 2, 2),
+			scoreChange(4, 10, 3, 2),
+		), model.PeriodTypeOvertime, 3, 2),
+		// TOR leads 3-0, MTL ties it before the third and wins 4-3.
+		finalAfter(shootoutGame(
+			scoreChange(1, 10, 1, 0),
+			scoreChange(1, 10, 2, 0),
+			scoreChange(1, 10, 3, 0),
+			scoreChange(2, 8, 3, 1),
+			scoreChange(2, 8, 3, 2),
+			scoreChange(2, 8, 3, 3),
+			scoreChange(3, 8, 3, 4),
+		), model.PeriodTypeRegulation, 3, 4),
+		// Wire to wire: MTL never trails.
+		finalAfter(shootoutGame(
+			scoreChange(1, 8, 0, 1),
+		), model.PeriodTypeRegulation, 0, 1),
+		// In progress: skipped.
+		shootoutGame(scoreChange(1, 10, 5, 0)),
+		nil,
+	}
+
+	records := Comebacks(games)
+	want := []ComebackRecord{
+		{Team: "MTL", Games: 3, ComebackWins: 1, LargestComeback: 3, BlownLeads: 1, LargestBlownLead: 2, BlownThirdPeriodLeads: 1},
+		{Team: "TOR", Games: 3, ComebackWins: 1, LargestComeback: 2, BlownLeads: 1, LargestBlownLead: 3, ThirdPeriodComebacks: 1},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("records = %+v", records)
+	}
+	for i := range want {
+		if records[i] != want[i] {
+			t.Errorf("records[%d] = %+v, want %+v", i, records[i], want[i])
+		}
+	}
+}
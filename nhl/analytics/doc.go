@@ -0,0 +1,6 @@
+// Package analytics provides derived metrics computed from NHL API models.
+//
+// Functions in this package are pure computations over the types of
+// nhl/model; it does not import the nhl client. The few that fetch their own
+// data take a source interface, which *nhl.Client implements.
+package analytics
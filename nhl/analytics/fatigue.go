@@ -0,0 +1,257 @@
+package analytics
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// VenueDB locates where games are played, as UTC offsets in hours of
+// standard time, for FatigueIndex to count time zone changes. Daylight
+// saving time shifts nearly every club together, so standard offsets
+// measure travel well enough.
+type VenueDB struct {
+	// Home maps each club to its home arena's offset.
+	Home map[model.TeamAbbrev]int
+	// Venues maps venue names, as the schedule spells them, to offsets.
+	// It is checked first, for games away from the home team's arena
+	// such as outdoor games and international series.
+	Venues map[string]int
+}
+
+// DefaultVenueDB holds the offsets of the clubs' current home arenas. Copy
+// it and add Venues entries for neutral sites.
+var DefaultVenueDB = VenueDB{
+	Home: map[model.TeamAbbrev]int{
+		model.TeamANA: -8,
+		model.TeamBOS: -5,
+		model.TeamBUF: -5,
+		model.TeamCAR: -5,
+		model.TeamCBJ: -5,
+		model.TeamCGY: -7,
+		model.TeamCHI: -6,
+		model.TeamCOL: -7,
+		model.TeamDAL: -6,
+		model.TeamDET: -5,
+		model.TeamEDM: -7,
+		model.TeamFLA: -5,
+		model.TeamLAK: -8,
+		model.TeamMIN: -6,
+		model.TeamMTL: -5,
+		model.TeamNJD: -5,
+		model.TeamNSH: -6,
+		model.TeamNYI: -5,
+		model.TeamNYR: -5,
+		model.TeamOTT: -5,
+		model.TeamPHI: -5,
+		model.TeamPIT: -5,
+		model.TeamSEA: -8,
+		model.TeamSJS: -8,
+		model.TeamSTL: -6,
+		model.TeamTBL: -5,
+		model.TeamTOR: -5,
+		model.TeamUTA: -7,
+		model.TeamVAN: -8,
+		model.TeamVGK: -8,
+		model.TeamWPG: -6,
+		model.TeamWSH: -5,
+	},
+}
+
+// offset returns the UTC offset of the game's venue.
+func (db VenueDB) offset(g model.ScheduleGame) (int, bool) {
+	if g.Venue != nil {
+		if hours, ok := db.Venues[g.Venue.Default]; ok {
+			return hours, true
+		}
+	}
+	hours, ok := db.Home[model.TeamAbbrev(g.HomeTeam.Abbrev)]
+	return hours, ok
+}
+
+// GameFatigue is how tired a team comes into one game, from its schedule.
+type GameFatigue struct {
+	GameID   model.GameID
+	Date     string
+	Team     string
+	Opponent string
+	Home     bool
+	// RestDays counts the days off since the team's previous game: 0 on
+	// the second night of a back-to-back, -1 for the first game of the
+	// schedule.
+	RestDays int
+	// GamesInFourNights counts the team's games in the four nights ending
+	// with this one, this game included.
+	GamesInFourNights int
+	// TimeZoneChange is the hours the team's clock moved since its
+	// previous game, positive going east. It is 0 when either venue is
+	// unknown to the VenueDB.
+	TimeZoneChange int
+	// Score adds up the load: 2 on a back-to-back, 1 after one day off,
+	// 0.5 after two, 1 more for a third game in four nights, and 0.5 per
+	// hour of time zone change. Scores are on the same scale for every
+	// team, so the two sides of a game compare directly.
+	Score float64
+}
+
+// FatigueIndex computes, for each game of a team's schedule, the rest and
+// time zone changes the team comes in with and a fatigue score. The team
+// is the one playing every game of the schedule; with a single game, the
+// home team. Postponed, suspended and cancelled games, and games without a
+// date, are left out. Games are in date order.
+func FatigueIndex(schedule *model.TeamScheduleResponse, venues VenueDB) []GameFatigue {
+	result := []GameFatigue{}
+	if schedule == nil {
+		return result
+	}
+
+	type dated struct {
+		game model.ScheduleGame
+		day  time.Time
+	}
+	var games []dated
+	for _, g := range schedule.Games {
+		if g.GameScheduleState != nil && !playedState(*g.GameScheduleState) {
+			continue
+		}
+		day, ok := scheduleDay(g)
+		if !ok {
+			continue
+		}
+		games = append(games, dated{game: g, day: day})
+	}
+	if len(games) == 0 {
+		return result
+	}
+	slices.SortStableFunc(games, func(a, b dated) int { return a.day.Compare(b.day) })
+
+	count := make(map[string]int)
+	for _, d := range games {
+		count[d.game.AwayTeam.Abbrev]++
+		count[d.game.HomeTeam.Abbrev]++
+	}
+	team := games[0].game.HomeTeam.Abbrev
+	if count[games[0].game.AwayTeam.Abbrev] > count[team] {
+		team = games[0].game.AwayTeam.Abbrev
+	}
+
+	var days []time.Time
+	for i, d := range games {
+		g := d.game
+		f := GameFatigue{
+			GameID:   g.ID,
+			Date:     d.day.Format(model.DateLayout),
+			Team:     team,
+			Opponent: g.HomeTeam.Abbrev,
+			Home:     g.HomeTeam.Abbrev == team,
+			RestDays: -1,
+		}
+		if f.Home {
+			f.Opponent = g.AwayTeam.Abbrev
+		}
+
+		if i > 0 {
+			prev := games[i-1]
+			f.RestDays = int(d.day.Sub(prev.day).Hours()/24) - 1
+			from, ok1 := venues.offset(prev.game)
+			to, ok2 := venues.offset(g)
+			if ok1 && ok2 {
+				f.TimeZoneChange = to - from
+			}
+		}
+		days = append(days, d.day)
+		fourNightsAgo := d.day.AddDate(0, 0, -3)
+		for _, day := range days {
+			if !day.Before(fourNightsAgo) {
+				f.GamesInFourNights++
+			}
+		}
+
+		f.Score = fatigueScore(f)
+		result = append(result, f)
+	}
+	return result
+}
+
+func fatigueScore(f GameFatigue) float64 {
+	var score float64
+	switch f.RestDays {
+	case 0:
+		score = 2
+	case 1:
+		score = 1
+	case 2:
+		score = 0.5
+	}
+	if f.GamesInFourNights >= 3 {
+		score++
+	}
+	return score + 0.5*float64(max(f.TimeZoneChange, -f.TimeZoneChange))
+}
+
+// playedState reports whether a game in this schedule state is, or will
+// be, played as scheduled.
+func playedState(state model.GameScheduleState) bool {
+	switch state {
+	case model.GameScheduleStatePostponed, model.GameScheduleStateSuspended, model.GameScheduleStateCancelled:
+		return false
+	default:
+		return true
+	}
+}
+
+// scheduleDay returns the day a game is played on, from its date or, for
+// schedules without one, its UTC start time.
+func scheduleDay(g model.ScheduleGame) (time.Time, bool) {
+	if g.GameDate != nil {
+		if d, err := model.ParseDate(*g.GameDate); err == nil {
+			return d.Time, true
+		}
+	}
+	start, err := g.StartTime()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return model.DateFromTime(start).Time, true
+}
+
+// FatigueMatchup lines up two teams' fatigue for a game they play against
+// each other.
+type FatigueMatchup struct {
+	GameID   model.GameID
+	Team     GameFatigue
+	Opponent GameFatigue
+	// RestDifferential is the team's rest days minus the opponent's, so
+	// positive when the team is the more rested; 0 when either is unknown.
+	RestDifferential int
+	// ScoreDifferential is the opponent's score minus the team's, so
+	// positive when the team is the fresher.
+	ScoreDifferential float64
+}
+
+// CompareFatigue joins two FatigueIndex results on their common games, in
+// date order.
+func CompareFatigue(team, opponent []GameFatigue) []FatigueMatchup {
+	byGame := make(map[model.GameID]GameFatigue, len(opponent))
+	for _, f := range opponent {
+		byGame[f.GameID] = f
+	}
+	result := []FatigueMatchup{}
+	for _, f := range team {
+		o, ok := byGame[f.GameID]
+		if !ok {
+			continue
+		}
+		m := FatigueMatchup{GameID: f.GameID, Team: f, Opponent: o, ScoreDifferential: o.Score - f.Score}
+		if f.RestDays >= 0 && o.RestDays >= 0 {
+			m.RestDifferential = f.RestDays - o.RestDays
+		}
+		result = append(result, m)
+	}
+	slices.SortStableFunc(result, func(a, b FatigueMatchup) int {
+		return cmp.Compare(a.Team.Date, b.Team.Date)
+	})
+	return result
+}
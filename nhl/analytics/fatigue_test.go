@@ -0,0 +1,101 @@
+package analytics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func fatigueGame(id model.GameID, date, away, home string) model.ScheduleGame {
+	return model.ScheduleGame{
+		ID:       id,
+		GameDate: &date,
+		AwayTeam: model.ScheduleTeam{Abbrev: away},
+		HomeTeam: model.ScheduleTeam{Abbrev: home},
+	}
+}
+
+func TestFatigueIndex(t *testing.T) {
+	postponed := model.GameScheduleStatePostponed
+	ppd := fatigueGame(9, "2024-10-11", "TOR", "BOS")
+	ppd.GameScheduleState = &postponed
+	stockholm := fatigueGame(6, "2024-11-01", "TOR", "OTT")
+	stockholm.Venue = &model.LocalizedString{Default: "Avicii Arena"}
+
+	venues := DefaultVenueDB
+	venues.Venues = map[string]int{"Avicii Arena": 1}
+	schedule := &model.TeamScheduleResponse{Games: []model.ScheduleGame{
+		fatigueGame(2, "2024-10-10", "TOR", "MTL"),
+		fatigueGame(1, "2024-10-09", "VAN", "TOR"),
+		ppd,
+		fatigueGame(3, "2024-10-12", "TOR", "VAN"),
+		fatigueGame(4, "2024-10-15", "CHI", "TOR"),
+		fatigueGame(5, "2024-10-16", "TOR", "XXX"),
+		stockholm,
+	}}
+
+	got := FatigueIndex(schedule, venues)
+	want := []struct {
+		id        model.GameID
+		opponent  string
+		home      bool
+		rest      int
+		fourNight int
+		tz        int
+		score     float64
+	}{
+		{1, "VAN", true, -1, 1, 0, 0},
+		{2, "MTL", false, 0, 2, 0, 2},
+		{3, "VAN", false, 1, 3, -3, 1 + 1 + 1.5},
+		{4, "CHI", true, 2, 2, 3, 0.5 + 1.5},
+		// The venue of an unknown club adds no time zone change.
+		{5, "XXX", false, 0, 2, 0, 2},
+		{6, "OTT", false, 15, 1, 0, 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("FatigueIndex() = %+v, want %d games", got, len(want))
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.GameID != w.id || g.Team != "TOR" || g.Opponent != w.opponent || g.Home != w.home ||
+			g.RestDays != w.rest || g.GamesInFourNights != w.fourNight || g.TimeZoneChange != w.tz ||
+			math.Abs(g.Score-w.score) > 1e-9 {
+			t.Errorf("game %d = %+v, want %+v", i, g, w)
+		}
+	}
+	// Stockholm from an unknown venue: no change; into it from Toronto
+	// would be six hours east.
+	if got := FatigueIndex(&model.TeamScheduleResponse{Games: []model.ScheduleGame{
+		fatigueGame(4, "2024-10-30", "CHI", "TOR"), stockholm,
+	}}, venues); got[1].TimeZoneChange != 6 {
+		t.Errorf("TimeZoneChange into Stockholm = %d, want 6", got[1].TimeZoneChange)
+	}
+
+	if got := FatigueIndex(nil, venues); len(got) != 0 {
+		t.Errorf("FatigueIndex(nil) = %+v", got)
+	}
+}
+
+func TestCompareFatigue(t *testing.T) {
+	tor := []GameFatigue{
+		{GameID: 2, Date: "2024-10-12", Team: "TOR", RestDays: 0, Score: 2},
+		{GameID: 1, Date: "2024-10-09", Team: "TOR", RestDays: -1},
+	}
+	mtl := []GameFatigue{
+		{GameID: 1, Date: "2024-10-09", Team: "MTL", RestDays: 3},
+		{GameID: 2, Date: "2024-10-12", Team: "MTL", RestDays: 2, Score: 0.5},
+		{GameID: 3, Date: "2024-10-14", Team: "MTL", RestDays: 1, Score: 1},
+	}
+
+	got := CompareFatigue(tor, mtl)
+	if len(got) != 2 {
+		t.Fatalf("CompareFatigue() = %+v, want 2 games", got)
+	}
+	if got[0].GameID != 1 || got[0].RestDifferential != 0 || got[0].ScoreDifferential != 0 {
+		t.Errorf("first game = %+v", got[0])
+	}
+	if got[1].GameID != 2 || got[1].RestDifferential != -2 || got[1].ScoreDifferential != -1.5 {
+		t.Errorf("second game = %+v", got[1])
+	}
+}
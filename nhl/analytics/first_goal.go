@@ -0,0 +1,165 @@
+package analytics
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// FirstGoalRecord is a win-loss record, with losses after regulation
+// counted separately.
+type FirstGoalRecord struct {
+	Wins     int
+	Losses   int
+	OTLosses int
+}
+
+// Games returns the number of games in the record.
+func (r FirstGoalRecord) Games() int {
+	return r.Wins + r.Losses + r.OTLosses
+}
+
+// WinPercentage returns the share of games won, or 0 without games.
+func (r FirstGoalRecord) WinPercentage() float64 {
+	if r.Games() == 0 {
+		return 0
+	}
+	return float64(r.Wins) / float64(r.Games())
+}
+
+// FirstGoalTeam is how a team fares depending on who scores first.
+type FirstGoalTeam struct {
+	Team string
+
+	// ScoredFirst is the record in games the team opened the scoring, and
+	// TrailedFirst the record in games the opponent did.
+	ScoredFirst  FirstGoalRecord
+	TrailedFirst FirstGoalRecord
+}
+
+// Games returns the number of games counted for the team.
+func (t FirstGoalTeam) Games() int {
+	return t.ScoredFirst.Games() + t.TrailedFirst.Games()
+}
+
+// ScoredFirstPercentage returns the share of games in which the team
+// scored first, or 0 without games.
+func (t FirstGoalTeam) ScoredFirstPercentage() float64 {
+	if t.Games() == 0 {
+		return 0
+	}
+	return float64(t.ScoredFirst.Games()) / float64(t.Games())
+}
+
+// FirstGoalScorer counts the games a player opened the scoring in.
+type FirstGoalScorer struct {
+	PlayerID   model.PlayerID
+	Name       string
+	Team       string
+	FirstGoals int
+}
+
+// FirstGoalReport finds the first goal of each final game in the
+// play-by-play and reports, per team, the record when scoring first and
+// when trailing first, and per player the number of first goals. Games
+// without a winner or without a goal before the shootout are skipped, as
+// are nil play-by-plays and games that are not final.
+//
+// Teams are ordered by games scoring first, then wins when scoring first,
+// then team; scorers by first goals, then player ID.
+func FirstGoalReport(games []*model.PlayByPlay) ([]FirstGoalTeam, []FirstGoalScorer) {
+	teams := make(map[string]*FirstGoalTeam)
+	scorers := make(map[model.PlayerID]*FirstGoalScorer)
+	team := func(abbrev string) *FirstGoalTeam {
+		t := teams[abbrev]
+		if t == nil {
+			t = &FirstGoalTeam{Team: abbrev}
+			teams[abbrev] = t
+		}
+		return t
+	}
+
+	for _, pbp := range games {
+		if pbp == nil {
+			continue
+		}
+		winner, _, lastPeriod, ok := finalResult(pbp)
+		if !ok {
+			continue
+		}
+		first := firstGoal(pbp)
+		if first == nil || first.Details.EventOwnerTeamID == nil {
+			continue
+		}
+		scoring, trailing := pbp.HomeTeam.Abbrev, pbp.AwayTeam.Abbrev
+		if *first.Details.EventOwnerTeamID == pbp.AwayTeam.ID {
+			scoring, trailing = trailing, scoring
+		}
+		addResult(&team(scoring).ScoredFirst, scoring == winner, lastPeriod)
+		addResult(&team(trailing).TrailedFirst, trailing == winner, lastPeriod)
+
+		if id := first.Details.ScoringPlayerID; id != nil {
+			s := scorers[*id]
+			if s == nil {
+				s = &FirstGoalScorer{PlayerID: *id}
+				scorers[*id] = s
+			}
+			s.Name = rosterName(pbp, *id)
+			s.Team = scoring
+			s.FirstGoals++
+		}
+	}
+
+	teamList := make([]FirstGoalTeam, 0, len(teams))
+	for _, t := range teams {
+		teamList = append(teamList, *t)
+	}
+	slices.SortFunc(teamList, func(a, b FirstGoalTeam) int {
+		return cmp.Or(
+			cmp.Compare(b.ScoredFirst.Games(), a.ScoredFirst.Games()),
+			cmp.Compare(b.ScoredFirst.Wins, a.ScoredFirst.Wins),
+			cmp.Compare(a.Team, b.Team),
+		)
+	})
+
+	scorerList := make([]FirstGoalScorer, 0, len(scorers))
+	for _, s := range scorers {
+		scorerList = append(scorerList, *s)
+	}
+	slices.SortFunc(scorerList, func(a, b FirstGoalScorer) int {
+		return cmp.Or(
+			cmp.Compare(b.FirstGoals, a.FirstGoals),
+			cmp.Compare(a.PlayerID, b.PlayerID),
+		)
+	})
+	return teamList, scorerList
+}
+
+// firstGoal returns the earliest goal before the shootout, or nil.
+func firstGoal(pbp *model.PlayByPlay) *model.PlayEvent {
+	var first *model.PlayEvent
+	for i := range pbp.Plays {
+		play := &pbp.Plays[i]
+		if play.TypeDescKey != model.PlayEventTypeGoal || play.Details == nil ||
+			play.PeriodDescriptor.PeriodType == model.PeriodTypeShootout {
+			continue
+		}
+		if first == nil || play.SortOrder < first.SortOrder {
+			first = play
+		}
+	}
+	return first
+}
+
+// addResult adds one game to a record.
+func addResult(r *FirstGoalRecord, won bool, lastPeriod model.PeriodType) {
+	switch {
+	case won:
+		r.Wins++
+	case lastPeriod.IsOvertime():
+		r.OTLosses++
+	default:
+		r.Losses++
+	}
+}
@@ -0,0 +1,66 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func TestFirstGoalReport(t *testing.T) {
+	reg, ot, so := model.PeriodTypeRegulation, model.PeriodTypeOvertime, model.PeriodTypeShootout
+
+	// Plays are listed out of order: the earlier sort order wins.
+	late := scoredGoal(reg, 8, 3)
+	late.SortOrder = 200
+	early := scoredGoal(reg, 10, 1)
+	early.SortOrder = 100
+
+	games := []*model.PlayByPlay{
+		// TOR scores first and wins.
+		finalAfter(shootoutGame(late, early), reg, 2, 1),
+		// MTL scores first, TOR wins in overtime.
+		finalAfter(shootoutGame(scoredGoal(reg, 8, 4), scoredGoal(ot, 10, 2)), ot, 2, 1),
+		// MTL scores first and wins.
+		finalAfter(shootoutGame(scoredGoal(reg, 8, 4)), reg, 0, 1),
+		// Only the shootout scored: skipped.
+		finalAfter(shootoutGame(attempt(so, model.PlayEventTypeGoal, 10, 2, 31)), so, 1, 0),
+		// Not final: skipped.
+		shootoutGame(scoredGoal(reg, 10, 1)),
+		nil,
+	}
+
+	teams, scorers := FirstGoalReport(games)
+	if len(teams) != 2 {
+		t.Fatalf("teams = %+v", teams)
+	}
+	mtl, tor := teams[0], teams[1]
+	if mtl.Team != "MTL" || mtl.ScoredFirst != (FirstGoalRecord{Wins: 1, OTLosses: 1}) || mtl.TrailedFirst != (FirstGoalRecord{Losses: 1}) {
+		t.Errorf("MTL = %+v", mtl)
+	}
+	if tor.Team != "TOR" || tor.ScoredFirst != (FirstGoalRecord{Wins: 1}) || tor.TrailedFirst != (FirstGoalRecord{Wins: 1, Losses: 1}) {
+		t.Errorf("TOR = %+v", tor)
+	}
+	if got := tor.ScoredFirstPercentage(); got != 1.0/3 {
+		t.Errorf("TOR ScoredFirstPercentage() = %v", got)
+	}
+	if got := mtl.ScoredFirst.WinPercentage(); got != 0.5 {
+		t.Errorf("MTL WinPercentage() = %v", got)
+	}
+
+	want := []FirstGoalScorer{
+		{PlayerID: 4, Name: "Cole Caufield", Team: "MTL", FirstGoals: 2},
+		{PlayerID: 1, Name: "Auston Matthews", Team: "TOR", FirstGoals: 1},
+	}
+	if len(scorers) != len(want) {
+		t.Fatalf("scorers = %+v", scorers)
+	}
+	for i := range want {
+		if scorers[i] != want[i] {
+			t.Errorf("scorers[%d] = %+v, want %+v", i, scorers[i], want[i])
+		}
+	}
+
+	if (FirstGoalTeam{}).ScoredFirstPercentage() != 0 || (FirstGoalRecord{}).WinPercentage() != 0 {
+		t.Error("percentages without games should be 0")
+	}
+}
@@ -0,0 +1,243 @@
+package analytics
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// Strength selects the game situations on-ice stats count.
+type Strength int
+
+const (
+	// AllStrengths counts every situation except the shootout.
+	AllStrengths Strength = iota
+	// FiveOnFive counts only five skaters a side with both goalies in net.
+	FiveOnFive
+)
+
+// shiftTypeCode marks shift rows in a shift chart; other rows describe
+// goals.
+const shiftTypeCode = 517
+
+// PlayerOnIce is a skater's on-ice shot attempts and zone starts in one
+// game. Corsi counts every shot attempt (goals, shots on goal, misses and
+// blocked shots); Fenwick leaves out blocked shots.
+type PlayerOnIce struct {
+	PlayerID model.PlayerID
+	Name     string
+	Team     string
+
+	CorsiFor       int
+	CorsiAgainst   int
+	FenwickFor     int
+	FenwickAgainst int
+
+	// Zone starts count the faceoffs a shift began with, by zone from the
+	// player's side.
+	OffensiveZoneStarts int
+	NeutralZoneStarts   int
+	DefensiveZoneStarts int
+}
+
+// CorsiDiff returns CorsiFor minus CorsiAgainst.
+func (p PlayerOnIce) CorsiDiff() int {
+	return p.CorsiFor - p.CorsiAgainst
+}
+
+// CorsiPercentage returns the share of on-ice shot attempts taken by the
+// player's team, or 0 without attempts.
+func (p PlayerOnIce) CorsiPercentage() float64 {
+	return share(p.CorsiFor, p.CorsiAgainst)
+}
+
+// FenwickPercentage returns the share of on-ice unblocked shot attempts
+// taken by the player's team, or 0 without attempts.
+func (p PlayerOnIce) FenwickPercentage() float64 {
+	return share(p.FenwickFor, p.FenwickAgainst)
+}
+
+// ZoneStartPercentage returns the share of offensive-zone starts among
+// offensive and defensive-zone starts, or 0 without either.
+func (p PlayerOnIce) ZoneStartPercentage() float64 {
+	return share(p.OffensiveZoneStarts, p.DefensiveZoneStarts)
+}
+
+func share(part, rest int) float64 {
+	if part+rest == 0 {
+		return 0
+	}
+	return float64(part) / float64(part+rest)
+}
+
+// shift is one shift in elapsed seconds of its period.
+type shift struct {
+	period     int
+	start, end int
+}
+
+// OnIce joins a game's play-by-play and shift chart into per-skater
+// on-ice shot attempt differentials and zone starts at the given strength.
+// A skater is on the ice for an event when the event falls after the
+// start of one of their shifts and no later than its end, so players
+// changing at the moment of an event are credited to the outgoing group.
+// A faceoff is a zone start for the players whose shift begins with it.
+//
+// The shooting team of an attempt is the shooter's team on the roster,
+// falling back to the event owner. Goalies, as listed on the roster, are
+// left out. Skaters are ordered by Corsi differential, then player ID.
+func OnIce(pbp *model.PlayByPlay, shifts *model.ShiftChart, strength Strength) []PlayerOnIce {
+	if pbp == nil || shifts == nil {
+		return []PlayerOnIce{}
+	}
+
+	players := make(map[model.PlayerID]*PlayerOnIce)
+	playerShifts := make(map[model.PlayerID][]shift)
+	teamOf := make(map[model.PlayerID]model.TeamID)
+	for _, e := range shifts.Data {
+		if e.TypeCode != shiftTypeCode {
+			continue
+		}
+		if spot := pbp.GetPlayer(e.PlayerID); spot != nil && spot.Position == model.PositionGoalie {
+			continue
+		}
+		start, ok1 := clockSeconds(e.StartTime)
+		end, ok2 := clockSeconds(e.EndTime)
+		if !ok1 || !ok2 || end <= start {
+			continue
+		}
+		playerShifts[e.PlayerID] = append(playerShifts[e.PlayerID], shift{period: e.Period, start: start, end: end})
+		teamOf[e.PlayerID] = e.TeamID
+		if players[e.PlayerID] == nil {
+			players[e.PlayerID] = &PlayerOnIce{
+				PlayerID: e.PlayerID,
+				Name:     strings.TrimSpace(e.FirstName + " " + e.LastName),
+				Team:     e.TeamAbbrev,
+			}
+		}
+	}
+
+	for i := range pbp.Plays {
+		play := &pbp.Plays[i]
+		if play.Details == nil || play.PeriodDescriptor.PeriodType == model.PeriodTypeShootout {
+			continue
+		}
+		if strength == FiveOnFive && play.SituationCode != "1551" {
+			continue
+		}
+		at, ok := clockSeconds(play.TimeInPeriod)
+		if !ok {
+			continue
+		}
+		period := play.PeriodDescriptor.Number
+
+		if play.TypeDescKey == model.PlayEventTypeFaceoff {
+			if play.Details.EventOwnerTeamID == nil || play.Details.ZoneCode == nil {
+				continue
+			}
+			for id, list := range playerShifts {
+				if !slices.ContainsFunc(list, func(s shift) bool { return s.period == period && s.start == at }) {
+					continue
+				}
+				zone := *play.Details.ZoneCode
+				if teamOf[id] != *play.Details.EventOwnerTeamID {
+					zone = flipZone(zone)
+				}
+				p := players[id]
+				switch zone {
+				case model.ZoneCodeOffensive:
+					p.OffensiveZoneStarts++
+				case model.ZoneCodeDefensive:
+					p.DefensiveZoneStarts++
+				case model.ZoneCodeNeutral:
+					p.NeutralZoneStarts++
+				}
+			}
+			continue
+		}
+
+		shooting, blocked, ok := attemptTeam(pbp, play, teamOf)
+		if !ok {
+			continue
+		}
+		for id, list := range playerShifts {
+			if !slices.ContainsFunc(list, func(s shift) bool { return s.period == period && s.start < at && at <= s.end }) {
+				continue
+			}
+			p := players[id]
+			if teamOf[id] == shooting {
+				p.CorsiFor++
+				if !blocked {
+					p.FenwickFor++
+				}
+			} else {
+				p.CorsiAgainst++
+				if !blocked {
+					p.FenwickAgainst++
+				}
+			}
+		}
+	}
+
+	result := make([]PlayerOnIce, 0, len(players))
+	for _, p := range players {
+		result = append(result, *p)
+	}
+	slices.SortFunc(result, func(a, b PlayerOnIce) int {
+		return cmp.Or(
+			cmp.Compare(b.CorsiDiff(), a.CorsiDiff()),
+			cmp.Compare(a.PlayerID, b.PlayerID),
+		)
+	})
+	return result
+}
+
+// attemptTeam returns the team that took a shot attempt and whether it was
+// blocked, reporting false for plays that are not attempts.
+func attemptTeam(pbp *model.PlayByPlay, play *model.PlayEvent, teamOf map[model.PlayerID]model.TeamID) (model.TeamID, bool, bool) {
+	d := play.Details
+	var shooter *model.PlayerID
+	switch play.TypeDescKey {
+	case model.PlayEventTypeGoal:
+		shooter = d.ScoringPlayerID
+	case model.PlayEventTypeShotOnGoal, model.PlayEventTypeMissedShot, model.PlayEventTypeBlockedShot:
+		shooter = d.ShootingPlayerID
+	default:
+		return 0, false, false
+	}
+	blocked := play.TypeDescKey == model.PlayEventTypeBlockedShot
+	if shooter != nil {
+		if spot := pbp.GetPlayer(*shooter); spot != nil {
+			return spot.TeamID, blocked, true
+		}
+		if team, ok := teamOf[*shooter]; ok {
+			return team, blocked, true
+		}
+	}
+	if d.EventOwnerTeamID == nil {
+		return 0, false, false
+	}
+	return *d.EventOwnerTeamID, blocked, true
+}
+
+func flipZone(zone model.ZoneCode) model.ZoneCode {
+	switch zone {
+	case model.ZoneCodeOffensive:
+		return model.ZoneCodeDefensive
+	case model.ZoneCodeDefensive:
+		return model.ZoneCodeOffensive
+	default:
+		return zone
+	}
+}
+
+// clockSeconds parses an elapsed period time in MM:SS form.
+func clockSeconds(s string) (int, bool) {
+	t, err := model.ParseTOI(s)
+	if err != nil || t.IsMissing() {
+		return 0, false
+	}
+	return t.Seconds(), true
+}
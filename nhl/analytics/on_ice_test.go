@@ -0,0 +1,101 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func shiftEntry(team model.TeamID, abbrev string, id model.PlayerID, start, end string) model.ShiftEntry {
+	return model.ShiftEntry{
+		PlayerID:   id,
+		TeamID:     team,
+		TeamAbbrev: abbrev,
+		Period:     1,
+		StartTime:  start,
+		EndTime:    end,
+		TypeCode:   shiftTypeCode,
+	}
+}
+
+// onIcePlay sets the period, clock and situation of a play.
+func onIcePlay(play model.PlayEvent, clock, situation string) model.PlayEvent {
+	play.PeriodDescriptor.Number = 1
+	play.TimeInPeriod = clock
+	play.SituationCode = situation
+	return play
+}
+
+func faceoff(owner model.TeamID, zone model.ZoneCode, clock string) model.PlayEvent {
+	return onIcePlay(model.PlayEvent{
+		TypeDescKey: model.PlayEventTypeFaceoff,
+		Details:     &model.PlayEventDetails{EventOwnerTeamID: &owner, ZoneCode: &zone},
+	}, clock, "1551")
+}
+
+func TestOnIce(t *testing.T) {
+	reg := model.PeriodTypeRegulation
+	pbp := shootoutGame(
+		faceoff(8, model.ZoneCodeNeutral, "00:00"),
+		onIcePlay(attempt(reg, model.PlayEventTypeShotOnGoal, 10, 1, 31), "00:30", "1551"),
+		// The blocking team owns a blocked shot; the shooter decides.
+		onIcePlay(attempt(reg, model.PlayEventTypeBlockedShot, 10, 3, 0), "01:00", "1551"),
+		faceoff(10, model.ZoneCodeOffensive, "01:00"),
+		onIcePlay(attempt(reg, model.PlayEventTypeMissedShot, 8, 3, 30), "01:30", "1451"),
+		onIcePlay(attempt(model.PeriodTypeShootout, model.PlayEventTypeGoal, 8, 3, 30), "00:00", "0101"),
+	)
+	pbp.RosterSpots[2].Position = model.PositionGoalie
+	shifts := &model.ShiftChart{Data: []model.ShiftEntry{
+		shiftEntry(10, "TOR", 1, "00:00", "01:00"),
+		shiftEntry(10, "TOR", 2, "01:00", "02:00"),
+		shiftEntry(8, "MTL", 3, "00:00", "02:00"),
+		shiftEntry(10, "TOR", 30, "00:00", "20:00"),
+		{PlayerID: 4, TeamID: 8, Period: 1, StartTime: "00:30", EndTime: "00:30", TypeCode: 505},
+	}}
+
+	all := OnIce(pbp, shifts, AllStrengths)
+	want := []PlayerOnIce{
+		{PlayerID: 3, Team: "MTL", CorsiFor: 2, CorsiAgainst: 1, FenwickFor: 1, FenwickAgainst: 1, NeutralZoneStarts: 1},
+		{PlayerID: 1, Team: "TOR", CorsiFor: 1, CorsiAgainst: 1, FenwickFor: 1, NeutralZoneStarts: 1},
+		{PlayerID: 2, Team: "TOR", CorsiAgainst: 1, FenwickAgainst: 1, OffensiveZoneStarts: 1},
+	}
+	if len(all) != len(want) {
+		t.Fatalf("OnIce(all) = %+v", all)
+	}
+	for i := range want {
+		if all[i] != want[i] {
+			t.Errorf("OnIce(all)[%d] = %+v, want %+v", i, all[i], want[i])
+		}
+	}
+	if got := all[0].CorsiPercentage(); got != 2.0/3 {
+		t.Errorf("CorsiPercentage() = %v", got)
+	}
+	if got := all[0].FenwickPercentage(); got != 0.5 {
+		t.Errorf("FenwickPercentage() = %v", got)
+	}
+	if got := all[2].ZoneStartPercentage(); got != 1 {
+		t.Errorf("ZoneStartPercentage() = %v", got)
+	}
+
+	even := OnIce(pbp, shifts, FiveOnFive)
+	wantEven := []PlayerOnIce{
+		{PlayerID: 1, Team: "TOR", CorsiFor: 1, CorsiAgainst: 1, FenwickFor: 1, NeutralZoneStarts: 1},
+		{PlayerID: 2, Team: "TOR", OffensiveZoneStarts: 1},
+		{PlayerID: 3, Team: "MTL", CorsiFor: 1, CorsiAgainst: 1, FenwickAgainst: 1, NeutralZoneStarts: 1},
+	}
+	if len(even) != len(wantEven) {
+		t.Fatalf("OnIce(5v5) = %+v", even)
+	}
+	for i := range wantEven {
+		if even[i] != wantEven[i] {
+			t.Errorf("OnIce(5v5)[%d] = %+v, want %+v", i, even[i], wantEven[i])
+		}
+	}
+
+	if got := OnIce(nil, shifts, AllStrengths); len(got) != 0 {
+		t.Errorf("OnIce(nil) = %+v", got)
+	}
+	if (PlayerOnIce{}).CorsiPercentage() != 0 {
+		t.Error("CorsiPercentage() without attempts should be 0")
+	}
+}
@@ -0,0 +1,162 @@
+package analytics
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// OvertimeScorer is a player's overtime scoring across games.
+type OvertimeScorer struct {
+	PlayerID model.PlayerID
+	Name     string
+	Team     string
+
+	Goals   int
+	Assists int
+}
+
+// Points returns goals plus assists.
+func (s OvertimeScorer) Points() int {
+	return s.Goals + s.Assists
+}
+
+// OvertimeRecord is a team's record in games decided after regulation,
+// split by whether overtime or a shootout settled them.
+type OvertimeRecord struct {
+	Team string
+
+	OTWins   int
+	OTLosses int
+	SOWins   int
+	SOLosses int
+}
+
+// Games returns the number of games decided after regulation.
+func (r OvertimeRecord) Games() int {
+	return r.OTWins + r.OTLosses + r.SOWins + r.SOLosses
+}
+
+// Wins returns the wins in overtime and shootouts.
+func (r OvertimeRecord) Wins() int {
+	return r.OTWins + r.SOWins
+}
+
+// OvertimeLeaders aggregates overtime from the play-by-play of several
+// games: goals and assists scored in overtime periods per player, and per
+// team the final games decided in overtime or by a shootout. Overtime is
+// three-on-three in the regular season and full strength in the playoffs;
+// both count. Shootout goals are not overtime goals, see ShootoutRecords.
+//
+// Scorers are ordered by goals, then points, then player ID; records by
+// wins, then overtime wins, then team. Nil play-by-plays are skipped, and
+// games that are not final count only toward scorers.
+func OvertimeLeaders(pbps []*model.PlayByPlay) ([]OvertimeScorer, []OvertimeRecord) {
+	scorers := make(map[model.PlayerID]*OvertimeScorer)
+	records := make(map[string]*OvertimeRecord)
+
+	for _, pbp := range pbps {
+		if pbp == nil {
+			continue
+		}
+		teams := map[model.TeamID]string{
+			pbp.AwayTeam.ID: pbp.AwayTeam.Abbrev,
+			pbp.HomeTeam.ID: pbp.HomeTeam.Abbrev,
+		}
+		credit := func(id *model.PlayerID, team string, goal bool) {
+			if id == nil {
+				return
+			}
+			s := scorers[*id]
+			if s == nil {
+				s = &OvertimeScorer{PlayerID: *id}
+				scorers[*id] = s
+			}
+			s.Name = rosterName(pbp, *id)
+			s.Team = team
+			if goal {
+				s.Goals++
+			} else {
+				s.Assists++
+			}
+		}
+		for i := range pbp.Plays {
+			play := &pbp.Plays[i]
+			if play.PeriodDescriptor.PeriodType != model.PeriodTypeOvertime ||
+				play.TypeDescKey != model.PlayEventTypeGoal || play.Details == nil {
+				continue
+			}
+			team := ""
+			if play.Details.EventOwnerTeamID != nil {
+				team = teams[*play.Details.EventOwnerTeamID]
+			}
+			credit(play.Details.ScoringPlayerID, team, true)
+			credit(play.Details.Assist1PlayerID, team, false)
+			credit(play.Details.Assist2PlayerID, team, false)
+		}
+
+		winner, loser, lastPeriod, ok := finalResult(pbp)
+		if !ok || !lastPeriod.IsOvertime() {
+			continue
+		}
+		record := func(team string) *OvertimeRecord {
+			r := records[team]
+			if r == nil {
+				r = &OvertimeRecord{Team: team}
+				records[team] = r
+			}
+			return r
+		}
+		if lastPeriod == model.PeriodTypeShootout {
+			record(winner).SOWins++
+			record(loser).SOLosses++
+		} else {
+			record(winner).OTWins++
+			record(loser).OTLosses++
+		}
+	}
+
+	scorerList := make([]OvertimeScorer, 0, len(scorers))
+	for _, s := range scorers {
+		scorerList = append(scorerList, *s)
+	}
+	slices.SortFunc(scorerList, func(a, b OvertimeScorer) int {
+		return cmp.Or(
+			cmp.Compare(b.Goals, a.Goals),
+			cmp.Compare(b.Points(), a.Points()),
+			cmp.Compare(a.PlayerID, b.PlayerID),
+		)
+	})
+
+	recordList := make([]OvertimeRecord, 0, len(records))
+	for _, r := range records {
+		recordList = append(recordList, *r)
+	}
+	slices.SortFunc(recordList, func(a, b OvertimeRecord) int {
+		return cmp.Or(
+			cmp.Compare(b.Wins(), a.Wins()),
+			cmp.Compare(b.OTWins, a.OTWins),
+			cmp.Compare(a.Team, b.Team),
+		)
+	})
+	return scorerList, recordList
+}
+
+// finalResult returns the winner and loser of a final game and the type of
+// its last period, reporting false when the game is not final or has no
+// winner.
+func finalResult(pbp *model.PlayByPlay) (winner, loser string, lastPeriod model.PeriodType, ok bool) {
+	if !pbp.GameState.IsFinal() || pbp.AwayTeam.Score == pbp.HomeTeam.Score {
+		return "", "", "", false
+	}
+	lastPeriod = pbp.PeriodDescriptor.PeriodType
+	if pbp.GameOutcome != nil {
+		lastPeriod = pbp.GameOutcome.LastPeriodType
+	}
+	winner, loser = pbp.HomeTeam.Abbrev, pbp.AwayTeam.Abbrev
+	if pbp.AwayTeam.Score > pbp.HomeTeam.Score {
+		winner, loser = loser, winner
+	}
+	return winner, loser, lastPeriod, true
+}
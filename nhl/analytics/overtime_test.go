@@ -0,0 +1,85 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// finalAfter marks a shootoutGame final with the given score, decided in
+// the last period type.
+func finalAfter(pbp *model.PlayByPlay, last model.PeriodType, away, home int) *model.PlayByPlay {
+	pbp.GameState = model.GameStateOff
+	pbp.GameOutcome = &model.GameOutcome{LastPeriodType: last}
+	pbp.AwayTeam.Score, pbp.HomeTeam.Score = away, home
+	return pbp
+}
+
+func scoredGoal(periodType model.PeriodType, team model.TeamID, scorer model.PlayerID, assists ...model.PlayerID) model.PlayEvent {
+	play := attempt(periodType, model.PlayEventTypeGoal, team, scorer, 0)
+	if len(assists) > 0 {
+		play.Details.Assist1PlayerID = &assists[0]
+	}
+	if len(assists) > 1 {
+		play.Details.Assist2PlayerID = &assists[1]
+	}
+	return play
+}
+
+func TestOvertimeLeaders(t *testing.T) {
+	ot := model.PeriodTypeOvertime
+	games := []*model.PlayByPlay{
+		// Matthews from Marner wins in overtime.
+		finalAfter(shootoutGame(
+			scoredGoal(model.PeriodTypeRegulation, 8, 3, 4),
+			scoredGoal(ot, 10, 1, 2),
+		), ot, 2, 1),
+		// Suzuki from Caufield and Montembeault wins in overtime.
+		finalAfter(shootoutGame(
+			scoredGoal(ot, 8, 3, 4, 31),
+		), ot, 3, 4),
+		// Decided by a shootout: the shootout goal is not an overtime goal.
+		finalAfter(shootoutGame(
+			attempt(model.PeriodTypeShootout, model.PlayEventTypeGoal, 10, 2, 31),
+		), model.PeriodTypeShootout, 3, 2),
+		// A regulation win and a game in progress do not count toward records.
+		finalAfter(shootoutGame(), model.PeriodTypeRegulation, 1, 4),
+		shootoutGame(scoredGoal(ot, 8, 4)),
+		nil,
+	}
+
+	scorers, records := OvertimeLeaders(games)
+
+	// Caufield's goal and assist outrank the other single-goal scorers.
+	want := []OvertimeScorer{
+		{PlayerID: 4, Name: "Cole Caufield", Team: "MTL", Goals: 1, Assists: 1},
+		{PlayerID: 1, Name: "Auston Matthews", Team: "TOR", Goals: 1},
+		{PlayerID: 3, Name: "Nick Suzuki", Team: "MTL", Goals: 1},
+		{PlayerID: 2, Name: "Mitch Marner", Team: "TOR", Assists: 1},
+		{PlayerID: 31, Name: "Sam Montembeault", Team: "MTL", Assists: 1},
+	}
+	if len(scorers) != len(want) {
+		t.Fatalf("scorers = %+v", scorers)
+	}
+	for i := range want {
+		if scorers[i] != want[i] {
+			t.Errorf("scorers[%d] = %+v, want %+v", i, scorers[i], want[i])
+		}
+	}
+
+	wantRecords := []OvertimeRecord{
+		{Team: "TOR", OTWins: 1, OTLosses: 1, SOWins: 1},
+		{Team: "MTL", OTWins: 1, OTLosses: 1, SOLosses: 1},
+	}
+	if len(records) != len(wantRecords) {
+		t.Fatalf("records = %+v", records)
+	}
+	for i := range wantRecords {
+		if records[i] != wantRecords[i] {
+			t.Errorf("records[%d] = %+v, want %+v", i, records[i], wantRecords[i])
+		}
+	}
+	if records[0].Games() != 3 || records[0].Wins() != 2 || scorers[0].Points() != 2 {
+		t.Errorf("totals = %d games, %d wins, %d points", records[0].Games(), records[0].Wins(), scorers[0].Points())
+	}
+}
@@ -0,0 +1,167 @@
+package analytics
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// RegularPairs is the number of defense pairs a team dresses, and so the
+// number of most-used pairs PairingContinuity measures.
+const RegularPairs = 3
+
+// GameShifts is one game's play-by-play, which gives the roster and
+// positions, and its shift chart.
+type GameShifts struct {
+	PlayByPlay *model.PlayByPlay
+	Shifts     *model.ShiftChart
+}
+
+// DefensePair is two defensemen of a team and the time they spent on the
+// ice together. Players holds the lower player ID first.
+type DefensePair struct {
+	Players [2]model.PlayerID
+	Names   [2]string
+	TOI     time.Duration
+	// Games counts the games in which the pair shared the ice.
+	Games int
+}
+
+// DefensePairs detects a team's defense pairs in one game from the
+// overlap of its defensemen's shifts, at every strength. Defensemen are
+// the roster's players listed at defense; team is the abbreviation the
+// shift chart uses. Pairs are ordered by time together, then player IDs.
+func DefensePairs(game GameShifts, team string) []DefensePair {
+	pbp, shifts := game.PlayByPlay, game.Shifts
+	if pbp == nil || shifts == nil {
+		return []DefensePair{}
+	}
+
+	names := make(map[model.PlayerID]string)
+	playerShifts := make(map[model.PlayerID][]shift)
+	for _, e := range shifts.Data {
+		if e.TypeCode != shiftTypeCode || e.TeamAbbrev != team {
+			continue
+		}
+		if spot := pbp.GetPlayer(e.PlayerID); spot == nil || spot.Position != model.PositionDefense {
+			continue
+		}
+		start, ok1 := clockSeconds(e.StartTime)
+		end, ok2 := clockSeconds(e.EndTime)
+		if !ok1 || !ok2 || end <= start {
+			continue
+		}
+		playerShifts[e.PlayerID] = append(playerShifts[e.PlayerID], shift{period: e.Period, start: start, end: end})
+		names[e.PlayerID] = strings.TrimSpace(e.FirstName + " " + e.LastName)
+	}
+
+	ids := make([]model.PlayerID, 0, len(playerShifts))
+	for id := range playerShifts {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	pairs := []DefensePair{}
+	for i, a := range ids {
+		for _, b := range ids[i+1:] {
+			seconds := overlap(playerShifts[a], playerShifts[b])
+			if seconds == 0 {
+				continue
+			}
+			pairs = append(pairs, DefensePair{
+				Players: [2]model.PlayerID{a, b},
+				Names:   [2]string{names[a], names[b]},
+				TOI:     time.Duration(seconds) * time.Second,
+				Games:   1,
+			})
+		}
+	}
+	sortPairs(pairs)
+	return pairs
+}
+
+// overlap returns the seconds two players' shifts have in common.
+func overlap(a, b []shift) int {
+	total := 0
+	for _, x := range a {
+		for _, y := range b {
+			if x.period != y.period {
+				continue
+			}
+			if d := min(x.end, y.end) - max(x.start, y.start); d > 0 {
+				total += d
+			}
+		}
+	}
+	return total
+}
+
+func sortPairs(pairs []DefensePair) {
+	slices.SortFunc(pairs, func(a, b DefensePair) int {
+		return cmp.Or(
+			cmp.Compare(b.TOI, a.TOI),
+			cmp.Compare(a.Players[0], b.Players[0]),
+			cmp.Compare(a.Players[1], b.Players[1]),
+		)
+	})
+}
+
+// Continuity is how stable a team's defense pairs have been over a set of
+// games.
+type Continuity struct {
+	Team string
+	// Games counts the games with pairs for the team.
+	Games int
+	// Pairs lists every pair over the games, most time together first.
+	Pairs []DefensePair
+	// TotalTOI is the time together of all pairs, and TopTOI that of the
+	// RegularPairs most-used ones.
+	TotalTOI time.Duration
+	TopTOI   time.Duration
+	// Percentage is TopTOI over TotalTOI, as a fraction: 1 when the team
+	// never broke up its three pairs, lower as it shuffled them. It is 0
+	// without pairs.
+	Percentage float64
+}
+
+// PairingContinuity measures how stable a team's defense pairs have been
+// over games: the share of the time its defensemen spent paired that the
+// RegularPairs most-used pairs account for. Pairs are detected in each game
+// as DefensePairs does and summed across games; Games counts the games in
+// which the team had any.
+func PairingContinuity(team string, games []GameShifts) *Continuity {
+	result := &Continuity{Team: team, Pairs: []DefensePair{}}
+	byPlayers := make(map[[2]model.PlayerID]int)
+	for _, game := range games {
+		pairs := DefensePairs(game, team)
+		if len(pairs) == 0 {
+			continue
+		}
+		result.Games++
+		for _, p := range pairs {
+			i, ok := byPlayers[p.Players]
+			if !ok {
+				byPlayers[p.Players] = len(result.Pairs)
+				result.Pairs = append(result.Pairs, p)
+				continue
+			}
+			result.Pairs[i].TOI += p.TOI
+			result.Pairs[i].Games++
+		}
+	}
+	sortPairs(result.Pairs)
+
+	for i, p := range result.Pairs {
+		result.TotalTOI += p.TOI
+		if i < RegularPairs {
+			result.TopTOI += p.TOI
+		}
+	}
+	if result.TotalTOI > 0 {
+		result.Percentage = float64(result.TopTOI) / float64(result.TotalTOI)
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// pairingGame is a game with Toronto defensemen 5 to 8 and forward 1.
+func pairingGame(shifts ...model.ShiftEntry) GameShifts {
+	pbp := shootoutGame()
+	for _, id := range []model.PlayerID{5, 6, 7, 8} {
+		pbp.RosterSpots = append(pbp.RosterSpots, model.RosterSpot{TeamID: 10, PlayerID: id, Position: model.PositionDefense})
+	}
+	pbp.RosterSpots[0].Position = model.PositionCenter
+	return GameShifts{PlayByPlay: pbp, Shifts: &model.ShiftChart{Data: shifts}}
+}
+
+func TestDefensePairs(t *testing.T) {
+	game := pairingGame(
+		shiftEntry(10, "TOR", 5, "00:00", "01:00"),
+		shiftEntry(10, "TOR", 6, "00:00", "00:50"),
+		shiftEntry(10, "TOR", 7, "00:40", "02:00"),
+		shiftEntry(10, "TOR", 8, "01:00", "02:00"),
+		// A forward, the other team and a second period do not pair.
+		shiftEntry(10, "TOR", 1, "00:00", "02:00"),
+		shiftEntry(8, "MTL", 3, "00:00", "02:00"),
+		model.ShiftEntry{PlayerID: 8, TeamID: 10, TeamAbbrev: "TOR", Period: 2, StartTime: "00:00", EndTime: "01:00", TypeCode: shiftTypeCode},
+	)
+
+	got := DefensePairs(game, "TOR")
+	want := []struct {
+		players [2]model.PlayerID
+		toi     time.Duration
+	}{
+		{[2]model.PlayerID{7, 8}, 60 * time.Second},
+		{[2]model.PlayerID{5, 6}, 50 * time.Second},
+		{[2]model.PlayerID{5, 7}, 20 * time.Second},
+		{[2]model.PlayerID{6, 7}, 10 * time.Second},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("DefensePairs() = %+v, want %d pairs", got, len(want))
+	}
+	for i, w := range want {
+		if got[i].Players != w.players || got[i].TOI != w.toi || got[i].Games != 1 {
+			t.Errorf("pair %d = %+v, want %v for %v", i, got[i], w.players, w.toi)
+		}
+	}
+
+	if pairs := DefensePairs(GameShifts{}, "TOR"); len(pairs) != 0 {
+		t.Errorf("DefensePairs() without data = %+v", pairs)
+	}
+}
+
+func TestPairingContinuity(t *testing.T) {
+	stable := pairingGame(
+		shiftEntry(10, "TOR", 5, "00:00", "01:00"),
+		shiftEntry(10, "TOR", 6, "00:00", "01:00"),
+		shiftEntry(10, "TOR", 7, "01:00", "02:00"),
+		shiftEntry(10, "TOR", 8, "01:00", "02:00"),
+	)
+	shuffled := pairingGame(
+		shiftEntry(10, "TOR", 5, "00:00", "01:00"),
+		shiftEntry(10, "TOR", 7, "00:00", "01:00"),
+		shiftEntry(10, "TOR", 6, "01:00", "01:30"),
+		shiftEntry(10, "TOR", 8, "01:00", "01:30"),
+	)
+	idle := pairingGame(shiftEntry(8, "MTL", 3, "00:00", "01:00"))
+
+	got := PairingContinuity("TOR", []GameShifts{stable, stable, shuffled, idle})
+	if got.Games != 3 {
+		t.Errorf("Games = %d, want 3", got.Games)
+	}
+	if len(got.Pairs) != 4 {
+		t.Fatalf("Pairs = %+v, want 4", got.Pairs)
+	}
+	if p := got.Pairs[0]; p.Players != [2]model.PlayerID{5, 6} || p.TOI != 2*time.Minute || p.Games != 2 {
+		t.Errorf("top pair = %+v", p)
+	}
+	if got.TotalTOI != 330*time.Second || got.TopTOI != 300*time.Second {
+		t.Errorf("TotalTOI, TopTOI = %v, %v, want 5m30s, 5m0s", got.TotalTOI, got.TopTOI)
+	}
+	if want := 300.0 / 330.0; got.Percentage != want {
+		t.Errorf("Percentage = %v, want %v", got.Percentage, want)
+	}
+
+	empty := PairingContinuity("TOR", nil)
+	if empty.Games != 0 || empty.Percentage != 0 || empty.Pairs == nil {
+		t.Errorf("PairingContinuity(nil) = %+v", empty)
+	}
+}
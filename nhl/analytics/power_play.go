@@ -0,0 +1,196 @@
+package analytics
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// PowerPlayOutcome is how a power-play segment ended.
+type PowerPlayOutcome string
+
+const (
+	// PowerPlayGoal means the team with the advantage scored.
+	PowerPlayGoal PowerPlayOutcome = "power-play-goal"
+	// ShortHandedGoal means the short-handed team scored.
+	ShortHandedGoal PowerPlayOutcome = "short-handed-goal"
+	// PowerPlayExpired means the situation changed without a goal: a
+	// penalty expired, another was called, or a goalie was pulled.
+	PowerPlayExpired PowerPlayOutcome = "expired"
+	// PowerPlayPeriodEnd means the period ended during the segment.
+	PowerPlayPeriodEnd PowerPlayOutcome = "period-end"
+)
+
+// PowerPlaySegment is a stretch of play with one team holding a man
+// advantage at a constant strength, with both goalies in net.
+type PowerPlaySegment struct {
+	Period int
+	// Start and End are elapsed times in the period, in MM:SS form.
+	Start string
+	End   string
+
+	PowerPlayTeam   string
+	ShortHandedTeam string
+	// Strength is the skater count, power-play team first, e.g. "5v4".
+	Strength string
+	Outcome  PowerPlayOutcome
+
+	// ShotsFor and ShotsAgainst count shots on goal, goals included, by
+	// the power-play team and the short-handed team.
+	ShotsFor     int
+	ShotsAgainst int
+
+	// PowerPlaySkaters and PenaltyKillSkaters list, by player ID, the
+	// skaters on the ice at any point of the segment. They are nil without
+	// a shift chart.
+	PowerPlaySkaters   []model.PlayerID
+	PenaltyKillSkaters []model.PlayerID
+}
+
+// Duration returns the length of the segment.
+func (s PowerPlaySegment) Duration() time.Duration {
+	start, ok1 := clockSeconds(s.Start)
+	end, ok2 := clockSeconds(s.End)
+	if !ok1 || !ok2 || end < start {
+		return 0
+	}
+	return time.Duration(end-start) * time.Second
+}
+
+// PowerPlaySegments splits a game into power-play segments using the
+// situation code of each play. A segment starts at the first play with a
+// man advantage and ends at the first play with a different situation, at
+// a goal, or at the end of the period, so a 5-on-4 that becomes a 5-on-3
+// is two segments. With a shift chart, the skaters on the ice for each
+// side are listed; shifts may be nil. Shootouts are ignored.
+func PowerPlaySegments(pbp *model.PlayByPlay, shifts *model.ShiftChart) []PowerPlaySegment {
+	segments := []PowerPlaySegment{}
+	if pbp == nil {
+		return segments
+	}
+	plays := make([]*model.PlayEvent, 0, len(pbp.Plays))
+	for i := range pbp.Plays {
+		if pbp.Plays[i].PeriodDescriptor.PeriodType != model.PeriodTypeShootout {
+			plays = append(plays, &pbp.Plays[i])
+		}
+	}
+	slices.SortStableFunc(plays, func(a, b *model.PlayEvent) int { return cmp.Compare(a.SortOrder, b.SortOrder) })
+
+	var open *PowerPlaySegment
+	var openHome bool
+	closeAt := func(play *model.PlayEvent, outcome PowerPlayOutcome) {
+		open.End = play.TimeInPeriod
+		open.Outcome = outcome
+		segments = append(segments, *open)
+		open = nil
+	}
+
+	var prev *model.PlayEvent
+	for _, play := range plays {
+		period := play.PeriodDescriptor.Number
+		if open != nil && period != open.Period {
+			// The period ended without a period-end play.
+			closeAt(prev, PowerPlayPeriodEnd)
+		}
+		prev = play
+		if play.TypeDescKey == model.PlayEventTypePeriodEnd {
+			if open != nil {
+				closeAt(play, PowerPlayPeriodEnd)
+			}
+			continue
+		}
+		situation := play.Situation()
+		if situation == nil {
+			continue
+		}
+		advantage := situation.AwayGoalieIn && situation.HomeGoalieIn && !situation.IsEvenStrength()
+		home := situation.IsHomePowerPlay()
+		strength := fmt.Sprintf("%dv%d", max(situation.AwaySkaters, situation.HomeSkaters), min(situation.AwaySkaters, situation.HomeSkaters))
+
+		if open != nil && (!advantage || home != openHome || strength != open.Strength) {
+			closeAt(play, PowerPlayExpired)
+		}
+		if advantage && open == nil {
+			open = &PowerPlaySegment{
+				Period:          period,
+				Start:           play.TimeInPeriod,
+				PowerPlayTeam:   pbp.AwayTeam.Abbrev,
+				ShortHandedTeam: pbp.HomeTeam.Abbrev,
+				Strength:        strength,
+			}
+			if home {
+				open.PowerPlayTeam, open.ShortHandedTeam = open.ShortHandedTeam, open.PowerPlayTeam
+			}
+			openHome = home
+		}
+		if open == nil || play.Details == nil || play.Details.EventOwnerTeamID == nil {
+			continue
+		}
+		if play.TypeDescKey != model.PlayEventTypeShotOnGoal && play.TypeDescKey != model.PlayEventTypeGoal {
+			continue
+		}
+		ppTeamID := pbp.AwayTeam.ID
+		if openHome {
+			ppTeamID = pbp.HomeTeam.ID
+		}
+		forPP := *play.Details.EventOwnerTeamID == ppTeamID
+		if forPP {
+			open.ShotsFor++
+		} else {
+			open.ShotsAgainst++
+		}
+		if play.TypeDescKey == model.PlayEventTypeGoal {
+			if forPP {
+				closeAt(play, PowerPlayGoal)
+			} else {
+				closeAt(play, ShortHandedGoal)
+			}
+		}
+	}
+	if open != nil {
+		closeAt(prev, PowerPlayPeriodEnd)
+	}
+
+	if shifts != nil {
+		for i := range segments {
+			addSegmentSkaters(pbp, shifts, &segments[i])
+		}
+	}
+	return segments
+}
+
+// addSegmentSkaters lists the skaters whose shifts overlap a segment.
+func addSegmentSkaters(pbp *model.PlayByPlay, shifts *model.ShiftChart, seg *PowerPlaySegment) {
+	start, ok1 := clockSeconds(seg.Start)
+	end, ok2 := clockSeconds(seg.End)
+	if !ok1 || !ok2 {
+		return
+	}
+	seg.PowerPlaySkaters, seg.PenaltyKillSkaters = []model.PlayerID{}, []model.PlayerID{}
+	for _, e := range shifts.Data {
+		if e.TypeCode != shiftTypeCode || e.Period != seg.Period {
+			continue
+		}
+		if spot := pbp.GetPlayer(e.PlayerID); spot != nil && spot.Position == model.PositionGoalie {
+			continue
+		}
+		from, ok1 := clockSeconds(e.StartTime)
+		to, ok2 := clockSeconds(e.EndTime)
+		if !ok1 || !ok2 || from >= end || to <= start {
+			continue
+		}
+		switch e.TeamAbbrev {
+		case seg.PowerPlayTeam:
+			seg.PowerPlaySkaters = append(seg.PowerPlaySkaters, e.PlayerID)
+		case seg.ShortHandedTeam:
+			seg.PenaltyKillSkaters = append(seg.PenaltyKillSkaters, e.PlayerID)
+		}
+	}
+	slices.Sort(seg.PowerPlaySkaters)
+	seg.PowerPlaySkaters = slices.Compact(seg.PowerPlaySkaters)
+	slices.Sort(seg.PenaltyKillSkaters)
+	seg.PenaltyKillSkaters = slices.Compact(seg.PenaltyKillSkaters)
+}
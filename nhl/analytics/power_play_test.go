@@ -0,0 +1,85 @@
+package analytics
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// situationPlay builds a play in a period with a sort order, clock and
+// situation code, owned by team when team is not 0.
+func situationPlay(kind model.PlayEventType, sortOrder, period int, clock, situation string, team model.TeamID) model.PlayEvent {
+	play := model.PlayEvent{
+		TypeDescKey:      kind,
+		SortOrder:        sortOrder,
+		PeriodDescriptor: model.PeriodDescriptor{Number: period, PeriodType: model.PeriodTypeRegulation},
+		TimeInPeriod:     clock,
+		SituationCode:    situation,
+		Details:          &model.PlayEventDetails{},
+	}
+	if team != 0 {
+		play.Details.EventOwnerTeamID = &team
+	}
+	return play
+}
+
+func TestPowerPlaySegments(t *testing.T) {
+	fo, sog, goal := model.PlayEventTypeFaceoff, model.PlayEventTypeShotOnGoal, model.PlayEventTypeGoal
+	pbp := shootoutGame(
+		situationPlay(fo, 1, 1, "02:00", "1551", 8),
+		// MTL (home) power play, ended by a power-play goal.
+		situationPlay(fo, 2, 1, "05:00", "1451", 8),
+		situationPlay(sog, 3, 1, "05:30", "1451", 8),
+		situationPlay(sog, 4, 1, "06:00", "1451", 10),
+		situationPlay(goal, 5, 1, "06:30", "1451", 8),
+		situationPlay(fo, 6, 1, "06:30", "1551", 10),
+		// TOR (away) 5-on-4 that becomes a 5-on-3 and runs out the period.
+		situationPlay(fo, 8, 1, "11:00", "1531", 10),
+		situationPlay(fo, 7, 1, "10:00", "1541", 10),
+		situationPlay(model.PlayEventTypePeriodEnd, 9, 1, "20:00", "1531", 0),
+		// An empty net is not a power play.
+		situationPlay(fo, 10, 2, "00:00", "0651", 10),
+	)
+	pbp.RosterSpots[2].Position = model.PositionGoalie
+	shifts := &model.ShiftChart{Data: []model.ShiftEntry{
+		shiftEntry(8, "MTL", 3, "04:30", "06:40"),
+		shiftEntry(8, "MTL", 4, "07:00", "08:00"),
+		shiftEntry(10, "TOR", 1, "05:00", "06:00"),
+		shiftEntry(10, "TOR", 30, "00:00", "20:00"),
+	}}
+
+	segments := PowerPlaySegments(pbp, shifts)
+	want := []PowerPlaySegment{
+		{
+			Period: 1, Start: "05:00", End: "06:30", PowerPlayTeam: "MTL", ShortHandedTeam: "TOR",
+			Strength: "5v4", Outcome: PowerPlayGoal, ShotsFor: 2, ShotsAgainst: 1,
+			PowerPlaySkaters: []model.PlayerID{3}, PenaltyKillSkaters: []model.PlayerID{1},
+		},
+		{
+			Period: 1, Start: "10:00", End: "11:00", PowerPlayTeam: "TOR", ShortHandedTeam: "MTL",
+			Strength: "5v4", Outcome: PowerPlayExpired,
+			PowerPlaySkaters: []model.PlayerID{}, PenaltyKillSkaters: []model.PlayerID{},
+		},
+		{
+			Period: 1, Start: "11:00", End: "20:00", PowerPlayTeam: "TOR", ShortHandedTeam: "MTL",
+			Strength: "5v3", Outcome: PowerPlayPeriodEnd,
+			PowerPlaySkaters: []model.PlayerID{}, PenaltyKillSkaters: []model.PlayerID{},
+		},
+	}
+	if !reflect.DeepEqual(segments, want) {
+		t.Errorf("PowerPlaySegments() =\n%+v\nwant\n%+v", segments, want)
+	}
+	if got := segments[0].Duration(); got != 90*time.Second {
+		t.Errorf("Duration() = %v, want 90s", got)
+	}
+
+	noShifts := PowerPlaySegments(pbp, nil)
+	if len(noShifts) != 3 || noShifts[0].PowerPlaySkaters != nil {
+		t.Errorf("PowerPlaySegments(no shifts) = %+v", noShifts)
+	}
+	if got := PowerPlaySegments(nil, nil); got == nil || len(got) != 0 {
+		t.Errorf("PowerPlaySegments(nil) = %v", got)
+	}
+}
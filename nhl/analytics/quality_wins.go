@@ -0,0 +1,119 @@
+package analytics
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// QualityRecord puts a team's record in the context of its schedule.
+type QualityRecord struct {
+	Team string
+
+	// GamesPlayed and Wins count the completed games considered.
+	GamesPlayed int
+	Wins        int
+
+	// GamesVsPlayoffTeams and QualityWins count games against, and wins
+	// over, teams currently holding a playoff spot.
+	GamesVsPlayoffTeams int
+	QualityWins         int
+
+	// StrengthOfSchedule is the average points percentage of the
+	// opponents faced, and StrengthOfVictory that of the opponents beaten.
+	// Both are 0 when there are no such games.
+	StrengthOfSchedule float64
+	StrengthOfVictory  float64
+}
+
+// QualityWins summarizes each team's completed games against the current
+// standings: wins over teams in playoff position (top three of a division
+// or a wild card) and the average points percentage of opponents faced
+// and beaten. Games that are not final, carry no score, or involve a team
+// missing from the standings are skipped. Records are ordered by quality
+// wins, then strength of victory, then team.
+func QualityWins(games []model.ScheduleGame, standings []model.Standing) []QualityRecord {
+	table := model.Standings(standings)
+	byAbbrev := make(map[string]*model.Standing, len(standings))
+	inPlayoffs := make(map[string]bool, len(standings))
+	for i := range standings {
+		s := &standings[i]
+		byAbbrev[s.TeamAbbrev.Default] = s
+	}
+	for conference := range table.ByConference() {
+		picture := table.PlayoffPicture(conference)
+		for abbrev := range byAbbrev {
+			if picture.InPlayoffPosition(model.TeamAbbrev(abbrev)) {
+				inPlayoffs[abbrev] = true
+			}
+		}
+	}
+
+	type tally struct {
+		record               QualityRecord
+		scheduleSum, winsSum float64
+	}
+	tallies := make(map[string]*tally)
+	teamTally := func(abbrev string) *tally {
+		t, ok := tallies[abbrev]
+		if !ok {
+			t = &tally{record: QualityRecord{Team: abbrev}}
+			tallies[abbrev] = t
+		}
+		return t
+	}
+	count := func(team, opponent string, won bool) {
+		t := teamTally(team)
+		pct := byAbbrev[opponent].PointsPercentage()
+		t.record.GamesPlayed++
+		t.scheduleSum += pct
+		if inPlayoffs[opponent] {
+			t.record.GamesVsPlayoffTeams++
+		}
+		if won {
+			t.record.Wins++
+			t.winsSum += pct
+			if inPlayoffs[opponent] {
+				t.record.QualityWins++
+			}
+		}
+	}
+
+	for _, g := range games {
+		if !g.GameState.IsFinal() || g.AwayTeam.Score == nil || g.HomeTeam.Score == nil {
+			continue
+		}
+		away, home := g.AwayTeam.Abbrev, g.HomeTeam.Abbrev
+		if byAbbrev[away] == nil || byAbbrev[home] == nil {
+			continue
+		}
+		awayWon := *g.AwayTeam.Score > *g.HomeTeam.Score
+		count(away, home, awayWon)
+		count(home, away, !awayWon)
+	}
+
+	records := make([]QualityRecord, 0, len(tallies))
+	for _, t := range tallies {
+		if t.record.GamesPlayed > 0 {
+			t.record.StrengthOfSchedule = t.scheduleSum / float64(t.record.GamesPlayed)
+		}
+		if t.record.Wins > 0 {
+			t.record.StrengthOfVictory = t.winsSum / float64(t.record.Wins)
+		}
+		records = append(records, t.record)
+	}
+	slices.SortFunc(records, func(a, b QualityRecord) int {
+		switch {
+		case a.QualityWins != b.QualityWins:
+			return b.QualityWins - a.QualityWins
+		case a.StrengthOfVictory != b.StrengthOfVictory:
+			if a.StrengthOfVictory > b.StrengthOfVictory {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Team, b.Team)
+	})
+	return records
+}
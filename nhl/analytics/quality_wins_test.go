@@ -0,0 +1,68 @@
+package analytics
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func finalGame(away, home string, awayScore, homeScore int) model.ScheduleGame {
+	g := makeGame(away, home)
+	g.GameState = model.GameStateOff
+	g.AwayTeam.Score = &awayScore
+	g.HomeTeam.Score = &homeScore
+	return g
+}
+
+func TestQualityWins(t *testing.T) {
+	// Top three of the division plus two wild cards: only DET is out.
+	standings := []model.Standing{
+		makeStanding("BOS", "E", "A", 40, 10, 0),
+		makeStanding("TOR", "E", "A", 35, 15, 0),
+		makeStanding("MTL", "E", "A", 30, 20, 0),
+		makeStanding("OTT", "E", "A", 25, 25, 0),
+		makeStanding("BUF", "E", "A", 20, 30, 0),
+		makeStanding("DET", "E", "A", 10, 40, 0),
+	}
+	games := []model.ScheduleGame{
+		finalGame("DET", "BOS", 3, 2),
+		finalGame("DET", "BUF", 1, 4),
+		finalGame("TOR", "MTL", 1, 5),
+		finalGame("MTL", "DET", 2, 0),
+		finalGame("XXX", "MTL", 9, 0),
+		makeGame("BOS", "TOR"),
+	}
+
+	records := QualityWins(games, standings)
+	var order []string
+	for _, r := range records {
+		order = append(order, r.Team)
+	}
+	if got := strings.Join(order, ","); got != "DET,MTL,BUF,BOS,TOR" {
+		t.Fatalf("order = %s, want DET,MTL,BUF,BOS,TOR", got)
+	}
+
+	det := records[0]
+	if det.GamesPlayed != 3 || det.Wins != 1 || det.QualityWins != 1 || det.GamesVsPlayoffTeams != 3 {
+		t.Errorf("DET = %+v", det)
+	}
+	if math.Abs(det.StrengthOfVictory-0.8) > 1e-9 || math.Abs(det.StrengthOfSchedule-0.6) > 1e-9 {
+		t.Errorf("DET SoV = %v, SoS = %v; want 0.8, 0.6", det.StrengthOfVictory, det.StrengthOfSchedule)
+	}
+
+	mtl := records[1]
+	if mtl.Wins != 2 || mtl.QualityWins != 1 || math.Abs(mtl.StrengthOfVictory-0.45) > 1e-9 {
+		t.Errorf("MTL = %+v", mtl)
+	}
+	if tor := records[4]; tor.Wins != 0 || tor.StrengthOfVictory != 0 || tor.GamesVsPlayoffTeams != 1 {
+		t.Errorf("TOR = %+v", tor)
+	}
+}
+
+func TestQualityWins_Empty(t *testing.T) {
+	if got := QualityWins(nil, nil); len(got) != 0 {
+		t.Errorf("QualityWins(nil, nil) = %v, want empty", got)
+	}
+}
@@ -0,0 +1,165 @@
+package analytics
+
+import "github.com/sperano/nhl-api-go/nhl/model"
+
+// Rivalry is a named pairing of two teams, identified by abbreviation.
+type Rivalry struct {
+	Name  string
+	Teams [2]string
+}
+
+// Involves returns true if the team abbreviation is part of the rivalry.
+func (r Rivalry) Involves(teamAbbrev string) bool {
+	return r.Teams[0] == teamAbbrev || r.Teams[1] == teamAbbrev
+}
+
+// Opponent returns the other team in the rivalry, or "" if the team is not part of it.
+func (r Rivalry) Opponent(teamAbbrev string) string {
+	switch teamAbbrev {
+	case r.Teams[0]:
+		return r.Teams[1]
+	case r.Teams[1]:
+		return r.Teams[0]
+	default:
+		return ""
+	}
+}
+
+// defaultRivalries is the curated rivalry list shipped with the package.
+var defaultRivalries = []Rivalry{
+	{Name: "Battle of Alberta", Teams: [2]string{"CGY", "EDM"}},
+	{Name: "Battle of Ontario", Teams: [2]string{"OTT", "TOR"}},
+	{Name: "Battle of Pennsylvania", Teams: [2]string{"PHI", "PIT"}},
+	{Name: "Battle of Florida", Teams: [2]string{"FLA", "TBL"}},
+	{Name: "Battle of New York", Teams: [2]string{"NYI", "NYR"}},
+	{Name: "Hudson River Rivalry", Teams: [2]string{"NJD", "NYR"}},
+	{Name: "Freeway Face-Off", Teams: [2]string{"ANA", "LAK"}},
+	{Name: "Sharks-Kings", Teams: [2]string{"LAK", "SJS"}},
+	{Name: "Capitals-Penguins", Teams: [2]string{"PIT", "WSH"}},
+	{Name: "Flyers-Rangers", Teams: [2]string{"NYR", "PHI"}},
+	{Name: "Avalanche-Red Wings", Teams: [2]string{"COL", "DET"}},
+	{Name: "Blackhawks-Blues", Teams: [2]string{"CHI", "STL"}},
+	{Name: "Bruins-Canadiens", Teams: [2]string{"BOS", "MTL"}},
+	{Name: "Canadiens-Maple Leafs", Teams: [2]string{"MTL", "TOR"}},
+	{Name: "Blackhawks-Red Wings", Teams: [2]string{"CHI", "DET"}},
+	{Name: "Original Six", Teams: [2]string{"BOS", "TOR"}},
+	{Name: "Original Six", Teams: [2]string{"BOS", "NYR"}},
+	{Name: "Original Six", Teams: [2]string{"BOS", "CHI"}},
+	{Name: "Original Six", Teams: [2]string{"BOS", "DET"}},
+	{Name: "Original Six", Teams: [2]string{"MTL", "NYR"}},
+	{Name: "Original Six", Teams: [2]string{"CHI", "MTL"}},
+	{Name: "Original Six", Teams: [2]string{"DET", "MTL"}},
+	{Name: "Original Six", Teams: [2]string{"NYR", "TOR"}},
+	{Name: "Original Six", Teams: [2]string{"CHI", "TOR"}},
+	{Name: "Original Six", Teams: [2]string{"DET", "TOR"}},
+	{Name: "Original Six", Teams: [2]string{"CHI", "NYR"}},
+	{Name: "Original Six", Teams: [2]string{"DET", "NYR"}},
+}
+
+// RivalryTable is a lookup of rivalries by team pairing.
+// The zero value is an empty table ready to use.
+type RivalryTable struct {
+	rivalries []Rivalry
+	byPair    map[[2]string]int
+}
+
+// NewRivalryTable creates a table containing the given rivalries.
+// A later rivalry for the same pairing replaces an earlier one.
+func NewRivalryTable(rivalries ...Rivalry) *RivalryTable {
+	t := &RivalryTable{}
+	for _, r := range rivalries {
+		t.Add(r)
+	}
+	return t
+}
+
+// DefaultRivalryTable returns a fresh copy of the curated rivalry table.
+// The returned table can be customized with Add and Remove without affecting
+// other callers.
+func DefaultRivalryTable() *RivalryTable {
+	return NewRivalryTable(defaultRivalries...)
+}
+
+// pairKey returns an order-independent key for two team abbreviations.
+func pairKey(a, b string) [2]string {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]string{a, b}
+}
+
+// Add registers a rivalry, replacing any existing rivalry for the same pairing.
+func (t *RivalryTable) Add(r Rivalry) {
+	if t.byPair == nil {
+		t.byPair = make(map[[2]string]int)
+	}
+	key := pairKey(r.Teams[0], r.Teams[1])
+	if i, ok := t.byPair[key]; ok {
+		t.rivalries[i] = r
+		return
+	}
+	t.byPair[key] = len(t.rivalries)
+	t.rivalries = append(t.rivalries, r)
+}
+
+// Remove deletes the rivalry between two teams, if any.
+func (t *RivalryTable) Remove(teamA, teamB string) {
+	key := pairKey(teamA, teamB)
+	i, ok := t.byPair[key]
+	if !ok {
+		return
+	}
+	t.rivalries = append(t.rivalries[:i], t.rivalries[i+1:]...)
+	delete(t.byPair, key)
+	for k, idx := range t.byPair {
+		if idx > i {
+			t.byPair[k] = idx - 1
+		}
+	}
+}
+
+// Rivalry returns the rivalry between two teams, if one is defined.
+func (t *RivalryTable) Rivalry(teamA, teamB string) (Rivalry, bool) {
+	i, ok := t.byPair[pairKey(teamA, teamB)]
+	if !ok {
+		return Rivalry{}, false
+	}
+	return t.rivalries[i], true
+}
+
+// IsRivalryGame returns true if the game's two teams form a rivalry.
+func (t *RivalryTable) IsRivalryGame(game model.ScheduleGame) bool {
+	_, ok := t.Rivalry(game.AwayTeam.Abbrev, game.HomeTeam.Abbrev)
+	return ok
+}
+
+// Rivalries returns all rivalries involving the team, in table order.
+func (t *RivalryTable) Rivalries(teamAbbrev string) []Rivalry {
+	result := make([]Rivalry, 0)
+	for _, r := range t.rivalries {
+		if r.Involves(teamAbbrev) {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// All returns every rivalry in the table, in table order.
+func (t *RivalryTable) All() []Rivalry {
+	all := make([]Rivalry, len(t.rivalries))
+	copy(all, t.rivalries)
+	return all
+}
+
+var defaultRivalryTable = DefaultRivalryTable()
+
+// IsRivalryGame reports whether the game is a rivalry game according to the
+// curated default table. Use a RivalryTable for customized definitions.
+func IsRivalryGame(game model.ScheduleGame) bool {
+	return defaultRivalryTable.IsRivalryGame(game)
+}
+
+// Rivalries returns the curated rivalries involving the team.
+func Rivalries(teamAbbrev string) []Rivalry {
+	return defaultRivalryTable.Rivalries(teamAbbrev)
+}
@@ -0,0 +1,129 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func TestIsRivalryGame(t *testing.T) {
+	tests := []struct {
+		name string
+		away string
+		home string
+		want bool
+	}{
+		{"battle of alberta", "EDM", "CGY", true},
+		{"battle of alberta reversed", "CGY", "EDM", true},
+		{"original six", "CHI", "NYR", true},
+		{"not a rivalry", "SEA", "NSH", false},
+		{"same team", "EDM", "EDM", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRivalryGame(makeGame(tt.away, tt.home)); got != tt.want {
+				t.Errorf("IsRivalryGame(%s @ %s) = %v, want %v", tt.away, tt.home, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRivalries(t *testing.T) {
+	mtl := Rivalries("MTL")
+	// BOS, TOR named rivalries plus NYR, CHI, DET Original Six pairings
+	if len(mtl) != 5 {
+		t.Fatalf("expected 5 MTL rivalries, got %d: %v", len(mtl), mtl)
+	}
+	for _, r := range mtl {
+		if !r.Involves("MTL") {
+			t.Errorf("rivalry %q does not involve MTL", r.Name)
+		}
+	}
+
+	if got := Rivalries("SEA"); len(got) != 0 {
+		t.Errorf("expected no SEA rivalries, got %v", got)
+	}
+}
+
+func TestRivalry_Opponent(t *testing.T) {
+	r := Rivalry{Name: "Battle of Alberta", Teams: [2]string{"CGY", "EDM"}}
+	if got := r.Opponent("CGY"); got != "EDM" {
+		t.Errorf("Opponent(CGY) = %q, want EDM", got)
+	}
+	if got := r.Opponent("EDM"); got != "CGY" {
+		t.Errorf("Opponent(EDM) = %q, want CGY", got)
+	}
+	if got := r.Opponent("TOR"); got != "" {
+		t.Errorf("Opponent(TOR) = %q, want empty", got)
+	}
+}
+
+func TestRivalryTable_Customize(t *testing.T) {
+	table := DefaultRivalryTable()
+	table.Add(Rivalry{Name: "Cascadia", Teams: [2]string{"SEA", "VAN"}})
+	table.Remove("EDM", "CGY")
+
+	if !table.IsRivalryGame(makeGame("VAN", "SEA")) {
+		t.Error("custom rivalry SEA-VAN not detected")
+	}
+	if table.IsRivalryGame(makeGame("EDM", "CGY")) {
+		t.Error("removed rivalry CGY-EDM still detected")
+	}
+	if _, ok := table.Rivalry("OTT", "TOR"); !ok {
+		t.Error("Remove disturbed unrelated rivalry OTT-TOR")
+	}
+
+	// The package default must be unaffected by customization of a copy.
+	if !IsRivalryGame(makeGame("EDM", "CGY")) {
+		t.Error("customizing a copy modified the default table")
+	}
+}
+
+func TestRivalryTable_AddReplaces(t *testing.T) {
+	table := NewRivalryTable(Rivalry{Name: "Old", Teams: [2]string{"AAA", "BBB"}})
+	table.Add(Rivalry{Name: "New", Teams: [2]string{"BBB", "AAA"}})
+
+	if n := len(table.All()); n != 1 {
+		t.Fatalf("expected 1 rivalry after replace, got %d", n)
+	}
+	r, _ := table.Rivalry("AAA", "BBB")
+	if r.Name != "New" {
+		t.Errorf("Rivalry name = %q, want New", r.Name)
+	}
+}
+
+func TestRivalryTable_ZeroValue(t *testing.T) {
+	var table RivalryTable
+	if table.IsRivalryGame(makeGame("EDM", "CGY")) {
+		t.Error("zero-value table should contain no rivalries")
+	}
+	table.Remove("EDM", "CGY")
+	table.Add(Rivalry{Name: "X", Teams: [2]string{"EDM", "CGY"}})
+	if !table.IsRivalryGame(makeGame("CGY", "EDM")) {
+		t.Error("rivalry added to zero-value table not detected")
+	}
+}
+
+func TestWatchability_CuratedRivalry(t *testing.T) {
+	standings := []model.Standing{
+		makeStanding("EDM", "W", "P", 30, 20, 5),
+		makeStanding("CGY", "W", "P", 30, 20, 5),
+		makeStanding("VAN", "W", "P", 30, 20, 5),
+	}
+
+	rival := Watchability(makeGame("EDM", "CGY"), standings)
+	if rival.Rivalry != 1.0 {
+		t.Errorf("Battle of Alberta Rivalry = %v, want 1.0", rival.Rivalry)
+	}
+
+	divisional := Watchability(makeGame("EDM", "VAN"), standings)
+	if divisional.Rivalry >= rival.Rivalry {
+		t.Errorf("divisional rivalry %v should be below curated %v", divisional.Rivalry, rival.Rivalry)
+	}
+
+	custom := Watchability(makeGame("EDM", "CGY"), standings, WithRivalries(NewRivalryTable()))
+	if custom.Rivalry != 0.6 {
+		t.Errorf("Rivalry with empty table = %v, want divisional 0.6", custom.Rivalry)
+	}
+}
@@ -0,0 +1,167 @@
+package analytics
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// ShootoutShooter is a skater's shootout record across games.
+type ShootoutShooter struct {
+	PlayerID model.PlayerID
+	Name     string
+	Team     string
+
+	Attempts int
+	Goals    int
+}
+
+// Percentage returns the share of attempts scored, or 0 without attempts.
+func (s ShootoutShooter) Percentage() float64 {
+	if s.Attempts == 0 {
+		return 0
+	}
+	return float64(s.Goals) / float64(s.Attempts)
+}
+
+// ShootoutGoalie is a goalie's shootout record across games.
+type ShootoutGoalie struct {
+	PlayerID model.PlayerID
+	Name     string
+	Team     string
+
+	// Faced counts every attempt against the goalie, including misses and
+	// failed attempts; Stops those that did not score.
+	Faced int
+	Stops int
+}
+
+// StopRate returns the share of attempts stopped, or 0 without attempts.
+func (g ShootoutGoalie) StopRate() float64 {
+	if g.Faced == 0 {
+		return 0
+	}
+	return float64(g.Stops) / float64(g.Faced)
+}
+
+// ShootoutRecords aggregates the shootout attempts in the play-by-play of
+// several games into per-player records, which the league's basic stat
+// endpoints do not report. Every shot, miss, failed attempt and goal in a
+// shootout period counts as an attempt, and each attempt is credited to
+// the goalie in net when the play records one. A player's team is the
+// team of their most recent game.
+//
+// Shooters are ordered by goals, then fewer attempts, then player ID;
+// goalies by stops, then fewer attempts faced, then player ID. Nil
+// play-by-plays and games without a shootout are skipped.
+func ShootoutRecords(pbps []*model.PlayByPlay) ([]ShootoutShooter, []ShootoutGoalie) {
+	shooters := make(map[model.PlayerID]*ShootoutShooter)
+	goalies := make(map[model.PlayerID]*ShootoutGoalie)
+
+	for _, pbp := range pbps {
+		if pbp == nil {
+			continue
+		}
+		teams := map[model.TeamID]string{
+			pbp.AwayTeam.ID: pbp.AwayTeam.Abbrev,
+			pbp.HomeTeam.ID: pbp.HomeTeam.Abbrev,
+		}
+		for i := range pbp.Plays {
+			play := &pbp.Plays[i]
+			if play.PeriodDescriptor.PeriodType != model.PeriodTypeShootout || play.Details == nil {
+				continue
+			}
+			shooterID, scored, ok := shootoutAttempt(play)
+			if !ok {
+				continue
+			}
+
+			team := ""
+			if play.Details.EventOwnerTeamID != nil {
+				team = teams[*play.Details.EventOwnerTeamID]
+			}
+			s := shooters[shooterID]
+			if s == nil {
+				s = &ShootoutShooter{PlayerID: shooterID}
+				shooters[shooterID] = s
+			}
+			s.Name = rosterName(pbp, shooterID)
+			s.Team = team
+			s.Attempts++
+			if scored {
+				s.Goals++
+			}
+
+			if play.Details.GoalieInNetID == nil {
+				continue
+			}
+			goalieID := *play.Details.GoalieInNetID
+			g := goalies[goalieID]
+			if g == nil {
+				g = &ShootoutGoalie{PlayerID: goalieID}
+				goalies[goalieID] = g
+			}
+			g.Name = rosterName(pbp, goalieID)
+			if spot := pbp.GetPlayer(goalieID); spot != nil {
+				g.Team = teams[spot.TeamID]
+			}
+			g.Faced++
+			if !scored {
+				g.Stops++
+			}
+		}
+	}
+
+	shooterList := make([]ShootoutShooter, 0, len(shooters))
+	for _, s := range shooters {
+		shooterList = append(shooterList, *s)
+	}
+	slices.SortFunc(shooterList, func(a, b ShootoutShooter) int {
+		return cmp.Or(
+			cmp.Compare(b.Goals, a.Goals),
+			cmp.Compare(a.Attempts, b.Attempts),
+			cmp.Compare(a.PlayerID, b.PlayerID),
+		)
+	})
+
+	goalieList := make([]ShootoutGoalie, 0, len(goalies))
+	for _, g := range goalies {
+		goalieList = append(goalieList, *g)
+	}
+	slices.SortFunc(goalieList, func(a, b ShootoutGoalie) int {
+		return cmp.Or(
+			cmp.Compare(b.Stops, a.Stops),
+			cmp.Compare(a.Faced, b.Faced),
+			cmp.Compare(a.PlayerID, b.PlayerID),
+		)
+	})
+	return shooterList, goalieList
+}
+
+// shootoutAttempt returns the shooter of a shootout play and whether it
+// scored, reporting false for plays that are not attempts.
+func shootoutAttempt(play *model.PlayEvent) (model.PlayerID, bool, bool) {
+	d := play.Details
+	switch play.TypeDescKey {
+	case model.PlayEventTypeGoal:
+		if d.ScoringPlayerID != nil {
+			return *d.ScoringPlayerID, true, true
+		}
+	case model.PlayEventTypeShotOnGoal, model.PlayEventTypeMissedShot, model.PlayEventTypeFailedShotAttempt:
+		if d.ShootingPlayerID != nil {
+			return *d.ShootingPlayerID, false, true
+		}
+	}
+	return 0, false, false
+}
+
+// rosterName returns a player's full name from the game roster, or "" when
+// the player is not listed.
+func rosterName(pbp *model.PlayByPlay, id model.PlayerID) string {
+	spot := pbp.GetPlayer(id)
+	if spot == nil {
+		return ""
+	}
+	return spot.FirstName.Default + " " + spot.LastName.Default
+}
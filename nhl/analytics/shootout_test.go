@@ -0,0 +1,110 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// shootoutGame builds a TOR @ MTL play-by-play with the given plays.
+func shootoutGame(plays ...model.PlayEvent) *model.PlayByPlay {
+	spot := func(team model.TeamID, id model.PlayerID, first, last string) model.RosterSpot {
+		return model.RosterSpot{
+			TeamID:    team,
+			PlayerID:  id,
+			FirstName: model.LocalizedString{Default: first},
+			LastName:  model.LocalizedString{Default: last},
+		}
+	}
+	return &model.PlayByPlay{
+		AwayTeam: model.BoxscoreTeam{ID: 10, Abbrev: "TOR"},
+		HomeTeam: model.BoxscoreTeam{ID: 8, Abbrev: "MTL"},
+		Plays:    plays,
+		RosterSpots: []model.RosterSpot{
+			spot(10, 1, "Auston", "Matthews"),
+			spot(10, 2, "Mitch", "Marner"),
+			spot(10, 30, "Joseph", "Woll"),
+			spot(8, 3, "Nick", "Suzuki"),
+			spot(8, 4, "Cole", "Caufield"),
+			spot(8, 31, "Sam", "Montembeault"),
+		},
+	}
+}
+
+// attempt builds a shot or goal by shooter against goalie, or against no
+// recorded goalie when goalie is 0.
+func attempt(periodType model.PeriodType, kind model.PlayEventType, team model.TeamID, shooter, goalie model.PlayerID) model.PlayEvent {
+	d := &model.PlayEventDetails{EventOwnerTeamID: &team}
+	if kind == model.PlayEventTypeGoal {
+		d.ScoringPlayerID = &shooter
+	} else {
+		d.ShootingPlayerID = &shooter
+	}
+	if goalie != 0 {
+		d.GoalieInNetID = &goalie
+	}
+	return model.PlayEvent{
+		PeriodDescriptor: model.PeriodDescriptor{PeriodType: periodType},
+		TypeDescKey:      kind,
+		Details:          d,
+	}
+}
+
+func TestShootoutRecords(t *testing.T) {
+	so := model.PeriodTypeShootout
+	game1 := shootoutGame(
+		attempt(model.PeriodTypeRegulation, model.PlayEventTypeGoal, 10, 1, 31),
+		attempt(so, model.PlayEventTypeGoal, 10, 1, 31),
+		attempt(so, model.PlayEventTypeShotOnGoal, 8, 3, 30),
+		attempt(so, model.PlayEventTypeMissedShot, 10, 2, 31),
+		attempt(so, model.PlayEventTypeGoal, 8, 4, 30),
+		attempt(so, model.PlayEventTypeFailedShotAttempt, 10, 1, 0),
+		attempt(so, model.PlayEventTypeShotOnGoal, 8, 3, 30),
+	)
+	game2 := shootoutGame(
+		attempt(so, model.PlayEventTypeGoal, 8, 3, 30),
+		attempt(so, model.PlayEventTypeShotOnGoal, 10, 1, 31),
+	)
+
+	shooters, goalies := ShootoutRecords([]*model.PlayByPlay{game1, nil, game2})
+
+	want := []ShootoutShooter{
+		{PlayerID: 4, Name: "Cole Caufield", Team: "MTL", Attempts: 1, Goals: 1},
+		{PlayerID: 1, Name: "Auston Matthews", Team: "TOR", Attempts: 3, Goals: 1},
+		{PlayerID: 3, Name: "Nick Suzuki", Team: "MTL", Attempts: 3, Goals: 1},
+		{PlayerID: 2, Name: "Mitch Marner", Team: "TOR", Attempts: 1, Goals: 0},
+	}
+	if len(shooters) != len(want) {
+		t.Fatalf("shooters = %+v", shooters)
+	}
+	for i := range want {
+		if shooters[i] != want[i] {
+			t.Errorf("shooters[%d] = %+v, want %+v", i, shooters[i], want[i])
+		}
+	}
+	if got := shooters[1].Percentage(); got != 1.0/3 {
+		t.Errorf("Matthews percentage = %v", got)
+	}
+
+	if len(goalies) != 2 {
+		t.Fatalf("goalies = %+v", goalies)
+	}
+	// Tied on stops, so the goalie who faced fewer attempts comes first.
+	monty, woll := goalies[0], goalies[1]
+	if monty.PlayerID != 31 || monty.Name != "Sam Montembeault" || monty.Team != "MTL" || monty.Faced != 3 || monty.Stops != 2 {
+		t.Errorf("goalies[0] = %+v", monty)
+	}
+	if woll.PlayerID != 30 || woll.Team != "TOR" || woll.Faced != 4 || woll.Stops != 2 {
+		t.Errorf("goalies[1] = %+v", woll)
+	}
+	if got := woll.StopRate(); got != 0.5 {
+		t.Errorf("StopRate() = %v, want 0.5", got)
+	}
+
+	if s, g := ShootoutRecords(nil); len(s) != 0 || len(g) != 0 {
+		t.Errorf("ShootoutRecords(nil) = %v, %v", s, g)
+	}
+	if (ShootoutShooter{}).Percentage() != 0 || (ShootoutGoalie{}).StopRate() != 0 {
+		t.Error("rates without attempts should be 0")
+	}
+}
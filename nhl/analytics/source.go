@@ -0,0 +1,37 @@
+package analytics
+
+import (
+	"context"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// The sources below are what the functions that fetch their own data read
+// from. *nhl.Client implements all of them; the package itself depends
+// only on nhl/model.
+
+// BoxscoreSource fetches the boxscore of a game.
+type BoxscoreSource interface {
+	Boxscore(ctx context.Context, gameID model.GameID) (*model.Boxscore, error)
+}
+
+// WeeklyScheduleSource fetches the league schedule of a week and its
+// boxscores, for WeeklyStars.
+type WeeklyScheduleSource interface {
+	BoxscoreSource
+	WeeklySchedule(ctx context.Context, date model.GameDate) (*model.WeeklyScheduleResponse, error)
+}
+
+// SeasonScheduleSource fetches the league schedule of a season and its
+// boxscores, for StandingsThroughGames.
+type SeasonScheduleSource interface {
+	BoxscoreSource
+	FullSeasonSchedule(ctx context.Context, season model.Season) ([]model.ScheduleGame, error)
+}
+
+// TeamScheduleSource fetches a club's schedule for a season and its
+// boxscores, for AttendanceReport.
+type TeamScheduleSource interface {
+	BoxscoreSource
+	TeamFullSeasonSchedule(ctx context.Context, teamAbbr string, season model.Season) ([]model.ScheduleGame, error)
+}
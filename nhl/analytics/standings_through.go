@@ -0,0 +1,216 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// StandingsThroughGames reconstructs the regular-season standings of a
+// season as they stood once every team had played gamesPlayed games, so
+// early-season records compare fairly across years and between teams that
+// have played a different number of games. A team that has not reached
+// gamesPlayed yet counts every game it has completed.
+//
+// Games come from the season schedule and results from each game's
+// boxscore, so the call makes one request per schedule week plus one per
+// game counted. Goal differential leaves out shootout goals, as the
+// league's does; the last-ten record and streak cover the counted games.
+func StandingsThroughGames(ctx context.Context, src SeasonScheduleSource, season model.Season, gamesPlayed int) (model.Standings, error) {
+	if gamesPlayed < 0 {
+		return nil, fmt.Errorf("games played must not be negative: %d", gamesPlayed)
+	}
+	schedule, err := src.FullSeasonSchedule(ctx, season)
+	if err != nil {
+		return nil, err
+	}
+	games := countedGames(schedule, gamesPlayed)
+
+	results := make(map[model.GameID]gameResult, len(games))
+	for _, g := range games {
+		if !g.GameState.IsFinal() {
+			continue
+		}
+		box, err := src.Boxscore(ctx, g.ID)
+		if err != nil {
+			return nil, err
+		}
+		results[g.ID] = gameResult{
+			away:       box.AwayTeam.Score,
+			home:       box.HomeTeam.Score,
+			lastPeriod: box.PeriodDescriptor.PeriodType,
+		}
+	}
+	return tallyStandings(schedule, results, gamesPlayed), nil
+}
+
+// gameResult is the final score of a game and the period it ended in.
+type gameResult struct {
+	away, home int
+	lastPeriod model.PeriodType
+}
+
+// countedGames returns the completed regular-season games that fall within
+// the first gamesPlayed games of at least one of the two teams.
+func countedGames(schedule []model.ScheduleGame, gamesPlayed int) []model.ScheduleGame {
+	played := make(map[string]int)
+	var counted []model.ScheduleGame
+	for _, g := range regularSeasonOrder(schedule) {
+		if !g.GameState.IsFinal() {
+			continue
+		}
+		away, home := g.AwayTeam.Abbrev, g.HomeTeam.Abbrev
+		if played[away] < gamesPlayed || played[home] < gamesPlayed {
+			counted = append(counted, g)
+		}
+		played[away]++
+		played[home]++
+	}
+	return counted
+}
+
+// regularSeasonOrder returns the regular-season games of a schedule in the
+// order they were played.
+func regularSeasonOrder(schedule []model.ScheduleGame) []model.ScheduleGame {
+	var games []model.ScheduleGame
+	for _, g := range schedule {
+		if g.GameType == model.GameTypeRegularSeason {
+			games = append(games, g)
+		}
+	}
+	slices.SortStableFunc(games, func(a, b model.ScheduleGame) int {
+		if a.StartTimeUTC != b.StartTimeUTC {
+			if a.StartTimeUTC < b.StartTimeUTC {
+				return -1
+			}
+			return 1
+		}
+		return 0
+	})
+	return games
+}
+
+// tallyStandings builds a standing for every team of the regular season
+// from the results of its first gamesPlayed completed games. Games without
+// a result are skipped.
+func tallyStandings(schedule []model.ScheduleGame, results map[model.GameID]gameResult, gamesPlayed int) model.Standings {
+	type record struct {
+		standing model.Standing
+		outcomes []byte // 'W', 'L' or 'O' per counted game
+	}
+	records := make(map[string]*record)
+	var order []string
+	teamRecord := func(team model.ScheduleTeam) *record {
+		r, ok := records[team.Abbrev]
+		if !ok {
+			abbrev := model.TeamAbbrev(team.Abbrev)
+			r = &record{standing: newStanding(abbrev, team.Logo)}
+			records[team.Abbrev] = r
+			order = append(order, team.Abbrev)
+		}
+		return r
+	}
+
+	for _, g := range regularSeasonOrder(schedule) {
+		away, home := teamRecord(g.AwayTeam), teamRecord(g.HomeTeam)
+		result, ok := results[g.ID]
+		if !ok {
+			continue
+		}
+		if len(away.outcomes) < gamesPlayed {
+			away.outcomes = append(away.outcomes, applyResult(&away.standing, result.away, result.home, result.lastPeriod))
+		}
+		if len(home.outcomes) < gamesPlayed {
+			home.outcomes = append(home.outcomes, applyResult(&home.standing, result.home, result.away, result.lastPeriod))
+		}
+	}
+
+	standings := make(model.Standings, 0, len(order))
+	for _, abbrev := range order {
+		r := records[abbrev]
+		setRecentForm(&r.standing, r.outcomes)
+		standings = append(standings, r.standing)
+	}
+	return standings.Sorted()
+}
+
+// newStanding returns an empty standing for a team, with its division and
+// conference as of today.
+func newStanding(abbrev model.TeamAbbrev, logo string) model.Standing {
+	conference, division := abbrev.Conference(), abbrev.Division()
+	standing := model.Standing{
+		DivisionAbbrev: division.Abbrev,
+		DivisionName:   division.Name,
+		TeamName:       model.LocalizedString{Default: abbrev.Name()},
+		TeamAbbrev:     model.LocalizedString{Default: abbrev.String()},
+		TeamLogo:       logo,
+	}
+	if conference.Abbrev != "" {
+		standing.ConferenceAbbrev = &conference.Abbrev
+		standing.ConferenceName = &conference.Name
+	}
+	return standing
+}
+
+// applyResult records one game for a team and returns its outcome: 'W',
+// 'L', or 'O' for an overtime or shootout loss.
+func applyResult(s *model.Standing, goalsFor, goalsAgainst int, lastPeriod model.PeriodType) byte {
+	scored, allowed := goalsFor, goalsAgainst
+	if lastPeriod == model.PeriodTypeShootout {
+		// The shootout winner is credited one goal in the final score.
+		if scored > allowed {
+			scored--
+		} else {
+			allowed--
+		}
+	}
+	s.GoalFor += scored
+	s.GoalAgainst += allowed
+	s.GoalDifferential += scored - allowed
+
+	switch {
+	case goalsFor > goalsAgainst:
+		s.Wins++
+		s.Points += 2
+		switch lastPeriod {
+		case model.PeriodTypeRegulation:
+			s.RegulationWins++
+			s.RegulationPlusOTWins++
+		case model.PeriodTypeOvertime:
+			s.RegulationPlusOTWins++
+		}
+		return 'W'
+	case lastPeriod == model.PeriodTypeOvertime || lastPeriod == model.PeriodTypeShootout:
+		s.OTLosses++
+		s.Points++
+		return 'O'
+	default:
+		s.Losses++
+		return 'L'
+	}
+}
+
+// setRecentForm fills the last-ten record and the current streak from a
+// team's outcomes, oldest first.
+func setRecentForm(s *model.Standing, outcomes []byte) {
+	for _, o := range outcomes[max(0, len(outcomes)-10):] {
+		switch o {
+		case 'W':
+			s.L10Wins++
+		case 'L':
+			s.L10Losses++
+		case 'O':
+			s.L10OTLosses++
+		}
+	}
+	if len(outcomes) == 0 {
+		return
+	}
+	last := outcomes[len(outcomes)-1]
+	s.StreakCode = string(last)
+	for i := len(outcomes) - 1; i >= 0 && outcomes[i] == last; i-- {
+		s.StreakCount++
+	}
+}
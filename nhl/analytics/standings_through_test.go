@@ -0,0 +1,141 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl"
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// seasonServer serves a one-week schedule and a boxscore for each of its
+// games, recording which boxscores were requested.
+type seasonServer struct {
+	games   []map[string]any
+	boxes   map[string]map[string]any
+	mu      sync.Mutex
+	fetched []string
+}
+
+func (s *seasonServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/schedule/"):
+		json.NewEncoder(w).Encode(map[string]any{
+			"gameWeek": []any{map[string]any{"date": "2023-10-10", "games": s.games}},
+		})
+	case strings.HasSuffix(r.URL.Path, "/boxscore"):
+		id := strings.Split(r.URL.Path, "/")[2]
+		s.mu.Lock()
+		s.fetched = append(s.fetched, id)
+		s.mu.Unlock()
+		json.NewEncoder(w).Encode(s.boxes[id])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *seasonServer) addGame(id int, start, state, away, home string, awayScore, homeScore int, lastPeriod string) {
+	s.games = append(s.games, map[string]any{
+		"id":           id,
+		"gameType":     2,
+		"startTimeUTC": start,
+		"gameState":    state,
+		"awayTeam":     map[string]any{"abbrev": away},
+		"homeTeam":     map[string]any{"abbrev": home},
+	})
+	if s.boxes == nil {
+		s.boxes = make(map[string]map[string]any)
+	}
+	s.boxes[model.GameID(id).String()] = map[string]any{
+		"id":               id,
+		"awayTeam":         map[string]any{"abbrev": away, "score": awayScore},
+		"homeTeam":         map[string]any{"abbrev": home, "score": homeScore},
+		"periodDescriptor": map[string]any{"number": 3, "periodType": lastPeriod},
+	}
+}
+
+func TestStandingsThroughGames(t *testing.T) {
+	fake := &seasonServer{}
+	fake.addGame(2023020001, "2023-10-10T23:00:00Z", "OFF", "TOR", "MTL", 2, 3, "REG")
+	fake.addGame(2023020002, "2023-10-11T23:00:00Z", "OFF", "BOS", "TOR", 2, 3, "OT")
+	fake.addGame(2023020003, "2023-10-12T23:00:00Z", "OFF", "MTL", "BOS", 4, 3, "SO")
+	fake.addGame(2023020004, "2023-10-13T23:00:00Z", "OFF", "TOR", "MTL", 5, 0, "REG")
+	fake.addGame(2023020005, "2023-10-14T23:00:00Z", "OFF", "SEA", "VAN", 5, 1, "REG")
+	fake.addGame(2023020006, "2023-10-15T23:00:00Z", "FUT", "BOS", "TOR", 0, 0, "")
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := nhl.NewClientWithBaseURL(server.URL)
+	standings, err := StandingsThroughGames(context.Background(), client, model.NewSeason(2023), 2)
+	if err != nil {
+		t.Fatalf("StandingsThroughGames() error = %v", err)
+	}
+
+	// Game 4 is the third game of both TOR and MTL and is never fetched.
+	if got := strings.Join(fake.fetched, ","); got != "2023020001,2023020002,2023020003,2023020005" {
+		t.Errorf("fetched boxscores = %s", got)
+	}
+
+	var order []string
+	for _, s := range standings {
+		order = append(order, s.TeamAbbrev.Default)
+	}
+	if got := strings.Join(order, ","); got != "MTL,SEA,TOR,BOS,VAN" {
+		t.Fatalf("order = %s, want MTL,SEA,TOR,BOS,VAN", got)
+	}
+
+	mtl := standings[0]
+	if mtl.Wins != 2 || mtl.Points != 4 || mtl.RegulationWins != 1 || mtl.RegulationPlusOTWins != 1 || mtl.GoalDifferential != 1 {
+		t.Errorf("MTL = %+v", mtl)
+	}
+	if mtl.StreakCode != "W" || mtl.StreakCount != 2 || mtl.L10Wins != 2 {
+		t.Errorf("MTL form = %s%d, L10 %d", mtl.StreakCode, mtl.StreakCount, mtl.L10Wins)
+	}
+	if mtl.DivisionAbbrev != "A" || mtl.ConferenceAbbrev == nil || *mtl.ConferenceAbbrev != "E" {
+		t.Errorf("MTL division/conference = %q/%v", mtl.DivisionAbbrev, mtl.ConferenceAbbrev)
+	}
+
+	tor, bos := standings[2], standings[3]
+	if tor.Wins != 1 || tor.Losses != 1 || tor.RegulationPlusOTWins != 1 || tor.GoalDifferential != 0 {
+		t.Errorf("TOR = %+v", tor)
+	}
+	if bos.OTLosses != 2 || bos.Points != 2 || bos.GoalDifferential != -1 || bos.StreakCode != "O" {
+		t.Errorf("BOS = %+v", bos)
+	}
+}
+
+func TestStandingsThroughGames_Negative(t *testing.T) {
+	client := nhl.NewClientWithBaseURL("http://127.0.0.1:0")
+	if _, err := StandingsThroughGames(context.Background(), client, model.NewSeason(2023), -1); err == nil {
+		t.Error("expected error for negative games played")
+	}
+}
+
+func TestApplyResult(t *testing.T) {
+	var s model.Standing
+	outcomes := []byte{
+		applyResult(&s, 3, 1, model.PeriodTypeRegulation),
+		applyResult(&s, 1, 2, model.PeriodTypeOvertime),
+		applyResult(&s, 2, 3, model.PeriodTypeShootout),
+		applyResult(&s, 0, 4, model.PeriodTypeRegulation),
+	}
+	if string(outcomes) != "WOOL" {
+		t.Errorf("outcomes = %s, want WOOL", outcomes)
+	}
+	if s.Wins != 1 || s.OTLosses != 2 || s.Losses != 1 || s.Points != 4 {
+		t.Errorf("record = %+v", s)
+	}
+	// +2, -1, 0 (shootout goal removed), -4
+	if s.GoalDifferential != -3 || s.GoalFor != 6 || s.GoalAgainst != 9 {
+		t.Errorf("GoalFor, GoalAgainst, GoalDifferential = %d, %d, %d, want 6, 9, -3", s.GoalFor, s.GoalAgainst, s.GoalDifferential)
+	}
+	if err := s.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// DefaultUsageWindow is the number of games UsageTrend averages over.
+const DefaultUsageWindow = 5
+
+// UsagePoint is one game of a usage trend.
+type UsagePoint struct {
+	GameID   model.GameID
+	GameDate string
+	Opponent string
+	TOI      model.TimeOnIce
+	Shifts   int
+	// AvgShift is TOI over Shifts, or zero without shifts.
+	AvgShift model.TimeOnIce
+	// PowerPlayTOI is nil unless set with Usage.SetPowerPlayTOI: game logs
+	// do not report it.
+	PowerPlayTOI *model.TimeOnIce
+
+	// RollingTOI and RollingShifts average the window of games ending with
+	// this one. RollingPowerPlayTOI averages the games of the window with
+	// a PowerPlayTOI, and is nil when none has one.
+	RollingTOI          model.TimeOnIce
+	RollingShifts       float64
+	RollingPowerPlayTOI *model.TimeOnIce
+}
+
+// Usage is a player's ice time game by game, for usage charts.
+type Usage struct {
+	PlayerID model.PlayerID
+	Season   model.Season
+	GameType model.GameType
+	// Window is the number of games of the rolling averages; the first
+	// Window-1 points average fewer games.
+	Window int
+	// Points lists the games in the order played.
+	Points []UsagePoint
+}
+
+// UsageTrend turns a game log into a usage series: time on ice, shifts and
+// average shift length per game, oldest first, with rolling averages over
+// DefaultUsageWindow games. See SetWindow and SetPowerPlayTOI.
+func UsageTrend(logs *model.PlayerGameLog) *Usage {
+	u := &Usage{PlayerID: logs.PlayerID, Season: logs.Season, GameType: logs.GameType, Window: DefaultUsageWindow}
+	for _, g := range model.SortGameLogs(logs.GameLog) {
+		p := UsagePoint{GameID: g.GameID, GameDate: g.GameDate, Opponent: g.OpponentAbbrev, TOI: g.TOI, Shifts: g.Shifts}
+		if g.Shifts > 0 {
+			p.AvgShift = model.TOIFromDuration(g.TOI.Duration() / time.Duration(g.Shifts))
+		}
+		u.Points = append(u.Points, p)
+	}
+	u.roll()
+	return u
+}
+
+// SetWindow changes the number of games of the rolling averages and
+// recomputes them. Windows below one game are treated as one.
+func (u *Usage) SetWindow(n int) {
+	u.Window = max(n, 1)
+	u.roll()
+}
+
+// SetPowerPlayTOI sets the power-play time on ice of the games in byGame,
+// e.g. from the stats API or shift charts, and recomputes the rolling
+// averages. Games missing from byGame keep their previous value.
+func (u *Usage) SetPowerPlayTOI(byGame map[model.GameID]model.TimeOnIce) {
+	for i := range u.Points {
+		if toi, ok := byGame[u.Points[i].GameID]; ok {
+			u.Points[i].PowerPlayTOI = &toi
+		}
+	}
+	u.roll()
+}
+
+// roll recomputes the rolling averages.
+func (u *Usage) roll() {
+	for i := range u.Points {
+		window := u.Points[max(0, i-u.Window+1) : i+1]
+		var toi, pp time.Duration
+		shifts, withPP := 0, 0
+		for _, p := range window {
+			toi += p.TOI.Duration()
+			shifts += p.Shifts
+			if p.PowerPlayTOI != nil {
+				pp += p.PowerPlayTOI.Duration()
+				withPP++
+			}
+		}
+		point := &u.Points[i]
+		point.RollingTOI = model.TOIFromDuration(toi / time.Duration(len(window)))
+		point.RollingShifts = float64(shifts) / float64(len(window))
+		point.RollingPowerPlayTOI = nil
+		if withPP > 0 {
+			avg := model.TOIFromDuration(pp / time.Duration(withPP))
+			point.RollingPowerPlayTOI = &avg
+		}
+	}
+}
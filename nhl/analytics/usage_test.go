@@ -0,0 +1,52 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func TestUsageTrend(t *testing.T) {
+	toi := func(minutes int) model.TimeOnIce { return model.TOIFromDuration(time.Duration(minutes) * time.Minute) }
+	logs := &model.PlayerGameLog{
+		PlayerID: 8480018,
+		Season:   model.NewSeason(2024),
+		GameType: model.GameTypeRegularSeason,
+		GameLog: []model.GameLog{
+			{GameID: 2024020030, GameDate: "2024-10-20", OpponentAbbrev: "BOS", TOI: toi(22), Shifts: 24},
+			{GameID: 2024020020, GameDate: "2024-10-15", OpponentAbbrev: "TOR", TOI: toi(20), Shifts: 20},
+			{GameID: 2024020010, GameDate: "2024-10-10", OpponentAbbrev: "OTT", TOI: toi(18), Shifts: 0},
+		},
+	}
+
+	u := UsageTrend(logs)
+	if u.Window != DefaultUsageWindow || len(u.Points) != 3 || u.Points[0].Opponent != "OTT" {
+		t.Fatalf("UsageTrend() = %+v", u)
+	}
+	if u.Points[0].AvgShift != 0 || u.Points[1].AvgShift.String() != "01:00" {
+		t.Errorf("AvgShift = %v, %v", u.Points[0].AvgShift, u.Points[1].AvgShift)
+	}
+	if got := u.Points[2].RollingTOI.String(); got != "20:00" {
+		t.Errorf("RollingTOI = %s, want 20:00", got)
+	}
+	if got := u.Points[2].RollingShifts; got != 44.0/3 {
+		t.Errorf("RollingShifts = %v", got)
+	}
+	if u.Points[2].PowerPlayTOI != nil || u.Points[2].RollingPowerPlayTOI != nil {
+		t.Error("power-play TOI should be nil until set")
+	}
+
+	u.SetWindow(2)
+	if got := u.Points[2].RollingTOI.String(); got != "21:00" {
+		t.Errorf("RollingTOI over 2 games = %s, want 21:00", got)
+	}
+
+	u.SetPowerPlayTOI(map[model.GameID]model.TimeOnIce{2024020020: toi(3), 2024020030: toi(2)})
+	if p := u.Points[2]; p.PowerPlayTOI == nil || p.RollingPowerPlayTOI == nil || p.RollingPowerPlayTOI.String() != "02:30" {
+		t.Errorf("power-play TOI = %v, rolling %v", p.PowerPlayTOI, p.RollingPowerPlayTOI)
+	}
+	if u.Points[0].RollingPowerPlayTOI != nil {
+		t.Error("a window without power-play TOI should stay nil")
+	}
+}
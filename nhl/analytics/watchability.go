@@ -0,0 +1,207 @@
+package analytics
+
+import (
+	"math"
+	"sort"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// Component weights used to combine the watchability sub-scores.
+const (
+	stakesWeight    = 0.35
+	rivalryWeight   = 0.20
+	starPowerWeight = 0.25
+	formWeight      = 0.20
+)
+
+// playoffLine is the conference rank of the last playoff spot.
+const playoffLine = 8
+
+// WatchabilityScore breaks down how appealing a game is to watch.
+// Each component is in the range [0, 1]; Total is in the range [0, 100].
+type WatchabilityScore struct {
+	Total     float64
+	Stakes    float64
+	Rivalry   float64
+	StarPower float64
+	Form      float64
+}
+
+// WatchabilityOption is a functional option for configuring Watchability.
+type WatchabilityOption func(*watchabilityConfig)
+
+type watchabilityConfig struct {
+	starCounts map[string]int
+	rivalries  *RivalryTable
+}
+
+// WithStarCounts supplies, per team abbreviation, how many of the league's
+// points leaders are on that team. Without it the star power component is
+// left out of the total.
+func WithStarCounts(counts map[string]int) WatchabilityOption {
+	return func(c *watchabilityConfig) {
+		c.starCounts = counts
+	}
+}
+
+// WithRivalries replaces the curated rivalry table used for the rivalry component.
+func WithRivalries(table *RivalryTable) WatchabilityOption {
+	return func(c *watchabilityConfig) {
+		c.rivalries = table
+	}
+}
+
+// Watchability scores a game on standings stakes, rivalry, star power and
+// recent form. Teams missing from standings contribute neutral values.
+func Watchability(game model.ScheduleGame, standings []model.Standing, opts ...WatchabilityOption) WatchabilityScore {
+	cfg := &watchabilityConfig{rivalries: defaultRivalryTable}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	table := newStandingsTable(standings)
+	away := table.lookup(game.AwayTeam.Abbrev)
+	home := table.lookup(game.HomeTeam.Abbrev)
+
+	score := WatchabilityScore{
+		Stakes:  stakesScore(game, table, away, home),
+		Rivalry: rivalryScore(cfg.rivalries, game, away, home),
+		Form:    formScore(away, home),
+	}
+
+	totalWeight := stakesWeight + rivalryWeight + formWeight
+	weighted := score.Stakes*stakesWeight + score.Rivalry*rivalryWeight + score.Form*formWeight
+	if cfg.starCounts != nil {
+		score.StarPower = starPowerScore(cfg.starCounts, game.AwayTeam.Abbrev, game.HomeTeam.Abbrev)
+		totalWeight += starPowerWeight
+		weighted += score.StarPower * starPowerWeight
+	}
+
+	score.Total = round2(weighted / totalWeight * 100)
+	return score
+}
+
+// RankedGame pairs a scheduled game with its watchability score.
+type RankedGame struct {
+	Game  model.ScheduleGame
+	Score WatchabilityScore
+}
+
+// RankByWatchability scores every game and returns them ordered from most to
+// least watchable. Ties keep their original schedule order.
+func RankByWatchability(games []model.ScheduleGame, standings []model.Standing, opts ...WatchabilityOption) []RankedGame {
+	ranked := make([]RankedGame, len(games))
+	for i, game := range games {
+		ranked[i] = RankedGame{Game: game, Score: Watchability(game, standings, opts...)}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score.Total > ranked[j].Score.Total
+	})
+	return ranked
+}
+
+// standingsTable indexes standings by team abbreviation and conference rank.
+type standingsTable struct {
+	byAbbrev       map[string]*model.Standing
+	conferenceRank map[string]int
+}
+
+func newStandingsTable(standings []model.Standing) *standingsTable {
+	t := &standingsTable{
+		byAbbrev:       make(map[string]*model.Standing, len(standings)),
+		conferenceRank: make(map[string]int, len(standings)),
+	}
+
+	byConference := make(map[string][]*model.Standing)
+	for i := range standings {
+		s := &standings[i]
+		t.byAbbrev[s.TeamAbbrev.Default] = s
+		conf := ""
+		if s.ConferenceAbbrev != nil {
+			conf = *s.ConferenceAbbrev
+		}
+		byConference[conf] = append(byConference[conf], s)
+	}
+
+	for _, teams := range byConference {
+		sort.SliceStable(teams, func(i, j int) bool {
+			return teams[i].Points > teams[j].Points
+		})
+		for rank, s := range teams {
+			t.conferenceRank[s.TeamAbbrev.Default] = rank + 1
+		}
+	}
+	return t
+}
+
+func (t *standingsTable) lookup(abbrev string) *model.Standing {
+	return t.byAbbrev[abbrev]
+}
+
+// stakesScore rewards games between closely matched teams near the playoff line.
+// Playoff games always carry maximum stakes.
+func stakesScore(game model.ScheduleGame, table *standingsTable, away, home *model.Standing) float64 {
+	if game.GameType == model.GameTypePlayoffs {
+		return 1.0
+	}
+	if away == nil || home == nil {
+		return 0.5
+	}
+
+	closeness := 1 - math.Min(math.Abs(away.PointsPercentage()-home.PointsPercentage())/0.3, 1)
+	proximity := (playoffProximity(table.conferenceRank[away.TeamAbbrev.Default]) +
+		playoffProximity(table.conferenceRank[home.TeamAbbrev.Default])) / 2
+
+	return 0.4*closeness + 0.6*proximity
+}
+
+// playoffProximity returns 1.0 for teams sitting on the playoff line and
+// decays linearly to 0.0 eight places away from it.
+func playoffProximity(rank int) float64 {
+	distance := math.Abs(float64(rank) - (playoffLine + 0.5))
+	return math.Max(0, 1-(distance-0.5)/playoffLine)
+}
+
+// rivalryScore gives curated rivalries full marks and rewards divisional and,
+// to a lesser extent, conference matchups.
+func rivalryScore(rivalries *RivalryTable, game model.ScheduleGame, away, home *model.Standing) float64 {
+	if rivalries != nil && rivalries.IsRivalryGame(game) {
+		return 1.0
+	}
+	if away == nil || home == nil {
+		return 0.0
+	}
+	if away.DivisionAbbrev != "" && away.DivisionAbbrev == home.DivisionAbbrev {
+		return 0.6
+	}
+	if away.ConferenceAbbrev != nil && home.ConferenceAbbrev != nil && *away.ConferenceAbbrev == *home.ConferenceAbbrev {
+		return 0.3
+	}
+	return 0.0
+}
+
+// formScore averages both teams' recent points percentage, falling back to
+// the season points percentage when the last-ten record is unavailable.
+func formScore(away, home *model.Standing) float64 {
+	return (teamForm(away) + teamForm(home)) / 2
+}
+
+func teamForm(s *model.Standing) float64 {
+	if s == nil {
+		return 0.5
+	}
+	if s.L10Wins+s.L10Losses+s.L10OTLosses > 0 {
+		return s.L10PointsPercentage()
+	}
+	return s.PointsPercentage()
+}
+
+// starPowerScore saturates once four league leaders are involved in a game.
+func starPowerScore(counts map[string]int, awayAbbrev, homeAbbrev string) float64 {
+	return math.Min(float64(counts[awayAbbrev]+counts[homeAbbrev])/4, 1)
+}
+
+func round2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
@@ -0,0 +1,162 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func makeStanding(abbrev, conference, division string, wins, losses, otl int) model.Standing {
+	return model.Standing{
+		ConferenceAbbrev: stringPtr(conference),
+		DivisionAbbrev:   division,
+		TeamAbbrev:       model.LocalizedString{Default: abbrev},
+		Wins:             wins,
+		Losses:           losses,
+		OTLosses:         otl,
+		Points:           2*wins + otl,
+	}
+}
+
+func makeGame(away, home string) model.ScheduleGame {
+	return model.ScheduleGame{
+		GameType:  model.GameTypeRegularSeason,
+		GameState: model.GameStateFuture,
+		AwayTeam:  model.ScheduleTeam{Abbrev: away},
+		HomeTeam:  model.ScheduleTeam{Abbrev: home},
+	}
+}
+
+func testStandings() []model.Standing {
+	return []model.Standing{
+		makeStanding("EDM", "W", "P", 40, 15, 5),
+		makeStanding("CGY", "W", "P", 32, 22, 6),
+		makeStanding("VAN", "W", "P", 31, 23, 6),
+		makeStanding("SJS", "W", "P", 15, 40, 5),
+		makeStanding("TOR", "E", "A", 35, 18, 7),
+		makeStanding("MTL", "E", "A", 20, 35, 5),
+	}
+}
+
+func TestWatchability_ComponentsInRange(t *testing.T) {
+	standings := testStandings()
+	for _, s := range standings {
+		for _, o := range standings {
+			if s.TeamAbbrev == o.TeamAbbrev {
+				continue
+			}
+			score := Watchability(makeGame(s.TeamAbbrev.Default, o.TeamAbbrev.Default), standings)
+			for name, v := range map[string]float64{
+				"Stakes": score.Stakes, "Rivalry": score.Rivalry, "StarPower": score.StarPower, "Form": score.Form,
+			} {
+				if v < 0 || v > 1 {
+					t.Errorf("%s @ %s: %s = %v out of [0,1]", s.TeamAbbrev, o.TeamAbbrev, name, v)
+				}
+			}
+			if score.Total < 0 || score.Total > 100 {
+				t.Errorf("%s @ %s: Total = %v out of [0,100]", s.TeamAbbrev, o.TeamAbbrev, score.Total)
+			}
+		}
+	}
+}
+
+func TestWatchability_DivisionRivalBeatsCrossConference(t *testing.T) {
+	standings := testStandings()
+	divisional := Watchability(makeGame("CGY", "VAN"), standings)
+	cross := Watchability(makeGame("SJS", "MTL"), standings)
+
+	if divisional.Rivalry <= cross.Rivalry {
+		t.Errorf("divisional rivalry %v should exceed cross-conference %v", divisional.Rivalry, cross.Rivalry)
+	}
+	if divisional.Total <= cross.Total {
+		t.Errorf("CGY @ VAN total %v should exceed SJS @ MTL total %v", divisional.Total, cross.Total)
+	}
+}
+
+func TestWatchability_PlayoffGameMaxStakes(t *testing.T) {
+	game := makeGame("SJS", "MTL")
+	game.GameType = model.GameTypePlayoffs
+
+	score := Watchability(game, testStandings())
+	if score.Stakes != 1.0 {
+		t.Errorf("Stakes = %v, want 1.0 for playoff game", score.Stakes)
+	}
+}
+
+func TestWatchability_UnknownTeams(t *testing.T) {
+	score := Watchability(makeGame("AAA", "BBB"), nil)
+	if score.Stakes != 0.5 || score.Form != 0.5 || score.Rivalry != 0.0 {
+		t.Errorf("unexpected neutral score: %+v", score)
+	}
+}
+
+func TestWatchability_FormPrefersL10(t *testing.T) {
+	hot := makeStanding("EDM", "W", "P", 20, 20, 0)
+	hot.L10Wins = 10
+	cold := makeStanding("CGY", "W", "P", 20, 20, 0)
+	cold.L10Losses = 10
+
+	score := Watchability(makeGame("EDM", "CGY"), []model.Standing{hot, cold})
+	if score.Form != 0.5 {
+		t.Errorf("Form = %v, want 0.5 (average of 1.0 and 0.0)", score.Form)
+	}
+}
+
+func TestWatchability_StarCounts(t *testing.T) {
+	standings := testStandings()
+	game := makeGame("EDM", "TOR")
+
+	without := Watchability(game, standings)
+	if without.StarPower != 0 {
+		t.Errorf("StarPower = %v without option, want 0", without.StarPower)
+	}
+
+	with := Watchability(game, standings, WithStarCounts(map[string]int{"EDM": 3, "TOR": 2}))
+	if with.StarPower != 1.0 {
+		t.Errorf("StarPower = %v, want 1.0 (saturated)", with.StarPower)
+	}
+	if with.Total <= without.Total {
+		t.Errorf("star-studded total %v should exceed %v", with.Total, without.Total)
+	}
+}
+
+func TestRankByWatchability(t *testing.T) {
+	standings := testStandings()
+	games := []model.ScheduleGame{
+		makeGame("SJS", "MTL"),
+		makeGame("CGY", "VAN"),
+	}
+
+	ranked := RankByWatchability(games, standings)
+	if len(ranked) != 2 {
+		t.Fatalf("expected 2 ranked games, got %d", len(ranked))
+	}
+	if ranked[0].Game.HomeTeam.Abbrev != "VAN" {
+		t.Errorf("expected CGY @ VAN first, got %s", ranked[0].Game)
+	}
+	if ranked[0].Score.Total < ranked[1].Score.Total {
+		t.Error("ranked games are not in descending order")
+	}
+}
+
+func TestPlayoffProximity(t *testing.T) {
+	tests := []struct {
+		rank int
+		want float64
+	}{
+		{8, 1.0},
+		{9, 1.0},
+		{1, 0.125},
+		{16, 0.125},
+		{0, 0.0},
+	}
+	for _, tt := range tests {
+		if got := playoffProximity(tt.rank); got != tt.want {
+			t.Errorf("playoffProximity(%d) = %v, want %v", tt.rank, got, tt.want)
+		}
+	}
+}
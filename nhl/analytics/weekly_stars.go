@@ -0,0 +1,165 @@
+package analytics
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// Weights used to score players for the weekly stars. Goalies are scored
+// on results and saves, skaters on points, so a goalie needs a strong week
+// to outrank a scorer, as in the league's picks.
+const (
+	starGoalWeight        = 3.0
+	starAssistWeight      = 2.0
+	starPowerPlayWeight   = 0.5
+	starGoalieWinWeight   = 3.0
+	starShutoutWeight     = 3.0
+	starSaveWeight        = 0.1
+	starGoalAgainstWeight = -1.0
+)
+
+// WeeklyStar is one player's week, with the numbers behind the score.
+type WeeklyStar struct {
+	PlayerID    model.PlayerID
+	Name        string
+	Team        string
+	Position    model.Position
+	GamesPlayed int
+
+	// Skater numbers.
+	Goals          int
+	Assists        int
+	PowerPlayGoals int
+
+	// Goalie numbers.
+	Wins         int
+	Shutouts     int
+	Saves        int
+	ShotsAgainst int
+	GoalsAgainst int
+
+	// Score is the weighted total used for the ranking.
+	Score float64
+}
+
+// Points returns goals plus assists.
+func (s WeeklyStar) Points() int {
+	return s.Goals + s.Assists
+}
+
+// SavePercentage returns saves over shots against, or 0 without shots.
+func (s WeeklyStar) SavePercentage() float64 {
+	if s.ShotsAgainst == 0 {
+		return 0
+	}
+	return float64(s.Saves) / float64(s.ShotsAgainst)
+}
+
+// WeeklyStars ranks the players of the seven days starting at weekStart,
+// approximating the league's three stars of the week: the first three
+// entries are the stars and the rest support the picks. Skaters score
+// three per goal, two per assist and a half per power-play goal; goalies
+// score three per win and per shutout, a tenth per save and lose one per
+// goal against. Only games that are final are counted.
+//
+// The call makes one schedule request plus one boxscore request per game.
+// Players are ordered by score, then points, then fewer games, then ID.
+func WeeklyStars(ctx context.Context, src WeeklyScheduleSource, weekStart model.GameDate) ([]WeeklyStar, error) {
+	week, err := src.WeeklySchedule(ctx, weekStart)
+	if err != nil {
+		return nil, err
+	}
+	first, last := weekStart.APIString(), weekStart.AddDays(6).APIString()
+	var boxes []*model.Boxscore
+	for _, day := range week.GameWeek {
+		if day.Date < first || day.Date > last {
+			continue
+		}
+		for _, g := range day.Games {
+			if !g.GameState.IsFinal() {
+				continue
+			}
+			box, err := src.Boxscore(ctx, g.ID)
+			if err != nil {
+				return nil, err
+			}
+			boxes = append(boxes, box)
+		}
+	}
+	return rankWeeklyStars(boxes), nil
+}
+
+// rankWeeklyStars totals and scores the players of the given boxscores.
+func rankWeeklyStars(boxes []*model.Boxscore) []WeeklyStar {
+	stars := make(map[model.PlayerID]*WeeklyStar)
+	star := func(id model.PlayerID, name model.LocalizedString, team string, position model.Position) *WeeklyStar {
+		s := stars[id]
+		if s == nil {
+			s = &WeeklyStar{PlayerID: id, Name: name.Default, Team: team, Position: position}
+			stars[id] = s
+		}
+		s.GamesPlayed++
+		return s
+	}
+
+	for _, box := range boxes {
+		sides := []struct {
+			team     string
+			stats    model.TeamPlayerStats
+			conceded int
+		}{
+			{box.AwayTeam.Abbrev, box.PlayerByGameStats.AwayTeam, box.HomeTeam.Score},
+			{box.HomeTeam.Abbrev, box.PlayerByGameStats.HomeTeam, box.AwayTeam.Score},
+		}
+		for _, side := range sides {
+			for _, skaters := range [][]model.SkaterStats{side.stats.Forwards, side.stats.Defense} {
+				for _, p := range skaters {
+					s := star(p.PlayerID, p.Name, side.team, p.Position)
+					s.Goals += p.Goals
+					s.Assists += p.Assists
+					s.PowerPlayGoals += p.PowerPlayGoals
+				}
+			}
+			for _, g := range side.stats.Goalies {
+				if g.ShotsAgainst == 0 && g.Decision == nil {
+					// Dressed as the backup without playing.
+					continue
+				}
+				s := star(g.PlayerID, g.Name, side.team, model.PositionGoalie)
+				s.Saves += g.Saves
+				s.ShotsAgainst += g.ShotsAgainst
+				s.GoalsAgainst += g.GoalsAgainst
+				if g.Decision != nil && *g.Decision == model.GoalieDecisionWin {
+					s.Wins++
+					if side.conceded == 0 {
+						s.Shutouts++
+					}
+				}
+			}
+		}
+	}
+
+	result := make([]WeeklyStar, 0, len(stars))
+	for _, s := range stars {
+		s.Score = starGoalWeight*float64(s.Goals) +
+			starAssistWeight*float64(s.Assists) +
+			starPowerPlayWeight*float64(s.PowerPlayGoals) +
+			starGoalieWinWeight*float64(s.Wins) +
+			starShutoutWeight*float64(s.Shutouts) +
+			starSaveWeight*float64(s.Saves) +
+			starGoalAgainstWeight*float64(s.GoalsAgainst)
+		result = append(result, *s)
+	}
+	slices.SortFunc(result, func(a, b WeeklyStar) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(b.Points(), a.Points()),
+			cmp.Compare(a.GamesPlayed, b.GamesPlayed),
+			cmp.Compare(a.PlayerID, b.PlayerID),
+		)
+	})
+	return result
+}
@@ -0,0 +1,90 @@
+package analytics
+
+import (
+	"context"
+	"math"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl"
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func TestWeeklyStars(t *testing.T) {
+	skater := func(id int, name string, goals, assists, ppg int) map[string]any {
+		return map[string]any{
+			"playerId": id, "name": map[string]any{"default": name}, "position": "C",
+			"goals": goals, "assists": assists, "powerPlayGoals": ppg,
+		}
+	}
+	goalie := func(id int, name, decision string, shots, saves int) map[string]any {
+		g := map[string]any{
+			"playerId": id, "name": map[string]any{"default": name}, "position": "G",
+			"shotsAgainst": shots, "saves": saves, "goalsAgainst": shots - saves,
+		}
+		if decision != "" {
+			g["decision"] = decision
+		}
+		return g
+	}
+	fake := &seasonServer{}
+	fake.addGame(2023020001, "2023-10-10T23:00:00Z", "OFF", "TOR", "MTL", 3, 0, "REG")
+	fake.addGame(2023020002, "2023-10-11T23:00:00Z", "OFF", "MTL", "TOR", 2, 1, "REG")
+	fake.addGame(2023020003, "2023-10-12T23:00:00Z", "FUT", "TOR", "BOS", 0, 0, "")
+	fake.boxes["2023020001"]["playerByGameStats"] = map[string]any{
+		"awayTeam": map[string]any{
+			"forwards": []any{skater(1, "Matthews", 2, 0, 1), skater(2, "Marner", 0, 3, 0)},
+			"goalies":  []any{goalie(30, "Woll", "W", 30, 30), goalie(35, "Backup", "", 0, 0)},
+		},
+		"homeTeam": map[string]any{
+			"forwards": []any{skater(3, "Suzuki", 0, 0, 0)},
+			"goalies":  []any{goalie(31, "Montembeault", "L", 20, 17)},
+		},
+	}
+	fake.boxes["2023020002"]["playerByGameStats"] = map[string]any{
+		"awayTeam": map[string]any{
+			"forwards": []any{skater(3, "Suzuki", 1, 1, 0)},
+			"goalies":  []any{goalie(31, "Montembeault", "W", 25, 24)},
+		},
+		"homeTeam": map[string]any{
+			"forwards": []any{skater(1, "Matthews", 1, 0, 0)},
+			"goalies":  []any{goalie(30, "Woll", "L", 10, 8)},
+		},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	stars, err := WeeklyStars(context.Background(), nhl.NewClientWithBaseURL(server.URL), model.FromYMD(2023, 10, 9))
+	if err != nil {
+		t.Fatalf("WeeklyStars() error = %v", err)
+	}
+	if len(fake.fetched) != 2 {
+		t.Errorf("fetched %v, want the two final games", fake.fetched)
+	}
+	// Woll: 1 win, 1 shutout, 38 saves, 2 against = 3 + 3 + 3.8 - 2.
+	// Matthews: 3 goals, 1 on the power play = 9.5.
+	// Montembeault: 1 win, 41 saves, 4 against = 3 + 4.1 - 4.
+	var names []string
+	for _, s := range stars {
+		names = append(names, s.Name)
+	}
+	want := []string{"Matthews", "Woll", "Marner", "Suzuki", "Montembeault"}
+	if len(names) != len(want) {
+		t.Fatalf("stars = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("stars = %v, want %v", names, want)
+		}
+	}
+	woll := stars[1]
+	if woll.Team != "TOR" || woll.GamesPlayed != 2 || woll.Wins != 1 || woll.Shutouts != 1 || math.Abs(woll.Score-7.8) > 1e-9 {
+		t.Errorf("Woll = %+v", woll)
+	}
+	if got := woll.SavePercentage(); math.Abs(got-0.95) > 1e-9 {
+		t.Errorf("SavePercentage() = %v, want 0.95", got)
+	}
+	if matthews := stars[0]; matthews.Score != 9.5 || matthews.Points() != 3 || matthews.GamesPlayed != 2 {
+		t.Errorf("Matthews = %+v", matthews)
+	}
+}
@@ -0,0 +1,240 @@
+// Package batch runs long series of API fetches, such as overnight archive
+// pulls, within a time window and the request rate the API tolerates.
+//
+// A Planner spreads tasks evenly over its window, never faster than its
+// request interval, and sets aside the tasks that do not fit for the next
+// window. Running the plan waits for each slot, backs off when the API
+// answers 429 Too Many Requests and reports progress after every task.
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl"
+)
+
+// Task is one unit of work, typically fetching and storing one game.
+type Task struct {
+	Name string
+	// Requests is the number of API requests Run makes, used to budget
+	// the window; zero counts as one.
+	Requests int
+	Run      func(ctx context.Context) error
+}
+
+func (t Task) requests() int {
+	return max(t.Requests, 1)
+}
+
+// Slot is a task scheduled to start at a given time.
+type Slot struct {
+	Task Task
+	At   time.Time
+}
+
+// Plan is a schedule made by Planner.Plan.
+type Plan struct {
+	Slots []Slot
+	// Deferred lists, in order, the tasks that did not fit in the window.
+	Deferred []Task
+	// Spacing is the time budgeted per request: the planner's interval, or
+	// more when the window has room to spare.
+	Spacing time.Duration
+	// End is the end of the window; Run starts no task after it.
+	End time.Time
+}
+
+// Progress reports one finished task.
+type Progress struct {
+	Task Task
+	// Err is the task's error, or nil.
+	Err error
+	// Done counts the tasks run so far, including this one, and Failed
+	// those that returned an error.
+	Done   int
+	Failed int
+	Total  int
+}
+
+// Report summarizes a run.
+type Report struct {
+	Completed int
+	Failed    int
+	// Skipped lists the tasks not started because the window ended or ctx
+	// was canceled.
+	Skipped []Task
+}
+
+// Planner schedules tasks across a time window.
+type Planner struct {
+	// Interval is the shortest average time between two requests, e.g.
+	// one second to stay under 60 requests a minute. Tasks burst their own
+	// requests; the interval is kept across tasks.
+	Interval time.Duration
+	// Start and End bound the window. A zero Start is the time Plan is
+	// called.
+	Start time.Time
+	End   time.Time
+	// Progress, when set, is called after each task, on the goroutine
+	// calling Run.
+	Progress func(Progress)
+
+	now func() time.Time
+}
+
+// NewPlanner returns a planner for the window from start to end at one
+// request per interval.
+func NewPlanner(interval time.Duration, start, end time.Time) *Planner {
+	return &Planner{Interval: interval, Start: start, End: end}
+}
+
+func (p *Planner) clock() time.Time {
+	if p.now != nil {
+		return p.now()
+	}
+	return time.Now()
+}
+
+// Plan schedules tasks in order. Each task is budgeted Requests times the
+// spacing, which is the window divided by the requests of the tasks that
+// fit, and never less than Interval; tasks past the window's capacity are
+// Deferred.
+func (p *Planner) Plan(tasks []Task) (*Plan, error) {
+	start := p.Start
+	if start.IsZero() {
+		start = p.clock()
+	}
+	if !p.End.After(start) {
+		return nil, fmt.Errorf("batch: window end %s is not after start %s", p.End.Format(time.RFC3339), start.Format(time.RFC3339))
+	}
+	if p.Interval < 0 {
+		return nil, fmt.Errorf("batch: negative request interval %s", p.Interval)
+	}
+	window := p.End.Sub(start)
+
+	plan := &Plan{End: p.End}
+	requests := 0
+	var fit []Task
+	for i, t := range tasks {
+		if t.Run == nil {
+			return nil, fmt.Errorf("batch: task %d (%q) has no Run function", i, t.Name)
+		}
+		if p.Interval > 0 && time.Duration(requests+t.requests())*p.Interval > window {
+			plan.Deferred = append(plan.Deferred, t)
+			continue
+		}
+		requests += t.requests()
+		fit = append(fit, t)
+	}
+	if requests == 0 {
+		return plan, nil
+	}
+
+	plan.Spacing = max(p.Interval, window/time.Duration(requests))
+	at := start
+	for _, t := range fit {
+		plan.Slots = append(plan.Slots, Slot{Task: t, At: at})
+		at = at.Add(time.Duration(t.requests()) * plan.Spacing)
+	}
+	return plan, nil
+}
+
+// Run executes plan, waiting for each slot. A run started after the first
+// slot moves the whole plan back to keep the spacing, and a task that
+// overruns its budget delays only the next one, which starts right away.
+// A task rejected with nhl.ErrRateLimited is retried
+// once after the server's Retry-After delay, or nhl.DefaultRateLimitPause,
+// and every later slot moves back by the same delay. No task starts after
+// the window's end; those left over are reported as Skipped.
+//
+// Run returns the joined errors of the failed tasks, each prefixed with
+// the task name, or ctx's error when it is canceled.
+func (p *Planner) Run(ctx context.Context, plan *Plan) (Report, error) {
+	var report Report
+	var errs []error
+	var shift time.Duration
+	if len(plan.Slots) > 0 {
+		shift = max(0, p.clock().Sub(plan.Slots[0].At))
+	}
+	for i, slot := range plan.Slots {
+		at := slot.At.Add(shift)
+		if at.After(plan.End) {
+			report.Skipped = append(report.Skipped, tasksOf(plan.Slots[i:])...)
+			break
+		}
+		if !p.sleepUntil(ctx, at) {
+			report.Skipped = append(report.Skipped, tasksOf(plan.Slots[i:])...)
+			return report, ctx.Err()
+		}
+
+		err := slot.Task.Run(ctx)
+		if pause, limited := rateLimitPause(err); limited && ctx.Err() == nil {
+			shift += pause
+			if !p.sleepUntil(ctx, p.clock().Add(pause)) {
+				report.Skipped = append(report.Skipped, tasksOf(plan.Slots[i:])...)
+				return report, ctx.Err()
+			}
+			err = slot.Task.Run(ctx)
+		}
+
+		if err != nil {
+			report.Failed++
+			errs = append(errs, fmt.Errorf("%s: %w", slot.Task.Name, err))
+		} else {
+			report.Completed++
+		}
+		if p.Progress != nil {
+			p.Progress(Progress{
+				Task:   slot.Task,
+				Err:    err,
+				Done:   report.Completed + report.Failed,
+				Failed: report.Failed,
+				Total:  len(plan.Slots),
+			})
+		}
+		if ctx.Err() != nil {
+			report.Skipped = append(report.Skipped, tasksOf(plan.Slots[i+1:])...)
+			return report, ctx.Err()
+		}
+	}
+	return report, errors.Join(errs...)
+}
+
+// sleepUntil waits until t, returning false if ctx is canceled first.
+func (p *Planner) sleepUntil(ctx context.Context, t time.Time) bool {
+	d := t.Sub(p.clock())
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// rateLimitPause reports whether err is a 429 and how long to back off.
+func rateLimitPause(err error) (time.Duration, bool) {
+	if !errors.Is(err, nhl.ErrRateLimited) {
+		return 0, false
+	}
+	var apiErr *nhl.APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter, true
+	}
+	return nhl.DefaultRateLimitPause, true
+}
+
+func tasksOf(slots []Slot) []Task {
+	tasks := make([]Task, len(slots))
+	for i, s := range slots {
+		tasks[i] = s.Task
+	}
+	return tasks
+}
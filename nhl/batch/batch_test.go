@@ -0,0 +1,127 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl"
+)
+
+func noop(context.Context) error { return nil }
+
+func TestPlanner_Plan(t *testing.T) {
+	start := time.Date(2024, 11, 5, 2, 0, 0, 0, time.UTC)
+	p := NewPlanner(time.Minute, start, start.Add(10*time.Minute))
+
+	plan, err := p.Plan([]Task{
+		{Name: "a", Requests: 2, Run: noop},
+		{Name: "b", Run: noop},
+		{Name: "c", Requests: 8, Run: noop},
+		{Name: "d", Requests: 3, Run: noop},
+	})
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	// a, b and d fit in 6 requests; c would need 11 minutes.
+	if len(plan.Slots) != 3 || len(plan.Deferred) != 1 || plan.Deferred[0].Name != "c" {
+		t.Fatalf("Plan() = %d slots, deferred %v", len(plan.Slots), plan.Deferred)
+	}
+	if plan.Spacing != 100*time.Second {
+		t.Errorf("Spacing = %v, want the window spread over 6 requests", plan.Spacing)
+	}
+	for i, want := range []time.Duration{0, 200 * time.Second, 300 * time.Second} {
+		if got := plan.Slots[i].At.Sub(start); got != want {
+			t.Errorf("slot %d (%s) at +%v, want +%v", i, plan.Slots[i].Task.Name, got, want)
+		}
+	}
+}
+
+func TestPlanner_PlanErrors(t *testing.T) {
+	start := time.Date(2024, 11, 5, 2, 0, 0, 0, time.UTC)
+	for name, tc := range map[string]struct {
+		p     *Planner
+		tasks []Task
+	}{
+		"empty window":     {NewPlanner(time.Second, start, start), nil},
+		"negative":         {NewPlanner(-time.Second, start, start.Add(time.Hour)), nil},
+		"task without run": {NewPlanner(time.Second, start, start.Add(time.Hour)), []Task{{Name: "x"}}},
+	} {
+		if _, err := tc.p.Plan(tc.tasks); err == nil {
+			t.Errorf("%s: Plan() expected error", name)
+		}
+	}
+}
+
+func TestPlanner_Run(t *testing.T) {
+	now := time.Now()
+	p := NewPlanner(time.Millisecond, now, now.Add(300*time.Millisecond))
+	var progress []Progress
+	p.Progress = func(pr Progress) { progress = append(progress, pr) }
+
+	limited := true
+	plan, err := p.Plan([]Task{
+		{Name: "ok", Run: noop},
+		{Name: "limited", Run: func(context.Context) error {
+			if limited {
+				limited = false
+				return &nhl.APIError{StatusCode: 429, RetryAfter: time.Millisecond}
+			}
+			return nil
+		}},
+		{Name: "broken", Run: func(context.Context) error { return errors.New("boom") }},
+	})
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+
+	report, err := p.Run(context.Background(), plan)
+	if err == nil || !strings.Contains(err.Error(), "broken: boom") {
+		t.Errorf("Run() error = %v, want the broken task's error", err)
+	}
+	if report.Completed != 2 || report.Failed != 1 || len(report.Skipped) != 0 {
+		t.Errorf("Run() report = %+v", report)
+	}
+	if len(progress) != 3 || progress[2].Done != 3 || progress[2].Failed != 1 || progress[1].Err != nil {
+		t.Errorf("progress = %+v", progress)
+	}
+}
+
+func TestPlanner_RunSkipsPastWindow(t *testing.T) {
+	start := time.Now()
+	plan := &Plan{
+		Slots: []Slot{
+			{Task: Task{Name: "now", Run: noop}, At: start},
+			{Task: Task{Name: "later", Run: noop}, At: start.Add(time.Hour)},
+		},
+		End: start.Add(time.Minute),
+	}
+	report, err := (&Planner{}).Run(context.Background(), plan)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if report.Completed != 1 || len(report.Skipped) != 1 || report.Skipped[0].Name != "later" {
+		t.Errorf("Run() report = %+v", report)
+	}
+}
+
+func TestPlanner_RunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	start := time.Now()
+	plan := &Plan{
+		Slots: []Slot{
+			{Task: Task{Name: "first", Run: func(context.Context) error { cancel(); return nil }}, At: start},
+			{Task: Task{Name: "second", Run: noop}, At: start.Add(time.Millisecond)},
+		},
+		End: start.Add(time.Minute),
+	}
+	report, err := (&Planner{}).Run(ctx, plan)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() error = %v, want context.Canceled", err)
+	}
+	if report.Completed != 1 || len(report.Skipped) != 1 {
+		t.Errorf("Run() report = %+v", report)
+	}
+}
@@ -0,0 +1,59 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+const whereToWatchJSON = `[
+	{"id": 1, "countryCode": "US", "name": "ESPN+", "url": "https://plus.espn.com", "logoUrl": "espn.svg"},
+	{"id": 2, "countryCode": "CA", "name": "Sportsnet+", "url": "https://sportsnet.ca/plus"},
+	{"id": 3, "countryCode": "US", "name": "Max"},
+	{"id": 4, "countryCode": "SE", "name": "Viaplay"}
+]`
+
+func TestWhereToWatch(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(whereToWatchJSON))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	options, err := client.WhereToWatch(context.Background())
+	if err != nil {
+		t.Fatalf("WhereToWatch() error = %v", err)
+	}
+	if gotPath != "/where-to-watch" {
+		t.Errorf("path = %q, want /where-to-watch", gotPath)
+	}
+	if len(options) != 4 {
+		t.Fatalf("len(options) = %d, want 4", len(options))
+	}
+
+	us := options.ForCountry("us")
+	if len(us) != 2 || us[0].Name != "ESPN+" || us[1].Name != "Max" {
+		t.Errorf("ForCountry(us) = %+v", us)
+	}
+	if got := options.ForCountry("FI"); got != nil {
+		t.Errorf("ForCountry(FI) = %+v, want nil", got)
+	}
+	if got, want := options.Countries(), []string{"US", "CA", "SE"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Countries() = %v, want %v", got, want)
+	}
+}
+
+func TestWhereToWatch_Error(t *testing.T) {
+	server := httptest.NewServer(makeErrorResponse(http.StatusNotFound))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	if _, err := client.WhereToWatch(context.Background()); err == nil {
+		t.Error("WhereToWatch() should return the API error")
+	}
+}
@@ -0,0 +1,30 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+)
+
+// PlayerCareerGameLog returns every game of gameType the player has played
+// in the NHL, oldest first. It reads the player's landing to find the
+// seasons played, CareerSeasons, including shortened ones, then fetches
+// the game log of each season in turn, so it makes one request per season
+// plus one. A failed season fails the whole call.
+func (c *Client) PlayerCareerGameLog(ctx context.Context, playerID PlayerID, gameType GameType) ([]CareerGame, error) {
+	landing, err := c.PlayerLanding(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var games []CareerGame
+	for _, season := range landing.CareerSeasons(gameType) {
+		log, err := c.PlayerGameLog(ctx, playerID, season, gameType)
+		if err != nil {
+			return nil, fmt.Errorf("game log of season %s: %w", season, err)
+		}
+		for _, g := range SortGameLogs(log.GameLog) {
+			games = append(games, CareerGame{Season: season, GameType: gameType, GameLog: g})
+		}
+	}
+	return games, nil
+}
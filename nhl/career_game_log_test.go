@@ -0,0 +1,51 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPlayerCareerGameLog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/player/8478402/landing":
+			fmt.Fprint(w, `{"playerId":8478402,"seasonTotals":[
+				{"season":20142015,"gameTypeId":2,"leagueAbbrev":"OHL","gamesPlayed":47},
+				{"season":20152016,"gameTypeId":2,"leagueAbbrev":"NHL","gamesPlayed":45},
+				{"season":20152016,"gameTypeId":3,"leagueAbbrev":"NHL","gamesPlayed":0},
+				{"season":20202021,"gameTypeId":2,"leagueAbbrev":"NHL","gamesPlayed":56}
+			]}`)
+		case "/player/8478402/game-log/20152016/2":
+			fmt.Fprint(w, `{"seasonId":20152016,"gameTypeId":2,"gameLog":[
+				{"gameId":2015020100,"gameDate":"2015-10-20","goals":1},
+				{"gameId":2015020010,"gameDate":"2015-10-08"}
+			]}`)
+		case "/player/8478402/game-log/20202021/2":
+			fmt.Fprint(w, `{"seasonId":20202021,"gameTypeId":2,"gameLog":[{"gameId":2020020005,"gameDate":"2021-01-13","goals":2}]}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	games, err := NewClientWithBaseURL(server.URL).PlayerCareerGameLog(context.Background(), 8478402, GameTypeRegularSeason)
+	if err != nil {
+		t.Fatalf("PlayerCareerGameLog() error = %v", err)
+	}
+	var got []string
+	for _, g := range games {
+		got = append(got, fmt.Sprintf("%s/%d", g.Season.APIString(), g.GameID))
+	}
+	want := "[20152016/2015020010 20152016/2015020100 20202021/2020020005]"
+	if fmt.Sprint(got) != want {
+		t.Errorf("PlayerCareerGameLog() = %v, want %v", got, want)
+	}
+	if games[2].Goals != 2 || games[2].GameType != GameTypeRegularSeason {
+		t.Errorf("last game = %+v", games[2])
+	}
+}
@@ -0,0 +1,165 @@
+// Package checkpoint records which games a long-running job has finished
+// so that backfills can resume after a crash without refetching or
+// duplicating work, and which plays a live watch has delivered so that it
+// can resume mid-game (see model.WithWatchCheckpoint).
+package checkpoint
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// Checkpoint tracks completed game IDs. Implementations must be safe for
+// concurrent use.
+type Checkpoint interface {
+	// IsDone reports whether the game has been marked complete.
+	IsDone(id model.GameID) bool
+	// MarkDone records the game as complete. Marking a game twice is not
+	// an error.
+	MarkDone(id model.GameID) error
+}
+
+// Memory is an in-memory Checkpoint. The zero value is ready to use.
+type Memory struct {
+	mu   sync.RWMutex
+	done map[model.GameID]struct{}
+}
+
+// IsDone reports whether the game has been marked complete.
+func (m *Memory) IsDone(id model.GameID) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.done[id]
+	return ok
+}
+
+// MarkDone records the game as complete.
+func (m *Memory) MarkDone(id model.GameID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.done == nil {
+		m.done = make(map[model.GameID]struct{})
+	}
+	m.done[id] = struct{}{}
+	return nil
+}
+
+// Completed returns the completed game IDs in ascending order.
+func (m *Memory) Completed() []model.GameID {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ids := make([]model.GameID, 0, len(m.done))
+	for id := range m.done {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// File is a Checkpoint persisted as an append-only text file with one game
+// ID per line. Each MarkDone is synced to disk before returning, so a crash
+// loses at most the game in flight. A torn final line left by a crash is
+// discarded when the file is reopened.
+type File struct {
+	mem  Memory
+	mu   sync.Mutex
+	file *os.File
+}
+
+// OpenFile opens or creates the checkpoint file at path and loads the game
+// IDs already recorded in it.
+func OpenFile(path string) (*File, error) {
+	f := &File{}
+	file, err := openLog(path, func(line int, text []byte) error {
+		id, err := strconv.ParseInt(string(text), 10, 64)
+		if err != nil {
+			return fmt.Errorf("checkpoint %s line %d: invalid game ID %q", path, line, text)
+		}
+		return f.mem.MarkDone(model.GameID(id))
+	})
+	if err != nil {
+		return nil, err
+	}
+	f.file = file
+	return f, nil
+}
+
+// openLog opens or creates the append-only checkpoint file at path, passes
+// each non-blank line to parse, and returns the file positioned for
+// appending.
+func openLog(path string, parse func(line int, text []byte) error) (*os.File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("reading checkpoint %s: %w", path, err)
+	}
+
+	// Drop any partial line written during a crash.
+	complete := data[:bytes.LastIndexByte(data, '\n')+1]
+
+	scanner := bufio.NewScanner(bytes.NewReader(complete))
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		if err := parse(line, text); err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening checkpoint %s: %w", path, err)
+	}
+	if len(complete) != len(data) {
+		if err := file.Truncate(int64(len(complete))); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("truncating checkpoint %s: %w", path, err)
+		}
+	}
+	if _, err := file.Seek(int64(len(complete)), io.SeekStart); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("seeking checkpoint %s: %w", path, err)
+	}
+	return file, nil
+}
+
+// IsDone reports whether the game has been marked complete.
+func (f *File) IsDone(id model.GameID) bool {
+	return f.mem.IsDone(id)
+}
+
+// MarkDone appends the game to the checkpoint file and syncs it to disk.
+func (f *File) MarkDone(id model.GameID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.mem.IsDone(id) {
+		return nil
+	}
+	if _, err := fmt.Fprintf(f.file, "%d\n", int64(id)); err != nil {
+		return fmt.Errorf("writing checkpoint: %w", err)
+	}
+	if err := f.file.Sync(); err != nil {
+		return fmt.Errorf("syncing checkpoint: %w", err)
+	}
+	return f.mem.MarkDone(id)
+}
+
+// Completed returns the completed game IDs in ascending order.
+func (f *File) Completed() []model.GameID {
+	return f.mem.Completed()
+}
+
+// Close closes the underlying file.
+func (f *File) Close() error {
+	return f.file.Close()
+}
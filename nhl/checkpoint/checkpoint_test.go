@@ -0,0 +1,123 @@
+package checkpoint
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func TestMemory(t *testing.T) {
+	var m Memory
+	if m.IsDone(2023020001) {
+		t.Error("zero Memory IsDone() = true")
+	}
+
+	for _, id := range []model.GameID{2023020003, 2023020001, 2023020003} {
+		if err := m.MarkDone(id); err != nil {
+			t.Fatalf("MarkDone(%d) error = %v", id, err)
+		}
+	}
+	if !m.IsDone(2023020001) || !m.IsDone(2023020003) || m.IsDone(2023020002) {
+		t.Error("IsDone() did not reflect MarkDone()")
+	}
+
+	want := []model.GameID{2023020001, 2023020003}
+	if got := m.Completed(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Completed() = %v, want %v", got, want)
+	}
+}
+
+func TestMemory_Concurrent(t *testing.T) {
+	var m Memory
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(id model.GameID) {
+			defer wg.Done()
+			m.MarkDone(id)
+			m.IsDone(id)
+		}(model.GameID(2023020001 + i))
+	}
+	wg.Wait()
+	if got := len(m.Completed()); got != 50 {
+		t.Errorf("len(Completed()) = %d, want 50", got)
+	}
+}
+
+func TestFile_PersistsAcrossOpens(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.txt")
+
+	f, err := OpenFile(path)
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+	for _, id := range []model.GameID{2023020001, 2023020002, 2023020001} {
+		if err := f.MarkDone(id); err != nil {
+			t.Fatalf("MarkDone() error = %v", err)
+		}
+	}
+	if err := f.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if string(data) != "2023020001\n2023020002\n" {
+		t.Errorf("file contents = %q, want no duplicates", data)
+	}
+
+	f, err = OpenFile(path)
+	if err != nil {
+		t.Fatalf("OpenFile() reopen error = %v", err)
+	}
+	defer f.Close()
+	if !f.IsDone(2023020002) {
+		t.Error("IsDone() = false after reopen")
+	}
+	if err := f.MarkDone(2023020003); err != nil {
+		t.Fatalf("MarkDone() error = %v", err)
+	}
+	want := []model.GameID{2023020001, 2023020002, 2023020003}
+	if got := f.Completed(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Completed() = %v, want %v", got, want)
+	}
+}
+
+func TestFile_DiscardsTornLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.txt")
+	if err := os.WriteFile(path, []byte("2023020001\n20230"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(path)
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+	if err := f.MarkDone(2023020002); err != nil {
+		t.Fatalf("MarkDone() error = %v", err)
+	}
+	f.Close()
+
+	data, _ := os.ReadFile(path)
+	if string(data) != "2023020001\n2023020002\n" {
+		t.Errorf("file contents = %q", data)
+	}
+}
+
+func TestFile_InvalidLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.txt")
+	if err := os.WriteFile(path, []byte("2023020001\nnot-a-game\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenFile(path); err == nil {
+		t.Error("OpenFile() expected error for invalid line")
+	}
+}
+
+var (
+	_ Checkpoint = (*Memory)(nil)
+	_ Checkpoint = (*File)(nil)
+)
@@ -0,0 +1,111 @@
+package checkpoint
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// Plays is an in-memory nhl.WatchCheckpoint. The zero value is ready to
+// use.
+type Plays struct {
+	mu        sync.RWMutex
+	delivered map[model.GameID]map[model.PlayKey]struct{}
+}
+
+// Delivered returns the plays of the game recorded so far, in sort order.
+func (p *Plays) Delivered(gameID model.GameID) ([]model.PlayKey, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	keys := make([]model.PlayKey, 0, len(p.delivered[gameID]))
+	for key := range p.delivered[gameID] {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b model.PlayKey) int {
+		return cmp.Or(cmp.Compare(a.SortOrder, b.SortOrder), cmp.Compare(a.EventID, b.EventID))
+	})
+	return keys, nil
+}
+
+// MarkDelivered records a play of the game.
+func (p *Plays) MarkDelivered(gameID model.GameID, key model.PlayKey) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.delivered == nil {
+		p.delivered = make(map[model.GameID]map[model.PlayKey]struct{})
+	}
+	if p.delivered[gameID] == nil {
+		p.delivered[gameID] = make(map[model.PlayKey]struct{})
+	}
+	p.delivered[gameID][key] = struct{}{}
+	return nil
+}
+
+func (p *Plays) isDelivered(gameID model.GameID, key model.PlayKey) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok := p.delivered[gameID][key]
+	return ok
+}
+
+// PlayFile is an nhl.WatchCheckpoint persisted as an append-only text file
+// with one "gameID eventID sortOrder" line per play. Like File, each
+// MarkDelivered is synced to disk before returning and a torn final line
+// is discarded on reopen, so after a crash a watch replays at most the play
+// in flight. One file can hold several games.
+type PlayFile struct {
+	mem  Plays
+	mu   sync.Mutex
+	file *os.File
+}
+
+// OpenPlayFile opens or creates the play checkpoint file at path and loads
+// the plays already recorded in it.
+func OpenPlayFile(path string) (*PlayFile, error) {
+	f := &PlayFile{}
+	file, err := openLog(path, func(line int, text []byte) error {
+		var gameID, eventID int64
+		var sortOrder int
+		if n, err := fmt.Sscanf(string(text), "%d %d %d", &gameID, &eventID, &sortOrder); err != nil || n != 3 {
+			return fmt.Errorf("checkpoint %s line %d: invalid play %q", path, line, text)
+		}
+		return f.mem.MarkDelivered(model.GameID(gameID), model.PlayKey{EventID: eventID, SortOrder: sortOrder})
+	})
+	if err != nil {
+		return nil, err
+	}
+	f.file = file
+	return f, nil
+}
+
+// Delivered returns the plays of the game recorded so far, in sort order.
+func (f *PlayFile) Delivered(gameID model.GameID) ([]model.PlayKey, error) {
+	return f.mem.Delivered(gameID)
+}
+
+// MarkDelivered appends the play to the checkpoint file and syncs it to
+// disk.
+func (f *PlayFile) MarkDelivered(gameID model.GameID, key model.PlayKey) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.mem.isDelivered(gameID, key) {
+		return nil
+	}
+	if _, err := fmt.Fprintf(f.file, "%d %d %d\n", int64(gameID), key.EventID, key.SortOrder); err != nil {
+		return fmt.Errorf("writing checkpoint: %w", err)
+	}
+	if err := f.file.Sync(); err != nil {
+		return fmt.Errorf("syncing checkpoint: %w", err)
+	}
+	return f.mem.MarkDelivered(gameID, key)
+}
+
+// Close closes the underlying file.
+func (f *PlayFile) Close() error {
+	return f.file.Close()
+}
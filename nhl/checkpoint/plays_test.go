@@ -0,0 +1,80 @@
+package checkpoint
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl"
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func TestPlays(t *testing.T) {
+	var p Plays
+	if keys, err := p.Delivered(2023020001); err != nil || len(keys) != 0 {
+		t.Errorf("zero Plays Delivered() = %v, %v", keys, err)
+	}
+
+	for _, key := range []model.PlayKey{{EventID: 8, SortOrder: 30}, {EventID: 5, SortOrder: 10}, {EventID: 8, SortOrder: 30}} {
+		if err := p.MarkDelivered(2023020001, key); err != nil {
+			t.Fatalf("MarkDelivered() error = %v", err)
+		}
+	}
+	p.MarkDelivered(2023020002, model.PlayKey{EventID: 1, SortOrder: 1})
+
+	want := []model.PlayKey{{EventID: 5, SortOrder: 10}, {EventID: 8, SortOrder: 30}}
+	if got, _ := p.Delivered(2023020001); !reflect.DeepEqual(got, want) {
+		t.Errorf("Delivered() = %v, want %v", got, want)
+	}
+}
+
+func TestPlayFile_PersistsAcrossOpens(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plays.txt")
+
+	f, err := OpenPlayFile(path)
+	if err != nil {
+		t.Fatalf("OpenPlayFile() error = %v", err)
+	}
+	for _, key := range []model.PlayKey{{EventID: 5, SortOrder: 10}, {EventID: 8, SortOrder: 30}, {EventID: 5, SortOrder: 10}} {
+		if err := f.MarkDelivered(2023020001, key); err != nil {
+			t.Fatalf("MarkDelivered() error = %v", err)
+		}
+	}
+	f.Close()
+
+	data, _ := os.ReadFile(path)
+	if string(data) != "2023020001 5 10\n2023020001 8 30\n" {
+		t.Errorf("file contents = %q, want no duplicates", data)
+	}
+
+	// A crash mid-write leaves a torn line, which is dropped.
+	os.WriteFile(path, append(data, "2023020001 9"...), 0o644)
+	f, err = OpenPlayFile(path)
+	if err != nil {
+		t.Fatalf("OpenPlayFile() reopen error = %v", err)
+	}
+	defer f.Close()
+	if err := f.MarkDelivered(2023020001, model.PlayKey{EventID: 9, SortOrder: 40}); err != nil {
+		t.Fatalf("MarkDelivered() error = %v", err)
+	}
+	want := []model.PlayKey{{EventID: 5, SortOrder: 10}, {EventID: 8, SortOrder: 30}, {EventID: 9, SortOrder: 40}}
+	if got, _ := f.Delivered(2023020001); !reflect.DeepEqual(got, want) {
+		t.Errorf("Delivered() = %v, want %v", got, want)
+	}
+}
+
+func TestPlayFile_InvalidLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plays.txt")
+	if err := os.WriteFile(path, []byte("2023020001 5\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenPlayFile(path); err == nil {
+		t.Error("OpenPlayFile() expected error for invalid line")
+	}
+}
+
+var (
+	_ nhl.WatchCheckpoint = (*Plays)(nil)
+	_ nhl.WatchCheckpoint = (*PlayFile)(nil)
+)
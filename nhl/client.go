@@ -5,9 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"maps"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 )
@@ -24,6 +25,9 @@ const (
 	EndpointAPIStats
 	// EndpointSearchV1 is the search API endpoint.
 	EndpointSearchV1
+	// EndpointAssets is the asset host serving images such as headshots.
+	// Its resources are absolute URLs taken from API responses.
+	EndpointAssets
 )
 
 const (
@@ -50,10 +54,33 @@ func (e Endpoint) baseURL() string {
 	}
 }
 
+// String returns a short name for the endpoint, suitable for logs and metrics.
+func (e Endpoint) String() string {
+	switch e {
+	case EndpointAPIWebV1:
+		return "api-web"
+	case EndpointAPICore:
+		return "api-core"
+	case EndpointAPIStats:
+		return "api-stats"
+	case EndpointSearchV1:
+		return "search"
+	case EndpointAssets:
+		return "assets"
+	default:
+		return fmt.Sprintf("Endpoint(%d)", int(e))
+	}
+}
+
 // Client is an HTTP client for the NHL Stats API.
 type Client struct {
 	httpClient      *http.Client
 	baseURLOverride string
+	language        Language
+	delayed         *delayBuffer
+	policies        map[MethodCategory]MethodPolicy
+	hedges          *hedgeLimiter
+	manifest        manifestCache
 }
 
 // NewClient creates a new NHL API client with default configuration.
@@ -66,6 +93,10 @@ func NewClient() *Client {
 func NewClientWithConfig(config *ClientConfig) *Client {
 	return &Client{
 		httpClient: config.ToHTTPClient(),
+		language:   config.Language,
+		delayed:    newDelayBuffer(config.DataDelay),
+		policies:   maps.Clone(config.MethodPolicies),
+		hedges:     newHedgeLimiter(),
 	}
 }
 
@@ -74,9 +105,22 @@ func NewClientWithBaseURL(baseURL string) *Client {
 	return &Client{
 		httpClient:      http.DefaultClient,
 		baseURLOverride: baseURL,
+		language:        LanguageEnglish,
 	}
 }
 
+// languageFor returns the content language for a call: the language set on
+// the context with WithLanguage, or the client-level language otherwise.
+func (c *Client) languageFor(ctx context.Context) Language {
+	if lang, ok := languageFromContext(ctx); ok && lang.IsValid() {
+		return lang
+	}
+	if c.language.IsValid() {
+		return c.language
+	}
+	return LanguageEnglish
+}
+
 // buildURL constructs a full URL from a base URL and resource path.
 // Handles proper slash normalization between base and resource.
 func buildURL(base, resource string) string {
@@ -109,7 +153,7 @@ func (c *Client) getJSON(ctx context.Context, endpoint Endpoint, resource string
 	if len(queryParams) > 0 {
 		u, err := url.Parse(fullURL)
 		if err != nil {
-			return NewRequestError(fmt.Errorf("parsing URL %s: %w", fullURL, err))
+			return withRequestID(ctx, &TransportError{Endpoint: endpoint, Resource: resource, Err: fmt.Errorf("parsing URL %s: %w", fullURL, err)})
 		}
 		q := u.Query()
 		for key, value := range queryParams {
@@ -119,36 +163,17 @@ func (c *Client) getJSON(ctx context.Context, endpoint Endpoint, resource string
 		fullURL = u.String()
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
-	if err != nil {
-		return NewRequestError(fmt.Errorf("creating request: %w", err))
-	}
-
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", defaultUserAgent)
-
-	resp, err := c.httpClient.Do(req)
+	body, err := c.fetch(ctx, endpoint, resource, fullURL)
 	if err != nil {
-		return NewRequestError(fmt.Errorf("executing request to %s: %w", fullURL, err))
-	}
-	defer resp.Body.Close()
-
-	// Check for HTTP errors
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		message := fmt.Sprintf("Request to %s failed", resource)
-		return ErrorFromStatusCode(resp.StatusCode, message)
-	}
-
-	// Read and unmarshal response
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return NewRequestError(fmt.Errorf("reading response body: %w", err))
+		return withRequestID(ctx, err)
 	}
 
 	if err := json.Unmarshal(body, result); err != nil {
-		return NewJSONError(fmt.Errorf("unmarshaling response from %s: %w", fullURL, err))
+		return withRequestID(ctx, &DecodeError{Endpoint: endpoint, Resource: resource, Err: fmt.Errorf("unmarshaling response from %s: %w", fullURL, err)})
 	}
 
+	localizeStrings(result, c.languageFor(ctx))
+
 	return nil
 }
 
@@ -170,42 +195,58 @@ func (c *Client) LeagueStandingsForDate(ctx context.Context, date GameDate) ([]S
 	return response.Standings, nil
 }
 
-// LeagueStandingsForSeason returns league standings for a specific season.
+// LeagueStandingsForSeason returns league standings for a specific season,
+// as of the season's last standings date.
 func (c *Client) LeagueStandingsForSeason(ctx context.Context, season Season) ([]Standing, error) {
-	seasons, err := c.SeasonStandingManifest(ctx)
+	end, err := c.StandingsEndDate(ctx, season)
 	if err != nil {
 		return nil, err
 	}
+	return c.LeagueStandingsForDate(ctx, FromDate(end.Time))
+}
 
-	// Find the season info for the requested season
-	seasonID := season.Int64()
-	var seasonInfo *SeasonInfo
-	for i := range seasons {
-		if seasons[i].ID.Int64() == seasonID {
-			seasonInfo = &seasons[i]
-			break
-		}
+// StandingsEndDate returns the last date with standings for a season. The
+// seasons manifest it reads is cached on the client for a day, so looping
+// over seasons costs one manifest request in total; a season missing from
+// the cached copy fetches it again once before failing.
+func (c *Client) StandingsEndDate(ctx context.Context, season Season) (Date, error) {
+	info, err := c.seasonInfo(ctx, season)
+	if err != nil {
+		return Date{}, err
 	}
+	return info.StandingsEnd, nil
+}
 
-	if seasonInfo == nil {
-		return nil, fmt.Errorf("invalid season: %s", season.String())
+// seasonInfo returns the manifest entry for season, from the cached
+// manifest when there is one. A season missing from a cached manifest may
+// have been added since, so the manifest is fetched again before failing.
+func (c *Client) seasonInfo(ctx context.Context, season Season) (SeasonInfo, error) {
+	seasons, cached := c.manifest.get()
+	if cached {
+		if info, found := findSeason(seasons, season); found {
+			return info, nil
+		}
+	}
+	seasons, err := c.SeasonStandingManifest(ctx)
+	if err != nil {
+		return SeasonInfo{}, err
 	}
 
-	// Fetch standings for the end date of the season
-	var response StandingsResponse
-	resource := fmt.Sprintf("standings/%s", seasonInfo.StandingsEnd)
-	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
-		return nil, err
+	info, found := findSeason(seasons, season)
+	if !found {
+		return SeasonInfo{}, fmt.Errorf("%w: season %s is not in the standings manifest", ErrInvalidArgument, season)
 	}
-	return response.Standings, nil
+	return info, nil
 }
 
-// SeasonStandingManifest returns metadata for all NHL seasons.
+// SeasonStandingManifest returns metadata for all NHL seasons. It always
+// fetches the manifest, refreshing the copy cached for StandingsEndDate.
 func (c *Client) SeasonStandingManifest(ctx context.Context) ([]SeasonInfo, error) {
 	var response SeasonsResponse
 	if err := c.getJSON(ctx, EndpointAPIWebV1, "standings-season", nil, &response); err != nil {
 		return nil, err
 	}
+	c.manifest.set(slices.Clone(response.Seasons))
 	return response.Seasons, nil
 }
 
@@ -233,25 +274,153 @@ func (c *Client) DailySchedule(ctx context.Context, date GameDate) (*DailySchedu
 		return nil, err
 	}
 
-	return c.extractDailySchedule(weeklySchedule, dateString), nil
+	schedule := c.extractDailySchedule(weeklySchedule, dateString)
+	c.delayed.applyScheduleGames(schedule.Games)
+	return schedule, nil
 }
 
 // WeeklySchedule returns the schedule for a week starting from the specified date.
 func (c *Client) WeeklySchedule(ctx context.Context, date GameDate) (*WeeklyScheduleResponse, error) {
-	return c.fetchWeeklySchedule(ctx, date.APIString())
+	week, err := c.fetchWeeklySchedule(ctx, date.APIString())
+	if err != nil {
+		return nil, err
+	}
+	for _, day := range week.GameWeek {
+		c.delayed.applyScheduleGames(day.Games)
+	}
+	return week, nil
 }
 
 // TeamWeeklySchedule returns the weekly schedule for a specific team.
-// The teamAbbr should be a team abbreviation like "MTL", "TOR", etc.
+// The teamAbbr is a team code such as "TOR", in any case.
 func (c *Client) TeamWeeklySchedule(ctx context.Context, teamAbbr string, date GameDate) (*TeamScheduleResponse, error) {
+	code, err := teamCode(teamAbbr)
+	if err != nil {
+		return nil, err
+	}
+	var response TeamScheduleResponse
+	resource := fmt.Sprintf("club-schedule/%s/week/%s", code, date.APIString())
+	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
+		return nil, err
+	}
+	c.delayed.applyScheduleGames(response.Games)
+	return &response, nil
+}
+
+// TeamMonthlySchedule returns a team's schedule for the calendar month
+// that contains month; only its year and month are used.
+func (c *Client) TeamMonthlySchedule(ctx context.Context, teamAbbr string, month GameDate) (*TeamScheduleResponse, error) {
+	return c.fetchTeamMonthlySchedule(ctx, teamAbbr, month.APIMonthString())
+}
+
+// TeamMonthlyScheduleNow returns a team's schedule for the current month,
+// as the API determines it.
+func (c *Client) TeamMonthlyScheduleNow(ctx context.Context, teamAbbr string) (*TeamScheduleResponse, error) {
+	return c.fetchTeamMonthlySchedule(ctx, teamAbbr, "now")
+}
+
+func (c *Client) fetchTeamMonthlySchedule(ctx context.Context, teamAbbr, month string) (*TeamScheduleResponse, error) {
+	code, err := teamCode(teamAbbr)
+	if err != nil {
+		return nil, err
+	}
 	var response TeamScheduleResponse
-	resource := fmt.Sprintf("club-schedule/%s/week/%s", teamAbbr, date.APIString())
+	resource := fmt.Sprintf("club-schedule/%s/month/%s", code, month)
 	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
 		return nil, err
 	}
+	c.delayed.applyScheduleGames(response.Games)
 	return &response, nil
 }
 
+// FullSeasonSchedule returns every game of the season, preseason through
+// playoffs, in schedule order, with GameDate set from the schedule day. It
+// pages through the weekly schedule following NextStartDate, so it makes
+// one request per week of the season.
+func (c *Client) FullSeasonSchedule(ctx context.Context, season Season) ([]ScheduleGame, error) {
+	return pageSeasonSchedule(ctx, season, func(ctx context.Context, date GameDate) ([]ScheduleGame, string, error) {
+		week, err := c.WeeklySchedule(ctx, date)
+		if err != nil {
+			return nil, "", err
+		}
+		var games []ScheduleGame
+		for _, day := range week.GameWeek {
+			for _, g := range day.Games {
+				if g.GameDate == nil {
+					date := day.Date
+					g.GameDate = &date
+				}
+				games = append(games, g)
+			}
+		}
+		return games, week.NextStartDate, nil
+	})
+}
+
+// TeamFullSeasonSchedule returns every game a team plays in the season,
+// preseason through playoffs, in schedule order. It pages through the
+// team's weekly schedule following NextStartDate.
+func (c *Client) TeamFullSeasonSchedule(ctx context.Context, teamAbbr string, season Season) ([]ScheduleGame, error) {
+	return pageSeasonSchedule(ctx, season, func(ctx context.Context, date GameDate) ([]ScheduleGame, string, error) {
+		week, err := c.TeamWeeklySchedule(ctx, teamAbbr, date)
+		if err != nil {
+			return nil, "", err
+		}
+		return week.Games, week.NextStartDate, nil
+	})
+}
+
+// pageSeasonSchedule follows weekly schedule pages from the start of season
+// and collects the games that belong to it. Paging starts on September 1,
+// before any preseason, and stops at the first page that reaches into the
+// following season, when the API stops advancing, or on October 1 of the
+// season's end year (the 2020 bubble playoffs ran into late September).
+func pageSeasonSchedule(ctx context.Context, season Season, fetch func(ctx context.Context, date GameDate) ([]ScheduleGame, string, error)) ([]ScheduleGame, error) {
+	date := FromYMD(season.StartYear(), 9, 1)
+	end := time.Date(season.EndYear(), time.October, 1, 0, 0, 0, 0, time.UTC)
+
+	var games []ScheduleGame
+	seen := make(map[GameID]bool)
+	for {
+		page, next, err := fetch(ctx, date)
+		if err != nil {
+			return nil, err
+		}
+
+		pastSeason := false
+		for _, g := range page {
+			gameSeason, err := g.ID.Season()
+			if err != nil {
+				continue
+			}
+			if gameSeason.StartYear() > season.StartYear() {
+				pastSeason = true
+			}
+			if gameSeason == season && !seen[g.ID] {
+				seen[g.ID] = true
+				games = append(games, g)
+			}
+		}
+		if pastSeason || next == "" {
+			break
+		}
+
+		nextDate, err := ParseDate(next)
+		if err != nil {
+			return nil, &DecodeError{Endpoint: EndpointAPIWebV1, Err: fmt.Errorf("nextStartDate: %w", err)}
+		}
+		if !nextDate.After(date.Date()) || !nextDate.Before(end) {
+			break
+		}
+		date = FromDate(nextDate.Time)
+	}
+
+	if games == nil {
+		games = []ScheduleGame{}
+	}
+	return games, nil
+}
+
 // DailyScores returns game scores for a specific date.
 func (c *Client) DailyScores(ctx context.Context, date GameDate) (*DailyScores, error) {
 	var response DailyScores
@@ -259,6 +428,7 @@ func (c *Client) DailyScores(ctx context.Context, date GameDate) (*DailyScores,
 	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
 		return nil, err
 	}
+	c.delayed.applyGameScores(response.Games)
 	return &response, nil
 }
 
@@ -298,6 +468,40 @@ func (c *Client) extractDailySchedule(weeklySchedule *WeeklyScheduleResponse, da
 	}
 }
 
+// WhereToWatch returns the streaming and broadcast services that carry NHL
+// games, by country.
+func (c *Client) WhereToWatch(ctx context.Context) (StreamingOptions, error) {
+	var response StreamingOptions
+	if err := c.getJSON(ctx, EndpointAPIWebV1, "where-to-watch", nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ===== Playoff Methods =====
+
+// PlayoffBracket returns the playoff bracket for a season.
+func (c *Client) PlayoffBracket(ctx context.Context, season Season) (*PlayoffBracket, error) {
+	var response PlayoffBracket
+	resource := fmt.Sprintf("playoff-bracket/%d", season.EndYear())
+	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// PlayoffSeries returns a playoff series and its games. The seriesLetter is
+// the series' letter in the bracket, "A" for the first first-round series
+// through "P" for the Stanley Cup Final.
+func (c *Client) PlayoffSeries(ctx context.Context, season Season, seriesLetter string) (*PlayoffSeries, error) {
+	var response PlayoffSeries
+	resource := fmt.Sprintf("schedule/playoff-series/%s/%s", season.APIString(), strings.ToLower(seriesLetter))
+	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
 // ===== Game Data Methods =====
 
 // Boxscore returns detailed boxscore data for a game.
@@ -306,6 +510,7 @@ func (c *Client) Boxscore(ctx context.Context, gameID GameID) (*Boxscore, error)
 	if err := c.fetchGamecenter(ctx, gameID, "boxscore", &response); err != nil {
 		return nil, err
 	}
+	c.delayed.applyBoxscore(&response)
 	return &response, nil
 }
 
@@ -315,6 +520,7 @@ func (c *Client) PlayByPlay(ctx context.Context, gameID GameID) (*PlayByPlay, er
 	if err := c.fetchGamecenter(ctx, gameID, "play-by-play", &response); err != nil {
 		return nil, err
 	}
+	c.delayed.applyPlayByPlay(&response)
 	return &response, nil
 }
 
@@ -346,6 +552,17 @@ func (c *Client) SeasonSeries(ctx context.Context, gameID GameID) (*SeasonSeries
 	return &response, nil
 }
 
+// GameRightRail returns the gamecenter sidebar for a game: season series,
+// game info, linescore, shots by period, official team stats, video
+// recaps and report links, in one request.
+func (c *Client) GameRightRail(ctx context.Context, gameID GameID) (*GameRightRail, error) {
+	var response GameRightRail
+	if err := c.fetchGamecenter(ctx, gameID, "right-rail", &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
 // ShiftChart returns shift chart data for a game.
 func (c *Client) ShiftChart(ctx context.Context, gameID GameID) (*ShiftChart, error) {
 	cayenneExpr := fmt.Sprintf(
@@ -359,7 +576,8 @@ func (c *Client) ShiftChart(ctx context.Context, gameID GameID) (*ShiftChart, er
 	}
 
 	var response ShiftChart
-	if err := c.getJSON(ctx, EndpointAPIStats, "en/shiftcharts", params, &response); err != nil {
+	resource := fmt.Sprintf("%s/shiftcharts", pathCode(c.languageFor(ctx)))
+	if err := c.getJSON(ctx, EndpointAPIStats, resource, params, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
@@ -405,7 +623,7 @@ func (c *Client) SearchPlayer(ctx context.Context, query string, limit *int) ([]
 	}
 
 	params := map[string]string{
-		"culture": "en-us",
+		"culture": cultureCode(c.languageFor(ctx)),
 		"q":       query,
 		"limit":   fmt.Sprintf("%d", limitValue),
 	}
@@ -417,37 +635,153 @@ func (c *Client) SearchPlayer(ctx context.Context, query string, limit *int) ([]
 	return response, nil
 }
 
-// ===== Teams/Franchises Methods =====
+// PlayerSpotlight returns the players currently featured by the league.
+func (c *Client) PlayerSpotlight(ctx context.Context) ([]PlayerSpotlight, error) {
+	var response []PlayerSpotlight
+	if err := c.getJSON(ctx, EndpointAPIWebV1, "player-spotlight", nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Milestones returns the active skaters or goalies approaching a career
+// milestone.
+func (c *Client) Milestones(ctx context.Context, kind MilestoneKind) ([]Milestone, error) {
+	if !kind.IsValid() {
+		return nil, fmt.Errorf("%w: milestone kind %q", ErrInvalidArgument, string(kind))
+	}
+	var response MilestonesResponse
+	resource := fmt.Sprintf("%s/milestones/%s", pathCode(c.languageFor(ctx)), kind)
+	if err := c.getJSON(ctx, EndpointAPIStats, resource, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
+
+// ===== Draft Methods =====
 
-// FranchisesResponse represents the API response for franchises.
-type FranchisesResponse struct {
-	Data []Franchise `json:"data"`
+// DraftRankings returns Central Scouting's prospect rankings for a draft
+// year and category.
+func (c *Client) DraftRankings(ctx context.Context, year int, category DraftProspectCategory) (*DraftRankings, error) {
+	if !category.IsValid() {
+		return nil, fmt.Errorf("%w: draft prospect category %d", ErrInvalidArgument, int(category))
+	}
+	var response DraftRankings
+	resource := fmt.Sprintf("draft/rankings/%d/%d", year, int(category))
+	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// DraftPicks returns the picks of a draft year. A round of 0 returns every
+// round.
+func (c *Client) DraftPicks(ctx context.Context, year int, round int) (*DraftPicks, error) {
+	roundParam := "all"
+	if round > 0 {
+		roundParam = fmt.Sprintf("%d", round)
+	}
+	var response DraftPicks
+	resource := fmt.Sprintf("draft/picks/%d/%s", year, roundParam)
+	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// DraftTracker returns the live tracker for the current or most recent
+// draft.
+func (c *Client) DraftTracker(ctx context.Context) (*DraftTracker, error) {
+	var response DraftTracker
+	if err := c.getJSON(ctx, EndpointAPIWebV1, "draft-tracker/picks/now", nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
 }
 
+// ===== Teams/Franchises Methods =====
+
 // Franchises returns a list of all NHL franchises (past and current).
 func (c *Client) Franchises(ctx context.Context) ([]Franchise, error) {
 	var response FranchisesResponse
-	if err := c.getJSON(ctx, EndpointAPIStats, "en/franchise", nil, &response); err != nil {
+	resource := fmt.Sprintf("%s/franchise", pathCode(c.languageFor(ctx)))
+	if err := c.getJSON(ctx, EndpointAPIStats, resource, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
+
+// AllTeams returns every team in the stats API team table, past and
+// current, including defunct clubs that Teams, which reads standings,
+// cannot return. See StatsTeam.IsNHL to keep only NHL clubs.
+func (c *Client) AllTeams(ctx context.Context) ([]StatsTeam, error) {
+	var response StatsTeamsResponse
+	resource := fmt.Sprintf("%s/team", pathCode(c.languageFor(ctx)))
+	if err := c.getJSON(ctx, EndpointAPIStats, resource, nil, &response); err != nil {
 		return nil, err
 	}
 	return response.Data, nil
 }
 
 // RosterCurrent returns the current roster for a team.
-// The teamAbbr should be a team abbreviation like "MTL", "TOR", etc.
+// The teamAbbr is a team code such as "TOR", in any case.
 func (c *Client) RosterCurrent(ctx context.Context, teamAbbr string) (*Roster, error) {
+	code, err := teamCode(teamAbbr)
+	if err != nil {
+		return nil, err
+	}
 	var response Roster
-	resource := fmt.Sprintf("roster/%s/current", teamAbbr)
+	resource := fmt.Sprintf("roster/%s/current", code)
 	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
 		return nil, err
 	}
+	team, _ := TeamByAbbrev(TeamAbbrev(code))
+	response.FranchiseID = team.FranchiseID
 	return &response, nil
 }
 
 // RosterSeason returns the roster for a team in a specific season.
 func (c *Client) RosterSeason(ctx context.Context, teamAbbr string, season Season) (*Roster, error) {
+	code, err := teamCode(teamAbbr)
+	if err != nil {
+		return nil, err
+	}
 	var response Roster
-	resource := fmt.Sprintf("roster/%s/%s", teamAbbr, season.APIString())
+	resource := fmt.Sprintf("roster/%s/%s", code, season.APIString())
+	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
+		return nil, err
+	}
+	team, ok := DefaultTeams.TeamByAbbrevInSeason(TeamAbbrev(code), season)
+	if !ok {
+		team, _ = TeamByAbbrev(TeamAbbrev(code))
+	}
+	response.FranchiseID = team.FranchiseID
+	return &response, nil
+}
+
+// RosterSeasons returns the seasons for which a team roster exists, oldest
+// first. RosterSeason returns a not-found error for any other season.
+func (c *Client) RosterSeasons(ctx context.Context, teamAbbr string) ([]Season, error) {
+	code, err := teamCode(teamAbbr)
+	if err != nil {
+		return nil, err
+	}
+	var response []Season
+	resource := fmt.Sprintf("roster-season/%s", code)
+	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// TeamProspects returns the prospects in a team's system.
+func (c *Client) TeamProspects(ctx context.Context, teamAbbr string) (*Prospects, error) {
+	code, err := teamCode(teamAbbr)
+	if err != nil {
+		return nil, err
+	}
+	var response Prospects
+	resource := fmt.Sprintf("prospects/%s", code)
 	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
 		return nil, err
 	}
@@ -456,8 +790,12 @@ func (c *Client) RosterSeason(ctx context.Context, teamAbbr string, season Seaso
 
 // ClubStats returns player statistics for a team in a specific season.
 func (c *Client) ClubStats(ctx context.Context, teamAbbr string, season Season, gameType GameType) (*ClubStats, error) {
+	code, err := teamCode(teamAbbr)
+	if err != nil {
+		return nil, err
+	}
 	var response ClubStats
-	resource := fmt.Sprintf("club-stats/%s/%s/%d", teamAbbr, season.APIString(), gameType.Int())
+	resource := fmt.Sprintf("club-stats/%s/%s/%d", code, season.APIString(), gameType.Int())
 	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
 		return nil, err
 	}
@@ -467,18 +805,27 @@ func (c *Client) ClubStats(ctx context.Context, teamAbbr string, season Season,
 // ClubScheduleSeason returns the full schedule for a team in a given season,
 // including preseason, regular season, and playoff games.
 func (c *Client) ClubScheduleSeason(ctx context.Context, teamAbbr string, season Season) (*TeamScheduleResponse, error) {
+	code, err := teamCode(teamAbbr)
+	if err != nil {
+		return nil, err
+	}
 	var response TeamScheduleResponse
-	resource := fmt.Sprintf("club-schedule-season/%s/%s", teamAbbr, season.APIString())
+	resource := fmt.Sprintf("club-schedule-season/%s/%s", code, season.APIString())
 	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
 		return nil, err
 	}
+	c.delayed.applyScheduleGames(response.Games)
 	return &response, nil
 }
 
 // ClubStatsSeason returns available seasons and game types for a team.
 func (c *Client) ClubStatsSeason(ctx context.Context, teamAbbr string) ([]SeasonGameTypes, error) {
+	code, err := teamCode(teamAbbr)
+	if err != nil {
+		return nil, err
+	}
 	var response []SeasonGameTypes
-	resource := fmt.Sprintf("club-stats-season/%s", teamAbbr)
+	resource := fmt.Sprintf("club-stats-season/%s", code)
 	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
 		return nil, err
 	}
@@ -713,6 +1060,16 @@ func (c *Client) EdgeTeamLanding(ctx context.Context, season Season, gameType Ga
 	return &response, nil
 }
 
+// ===== Stats API Methods =====
+
+// Stats returns a query builder for the stats REST API reports, e.g.
+//
+//	client.Stats().Skaters().Season(season).Filter("goals", ">=", 20).
+//		Sort("points", nhl.SortDescending).Limit(10).Summary(ctx)
+func (c *Client) Stats() *StatsAPI {
+	return &StatsAPI{client: c}
+}
+
 // ===== Helper Types and Methods =====
 
 // DefaultContext returns a context with a default timeout.
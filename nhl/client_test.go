@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -489,26 +491,60 @@ func TestClientMethodSignatures(t *testing.T) {
 	var _ func(context.Context, GameDate) (*DailySchedule, error) = client.DailySchedule
 	var _ func(context.Context, GameDate) (*WeeklyScheduleResponse, error) = client.WeeklySchedule
 	var _ func(context.Context, string, GameDate) (*TeamScheduleResponse, error) = client.TeamWeeklySchedule
+	var _ func(context.Context, string, GameDate) (*TeamScheduleResponse, error) = client.TeamMonthlySchedule
+	var _ func(context.Context, string) (*TeamScheduleResponse, error) = client.TeamMonthlyScheduleNow
+	var _ func(context.Context, string) (*TeamScoreboard, error) = client.TeamScoreboard
+	var _ func(context.Context, Season) ([]ScheduleGame, error) = client.FullSeasonSchedule
+	var _ func(context.Context, string, Season) ([]ScheduleGame, error) = client.TeamFullSeasonSchedule
+	var _ func(context.Context, Season, GameType) iter.Seq2[GameID, error] = client.GameIDs
+	var _ func(context.Context, GameID) (GameState, error) = client.GameExists
+	var _ func(context.Context, Season) (*SeasonDates, error) = client.SeasonImportantDates
 	var _ func(context.Context, GameDate) (*DailyScores, error) = client.DailyScores
 
+	// Draft methods
+	var _ func(context.Context, int, DraftProspectCategory) (*DraftRankings, error) = client.DraftRankings
+	var _ func(context.Context, int, int) (*DraftPicks, error) = client.DraftPicks
+	var _ func(context.Context) (*DraftTracker, error) = client.DraftTracker
+
+	// Playoff methods
+	var _ func(context.Context, Season) (*PlayoffBracket, error) = client.PlayoffBracket
+	var _ func(context.Context, Season, string) (*PlayoffSeries, error) = client.PlayoffSeries
+
 	// Game data methods
 	var _ func(context.Context, GameID) (*Boxscore, error) = client.Boxscore
 	var _ func(context.Context, GameID) (*PlayByPlay, error) = client.PlayByPlay
+	var _ func(context.Context, []GameID, PlayerID) (*GoalPlaylist, error) = client.GoalClips
 	var _ func(context.Context, GameID) (*GameMatchup, error) = client.Landing
 	var _ func(context.Context, GameID) (*GameStory, error) = client.GameStory
 	var _ func(context.Context, GameID) (*SeasonSeriesMatchup, error) = client.SeasonSeries
+	var _ func(context.Context, GameID) (*GameRightRail, error) = client.GameRightRail
+	var _ func(context.Context, GameID) (*SeriesGameInfo, error) = client.GameInfo
+	var _ func(context.Context, GameID) (*TeamComparison, error) = client.TeamComparison
 	var _ func(context.Context, GameID) (*ShiftChart, error) = client.ShiftChart
 
 	// Player methods
 	var _ func(context.Context, PlayerID) (*PlayerLanding, error) = client.PlayerLanding
 	var _ func(context.Context, PlayerID, Season, GameType) (*PlayerGameLog, error) = client.PlayerGameLog
+	var _ func(context.Context, PlayerID, GameType) ([]CareerGame, error) = client.PlayerCareerGameLog
 	var _ func(context.Context, string, *int) ([]PlayerSearchResult, error) = client.SearchPlayer
+	var _ func(context.Context) ([]PlayerSpotlight, error) = client.PlayerSpotlight
+	var _ func(context.Context, MilestoneKind) ([]Milestone, error) = client.Milestones
 
 	// Team/Franchise methods
 	var _ func(context.Context) ([]Franchise, error) = client.Franchises
+	var _ func(context.Context) ([]StatsTeam, error) = client.AllTeams
 	var _ func(context.Context, string) (*Roster, error) = client.RosterCurrent
 	var _ func(context.Context, string, Season) (*Roster, error) = client.RosterSeason
+	var _ func(context.Context, string) ([]Season, error) = client.RosterSeasons
+	var _ func(context.Context, string) (*Prospects, error) = client.TeamProspects
 	var _ func(context.Context, string, Season, GameType) (*ClubStats, error) = client.ClubStats
+	var _ func(context.Context, string, Season) (*TeamScheduleResponse, error) = client.ClubScheduleSeason
+	var _ func() *StatsAPI = client.Stats
+	var _ func(context.Context) (StreamingOptions, error) = client.WhereToWatch
+	var _ func(context.Context, GameDate, time.Duration) (<-chan ScoreUpdate, <-chan error) = client.WatchDailyScores
+	var _ func(context.Context, Season, GameType) (TeamSeasonStats, error) = client.TeamSeasonStats
+	var _ func(context.Context, Season) (Date, error) = client.StandingsEndDate
+	var _ func(context.Context, Season, time.Duration) iter.Seq2[StandingsSnapshot, error] = client.StandingsHistory
 	var _ func(context.Context, string) ([]SeasonGameTypes, error) = client.ClubStatsSeason
 
 	_ = ctx
@@ -892,6 +928,160 @@ func TestTeamWeeklySchedule(t *testing.T) {
 	}
 }
 
+func TestTeamMonthlySchedule(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"games": [{"id": 2024020650, "gameType": 2, "gameDate": "2024-01-13", "startTimeUTC": "2024-01-14T00:00:00Z",
+			"awayTeam": {"id": 8, "abbrev": "MTL"}, "homeTeam": {"id": 10, "abbrev": "TOR"}, "gameState": "FUT"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	ctx := context.Background()
+	result, err := client.TeamMonthlySchedule(ctx, "TOR", FromYMD(2024, 1, 20))
+	if err != nil {
+		t.Fatalf("TeamMonthlySchedule() error = %v", err)
+	}
+	if len(result.Games) != 1 || result.Games[0].ID != 2024020650 {
+		t.Errorf("unexpected games: %+v", result.Games)
+	}
+	if _, err := client.TeamMonthlyScheduleNow(ctx, "MTL"); err != nil {
+		t.Fatalf("TeamMonthlyScheduleNow() error = %v", err)
+	}
+	want := []string{"/club-schedule/TOR/month/2024-01", "/club-schedule/MTL/month/now"}
+	if len(paths) != 2 || paths[0] != want[0] || paths[1] != want[1] {
+		t.Errorf("requested %v, want %v", paths, want)
+	}
+}
+
+// weeklyPages serves week pages keyed by request path and records the
+// order in which they were requested.
+func weeklyPages(t *testing.T, pages map[string]any) (*httptest.Server, *[]string) {
+	t.Helper()
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		page, ok := pages[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		makeJSONResponse(http.StatusOK, page)(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requested
+}
+
+func weekGame(id GameID) ScheduleGame {
+	return ScheduleGame{ID: id, GameType: GameTypeRegularSeason, GameState: GameStateFuture}
+}
+
+func gameIDs(games []ScheduleGame) []GameID {
+	ids := make([]GameID, len(games))
+	for i, g := range games {
+		ids[i] = g.ID
+	}
+	return ids
+}
+
+func TestFullSeasonSchedule(t *testing.T) {
+	server, requested := weeklyPages(t, map[string]any{
+		"/schedule/2023-09-01": &WeeklyScheduleResponse{
+			NextStartDate: "2023-09-08",
+			// A late game from the previous season is filtered out.
+			GameWeek: []GameDay{{Date: "2023-09-01", Games: []ScheduleGame{weekGame(2022030411)}}},
+		},
+		"/schedule/2023-09-08": &WeeklyScheduleResponse{
+			NextStartDate: "2023-09-15",
+			GameWeek: []GameDay{
+				{Date: "2023-09-09", Games: []ScheduleGame{weekGame(2023010001)}},
+				{Date: "2023-09-10", Games: []ScheduleGame{weekGame(2023010002)}},
+			},
+		},
+		"/schedule/2023-09-15": &WeeklyScheduleResponse{
+			NextStartDate: "2023-09-22",
+			GameWeek: []GameDay{
+				{Date: "2023-09-15", Games: []ScheduleGame{weekGame(2023010002), weekGame(2023020001)}},
+			},
+		},
+		"/schedule/2023-09-22": &WeeklyScheduleResponse{
+			NextStartDate: "2023-09-29",
+			GameWeek:      []GameDay{{Date: "2023-09-22", Games: []ScheduleGame{weekGame(2023030111), weekGame(2024010001)}}},
+		},
+	})
+
+	client := NewClientWithBaseURL(server.URL)
+	games, err := client.FullSeasonSchedule(context.Background(), NewSeason(2023))
+	if err != nil {
+		t.Fatalf("FullSeasonSchedule() error = %v", err)
+	}
+
+	want := []GameID{2023010001, 2023010002, 2023020001, 2023030111}
+	if got := gameIDs(games); !reflect.DeepEqual(got, want) {
+		t.Errorf("games = %v, want %v", got, want)
+	}
+	if len(*requested) != 4 {
+		t.Errorf("made %d requests, want 4 (paging should stop once the next season appears): %v", len(*requested), *requested)
+	}
+}
+
+func TestFullSeasonScheduleStopsWhenPagingStalls(t *testing.T) {
+	server, requested := weeklyPages(t, map[string]any{
+		"/schedule/2023-09-01": &WeeklyScheduleResponse{
+			NextStartDate: "2023-09-01",
+			GameWeek:      []GameDay{{Date: "2023-09-01", Games: []ScheduleGame{weekGame(2023010001)}}},
+		},
+	})
+
+	client := NewClientWithBaseURL(server.URL)
+	games, err := client.FullSeasonSchedule(context.Background(), NewSeason(2023))
+	if err != nil {
+		t.Fatalf("FullSeasonSchedule() error = %v", err)
+	}
+	if len(games) != 1 || len(*requested) != 1 {
+		t.Errorf("got %d games from %d requests, want 1 from 1", len(games), len(*requested))
+	}
+}
+
+func TestFullSeasonScheduleError(t *testing.T) {
+	server, _ := weeklyPages(t, map[string]any{
+		"/schedule/2023-09-01": &WeeklyScheduleResponse{NextStartDate: "2023-09-08"},
+	})
+
+	client := NewClientWithBaseURL(server.URL)
+	_, err := client.FullSeasonSchedule(context.Background(), NewSeason(2023))
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestTeamFullSeasonSchedule(t *testing.T) {
+	server, _ := weeklyPages(t, map[string]any{
+		"/club-schedule/TOR/week/2023-09-01": &TeamScheduleResponse{
+			NextStartDate: "2023-09-08",
+			Games:         []ScheduleGame{},
+		},
+		"/club-schedule/TOR/week/2023-09-08": &TeamScheduleResponse{
+			NextStartDate: "2023-09-15",
+			Games:         []ScheduleGame{weekGame(2023010005)},
+		},
+		"/club-schedule/TOR/week/2023-09-15": &TeamScheduleResponse{
+			Games: []ScheduleGame{weekGame(2023020010)},
+		},
+	})
+
+	client := NewClientWithBaseURL(server.URL)
+	games, err := client.TeamFullSeasonSchedule(context.Background(), "TOR", NewSeason(2023))
+	if err != nil {
+		t.Fatalf("TeamFullSeasonSchedule() error = %v", err)
+	}
+	want := []GameID{2023010005, 2023020010}
+	if got := gameIDs(games); !reflect.DeepEqual(got, want) {
+		t.Errorf("games = %v, want %v", got, want)
+	}
+}
+
 func TestDailyScores(t *testing.T) {
 	dailyScores := &DailyScores{
 		CurrentDate: "2024-01-08",
@@ -1073,6 +1263,90 @@ func TestSeasonSeries(t *testing.T) {
 	}
 }
 
+func TestGameRightRail(t *testing.T) {
+	body := `{
+		"seasonSeries": [{"id": 2023020001, "awayTeam": {"abbrev": "TOR", "score": 2}, "homeTeam": {"abbrev": "MTL", "score": 3}}],
+		"seasonSeriesWins": {"awayTeamWins": 0, "homeTeamWins": 1},
+		"gameInfo": {"referees": [{"default": "Wes McCauley"}], "homeTeam": {"headCoach": {"default": "Martin St. Louis"}}},
+		"gameVideo": {"threeMinRecap": 6344023447112, "condensedGame": 6344023447113},
+		"linescore": {
+			"byPeriod": [
+				{"periodDescriptor": {"number": 1, "periodType": "REG"}, "away": 1, "home": 2},
+				{"periodDescriptor": {"number": 2, "periodType": "REG"}, "away": 1, "home": 1}
+			],
+			"totals": {"away": 2, "home": 3}
+		},
+		"shotsByPeriod": [{"periodDescriptor": {"number": 1, "periodType": "REG"}, "away": 9, "home": 14}],
+		"teamGameStats": [
+			{"category": "sog", "awayValue": 25, "homeValue": 31},
+			{"category": "powerPlay", "awayValue": "1/3", "homeValue": "0/2"}
+		],
+		"gameReports": {"gameSummary": "https://www.nhl.com/scores/htmlreports/20232024/GS020001.HTM"}
+	}`
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	rail, err := client.GameRightRail(context.Background(), GameID(2023020001))
+	if err != nil {
+		t.Fatalf("GameRightRail() error = %v", err)
+	}
+	if gotPath != "/gamecenter/2023020001/right-rail" {
+		t.Errorf("path = %q", gotPath)
+	}
+	if len(rail.SeasonSeries) != 1 || rail.SeasonSeriesWins.HomeTeamWins != 1 {
+		t.Errorf("season series = %+v, wins %+v", rail.SeasonSeries, rail.SeasonSeriesWins)
+	}
+	if rail.GameInfo.HomeTeam.HeadCoach.Default != "Martin St. Louis" {
+		t.Errorf("head coach = %q", rail.GameInfo.HomeTeam.HeadCoach.Default)
+	}
+	if rail.GameVideo == nil || rail.GameVideo.ThreeMinRecap != 6344023447112 {
+		t.Errorf("GameVideo = %+v", rail.GameVideo)
+	}
+	if rail.Linescore == nil || len(rail.Linescore.ByPeriod) != 2 || rail.Linescore.Totals.Home != 3 {
+		t.Errorf("Linescore = %+v", rail.Linescore)
+	}
+	if len(rail.ShotsByPeriod) != 1 || rail.ShotsByPeriod[0].Home != 14 {
+		t.Errorf("ShotsByPeriod = %+v", rail.ShotsByPeriod)
+	}
+	if rail.GameReports == nil || rail.GameReports.GameSummary == "" {
+		t.Errorf("GameReports = %+v", rail.GameReports)
+	}
+
+	away, home, ok := rail.OfficialTeamGameStats()
+	if !ok {
+		t.Fatal("OfficialTeamGameStats() ok = false")
+	}
+	if away.ShotsOnGoal != 25 || home.ShotsOnGoal != 31 || away.PowerPlayGoals != 1 || away.PowerPlayOpportunities != 3 {
+		t.Errorf("away = %+v, home = %+v", away, home)
+	}
+}
+
+func TestGameRightRail_Pregame(t *testing.T) {
+	server := httptest.NewServer(makeJSONResponse(http.StatusOK, map[string]any{
+		"seasonSeries":     []any{},
+		"seasonSeriesWins": map[string]int{"awayTeamWins": 0, "homeTeamWins": 0},
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	rail, err := client.GameRightRail(context.Background(), GameID(2023020001))
+	if err != nil {
+		t.Fatalf("GameRightRail() error = %v", err)
+	}
+	if rail.Linescore != nil || rail.GameVideo != nil || rail.GameReports != nil {
+		t.Errorf("pregame sections should be nil: %+v", rail)
+	}
+	if _, _, ok := rail.OfficialTeamGameStats(); ok {
+		t.Error("OfficialTeamGameStats() should report false before the game")
+	}
+}
+
 func TestShiftChart(t *testing.T) {
 	shiftChart := &ShiftChart{
 		Data: []ShiftEntry{},
@@ -1221,6 +1495,40 @@ func TestFranchises(t *testing.T) {
 	}
 }
 
+func TestAllTeams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/en/team" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"data": [
+			{"id": 10, "franchiseId": 5, "fullName": "Toronto Maple Leafs", "leagueId": 133, "rawTricode": "TOR", "triCode": "TOR"},
+			{"id": 11, "franchiseId": 35, "fullName": "Atlanta Thrashers", "leagueId": 133, "rawTricode": "ATL", "triCode": "ATL"},
+			{"id": 70, "franchiseId": null, "fullName": "To be determined", "leagueId": 0, "rawTricode": "TBD", "triCode": "TBD"}
+		], "total": 3}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	teams, err := client.AllTeams(context.Background())
+	if err != nil {
+		t.Fatalf("AllTeams() error = %v", err)
+	}
+	if len(teams) != 3 {
+		t.Fatalf("expected 3 teams, got %d", len(teams))
+	}
+	atl := teams[1]
+	if atl.Tricode != "ATL" || atl.FranchiseID == nil || *atl.FranchiseID != 35 || !atl.IsNHL() {
+		t.Errorf("unexpected defunct team: %+v", atl)
+	}
+	if team := atl.ToTeam(); team.ID != 11 || team.FranchiseID != 35 || team.LeagueAbbrev != "NHL" || team.FullName != "Atlanta Thrashers" {
+		t.Errorf("ToTeam() = %+v", team)
+	}
+	if tbd := teams[2]; tbd.FranchiseID != nil || tbd.IsNHL() || tbd.ToTeam().LeagueAbbrev != "" {
+		t.Errorf("unexpected placeholder team: %+v", tbd)
+	}
+}
+
 func TestRosterCurrent(t *testing.T) {
 	roster := &Roster{
 		Forwards:   []RosterPlayer{{ID: 8478402, Position: PositionCenter, ShootsCatches: HandednessLeft}},
@@ -1243,6 +1551,9 @@ func TestRosterCurrent(t *testing.T) {
 	if len(result.Forwards) != 1 {
 		t.Errorf("expected 1 forward, got %d", len(result.Forwards))
 	}
+	if result.FranchiseID != 25 {
+		t.Errorf("expected franchise 25, got %d", result.FranchiseID)
+	}
 }
 
 func TestRosterSeason(t *testing.T) {
@@ -1267,6 +1578,40 @@ func TestRosterSeason(t *testing.T) {
 	if len(result.Forwards) != 1 {
 		t.Errorf("expected 1 forward, got %d", len(result.Forwards))
 	}
+	if result.FranchiseID != 25 {
+		t.Errorf("expected franchise 25, got %d", result.FranchiseID)
+	}
+
+	// A relocated club's code resolves to the club that used it that season.
+	result, err = client.RosterSeason(ctx, "WIN", NewSeason(1990))
+	if err != nil {
+		t.Fatalf("RosterSeason() error = %v", err)
+	}
+	if result.FranchiseID != 28 {
+		t.Errorf("expected franchise 28, got %d", result.FranchiseID)
+	}
+}
+
+func TestRosterSeasons(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[20212022, 20222023, 20232024]`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	seasons, err := client.RosterSeasons(context.Background(), "SEA")
+	if err != nil {
+		t.Fatalf("RosterSeasons() error = %v", err)
+	}
+	if gotPath != "/roster-season/SEA" {
+		t.Errorf("path = %q, want /roster-season/SEA", gotPath)
+	}
+	if len(seasons) != 3 || seasons[0] != NewSeason(2021) || seasons[2] != NewSeason(2023) {
+		t.Errorf("seasons = %v", seasons)
+	}
 }
 
 func TestClubStats(t *testing.T) {
@@ -1670,6 +2015,135 @@ func TestEndpoint_baseURL_Default(t *testing.T) {
 	}
 }
 
+func TestEndpoint_String(t *testing.T) {
+	tests := map[Endpoint]string{
+		EndpointAPIWebV1: "api-web",
+		EndpointAPICore:  "api-core",
+		EndpointAPIStats: "api-stats",
+		EndpointSearchV1: "search",
+		EndpointAssets:   "assets",
+		Endpoint(999):    "Endpoint(999)",
+	}
+	for endpoint, want := range tests {
+		if got := endpoint.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestClient_getJSON_ErrorCategories(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/missing", makeErrorResponse(http.StatusNotFound))
+	mux.HandleFunc("/garbled", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{not json"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	ctx := context.Background()
+	var result StandingsResponse
+
+	err := client.getJSON(ctx, EndpointAPIStats, "missing", nil, &result)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("getJSON() error = %v, want *APIError", err)
+	}
+	if apiErr.Endpoint != EndpointAPIStats || apiErr.Resource != "missing" || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("APIError = %+v", apiErr)
+	}
+
+	err = client.getJSON(ctx, EndpointAPIWebV1, "garbled", nil, &result)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("getJSON() error = %v, want *DecodeError", err)
+	}
+	if decodeErr.Endpoint != EndpointAPIWebV1 || decodeErr.Resource != "garbled" {
+		t.Errorf("DecodeError = %+v", decodeErr)
+	}
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Errorf("DecodeError should wrap *json.SyntaxError, got %v", decodeErr.Err)
+	}
+	if errors.As(err, &apiErr) {
+		t.Error("DecodeError should not match *APIError")
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	err = client.getJSON(canceled, EndpointAPICore, "missing", nil, &result)
+	var transportErr *TransportError
+	if !errors.As(err, &transportErr) {
+		t.Fatalf("getJSON() error = %v, want *TransportError", err)
+	}
+	if transportErr.Endpoint != EndpointAPICore || transportErr.Resource != "missing" {
+		t.Errorf("TransportError = %+v", transportErr)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Error("TransportError should wrap context.Canceled")
+	}
+}
+
+func TestWithRequestID(t *testing.T) {
+	var gotHeader string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get(RequestIDHeader)
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/missing", makeErrorResponse(http.StatusNotFound))
+	mux.HandleFunc("/garbled", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	ctx := WithRequestID(context.Background(), "req-7")
+	var result map[string]any
+
+	if err := client.getJSON(ctx, EndpointAPIWebV1, "ok", nil, &result); err != nil {
+		t.Fatalf("getJSON() error = %v", err)
+	}
+	if gotHeader != "req-7" {
+		t.Errorf("%s header = %q, want req-7", RequestIDHeader, gotHeader)
+	}
+
+	err := client.getJSON(ctx, EndpointAPIWebV1, "missing", nil, &result)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RequestID != "req-7" {
+		t.Errorf("APIError = %v, want RequestID req-7", err)
+	}
+	if !strings.HasSuffix(err.Error(), "[request req-7]") {
+		t.Errorf("Error() = %q, want request ID suffix", err.Error())
+	}
+
+	err = client.getJSON(ctx, EndpointAPIWebV1, "garbled", nil, &result)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) || decodeErr.RequestID != "req-7" {
+		t.Errorf("DecodeError = %v, want RequestID req-7", err)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	err = client.getJSON(canceled, EndpointAPIWebV1, "ok", nil, &result)
+	var transportErr *TransportError
+	if !errors.As(err, &transportErr) || transportErr.RequestID != "req-7" {
+		t.Errorf("TransportError = %v, want RequestID req-7", err)
+	}
+
+	gotHeader = "unset"
+	if err := client.getJSON(context.Background(), EndpointAPIWebV1, "ok", nil, &result); err != nil {
+		t.Fatalf("getJSON() error = %v", err)
+	}
+	if gotHeader != "" {
+		t.Errorf("%s header = %q without a request ID, want none", RequestIDHeader, gotHeader)
+	}
+	if id, ok := RequestIDFromContext(WithRequestID(context.Background(), "")); ok {
+		t.Errorf("empty request ID should not be stored, got %q", id)
+	}
+}
+
 func TestClient_getJSON_URLParseError(t *testing.T) {
 	// Use a client with a base URL override that will cause URL parsing issues
 	// when combined with query params containing invalid characters
@@ -1745,3 +2219,105 @@ func TestLeagueStandingsForSeason_StandingsFetchError(t *testing.T) {
 		t.Error("LeagueStandingsForSeason() should error when standings fetch fails")
 	}
 }
+
+func TestClientLanguage(t *testing.T) {
+	var gotPath, gotCulture string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotCulture = r.URL.Query().Get("culture")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/franchise"):
+			w.Write([]byte(`{"data": []}`))
+		case strings.HasSuffix(r.URL.Path, "/shiftcharts"):
+			w.Write([]byte(`{"data": []}`))
+		case strings.HasPrefix(r.URL.Path, "/search"):
+			w.Write([]byte(`[]`))
+		default:
+			w.Write([]byte(`{"forwards": [{"firstName": {"default": "Nick", "fr": "Nicolas"}, "lastName": {"default": "Suzuki"}}]}`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	t.Run("default is English", func(t *testing.T) {
+		client := NewClientWithBaseURL(server.URL)
+		if _, err := client.Franchises(ctx); err != nil {
+			t.Fatalf("Franchises() error = %v", err)
+		}
+		if gotPath != "/en/franchise" {
+			t.Errorf("path = %q, want /en/franchise", gotPath)
+		}
+		if _, err := client.SearchPlayer(ctx, "Suzuki", nil); err != nil {
+			t.Fatalf("SearchPlayer() error = %v", err)
+		}
+		if gotCulture != "en-us" {
+			t.Errorf("culture = %q, want en-us", gotCulture)
+		}
+	})
+
+	t.Run("client-level French", func(t *testing.T) {
+		client := NewClientWithBaseURL(server.URL)
+		client.language = LanguageFrench
+
+		if _, err := client.ShiftChart(ctx, GameID(2023020001)); err != nil {
+			t.Fatalf("ShiftChart() error = %v", err)
+		}
+		if gotPath != "/fr/shiftcharts" {
+			t.Errorf("path = %q, want /fr/shiftcharts", gotPath)
+		}
+		if _, err := client.SearchPlayer(ctx, "Suzuki", nil); err != nil {
+			t.Fatalf("SearchPlayer() error = %v", err)
+		}
+		if gotCulture != "fr-ca" {
+			t.Errorf("culture = %q, want fr-ca", gotCulture)
+		}
+
+		roster, err := client.RosterCurrent(ctx, "MTL")
+		if err != nil {
+			t.Fatalf("RosterCurrent() error = %v", err)
+		}
+		if got := roster.Forwards[0].FirstName.String(); got != "Nicolas" {
+			t.Errorf("FirstName.String() = %q, want Nicolas", got)
+		}
+	})
+
+	t.Run("per-call override", func(t *testing.T) {
+		client := NewClientWithConfig(NewClientConfig(WithConfigLanguage(LanguageFrench)))
+		client.baseURLOverride = server.URL
+
+		if _, err := client.Franchises(WithLanguage(ctx, LanguageEnglish)); err != nil {
+			t.Fatalf("Franchises() error = %v", err)
+		}
+		if gotPath != "/en/franchise" {
+			t.Errorf("path = %q, want /en/franchise", gotPath)
+		}
+
+		roster, err := client.RosterCurrent(ctx, "MTL")
+		if err != nil {
+			t.Fatalf("RosterCurrent() error = %v", err)
+		}
+		if got := roster.Forwards[0].FirstName.String(); got != "Nicolas" {
+			t.Errorf("FirstName.String() = %q, want Nicolas from client-level French", got)
+		}
+	})
+
+	t.Run("language without API paths", func(t *testing.T) {
+		client := NewClientWithConfig(NewClientConfig(WithConfigLanguage(LanguageCzech)))
+		client.baseURLOverride = server.URL
+
+		if _, err := client.Franchises(ctx); err != nil {
+			t.Fatalf("Franchises() error = %v", err)
+		}
+		if gotPath != "/en/franchise" {
+			t.Errorf("path = %q, want /en/franchise", gotPath)
+		}
+		if _, err := client.SearchPlayer(ctx, "Suzuki", nil); err != nil {
+			t.Fatalf("SearchPlayer() error = %v", err)
+		}
+		if gotCulture != "en-us" {
+			t.Errorf("culture = %q, want en-us", gotCulture)
+		}
+	})
+}
@@ -2,7 +2,9 @@ package nhl
 
 import (
 	"crypto/tls"
+	"maps"
 	"net/http"
+	"slices"
 	"time"
 )
 
@@ -21,6 +23,24 @@ type ClientConfig struct {
 
 	// FollowRedirects controls whether HTTP redirects are followed.
 	FollowRedirects bool
+
+	// Language is the content language requested from the API.
+	// Individual calls can override it with WithLanguage.
+	Language Language
+
+	// DataDelay enables delayed-data mode when positive: plays first seen
+	// less than DataDelay ago are withheld, and the scores of games in
+	// progress are shown as of the last released play. See
+	// WithConfigDataDelay.
+	DataDelay time.Duration
+
+	// MethodPolicies holds per-category timeouts and request hedging. See
+	// WithConfigMethodTimeout and WithConfigHedging.
+	MethodPolicies map[MethodCategory]MethodPolicy
+
+	// Middlewares wrap every request the client sends, outermost first.
+	// See WithConfigMiddleware.
+	Middlewares []Middleware
 }
 
 // DefaultClientConfig returns a ClientConfig with sensible defaults.
@@ -29,6 +49,7 @@ func DefaultClientConfig() *ClientConfig {
 		Timeout:         DefaultConfigTimeout,
 		SSLVerify:       true,
 		FollowRedirects: true,
+		Language:        LanguageEnglish,
 	}
 }
 
@@ -68,6 +89,77 @@ func WithFollowRedirects(follow bool) ConfigOption {
 	}
 }
 
+// WithConfigLanguage sets the content language requested from the API.
+func WithConfigLanguage(lang Language) ConfigOption {
+	return func(c *ClientConfig) {
+		c.Language = lang
+	}
+}
+
+// WithConfigDataDelay enables delayed-data mode, for products that must
+// enforce a broadcast-style delay. Play-by-play events are withheld until
+// the client has known about them for at least delay, which also holds
+// back WatchGame. Boxscores, schedules and scores of games in progress
+// show the score as of the last released play, or no score when the
+// game's play-by-play has not been fetched; boxscores of such games omit
+// player stats. A non-positive delay disables the mode.
+func WithConfigDataDelay(delay time.Duration) ConfigOption {
+	return func(c *ClientConfig) {
+		c.DataDelay = delay
+	}
+}
+
+// WithConfigMethodTimeout bounds every call in a method category to
+// timeout. The client-wide Timeout still applies to each request.
+func WithConfigMethodTimeout(category MethodCategory, timeout time.Duration) ConfigOption {
+	return func(c *ClientConfig) {
+		policy := c.MethodPolicies[category]
+		policy.Timeout = timeout
+		c.setMethodPolicy(category, policy)
+	}
+}
+
+// WithConfigHedging enables request hedging for a method category, meant
+// for latency-sensitive calls such as CategoryScoreboard: when a request
+// has not succeeded after hedgeAfter, an identical request is sent and the
+// first success is used. A non-positive hedgeAfter disables hedging.
+func WithConfigHedging(category MethodCategory, hedgeAfter time.Duration) ConfigOption {
+	return func(c *ClientConfig) {
+		policy := c.MethodPolicies[category]
+		policy.HedgeAfter = hedgeAfter
+		c.setMethodPolicy(category, policy)
+	}
+}
+
+// WithConfigMiddleware appends middleware to the client's request chain.
+// Middleware added first sees each request first and each response last.
+func WithConfigMiddleware(middlewares ...Middleware) ConfigOption {
+	return func(c *ClientConfig) {
+		c.Middlewares = append(c.Middlewares, middlewares...)
+	}
+}
+
+// WithConfigRecordTo saves every response the client receives to dir,
+// keyed by request URL, for WithConfigReplayFrom. See RecordTo.
+func WithConfigRecordTo(dir string) ConfigOption {
+	return WithConfigMiddleware(RecordTo(dir))
+}
+
+// WithConfigReplayFrom answers every request from recordings saved by
+// WithConfigRecordTo in dir instead of the network, for deterministic
+// offline tests against real payloads. Requests without a recording fail.
+// See ReplayFrom.
+func WithConfigReplayFrom(dir string) ConfigOption {
+	return WithConfigMiddleware(ReplayFrom(dir))
+}
+
+func (c *ClientConfig) setMethodPolicy(category MethodCategory, policy MethodPolicy) {
+	if c.MethodPolicies == nil {
+		c.MethodPolicies = make(map[MethodCategory]MethodPolicy)
+	}
+	c.MethodPolicies[category] = policy
+}
+
 // ToHTTPClient converts the ClientConfig to a configured http.Client.
 func (c *ClientConfig) ToHTTPClient() *http.Client {
 	transport := &http.Transport{
@@ -78,7 +170,7 @@ func (c *ClientConfig) ToHTTPClient() *http.Client {
 
 	client := &http.Client{
 		Timeout:   c.Timeout,
-		Transport: transport,
+		Transport: chainMiddleware(transport, c.Middlewares),
 	}
 
 	if !c.FollowRedirects {
@@ -96,5 +188,9 @@ func (c *ClientConfig) Clone() *ClientConfig {
 		Timeout:         c.Timeout,
 		SSLVerify:       c.SSLVerify,
 		FollowRedirects: c.FollowRedirects,
+		Language:        c.Language,
+		DataDelay:       c.DataDelay,
+		MethodPolicies:  maps.Clone(c.MethodPolicies),
+		Middlewares:     slices.Clone(c.Middlewares),
 	}
 }
@@ -20,6 +20,10 @@ func TestDefaultClientConfig(t *testing.T) {
 	if !cfg.FollowRedirects {
 		t.Error("FollowRedirects should be true by default")
 	}
+
+	if cfg.Language != LanguageEnglish {
+		t.Errorf("Language = %v, want %v", cfg.Language, LanguageEnglish)
+	}
 }
 
 func TestNewClientConfig(t *testing.T) {
@@ -190,6 +194,9 @@ func TestClientConfig_Clone(t *testing.T) {
 		WithConfigTimeout(15*time.Second),
 		WithSSLVerify(false),
 		WithFollowRedirects(false),
+		WithConfigLanguage(LanguageFrench),
+		WithConfigDataDelay(time.Minute),
+		WithConfigHedging(CategoryScoreboard, 200*time.Millisecond),
 	)
 
 	cloned := original.Clone()
@@ -207,6 +214,22 @@ func TestClientConfig_Clone(t *testing.T) {
 		t.Errorf("cloned.FollowRedirects = %v, want %v", cloned.FollowRedirects, original.FollowRedirects)
 	}
 
+	if cloned.Language != original.Language {
+		t.Errorf("cloned.Language = %v, want %v", cloned.Language, original.Language)
+	}
+
+	if cloned.DataDelay != original.DataDelay {
+		t.Errorf("cloned.DataDelay = %v, want %v", cloned.DataDelay, original.DataDelay)
+	}
+
+	if cloned.MethodPolicies[CategoryScoreboard] != original.MethodPolicies[CategoryScoreboard] {
+		t.Errorf("cloned.MethodPolicies = %v, want %v", cloned.MethodPolicies, original.MethodPolicies)
+	}
+	cloned.MethodPolicies[CategoryScoreboard] = MethodPolicy{}
+	if original.MethodPolicies[CategoryScoreboard].HedgeAfter == 0 {
+		t.Error("modifying cloned MethodPolicies should not affect original")
+	}
+
 	// Verify it's a different instance
 	if cloned == original {
 		t.Error("cloned config should be a different instance than original")
@@ -275,4 +298,26 @@ func TestConfigOptions(t *testing.T) {
 			t.Error("FollowRedirects should be false")
 		}
 	})
+
+	t.Run("WithConfigLanguage", func(t *testing.T) {
+		cfg := &ClientConfig{}
+
+		opt := WithConfigLanguage(LanguageFrench)
+		opt(cfg)
+
+		if cfg.Language != LanguageFrench {
+			t.Errorf("Language = %v, want %v", cfg.Language, LanguageFrench)
+		}
+	})
+
+	t.Run("WithConfigDataDelay", func(t *testing.T) {
+		cfg := &ClientConfig{}
+
+		opt := WithConfigDataDelay(2 * time.Minute)
+		opt(cfg)
+
+		if cfg.DataDelay != 2*time.Minute {
+			t.Errorf("DataDelay = %v, want %v", cfg.DataDelay, 2*time.Minute)
+		}
+	})
 }
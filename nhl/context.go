@@ -0,0 +1,67 @@
+package nhl
+
+import "context"
+
+// contextKey is an unexported type for context keys defined in this package.
+type contextKey int
+
+const (
+	languageContextKey contextKey = iota
+	requestIDContextKey
+)
+
+// RequestIDHeader is the HTTP header carrying the request ID set with
+// WithRequestID on requests sent to the API.
+const RequestIDHeader = "X-Request-ID"
+
+// WithLanguage returns a context that requests content in the given language
+// for any client call made with it, overriding the client-level setting.
+func WithLanguage(ctx context.Context, lang Language) context.Context {
+	return context.WithValue(ctx, languageContextKey, lang)
+}
+
+// languageFromContext returns the language stored in the context, if any.
+func languageFromContext(ctx context.Context) (Language, bool) {
+	lang, ok := ctx.Value(languageContextKey).(Language)
+	return lang, ok
+}
+
+// WithRequestID returns a context that tags every client call made with it
+// with id: the ID is sent in the RequestIDHeader of each API request and
+// recorded in the RequestID field of any TransportError, APIError or
+// DecodeError returned, so a failure can be traced back to the caller's
+// request. An empty id leaves ctx unchanged.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, requestIDContextKey, id)
+}
+
+// RequestIDFromContext returns the request ID set with WithRequestID, for
+// logging and metrics code that wraps client calls.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDContextKey).(string)
+	return id, ok
+}
+
+// cultureCode returns the culture identifier of v used by the search API.
+func cultureCode(v Language) string {
+	switch v {
+	case LanguageFrench:
+		return "fr-ca"
+	default:
+		return "en-us"
+	}
+}
+
+// pathCode returns the language segment of v in stats API, shift chart and
+// milestone paths. Those endpoints serve English and French only, so other
+// languages request English and rely on the variants carried by
+// LocalizedString values.
+func pathCode(v Language) string {
+	if v == LanguageFrench {
+		return LanguageFrench.Code()
+	}
+	return LanguageEnglish.Code()
+}
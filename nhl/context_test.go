@@ -0,0 +1,21 @@
+package nhl
+
+import "testing"
+
+func TestCultureCode(t *testing.T) {
+	if got := cultureCode(LanguageEnglish); got != "en-us" {
+		t.Errorf("cultureCode(LanguageEnglish) = %q, want en-us", got)
+	}
+	if got := cultureCode(LanguageFrench); got != "fr-ca" {
+		t.Errorf("cultureCode(LanguageFrench) = %q, want fr-ca", got)
+	}
+}
+
+func TestPathCode(t *testing.T) {
+	tests := map[Language]string{LanguageEnglish: "en", LanguageFrench: "fr", LanguageFinnish: "en", "": "en"}
+	for lang, want := range tests {
+		if got := pathCode(lang); got != want {
+			t.Errorf("pathCode(%q) = %q, want %q", lang, got, want)
+		}
+	}
+}
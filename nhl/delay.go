@@ -0,0 +1,177 @@
+package nhl
+
+import (
+	"sync"
+	"time"
+)
+
+// delayBuffer implements delayed-data mode (see WithConfigDataDelay). It
+// records when each play of an in-progress game was first observed by the
+// client and withholds plays, and the score they produced, until they are
+// older than the delay.
+//
+// The API carries no wall-clock time for plays, so "newer than the delay"
+// means first observed by this client less than delay ago. Games that are
+// already final when the client first sees them are historical and are
+// passed through unchanged.
+type delayBuffer struct {
+	delay time.Duration
+	now   func() time.Time
+
+	mu    sync.Mutex
+	games map[GameID]*delayedGame
+}
+
+// delayedGame is the delayed view of one tracked game.
+type delayedGame struct {
+	firstSeen map[PlayKey]time.Time
+	withheld  bool
+	away      int
+	home      int
+}
+
+func newDelayBuffer(delay time.Duration) *delayBuffer {
+	if delay <= 0 {
+		return nil
+	}
+	return &delayBuffer{delay: delay, now: time.Now, games: make(map[GameID]*delayedGame)}
+}
+
+// applyPlayByPlay trims pbp to the plays visible under the delay and
+// rewinds the team scores to match. While plays are withheld, a final game
+// is reported as live and the game summary is removed, since it would
+// reveal the withheld goals.
+func (d *delayBuffer) applyPlayByPlay(pbp *PlayByPlay) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	g := d.games[pbp.ID]
+	if g == nil {
+		if !pbp.GameState.IsLive() {
+			return
+		}
+		g = &delayedGame{firstSeen: make(map[PlayKey]time.Time)}
+		d.games[pbp.ID] = g
+	}
+
+	now := d.now()
+	visible := make([]PlayEvent, 0, len(pbp.Plays))
+	g.away, g.home = 0, 0
+	for _, play := range pbp.Plays {
+		key := play.Key()
+		seen, ok := g.firstSeen[key]
+		if !ok {
+			seen = now
+			g.firstSeen[key] = now
+		}
+		if now.Sub(seen) < d.delay {
+			continue
+		}
+		visible = append(visible, play)
+		if play.TypeDescKey == PlayEventTypeGoal && play.Details != nil {
+			if play.Details.AwayScore != nil {
+				g.away = *play.Details.AwayScore
+			}
+			if play.Details.HomeScore != nil {
+				g.home = *play.Details.HomeScore
+			}
+		}
+	}
+	g.withheld = len(visible) < len(pbp.Plays)
+
+	pbp.Plays = visible
+	pbp.AwayTeam.Score = g.away
+	pbp.HomeTeam.Score = g.home
+	if g.withheld {
+		pbp.Summary = nil
+		if pbp.GameState.IsFinal() {
+			pbp.GameState = GameStateLive
+			pbp.GameOutcome = nil
+		}
+	} else if pbp.GameState.IsFinal() {
+		// Everything has been released; the game no longer needs tracking.
+		delete(d.games, pbp.ID)
+	}
+}
+
+// scores returns the delayed score for a game and whether the game's live
+// score must be hidden. Scores are hidden for live games and for tracked
+// games whose plays are still withheld. The delayed score is only known
+// for games whose play-by-play has been fetched; otherwise away and home
+// are nil.
+func (d *delayBuffer) scores(id GameID, state GameState) (away, home *int, hide bool) {
+	if d == nil {
+		return nil, nil, false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	g := d.games[id]
+	if g == nil {
+		return nil, nil, state.IsLive()
+	}
+	if !g.withheld && !state.IsLive() {
+		return nil, nil, false
+	}
+	a, h := g.away, g.home
+	return &a, &h, true
+}
+
+// applyBoxscore replaces a hidden game's team scores with the delayed score
+// (zero when unknown) and removes per-player stats, the linescore, shots by
+// period and team stats, which cannot be rewound.
+func (d *delayBuffer) applyBoxscore(box *Boxscore) {
+	away, home, hide := d.scores(box.ID, box.GameState)
+	if !hide {
+		return
+	}
+	box.AwayTeam.Score, box.HomeTeam.Score = 0, 0
+	if away != nil {
+		box.AwayTeam.Score, box.HomeTeam.Score = *away, *home
+	}
+	box.AwayTeam.SOG, box.HomeTeam.SOG = 0, 0
+	box.PlayerByGameStats = PlayerByGameStats{}
+	box.Linescore, box.ShotsByPeriod, box.TeamGameStats = nil, nil, nil
+	if box.GameState.IsFinal() {
+		box.GameState = GameStateLive
+	}
+}
+
+// applyScheduleGames replaces hidden games' scores in a schedule with the
+// delayed score, or removes them when it is unknown.
+func (d *delayBuffer) applyScheduleGames(games []ScheduleGame) {
+	for i := range games {
+		g := &games[i]
+		d.maskTeams(g.ID, &g.GameState, &g.AwayTeam, &g.HomeTeam)
+	}
+}
+
+// applyGameScores is applyScheduleGames for daily scores.
+func (d *delayBuffer) applyGameScores(games []GameScore) {
+	for i := range games {
+		g := &games[i]
+		d.maskTeams(g.ID, &g.GameState, &g.AwayTeam, &g.HomeTeam)
+	}
+}
+
+// applyScoreboardGames is applyScheduleGames for a team scoreboard.
+func (d *delayBuffer) applyScoreboardGames(games []ScoreboardGame) {
+	for i := range games {
+		g := &games[i]
+		d.maskTeams(g.ID, &g.GameState, &g.AwayTeam, &g.HomeTeam)
+	}
+}
+
+func (d *delayBuffer) maskTeams(id GameID, state *GameState, awayTeam, homeTeam *ScheduleTeam) {
+	away, home, hide := d.scores(id, *state)
+	if !hide {
+		return
+	}
+	awayTeam.Score, homeTeam.Score = away, home
+	if state.IsFinal() {
+		*state = GameStateLive
+	}
+}
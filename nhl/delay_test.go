@@ -0,0 +1,235 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a settable time source for delayBuffer.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// liveGame serves a play-by-play, boxscore and daily scores for one game
+// whose state and plays can be changed between requests.
+type liveGame struct {
+	mu    sync.Mutex
+	state string
+	plays []string
+	away  int
+	home  int
+}
+
+func (g *liveGame) set(state string, away, home int, plays ...string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.state, g.away, g.home, g.plays = state, away, home, plays
+}
+
+func (g *liveGame) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/gamecenter/2023020001/play-by-play":
+		fmt.Fprintf(w, `{"id":2023020001,"gameType":2,"gameState":%q,"gameScheduleState":"OK",
+			"awayTeam":{"abbrev":"TOR","score":%d},"homeTeam":{"abbrev":"MTL","score":%d},
+			"summary":{"scoring":[],"penalties":[]},"plays":[%s]}`,
+			g.state, g.away, g.home, strings.Join(g.plays, ","))
+	case "/gamecenter/2023020001/boxscore":
+		fmt.Fprintf(w, `{"id":2023020001,"gameType":2,"gameState":%q,"gameScheduleState":"OK",
+			"awayTeam":{"abbrev":"TOR","score":%d,"sog":20},"homeTeam":{"abbrev":"MTL","score":%d,"sog":18},
+			"playerByGameStats":{"awayTeam":{"forwards":[{"playerId":1}]}},
+			"linescore":{"byPeriod":[{"periodDescriptor":{"number":1,"periodType":"REG"},"away":%[2]d,"home":%[3]d}],"totals":{"away":%[2]d,"home":%[3]d}},
+			"shotsByPeriod":[{"periodDescriptor":{"number":1,"periodType":"REG"},"away":20,"home":18}],
+			"teamGameStats":[{"category":"sog","awayValue":"20","homeValue":"18"}]}`,
+			g.state, g.away, g.home)
+	case "/score/2023-10-10":
+		fmt.Fprintf(w, `{"currentDate":"2023-10-10","games":[
+			{"id":2023020001,"gameType":2,"gameState":%q,"awayTeam":{"abbrev":"TOR","score":%d},"homeTeam":{"abbrev":"MTL","score":%d}},
+			{"id":2023020002,"gameType":2,"gameState":"OFF","awayTeam":{"abbrev":"BOS","score":4},"homeTeam":{"abbrev":"NYR","score":1}}]}`,
+			g.state, g.away, g.home)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func faceoff(eventID, sortOrder int) string {
+	return fmt.Sprintf(`{"eventId":%d,"sortOrder":%d,"typeDescKey":"faceoff","periodDescriptor":{"number":1,"periodType":"REG"}}`, eventID, sortOrder)
+}
+
+func goal(eventID, sortOrder, away, home int) string {
+	return fmt.Sprintf(`{"eventId":%d,"sortOrder":%d,"typeDescKey":"goal","periodDescriptor":{"number":1,"periodType":"REG"},
+		"details":{"awayScore":%d,"homeScore":%d}}`, eventID, sortOrder, away, home)
+}
+
+func newDelayedClient(t *testing.T, game *liveGame, delay time.Duration) (*Client, *fakeClock) {
+	t.Helper()
+	server := httptest.NewServer(game)
+	t.Cleanup(server.Close)
+
+	clock := &fakeClock{now: time.Date(2023, 10, 10, 23, 0, 0, 0, time.UTC)}
+	client := NewClientWithBaseURL(server.URL)
+	client.delayed = newDelayBuffer(delay)
+	client.delayed.now = clock.Now
+	return client, clock
+}
+
+func TestNewDelayBufferDisabled(t *testing.T) {
+	if newDelayBuffer(0) != nil || newDelayBuffer(-time.Second) != nil {
+		t.Error("non-positive delays should disable delayed-data mode")
+	}
+	if NewClientWithConfig(NewClientConfig()).delayed != nil {
+		t.Error("delayed-data mode should be off by default")
+	}
+	if NewClientWithConfig(NewClientConfig(WithConfigDataDelay(time.Minute))).delayed == nil {
+		t.Error("WithConfigDataDelay should enable delayed-data mode")
+	}
+}
+
+func TestDelayedPlayByPlay(t *testing.T) {
+	game := &liveGame{}
+	client, clock := newDelayedClient(t, game, 2*time.Minute)
+	ctx := context.Background()
+
+	game.set("LIVE", 0, 0, faceoff(1, 10))
+	pbp, err := client.PlayByPlay(ctx, 2023020001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pbp.Plays) != 0 || pbp.Summary != nil {
+		t.Errorf("fresh plays should be withheld, got %d plays, summary %v", len(pbp.Plays), pbp.Summary)
+	}
+
+	clock.Advance(90 * time.Second)
+	game.set("LIVE", 1, 0, faceoff(1, 10), goal(2, 20, 1, 0))
+	pbp, _ = client.PlayByPlay(ctx, 2023020001)
+	if len(pbp.Plays) != 0 || pbp.AwayTeam.Score != 0 {
+		t.Errorf("at 90s: %d plays, away score %d; want 0 and 0", len(pbp.Plays), pbp.AwayTeam.Score)
+	}
+
+	clock.Advance(40 * time.Second)
+	game.set("OFF", 1, 0, faceoff(1, 10), goal(2, 20, 1, 0))
+	pbp, _ = client.PlayByPlay(ctx, 2023020001)
+	if len(pbp.Plays) != 1 || pbp.Plays[0].EventID != 1 {
+		t.Errorf("at 130s: want only the faceoff, got %+v", pbp.Plays)
+	}
+	if pbp.AwayTeam.Score != 0 || pbp.GameState != GameStateLive {
+		t.Errorf("at 130s: score %d, state %s; want 0 and LIVE while the goal is withheld", pbp.AwayTeam.Score, pbp.GameState)
+	}
+
+	clock.Advance(2 * time.Minute)
+	pbp, _ = client.PlayByPlay(ctx, 2023020001)
+	if len(pbp.Plays) != 2 || pbp.AwayTeam.Score != 1 || pbp.GameState != GameStateOff || pbp.Summary == nil {
+		t.Errorf("after the delay: %d plays, score %d, state %s, summary %v; want everything released",
+			len(pbp.Plays), pbp.AwayTeam.Score, pbp.GameState, pbp.Summary)
+	}
+	if len(client.delayed.games) != 0 {
+		t.Error("released final games should no longer be tracked")
+	}
+}
+
+func TestDelayedPlayByPlayHistoricalGame(t *testing.T) {
+	game := &liveGame{}
+	client, _ := newDelayedClient(t, game, time.Hour)
+
+	game.set("OFF", 1, 0, faceoff(1, 10), goal(2, 20, 1, 0))
+	pbp, err := client.PlayByPlay(context.Background(), 2023020001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pbp.Plays) != 2 || pbp.AwayTeam.Score != 1 {
+		t.Errorf("games already final when first seen should pass through, got %d plays and score %d", len(pbp.Plays), pbp.AwayTeam.Score)
+	}
+}
+
+func TestDelayedBoxscoreAndScores(t *testing.T) {
+	game := &liveGame{}
+	client, clock := newDelayedClient(t, game, time.Minute)
+	ctx := context.Background()
+
+	game.set("LIVE", 2, 1, goal(1, 10, 1, 0))
+
+	// Before any play-by-play is fetched, live scores are simply hidden.
+	scores, err := client.DailyScores(ctx, FromYMD(2023, 10, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scores.Games[0].AwayTeam.Score != nil || scores.Games[0].HomeTeam.Score != nil {
+		t.Errorf("live game score should be hidden, got %s", scores.Games[0])
+	}
+	if scores.Games[1].AwayTeam.Score == nil || *scores.Games[1].AwayTeam.Score != 4 {
+		t.Errorf("final game score should be untouched, got %s", scores.Games[1])
+	}
+
+	box, err := client.Boxscore(ctx, 2023020001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if box.AwayTeam.Score != 0 || box.AwayTeam.SOG != 0 || len(box.PlayerByGameStats.AwayTeam.Forwards) != 0 {
+		t.Errorf("live boxscore should be masked, got away %+v", box.AwayTeam)
+	}
+	if box.Linescore != nil || box.ShotsByPeriod != nil || box.TeamGameStats != nil {
+		t.Errorf("live boxscore should hide the linescore, shots and team stats, got %+v, %+v, %+v",
+			box.Linescore, box.ShotsByPeriod, box.TeamGameStats)
+	}
+	if periods := box.ByPeriod(nil, nil); len(periods) != 0 {
+		t.Errorf("ByPeriod() of a masked boxscore = %+v, want none", periods)
+	}
+
+	// Once the first goal is released, the delayed score is shown.
+	client.PlayByPlay(ctx, 2023020001)
+	clock.Advance(time.Minute)
+	client.PlayByPlay(ctx, 2023020001)
+
+	scores, _ = client.DailyScores(ctx, FromYMD(2023, 10, 10))
+	if s := scores.Games[0]; s.AwayTeam.Score == nil || *s.AwayTeam.Score != 1 || *s.HomeTeam.Score != 0 {
+		t.Errorf("want delayed score 1-0, got %s", s)
+	}
+	box, _ = client.Boxscore(ctx, 2023020001)
+	if box.AwayTeam.Score != 1 || box.HomeTeam.Score != 0 {
+		t.Errorf("want delayed boxscore 1-0, got %d-%d", box.AwayTeam.Score, box.HomeTeam.Score)
+	}
+}
+
+func TestWatchGameDelayed(t *testing.T) {
+	game := &liveGame{}
+	client, clock := newDelayedClient(t, game, time.Minute)
+	game.set("LIVE", 0, 0, faceoff(1, 10))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	events, _ := client.WatchGame(ctx, 2023020001, WithWatchInterval(5*time.Millisecond))
+
+	select {
+	case ev := <-events:
+		t.Fatalf("event %d delivered before the delay elapsed", ev.EventID)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	game.set("OFF", 0, 0, faceoff(1, 10))
+	clock.Advance(time.Minute)
+	got := collectEvents(t, events)
+	if len(got) != 1 || got[0].EventID != 1 {
+		t.Errorf("got %+v, want the faceoff once the delay elapsed", got)
+	}
+}
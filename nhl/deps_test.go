@@ -0,0 +1,96 @@
+package nhl
+
+import (
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestStdlibOnly keeps the main module free of third-party dependencies,
+// so pipelines that only need the models never pull in more than the
+// standard library. Integrations that need other modules live in their own
+// nested module, like nhlpb.
+func TestStdlibOnly(t *testing.T) {
+	const module = "github.com/sperano/nhl-api-go"
+	root := ".."
+
+	gomod, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		t.Fatalf("read go.mod: %v", err)
+	}
+	for _, line := range strings.Split(string(gomod), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "require") {
+			t.Errorf("go.mod requires other modules: %s", line)
+		}
+	}
+
+	fset := token.NewFileSet()
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root {
+				if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+					return filepath.SkipDir // a nested module
+				}
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.ImportsOnly)
+		if err != nil {
+			return err
+		}
+		for _, spec := range file.Imports {
+			imp, _ := strconv.Unquote(spec.Path.Value)
+			first, _, _ := strings.Cut(imp, "/")
+			if strings.Contains(first, ".") && imp != module && !strings.HasPrefix(imp, module+"/") {
+				t.Errorf("%s imports %s, outside the standard library", path, imp)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walk module: %v", err)
+	}
+}
+
+// TestClientFreePackages keeps the packages over the models from importing
+// the client, so depending on them never pulls in net/http.
+func TestClientFreePackages(t *testing.T) {
+	const module = "github.com/sperano/nhl-api-go/nhl/"
+	free := []string{"model", "analytics", "checkpoint", "describe", "export", "render", "watcher"}
+
+	fset := token.NewFileSet()
+	for _, dir := range free {
+		files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, path := range files {
+			if strings.HasSuffix(path, "_test.go") {
+				continue
+			}
+			file, err := parser.ParseFile(fset, path, nil, parser.ImportsOnly)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, spec := range file.Imports {
+				imp, _ := strconv.Unquote(spec.Path.Value)
+				sub, inModule := strings.CutPrefix(imp, module)
+				if strings.HasPrefix(imp, "net/") || imp == strings.TrimSuffix(module, "/") || inModule && !slices.Contains(free, sub) {
+					t.Errorf("%s imports %s", path, imp)
+				}
+			}
+		}
+	}
+}
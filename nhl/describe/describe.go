@@ -0,0 +1,363 @@
+// Package describe renders NHL API models as plain-language text suitable
+// for screen readers, text tickers and notification bodies.
+package describe
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// Play returns a one-sentence, human-readable description of a play event.
+// Player IDs are resolved to last names using roster; players missing from
+// the roster are described generically. Every PlayEventType produces a
+// complete sentence, so the output can be read aloud as-is.
+func Play(ev *model.PlayEvent, roster []model.RosterSpot) string {
+	if ev == nil {
+		return ""
+	}
+
+	d := describer{ev: ev, roster: roster}
+	if ev.Details != nil {
+		d.details = *ev.Details
+	}
+
+	switch ev.TypeDescKey {
+	case model.PlayEventTypeGameStart:
+		return "The game is underway."
+	case model.PlayEventTypePeriodStart:
+		return fmt.Sprintf("Start of %s.", periodName(ev.PeriodDescriptor))
+	case model.PlayEventTypePeriodEnd:
+		return fmt.Sprintf("End of %s.", periodName(ev.PeriodDescriptor))
+	case model.PlayEventTypeGameEnd:
+		return "End of the game."
+	case model.PlayEventTypeFaceoff:
+		return d.faceoff()
+	case model.PlayEventTypeHit:
+		return d.hit()
+	case model.PlayEventTypeGiveaway:
+		return sentence("Giveaway by", d.player(d.details.PlayerID), d.zone())
+	case model.PlayEventTypeTakeaway:
+		return sentence("Takeaway by", d.player(d.details.PlayerID), d.zone())
+	case model.PlayEventTypeShotOnGoal:
+		return d.shotOnGoal()
+	case model.PlayEventTypeMissedShot:
+		return d.missedShot()
+	case model.PlayEventTypeBlockedShot:
+		return d.blockedShot()
+	case model.PlayEventTypeGoal:
+		return d.goal()
+	case model.PlayEventTypePenalty:
+		return d.penalty()
+	case model.PlayEventTypeStoppage:
+		if reason := humanize(d.details.Reason); reason != "" {
+			return fmt.Sprintf("Stoppage in play: %s.", reason)
+		}
+		return "Stoppage in play."
+	case model.PlayEventTypeDelayedPenalty:
+		return "Delayed penalty signaled."
+	case model.PlayEventTypeFailedShotAttempt:
+		return sentence("Failed shot attempt by", d.player(d.details.ShootingPlayerID))
+	case model.PlayEventTypeShootoutComplete:
+		return "The shootout is complete."
+	default:
+		return "Unknown event."
+	}
+}
+
+// describer holds the state needed to describe a single event.
+type describer struct {
+	ev      *model.PlayEvent
+	details model.PlayEventDetails
+	roster  []model.RosterSpot
+}
+
+// lookup finds a player on the roster.
+func (d *describer) lookup(id *model.PlayerID) *model.RosterSpot {
+	if id == nil {
+		return nil
+	}
+	for i := range d.roster {
+		if d.roster[i].PlayerID == *id {
+			return &d.roster[i]
+		}
+	}
+	return nil
+}
+
+// player returns a player's last name, or a generic reference if unknown.
+func (d *describer) player(id *model.PlayerID) string {
+	if spot := d.lookup(id); spot != nil && spot.LastName.Default != "" {
+		return spot.LastName.String()
+	}
+	return "an unknown player"
+}
+
+// numberedPlayer returns a player's last name followed by sweater number,
+// e.g. "Hyman (18)".
+func (d *describer) numberedPlayer(id *model.PlayerID) string {
+	spot := d.lookup(id)
+	if spot == nil || spot.LastName.Default == "" {
+		return "an unknown player"
+	}
+	if spot.SweaterNumber > 0 {
+		return fmt.Sprintf("%s (%d)", spot.LastName.String(), spot.SweaterNumber)
+	}
+	return spot.LastName.String()
+}
+
+// zone returns a phrase like "in the offensive zone", or "" if unknown.
+func (d *describer) zone() string {
+	if d.details.ZoneCode == nil {
+		return ""
+	}
+	switch *d.details.ZoneCode {
+	case model.ZoneCodeOffensive:
+		return "in the offensive zone"
+	case model.ZoneCodeDefensive:
+		return "in the defensive zone"
+	case model.ZoneCodeNeutral:
+		return "in the neutral zone"
+	default:
+		return ""
+	}
+}
+
+// shotLocation returns a phrase like "from the slot" based on coordinates
+// and zone, or "" if the location is unknown.
+func (d *describer) shotLocation() string {
+	if d.details.ZoneCode != nil {
+		switch *d.details.ZoneCode {
+		case model.ZoneCodeNeutral:
+			return "from the neutral zone"
+		case model.ZoneCodeDefensive:
+			return "from the defensive zone"
+		}
+	}
+	if d.details.XCoord == nil || d.details.YCoord == nil {
+		return ""
+	}
+
+	// Rink coordinates are in feet, with goal lines at x = ±89.
+	x := math.Abs(float64(*d.details.XCoord))
+	y := math.Abs(float64(*d.details.YCoord))
+	distance := math.Hypot(89-x, y)
+
+	switch {
+	case x > 89:
+		return "from behind the net"
+	case distance <= 15:
+		return "from in close"
+	case y <= 22 && distance <= 35:
+		return "from the slot"
+	case x <= 40:
+		return "from the point"
+	default:
+		return "from the faceoff circle"
+	}
+}
+
+// shotType returns the shot type as words, e.g. "wrist shot".
+func (d *describer) shotType() string {
+	if d.details.ShotType == nil || *d.details.ShotType == "" {
+		return "shot"
+	}
+	if phrase, ok := shotPhrases[*d.details.ShotType]; ok {
+		return phrase
+	}
+	return humanize(d.details.ShotType) + " shot"
+}
+
+// shotPhrases covers shot types that don't read naturally as "<type> shot".
+var shotPhrases = map[string]string{
+	"tip-in":       "tip-in",
+	"deflected":    "deflection",
+	"wrap-around":  "wraparound",
+	"between-legs": "between-the-legs shot",
+	"bat":          "batted puck",
+}
+
+func (d *describer) faceoff() string {
+	if d.details.WinningPlayerID == nil {
+		return sentence("Faceoff", d.zone())
+	}
+	winner := capitalize(d.player(d.details.WinningPlayerID))
+	if d.details.LosingPlayerID != nil {
+		return sentence(winner, "wins the faceoff against", d.player(d.details.LosingPlayerID), d.zone())
+	}
+	return sentence(winner, "wins the faceoff", d.zone())
+}
+
+func (d *describer) hit() string {
+	hitter := d.player(d.details.HittingPlayerID)
+	if d.details.HitteePlayerID == nil {
+		return sentence(capitalize(hitter), "delivers a hit", d.zone())
+	}
+	return sentence(capitalize(hitter), "hits", d.player(d.details.HitteePlayerID), d.zone())
+}
+
+func (d *describer) shotOnGoal() string {
+	base := sentenceBody(capitalize(d.numberedPlayer(d.details.ShootingPlayerID)), d.shotType(), d.shotLocation())
+	if d.details.GoalieInNetID != nil {
+		return base + ", saved by " + d.player(d.details.GoalieInNetID) + "."
+	}
+	return base + ", on goal."
+}
+
+func (d *describer) missedShot() string {
+	base := sentenceBody(capitalize(d.numberedPlayer(d.details.ShootingPlayerID)), d.shotType(), d.shotLocation())
+	if reason := humanize(d.details.Reason); reason != "" {
+		return base + ", " + reason + "."
+	}
+	return base + ", missed the net."
+}
+
+func (d *describer) blockedShot() string {
+	shooter := "A shot"
+	if d.details.ShootingPlayerID != nil {
+		shooter = capitalize(d.numberedPlayer(d.details.ShootingPlayerID)) + " shot"
+	}
+	if d.details.BlockingPlayerID != nil {
+		return sentence(shooter, "blocked by", d.player(d.details.BlockingPlayerID))
+	}
+	return sentence(shooter, "blocked")
+}
+
+func (d *describer) goal() string {
+	var b strings.Builder
+	b.WriteString("Goal by ")
+	b.WriteString(d.numberedPlayer(d.details.ScoringPlayerID))
+	if d.details.ScoringPlayerTotal != nil {
+		fmt.Fprintf(&b, ", his %s of the season", ordinal(*d.details.ScoringPlayerTotal))
+	}
+	b.WriteString(", ")
+	b.WriteString(sentenceBody(d.shotType(), d.shotLocation()))
+
+	var assists []string
+	if d.details.Assist1PlayerID != nil {
+		assists = append(assists, d.player(d.details.Assist1PlayerID))
+	}
+	if d.details.Assist2PlayerID != nil {
+		assists = append(assists, d.player(d.details.Assist2PlayerID))
+	}
+	switch len(assists) {
+	case 0:
+		b.WriteString(", unassisted")
+	default:
+		b.WriteString(", assisted by ")
+		b.WriteString(strings.Join(assists, " and "))
+	}
+	b.WriteString(".")
+
+	if d.details.AwayScore != nil && d.details.HomeScore != nil {
+		fmt.Fprintf(&b, " The score is %d to %d, away team first.", *d.details.AwayScore, *d.details.HomeScore)
+	}
+	return b.String()
+}
+
+func (d *describer) penalty() string {
+	var b strings.Builder
+	if d.details.CommittedByPlayerID != nil {
+		b.WriteString("Penalty on ")
+		b.WriteString(d.numberedPlayer(d.details.CommittedByPlayerID))
+	} else {
+		b.WriteString("Team penalty")
+	}
+	if d.details.Duration != nil {
+		fmt.Fprintf(&b, ", %d %s", *d.details.Duration, plural(*d.details.Duration, "minute", "minutes"))
+	}
+	if desc := humanize(d.details.DescKey); desc != "" {
+		if d.details.Duration != nil {
+			b.WriteString(" for ")
+		} else {
+			b.WriteString(", ")
+		}
+		b.WriteString(desc)
+	}
+	if d.details.DrawnByPlayerID != nil {
+		b.WriteString(", drawn by ")
+		b.WriteString(d.player(d.details.DrawnByPlayerID))
+	}
+	b.WriteString(".")
+	return b.String()
+}
+
+// periodName returns "the 1st period", "overtime", "the shootout", etc.
+func periodName(pd model.PeriodDescriptor) string {
+	switch pd.PeriodType {
+	case model.PeriodTypeShootout:
+		return "the shootout"
+	case model.PeriodTypeOvertime:
+		regulation := pd.MaxRegulationPeriods
+		if regulation == 0 {
+			regulation = 3
+		}
+		if n := pd.Number - regulation; n > 1 {
+			return fmt.Sprintf("the %s overtime", ordinal(n))
+		}
+		return "overtime"
+	default:
+		if pd.Number <= 0 {
+			return "the period"
+		}
+		return fmt.Sprintf("the %s period", ordinal(pd.Number))
+	}
+}
+
+// ordinal formats n as 1st, 2nd, 3rd, 4th, 11th, 21st, ...
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 100 {
+	case 11, 12, 13:
+	default:
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+func plural(n int, singular, pluralForm string) string {
+	if n == 1 {
+		return singular
+	}
+	return pluralForm
+}
+
+// humanize turns API keys like "wide-of-net" or "tip-in" into words.
+func humanize(key *string) string {
+	if key == nil {
+		return ""
+	}
+	return strings.TrimSpace(strings.NewReplacer("-", " ", "_", " ").Replace(*key))
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// sentenceBody joins the non-empty parts with spaces.
+func sentenceBody(parts ...string) string {
+	words := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p != "" {
+			words = append(words, p)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// sentence joins the non-empty parts and terminates with a period.
+func sentence(parts ...string) string {
+	return sentenceBody(parts...) + "."
+}
@@ -0,0 +1,320 @@
+package describe
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func playerPtr(id model.PlayerID) *model.PlayerID {
+	return &id
+}
+
+func zonePtr(z model.ZoneCode) *model.ZoneCode {
+	return &z
+}
+
+func testRoster() []model.RosterSpot {
+	spot := func(id model.PlayerID, last string, number int) model.RosterSpot {
+		return model.RosterSpot{
+			PlayerID:      id,
+			LastName:      model.LocalizedString{Default: last},
+			SweaterNumber: number,
+		}
+	}
+	return []model.RosterSpot{
+		spot(1, "Hyman", 18),
+		spot(2, "McDavid", 97),
+		spot(3, "Draisaitl", 29),
+		spot(4, "Saros", 74),
+		spot(5, "Josi", 59),
+		spot(6, "Forsberg", 9),
+	}
+}
+
+func regulation(number int) model.PeriodDescriptor {
+	return model.PeriodDescriptor{Number: number, PeriodType: model.PeriodTypeRegulation, MaxRegulationPeriods: 3}
+}
+
+func TestPlay(t *testing.T) {
+	tests := []struct {
+		name string
+		ev   model.PlayEvent
+		want string
+	}{
+		{
+			name: "game start",
+			ev:   model.PlayEvent{TypeDescKey: model.PlayEventTypeGameStart},
+			want: "The game is underway.",
+		},
+		{
+			name: "period start",
+			ev:   model.PlayEvent{TypeDescKey: model.PlayEventTypePeriodStart, PeriodDescriptor: regulation(2)},
+			want: "Start of the 2nd period.",
+		},
+		{
+			name: "period end overtime",
+			ev: model.PlayEvent{
+				TypeDescKey:      model.PlayEventTypePeriodEnd,
+				PeriodDescriptor: model.PeriodDescriptor{Number: 4, PeriodType: model.PeriodTypeOvertime, MaxRegulationPeriods: 3},
+			},
+			want: "End of overtime.",
+		},
+		{
+			name: "game end",
+			ev:   model.PlayEvent{TypeDescKey: model.PlayEventTypeGameEnd},
+			want: "End of the game.",
+		},
+		{
+			name: "faceoff",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypeFaceoff,
+				Details: &model.PlayEventDetails{
+					WinningPlayerID: playerPtr(2),
+					LosingPlayerID:  playerPtr(6),
+					ZoneCode:        zonePtr(model.ZoneCodeNeutral),
+				},
+			},
+			want: "McDavid wins the faceoff against Forsberg in the neutral zone.",
+		},
+		{
+			name: "hit",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypeHit,
+				Details:     &model.PlayEventDetails{HittingPlayerID: playerPtr(1), HitteePlayerID: playerPtr(5)},
+			},
+			want: "Hyman hits Josi.",
+		},
+		{
+			name: "giveaway",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypeGiveaway,
+				Details:     &model.PlayEventDetails{PlayerID: playerPtr(5), ZoneCode: zonePtr(model.ZoneCodeDefensive)},
+			},
+			want: "Giveaway by Josi in the defensive zone.",
+		},
+		{
+			name: "takeaway",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypeTakeaway,
+				Details:     &model.PlayEventDetails{PlayerID: playerPtr(3)},
+			},
+			want: "Takeaway by Draisaitl.",
+		},
+		{
+			name: "shot on goal from the slot",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypeShotOnGoal,
+				Details: &model.PlayEventDetails{
+					ShootingPlayerID: playerPtr(1),
+					GoalieInNetID:    playerPtr(4),
+					ShotType:         stringPtr("wrist"),
+					ZoneCode:         zonePtr(model.ZoneCodeOffensive),
+					XCoord:           intPtr(-66),
+					YCoord:           intPtr(4),
+				},
+			},
+			want: "Hyman (18) wrist shot from the slot, saved by Saros.",
+		},
+		{
+			name: "missed shot",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypeMissedShot,
+				Details: &model.PlayEventDetails{
+					ShootingPlayerID: playerPtr(3),
+					ShotType:         stringPtr("snap"),
+					ZoneCode:         zonePtr(model.ZoneCodeOffensive),
+					XCoord:           intPtr(35),
+					YCoord:           intPtr(-30),
+					Reason:           stringPtr("wide-of-net"),
+				},
+			},
+			want: "Draisaitl (29) snap shot from the point, wide of net.",
+		},
+		{
+			name: "blocked shot",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypeBlockedShot,
+				Details:     &model.PlayEventDetails{ShootingPlayerID: playerPtr(2), BlockingPlayerID: playerPtr(5)},
+			},
+			want: "McDavid (97) shot blocked by Josi.",
+		},
+		{
+			name: "goal",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypeGoal,
+				Details: &model.PlayEventDetails{
+					ScoringPlayerID:    playerPtr(1),
+					ScoringPlayerTotal: intPtr(12),
+					Assist1PlayerID:    playerPtr(2),
+					Assist2PlayerID:    playerPtr(3),
+					ShotType:           stringPtr("tip-in"),
+					ZoneCode:           zonePtr(model.ZoneCodeOffensive),
+					XCoord:             intPtr(85),
+					YCoord:             intPtr(2),
+					AwayScore:          intPtr(2),
+					HomeScore:          intPtr(1),
+				},
+			},
+			want: "Goal by Hyman (18), his 12th of the season, tip-in from in close, assisted by McDavid and Draisaitl. The score is 2 to 1, away team first.",
+		},
+		{
+			name: "unassisted goal",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypeGoal,
+				Details:     &model.PlayEventDetails{ScoringPlayerID: playerPtr(6), ShotType: stringPtr("wrist")},
+			},
+			want: "Goal by Forsberg (9), wrist shot, unassisted.",
+		},
+		{
+			name: "penalty",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypePenalty,
+				Details: &model.PlayEventDetails{
+					CommittedByPlayerID: playerPtr(5),
+					DrawnByPlayerID:     playerPtr(2),
+					DescKey:             stringPtr("tripping"),
+					Duration:            intPtr(2),
+				},
+			},
+			want: "Penalty on Josi (59), 2 minutes for tripping, drawn by McDavid.",
+		},
+		{
+			name: "bench penalty",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypePenalty,
+				Details:     &model.PlayEventDetails{DescKey: stringPtr("too-many-men-on-the-ice"), Duration: intPtr(2)},
+			},
+			want: "Team penalty, 2 minutes for too many men on the ice.",
+		},
+		{
+			name: "stoppage",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypeStoppage,
+				Details:     &model.PlayEventDetails{Reason: stringPtr("icing")},
+			},
+			want: "Stoppage in play: icing.",
+		},
+		{
+			name: "delayed penalty",
+			ev:   model.PlayEvent{TypeDescKey: model.PlayEventTypeDelayedPenalty},
+			want: "Delayed penalty signaled.",
+		},
+		{
+			name: "failed shot attempt",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypeFailedShotAttempt,
+				Details:     &model.PlayEventDetails{ShootingPlayerID: playerPtr(6)},
+			},
+			want: "Failed shot attempt by Forsberg.",
+		},
+		{
+			name: "shootout complete",
+			ev:   model.PlayEvent{TypeDescKey: model.PlayEventTypeShootoutComplete},
+			want: "The shootout is complete.",
+		},
+		{
+			name: "unknown",
+			ev:   model.PlayEvent{TypeDescKey: model.PlayEventTypeUnknown},
+			want: "Unknown event.",
+		},
+		{
+			name: "player missing from roster",
+			ev: model.PlayEvent{
+				TypeDescKey: model.PlayEventTypeShotOnGoal,
+				Details:     &model.PlayEventDetails{ShootingPlayerID: playerPtr(99), GoalieInNetID: playerPtr(4)},
+			},
+			want: "An unknown player shot, saved by Saros.",
+		},
+	}
+
+	roster := testRoster()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Play(&tt.ev, roster); got != tt.want {
+				t.Errorf("Play() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlay_Nil(t *testing.T) {
+	if got := Play(nil, testRoster()); got != "" {
+		t.Errorf("Play(nil) = %q, want empty", got)
+	}
+}
+
+func TestPlay_EveryEventTypeIsASentence(t *testing.T) {
+	types := []model.PlayEventType{
+		model.PlayEventTypeGameStart,
+		model.PlayEventTypePeriodStart,
+		model.PlayEventTypePeriodEnd,
+		model.PlayEventTypeGameEnd,
+		model.PlayEventTypeFaceoff,
+		model.PlayEventTypeHit,
+		model.PlayEventTypeGiveaway,
+		model.PlayEventTypeTakeaway,
+		model.PlayEventTypeShotOnGoal,
+		model.PlayEventTypeMissedShot,
+		model.PlayEventTypeBlockedShot,
+		model.PlayEventTypeGoal,
+		model.PlayEventTypePenalty,
+		model.PlayEventTypeStoppage,
+		model.PlayEventTypeDelayedPenalty,
+		model.PlayEventTypeFailedShotAttempt,
+		model.PlayEventTypeShootoutComplete,
+	}
+
+	for _, typ := range types {
+		t.Run(string(typ), func(t *testing.T) {
+			// Events without details must still describe cleanly.
+			got := Play(&model.PlayEvent{TypeDescKey: typ, PeriodDescriptor: regulation(1)}, nil)
+			if got == "" || got == "Unknown event." {
+				t.Fatalf("Play() = %q, want a description", got)
+			}
+			if !strings.HasSuffix(got, ".") {
+				t.Errorf("Play() = %q, want trailing period", got)
+			}
+			if first := got[:1]; first != strings.ToUpper(first) {
+				t.Errorf("Play() = %q, want capitalized sentence", got)
+			}
+		})
+	}
+}
+
+func TestPeriodName(t *testing.T) {
+	tests := []struct {
+		pd   model.PeriodDescriptor
+		want string
+	}{
+		{regulation(1), "the 1st period"},
+		{regulation(3), "the 3rd period"},
+		{model.PeriodDescriptor{Number: 4, PeriodType: model.PeriodTypeOvertime, MaxRegulationPeriods: 3}, "overtime"},
+		{model.PeriodDescriptor{Number: 6, PeriodType: model.PeriodTypeOvertime, MaxRegulationPeriods: 3}, "the 3rd overtime"},
+		{model.PeriodDescriptor{Number: 5, PeriodType: model.PeriodTypeShootout}, "the shootout"},
+	}
+
+	for _, tt := range tests {
+		if got := periodName(tt.pd); got != tt.want {
+			t.Errorf("periodName(%+v) = %q, want %q", tt.pd, got, tt.want)
+		}
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := map[int]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th", 21: "21st", 102: "102nd"}
+	for n, want := range tests {
+		if got := ordinal(n); got != want {
+			t.Errorf("ordinal(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package nhl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDraftEndpoints(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/draft/rankings/2024/1":
+			w.Write([]byte(`{}`))
+		case strings.HasPrefix(r.URL.Path, "/draft/picks/2024/"):
+			w.Write([]byte(`{}`))
+		case r.URL.Path == "/draft-tracker/picks/now":
+			w.Write([]byte(`{}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	ctx := context.Background()
+
+	if _, err := client.DraftRankings(ctx, 2024, DraftNorthAmericanSkater); err != nil {
+		t.Errorf("DraftRankings() error = %v", err)
+	}
+	if _, err := client.DraftPicks(ctx, 2024, 0); err != nil {
+		t.Errorf("DraftPicks(all) error = %v", err)
+	}
+	if _, err := client.DraftPicks(ctx, 2024, 2); err != nil {
+		t.Errorf("DraftPicks(2) error = %v", err)
+	}
+	if _, err := client.DraftTracker(ctx); err != nil {
+		t.Errorf("DraftTracker() error = %v", err)
+	}
+
+	want := []string{"/draft/rankings/2024/1", "/draft/picks/2024/all", "/draft/picks/2024/2", "/draft-tracker/picks/now"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("requested %v, want %v", paths, want)
+	}
+
+	_, err := client.DraftRankings(ctx, 2024, DraftProspectCategory(9))
+	if !errors.Is(err, ErrInvalidArgument) || len(paths) != len(want) {
+		t.Errorf("an invalid category should fail with ErrInvalidArgument without a request, got %v", err)
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		t.Errorf("invalid category should not be an APIError, got %v", err)
+	}
+}
@@ -1,9 +1,12 @@
 package nhl
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // Sentinel errors for well-known HTTP status codes.
@@ -16,18 +19,69 @@ var (
 	ErrServerError   = &APIError{StatusCode: http.StatusInternalServerError}
 )
 
+// ErrInvalidArgument is wrapped by the errors of Client methods that reject
+// an argument before making any request, such as an unknown milestone kind,
+// a season missing from the manifest or an invalid stats query.
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// Every failed request made by Client methods falls into exactly one of
+// three categories, each recording the Endpoint and Resource that was
+// requested:
+//
+//   - *TransportError: the request never produced an HTTP response (DNS,
+//     connection, timeout, context cancellation, truncated body).
+//   - *APIError: the server answered with a non-2xx status.
+//   - *DecodeError: the server answered 2xx but the body could not be
+//     decoded into the expected type, or decoded into values the method
+//     cannot use, such as a malformed date or image.
+//
+// Use errors.As to branch on the category, e.g. to retry transport errors
+// and 5xx responses but alert on decode errors, which usually indicate an
+// upstream schema change.
+//
+// Methods that reject their arguments before making any request return
+// errors wrapping ErrInvalidArgument instead. Failures on the caller's
+// side, such as writing downloaded files or an error returned by a
+// WatchGame handler, are returned with context added but otherwise as is.
+
 // APIError represents an NHL API error with an HTTP status code and message.
 // Use errors.Is with sentinel errors (ErrNotFound, ErrRateLimited, etc.) to
 // check for specific status codes. Matching is done by status code, so any
 // 404 APIError will match ErrNotFound regardless of message.
+//
+// Errors returned by Client methods also record the request (Method and
+// URL), the server's Retry-After delay when it sent one, and up to
+// maxErrorBodySize bytes of the response body; DecodeBody reads a JSON
+// error payload from it.
 type APIError struct {
-	Message    string `json:"message"`
-	StatusCode int    `json:"status_code"`
+	Message    string        `json:"message"`
+	StatusCode int           `json:"status_code"`
+	Endpoint   Endpoint      `json:"-"`
+	Resource   string        `json:"-"`
+	RequestID  string        `json:"request_id,omitempty"`
+	Method     string        `json:"-"`
+	URL        string        `json:"-"`
+	RetryAfter time.Duration `json:"-"`
+	Body       []byte        `json:"-"`
+}
+
+// maxErrorBodySize caps how much of an error response is kept in
+// APIError.Body.
+const maxErrorBodySize = 64 << 10
+
+// DecodeBody unmarshals the response body, typically a JSON error payload,
+// into v. It returns an error when there is no body or it is not valid
+// JSON for v.
+func (e *APIError) DecodeBody(v any) error {
+	if len(e.Body) == 0 {
+		return fmt.Errorf("no response body for status %d", e.StatusCode)
+	}
+	return json.Unmarshal(e.Body, v)
 }
 
 // Error implements the error interface.
 func (e *APIError) Error() string {
-	return fmt.Sprintf("NHL API error (status %d): %s", e.StatusCode, e.Message)
+	return fmt.Sprintf("NHL API error (status %d): %s%s", e.StatusCode, e.Message, requestIDSuffix(e.RequestID))
 }
 
 // Is supports errors.Is matching by status code, with one asymmetry:
@@ -71,51 +125,95 @@ func ErrorFromStatusCode(statusCode int, message string) error {
 	return NewAPIError(statusCode, message)
 }
 
-// RequestError wraps errors that occur during HTTP request execution.
-type RequestError struct {
-	Err error
+// TransportError wraps errors that occur before a complete HTTP response is
+// received. Context cancellation and deadlines surface as TransportErrors
+// wrapping context.Canceled or context.DeadlineExceeded.
+type TransportError struct {
+	Endpoint  Endpoint
+	Resource  string
+	RequestID string
+	Err       error
 }
 
-// NewRequestError creates a new RequestError.
-func NewRequestError(err error) *RequestError {
-	return &RequestError{Err: err}
+// RequestError is the former name of TransportError.
+//
+// Deprecated: Use TransportError.
+type RequestError = TransportError
+
+// NewRequestError creates a new TransportError with no endpoint or resource.
+func NewRequestError(err error) *TransportError {
+	return &TransportError{Err: err}
 }
 
 // Error implements the error interface.
-func (e *RequestError) Error() string {
-	return fmt.Sprintf("request error: %v", e.Err)
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("request error: %v%s", e.Err, requestIDSuffix(e.RequestID))
 }
 
 // Unwrap returns the wrapped error for errors.Is and errors.As.
-func (e *RequestError) Unwrap() error {
+func (e *TransportError) Unwrap() error {
 	return e.Err
 }
 
-// JSONError wraps errors that occur during JSON marshaling/unmarshaling.
-type JSONError struct {
-	Err error
+// DecodeError wraps errors that occur while decoding a successful response.
+type DecodeError struct {
+	Endpoint  Endpoint
+	Resource  string
+	RequestID string
+	Err       error
 }
 
-// NewJSONError creates a new JSONError.
-func NewJSONError(err error) *JSONError {
-	return &JSONError{Err: err}
+// JSONError is the former name of DecodeError.
+//
+// Deprecated: Use DecodeError.
+type JSONError = DecodeError
+
+// NewJSONError creates a new DecodeError with no endpoint or resource.
+func NewJSONError(err error) *DecodeError {
+	return &DecodeError{Err: err}
 }
 
 // Error implements the error interface.
-func (e *JSONError) Error() string {
-	return fmt.Sprintf("JSON error: %v", e.Err)
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("JSON error: %v%s", e.Err, requestIDSuffix(e.RequestID))
 }
 
 // Unwrap returns the wrapped error for errors.Is and errors.As.
-func (e *JSONError) Unwrap() error {
+func (e *DecodeError) Unwrap() error {
 	return e.Err
 }
 
+// requestIDSuffix formats a request ID for an error message.
+func requestIDSuffix(id string) string {
+	if id == "" {
+		return ""
+	}
+	return fmt.Sprintf(" [request %s]", id)
+}
+
+// withRequestID records the request ID of ctx on a client error.
+func withRequestID(ctx context.Context, err error) error {
+	id, ok := RequestIDFromContext(ctx)
+	if !ok {
+		return err
+	}
+	switch e := err.(type) {
+	case *TransportError:
+		e.RequestID = id
+	case *APIError:
+		e.RequestID = id
+	case *DecodeError:
+		e.RequestID = id
+	}
+	return err
+}
+
 // UnmarshalJSON implements custom JSON unmarshaling for APIError.
 func (e *APIError) UnmarshalJSON(data []byte) error {
 	var raw struct {
 		Message    string `json:"message"`
 		StatusCode int    `json:"status_code"`
+		RequestID  string `json:"request_id"`
 	}
 
 	if err := json.Unmarshal(data, &raw); err != nil {
@@ -124,6 +222,7 @@ func (e *APIError) UnmarshalJSON(data []byte) error {
 
 	e.Message = raw.Message
 	e.StatusCode = raw.StatusCode
+	e.RequestID = raw.RequestID
 	return nil
 }
 
@@ -132,8 +231,10 @@ func (e *APIError) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
 		Message    string `json:"message"`
 		StatusCode int    `json:"status_code"`
+		RequestID  string `json:"request_id,omitempty"`
 	}{
 		Message:    e.Message,
 		StatusCode: e.StatusCode,
+		RequestID:  e.RequestID,
 	})
 }
@@ -1,11 +1,15 @@
 package nhl
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestAPIError_Error(t *testing.T) {
@@ -195,6 +199,124 @@ func TestJSONError(t *testing.T) {
 	}
 }
 
+func TestErrorCategories_Distinct(t *testing.T) {
+	cause := fmt.Errorf("boom")
+	errs := []error{
+		&TransportError{Endpoint: EndpointAPIWebV1, Resource: "score/now", Err: cause},
+		&DecodeError{Endpoint: EndpointAPIWebV1, Resource: "score/now", Err: cause},
+		&APIError{StatusCode: 502, Endpoint: EndpointAPIWebV1, Resource: "score/now"},
+	}
+
+	for i, err := range errs {
+		var transportErr *TransportError
+		var decodeErr *DecodeError
+		var apiErr *APIError
+		matched := 0
+		if errors.As(err, &transportErr) {
+			matched++
+		}
+		if errors.As(err, &decodeErr) {
+			matched++
+		}
+		if errors.As(err, &apiErr) {
+			matched++
+		}
+		if matched != 1 {
+			t.Errorf("errs[%d] (%T) matched %d categories, want 1", i, err, matched)
+		}
+	}
+
+	if !errors.Is(errs[0], cause) || !errors.Is(errs[1], cause) {
+		t.Error("TransportError and DecodeError should unwrap to their cause")
+	}
+}
+
+func TestAPIError_RequestIDJSON(t *testing.T) {
+	original := &APIError{Message: "Not Found", StatusCode: 404, RequestID: "req-9"}
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"message":"Not Found","status_code":404,"request_id":"req-9"}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	var decoded APIError
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if decoded.RequestID != "req-9" {
+		t.Errorf("RequestID = %q, want req-9", decoded.RequestID)
+	}
+}
+
+func TestAPIError_ResponseDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Retry-After", "12")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"message": "Too many requests", "code": "RATE_LIMIT"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	ctx := WithRequestID(context.Background(), "req-42")
+	_, err := client.Boxscore(ctx, GameID(2023020001))
+
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("error = %v, want ErrRateLimited", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error = %T, want *APIError", err)
+	}
+	if apiErr.Method != http.MethodGet || apiErr.URL != server.URL+"/gamecenter/2023020001/boxscore" {
+		t.Errorf("request = %s %s", apiErr.Method, apiErr.URL)
+	}
+	if apiErr.RetryAfter != 12*time.Second || apiErr.RequestID != "req-42" {
+		t.Errorf("RetryAfter = %v, RequestID = %q", apiErr.RetryAfter, apiErr.RequestID)
+	}
+
+	var payload struct {
+		Message string `json:"message"`
+		Code    string `json:"code"`
+	}
+	if err := apiErr.DecodeBody(&payload); err != nil {
+		t.Fatalf("DecodeBody() error = %v", err)
+	}
+	if payload.Code != "RATE_LIMIT" || payload.Message != "Too many requests" {
+		t.Errorf("payload = %+v", payload)
+	}
+}
+
+func TestAPIError_BodyLimits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		if r.URL.Path == "/large" {
+			w.Write([]byte(strings.Repeat("x", maxErrorBodySize+100)))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	var out map[string]any
+	err := client.getJSON(context.Background(), EndpointAPIWebV1, "large", nil, &out)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Body) != maxErrorBodySize {
+		t.Fatalf("error = %v, body length %d", err, len(apiErr.Body))
+	}
+	if apiErr.RetryAfter != 0 {
+		t.Errorf("RetryAfter = %v, want 0 without a header", apiErr.RetryAfter)
+	}
+
+	err = client.getJSON(context.Background(), EndpointAPIWebV1, "empty", nil, &out)
+	if !errors.As(err, &apiErr) || apiErr.Body != nil {
+		t.Fatalf("error = %v, want empty body", err)
+	}
+	if err := apiErr.DecodeBody(&out); err == nil {
+		t.Error("DecodeBody() should fail without a body")
+	}
+}
+
 func TestErrorFromStatusCode(t *testing.T) {
 	tests := []struct {
 		name       string
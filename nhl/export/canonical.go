@@ -0,0 +1,47 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalCanonical returns the canonical JSON encoding of v.
+//
+// Canonical JSON has object keys sorted lexicographically at every level,
+// no insignificant whitespace, no HTML escaping and no trailing newline.
+// Numbers are preserved exactly as v's JSON encoding produces them. Two
+// values with the same JSON content always produce identical bytes, which
+// keeps diffs of stored exports meaningful.
+func MarshalCanonical(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("export: marshal: %w", err)
+	}
+	return Canonicalize(data)
+}
+
+// Canonicalize rewrites an existing JSON document into canonical form.
+// It is useful for normalizing raw API payloads before storing them.
+func Canonicalize(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("export: decode: %w", err)
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("export: decode: unexpected data after top-level value")
+	}
+
+	// encoding/json writes map keys in sorted order, so re-encoding the
+	// generic document yields key-ordered output.
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("export: encode: %w", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,116 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func TestMarshalCanonical_SortsKeys(t *testing.T) {
+	v := struct {
+		Zebra int            `json:"zebra"`
+		Alpha string         `json:"alpha"`
+		Inner map[string]int `json:"inner"`
+	}{
+		Zebra: 1,
+		Alpha: "a",
+		Inner: map[string]int{"b": 2, "a": 1},
+	}
+
+	got, err := MarshalCanonical(v)
+	if err != nil {
+		t.Fatalf("MarshalCanonical() error = %v", err)
+	}
+	want := `{"alpha":"a","inner":{"a":1,"b":2},"zebra":1}`
+	if string(got) != want {
+		t.Errorf("MarshalCanonical() = %s, want %s", got, want)
+	}
+}
+
+func TestMarshalCanonical_Model(t *testing.T) {
+	team := model.Team{
+		ID:             8,
+		FranchiseID:    1,
+		FullName:       "Montréal Canadiens",
+		Tricode:        "MTL",
+		TeamPlaceName:  model.LocalizedString{Default: "Montréal"},
+		TeamCommonName: model.LocalizedString{Default: "Canadiens"},
+		Conference:     model.Conference{Abbrev: "E", Name: "Eastern"},
+		Division:       model.Division{Abbrev: "A", Name: "Atlantic"},
+	}
+
+	first, err := MarshalCanonical(team)
+	if err != nil {
+		t.Fatalf("MarshalCanonical() error = %v", err)
+	}
+	second, err := MarshalCanonical(&team)
+	if err != nil {
+		t.Fatalf("MarshalCanonical() error = %v", err)
+	}
+	if string(first) != string(second) {
+		t.Errorf("MarshalCanonical() not stable:\n%s\n%s", first, second)
+	}
+
+	// Keys come out sorted regardless of struct field order.
+	want := `{"conference":{"abbrev":"E","name":"Eastern"},"division":{"abbrev":"A","name":"Atlantic"},` +
+		`"franchiseId":1,"fullName":"Montréal Canadiens","id":8,"leagueAbbrev":"","rawTricode":"",` +
+		`"teamCommonName":{"default":"Canadiens"},"teamLogo":"","teamPlaceName":{"default":"Montréal"},"tricode":"MTL"}`
+	if string(first) != want {
+		t.Errorf("MarshalCanonical() = %s, want %s", first, want)
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "whitespace and key order",
+			input: "{\n  \"b\": [1, 2, {\"y\": true, \"x\": null}],\n  \"a\": \"text\"\n}\n",
+			want:  `{"a":"text","b":[1,2,{"x":null,"y":true}]}`,
+		},
+		{
+			name:  "numbers preserved",
+			input: `{"big":9007199254740993,"frac":1.50,"exp":1e3}`,
+			want:  `{"big":9007199254740993,"exp":1e3,"frac":1.50}`,
+		},
+		{
+			name:  "no html escaping",
+			input: `{"url":"https://nhl.com/?a=1&b=<2>"}`,
+			want:  `{"url":"https://nhl.com/?a=1&b=<2>"}`,
+		},
+		{
+			name:  "scalar",
+			input: ` "x" `,
+			want:  `"x"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Canonicalize([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("Canonicalize() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Canonicalize() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalize_Invalid(t *testing.T) {
+	for _, input := range []string{``, `{`, `{"a":1} {"b":2}`} {
+		if _, err := Canonicalize([]byte(input)); err == nil {
+			t.Errorf("Canonicalize(%q) expected error", input)
+		}
+	}
+}
+
+func TestMarshalCanonical_Unsupported(t *testing.T) {
+	if _, err := MarshalCanonical(make(chan int)); err == nil {
+		t.Error("MarshalCanonical(chan) expected error")
+	}
+}
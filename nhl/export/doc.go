@@ -0,0 +1,8 @@
+// Package export provides helpers for writing NHL API models to stored
+// files and archives in a stable, diff-friendly form, and for flattening
+// plays, shifts, boxscores and game logs into rows for analytics tools.
+//
+// Rows have one fixed schema per record type, with game, season and team
+// columns repeated on every row so files load into DuckDB or pandas
+// without joins. RowWriter streams them as JSON lines or CSV.
+package export
@@ -0,0 +1,34 @@
+package export
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Fingerprint returns a stable content hash of v: the hex-encoded SHA-256
+// of its canonical JSON. Values with the same JSON content have the same
+// fingerprint regardless of map ordering or how they were constructed.
+// It returns "" if v cannot be marshaled to JSON.
+func Fingerprint(v any) string {
+	data, err := MarshalCanonical(v)
+	if err != nil {
+		return ""
+	}
+	return fingerprintCanonical(data)
+}
+
+// FingerprintJSON returns the fingerprint of a raw JSON document, matching
+// Fingerprint for the value it encodes. It is useful for comparing a fresh
+// model against a payload already stored on disk.
+func FingerprintJSON(data []byte) (string, error) {
+	canonical, err := Canonicalize(data)
+	if err != nil {
+		return "", err
+	}
+	return fingerprintCanonical(canonical), nil
+}
+
+func fingerprintCanonical(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,59 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func TestFingerprint(t *testing.T) {
+	a := map[string]any{"id": 2023020001, "teams": []string{"TOR", "MTL"}}
+	b := map[string]any{"teams": []string{"TOR", "MTL"}, "id": 2023020001}
+	c := map[string]any{"id": 2023020002, "teams": []string{"TOR", "MTL"}}
+
+	fa := Fingerprint(a)
+	if len(fa) != 64 {
+		t.Fatalf("Fingerprint() = %q, want 64 hex characters", fa)
+	}
+	if fb := Fingerprint(b); fb != fa {
+		t.Errorf("Fingerprint() differs for equal content: %s vs %s", fa, fb)
+	}
+	if fc := Fingerprint(c); fc == fa {
+		t.Error("Fingerprint() equal for different content")
+	}
+}
+
+func TestFingerprint_Model(t *testing.T) {
+	game := model.ScheduleGame{ID: 2023020001, GameType: model.GameTypeRegularSeason, GameState: model.GameStateFinal}
+	same := game
+	changed := game
+	changed.GameState = model.GameStateLive
+
+	if Fingerprint(game) != Fingerprint(&same) {
+		t.Error("Fingerprint() differs for value and pointer")
+	}
+	if Fingerprint(game) == Fingerprint(changed) {
+		t.Error("Fingerprint() unchanged after GameState changed")
+	}
+}
+
+func TestFingerprint_Unmarshalable(t *testing.T) {
+	if got := Fingerprint(func() {}); got != "" {
+		t.Errorf("Fingerprint(func) = %q, want empty", got)
+	}
+}
+
+func TestFingerprintJSON(t *testing.T) {
+	v := map[string]int{"a": 1, "b": 2}
+	got, err := FingerprintJSON([]byte("{ \"b\": 2,\n \"a\": 1 }"))
+	if err != nil {
+		t.Fatalf("FingerprintJSON() error = %v", err)
+	}
+	if want := Fingerprint(v); got != want {
+		t.Errorf("FingerprintJSON() = %s, want %s", got, want)
+	}
+
+	if _, err := FingerprintJSON([]byte("{")); err == nil {
+		t.Error("FingerprintJSON() expected error for invalid JSON")
+	}
+}
@@ -0,0 +1,384 @@
+package export
+
+import (
+	"slices"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// GameColumns are the game columns repeated on rows of per-game records,
+// so the rows load into one table without joins.
+type GameColumns struct {
+	GameID   int64  `json:"game_id"`
+	Season   int64  `json:"season"`
+	GameType int    `json:"game_type"`
+	GameDate string `json:"game_date"`
+	AwayTeam string `json:"away_team"`
+	HomeTeam string `json:"home_team"`
+}
+
+// PlayRow is one play-by-play event, flattened. Detail columns are nil
+// when the event does not have them.
+type PlayRow struct {
+	GameColumns
+
+	EventID       int64  `json:"event_id"`
+	SortOrder     int    `json:"sort_order"`
+	Period        int    `json:"period"`
+	PeriodType    string `json:"period_type"`
+	TimeInPeriod  string `json:"time_in_period"`
+	TimeRemaining string `json:"time_remaining"`
+	SituationCode string `json:"situation_code"`
+	TypeCode      int    `json:"type_code"`
+	TypeDescKey   string `json:"type_desc_key"`
+	// Team is the abbreviation of the team owning the event.
+	Team *string `json:"team"`
+
+	XCoord   *int    `json:"x_coord"`
+	YCoord   *int    `json:"y_coord"`
+	ZoneCode *string `json:"zone_code"`
+	ShotType *string `json:"shot_type"`
+
+	ShootingPlayerID    *int64 `json:"shooting_player_id"`
+	GoalieInNetID       *int64 `json:"goalie_in_net_id"`
+	BlockingPlayerID    *int64 `json:"blocking_player_id"`
+	ScoringPlayerID     *int64 `json:"scoring_player_id"`
+	Assist1PlayerID     *int64 `json:"assist1_player_id"`
+	Assist2PlayerID     *int64 `json:"assist2_player_id"`
+	CommittedByPlayerID *int64 `json:"committed_by_player_id"`
+	DrawnByPlayerID     *int64 `json:"drawn_by_player_id"`
+	HittingPlayerID     *int64 `json:"hitting_player_id"`
+	HitteePlayerID      *int64 `json:"hittee_player_id"`
+	WinningPlayerID     *int64 `json:"winning_player_id"`
+	LosingPlayerID      *int64 `json:"losing_player_id"`
+	PlayerID            *int64 `json:"player_id"`
+
+	AwayScore       *int    `json:"away_score"`
+	HomeScore       *int    `json:"home_score"`
+	AwaySOG         *int    `json:"away_sog"`
+	HomeSOG         *int    `json:"home_sog"`
+	PenaltyTypeCode *string `json:"penalty_type_code"`
+	PenaltyDescKey  *string `json:"penalty_desc_key"`
+	PenaltyMinutes  *int    `json:"penalty_minutes"`
+	Reason          *string `json:"reason"`
+}
+
+// PlayRows flattens a game's plays, in feed order.
+func PlayRows(pbp *model.PlayByPlay) []PlayRow {
+	if pbp == nil {
+		return []PlayRow{}
+	}
+	game := newGameColumns(pbp.ID, pbp.Season, pbp.GameType, pbp.GameDate, pbp.AwayTeam.Abbrev, pbp.HomeTeam.Abbrev)
+	teams := map[model.TeamID]string{pbp.AwayTeam.ID: pbp.AwayTeam.Abbrev, pbp.HomeTeam.ID: pbp.HomeTeam.Abbrev}
+
+	rows := make([]PlayRow, 0, len(pbp.Plays))
+	for _, p := range pbp.Plays {
+		row := PlayRow{
+			GameColumns: game,
+
+			EventID:       p.EventID,
+			SortOrder:     p.SortOrder,
+			Period:        p.PeriodDescriptor.Number,
+			PeriodType:    string(p.PeriodDescriptor.PeriodType),
+			TimeInPeriod:  p.TimeInPeriod,
+			TimeRemaining: p.TimeRemaining,
+			SituationCode: p.SituationCode,
+			TypeCode:      p.TypeCode,
+			TypeDescKey:   string(p.TypeDescKey),
+		}
+		if d := p.Details; d != nil {
+			if d.EventOwnerTeamID != nil {
+				if abbrev, ok := teams[*d.EventOwnerTeamID]; ok {
+					row.Team = &abbrev
+				}
+			}
+			row.XCoord, row.YCoord = d.XCoord, d.YCoord
+			if d.ZoneCode != nil {
+				zone := string(*d.ZoneCode)
+				row.ZoneCode = &zone
+			}
+			row.ShotType = d.ShotType
+			row.ShootingPlayerID = playerID(d.ShootingPlayerID)
+			row.GoalieInNetID = playerID(d.GoalieInNetID)
+			row.BlockingPlayerID = playerID(d.BlockingPlayerID)
+			row.ScoringPlayerID = playerID(d.ScoringPlayerID)
+			row.Assist1PlayerID = playerID(d.Assist1PlayerID)
+			row.Assist2PlayerID = playerID(d.Assist2PlayerID)
+			row.CommittedByPlayerID = playerID(d.CommittedByPlayerID)
+			row.DrawnByPlayerID = playerID(d.DrawnByPlayerID)
+			row.HittingPlayerID = playerID(d.HittingPlayerID)
+			row.HitteePlayerID = playerID(d.HitteePlayerID)
+			row.WinningPlayerID = playerID(d.WinningPlayerID)
+			row.LosingPlayerID = playerID(d.LosingPlayerID)
+			row.PlayerID = playerID(d.PlayerID)
+			row.AwayScore, row.HomeScore = d.AwayScore, d.HomeScore
+			row.AwaySOG, row.HomeSOG = d.AwaySOG, d.HomeSOG
+			row.PenaltyTypeCode, row.PenaltyDescKey = d.TypeCode, d.DescKey
+			row.PenaltyMinutes = d.Duration
+			row.Reason = d.Reason
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// ShiftRow is one shift, or one goal row of the shift chart, flattened.
+// Times are elapsed in the period, in MM:SS form; Duration is in seconds.
+type ShiftRow struct {
+	GameID int64 `json:"game_id"`
+	Season int64 `json:"season"`
+
+	Team             string  `json:"team"`
+	TeamID           int64   `json:"team_id"`
+	PlayerID         int64   `json:"player_id"`
+	FirstName        string  `json:"first_name"`
+	LastName         string  `json:"last_name"`
+	Period           int     `json:"period"`
+	ShiftNumber      int     `json:"shift_number"`
+	StartTime        string  `json:"start_time"`
+	EndTime          string  `json:"end_time"`
+	Duration         int     `json:"duration"`
+	TypeCode         int     `json:"type_code"`
+	DetailCode       int     `json:"detail_code"`
+	EventNumber      int64   `json:"event_number"`
+	EventDescription *string `json:"event_description"`
+}
+
+// ShiftRows flattens a shift chart, in chart order. The season comes from
+// each game ID.
+func ShiftRows(chart *model.ShiftChart) []ShiftRow {
+	if chart == nil {
+		return []ShiftRow{}
+	}
+	rows := make([]ShiftRow, 0, len(chart.Data))
+	for _, e := range chart.Data {
+		season, _ := e.GameID.Season()
+		rows = append(rows, ShiftRow{
+			GameID:           e.GameID.Int64(),
+			Season:           season.Int64(),
+			Team:             e.TeamAbbrev,
+			TeamID:           e.TeamID.Int64(),
+			PlayerID:         e.PlayerID.Int64(),
+			FirstName:        e.FirstName,
+			LastName:         e.LastName,
+			Period:           e.Period,
+			ShiftNumber:      e.ShiftNumber,
+			StartTime:        e.StartTime,
+			EndTime:          e.EndTime,
+			Duration:         e.Duration.Seconds(),
+			TypeCode:         e.TypeCode,
+			DetailCode:       e.DetailCode,
+			EventNumber:      e.EventNumber,
+			EventDescription: e.EventDescription,
+		})
+	}
+	return rows
+}
+
+// SkaterRow is one skater's line of a boxscore. TOI is in seconds.
+type SkaterRow struct {
+	GameColumns
+
+	Team          string `json:"team"`
+	Opponent      string `json:"opponent"`
+	Home          bool   `json:"home"`
+	PlayerID      int64  `json:"player_id"`
+	Name          string `json:"name"`
+	SweaterNumber int    `json:"sweater_number"`
+	Position      string `json:"position"`
+
+	Goals              int     `json:"goals"`
+	Assists            int     `json:"assists"`
+	Points             int     `json:"points"`
+	PlusMinus          int     `json:"plus_minus"`
+	PIM                int     `json:"pim"`
+	Hits               int     `json:"hits"`
+	PowerPlayGoals     int     `json:"power_play_goals"`
+	SOG                int     `json:"sog"`
+	FaceoffWinningPctg float64 `json:"faceoff_winning_pctg"`
+	TOI                int     `json:"toi"`
+	BlockedShots       int     `json:"blocked_shots"`
+	Shifts             int     `json:"shifts"`
+	Giveaways          int     `json:"giveaways"`
+	Takeaways          int     `json:"takeaways"`
+}
+
+// GoalieRow is one goalie's line of a boxscore. TOI is in seconds.
+type GoalieRow struct {
+	GameColumns
+
+	Team          string `json:"team"`
+	Opponent      string `json:"opponent"`
+	Home          bool   `json:"home"`
+	PlayerID      int64  `json:"player_id"`
+	Name          string `json:"name"`
+	SweaterNumber int    `json:"sweater_number"`
+
+	ShotsAgainst             int      `json:"shots_against"`
+	Saves                    int      `json:"saves"`
+	GoalsAgainst             int      `json:"goals_against"`
+	SavePctg                 *float64 `json:"save_pctg"`
+	EvenStrengthGoalsAgainst int      `json:"even_strength_goals_against"`
+	PowerPlayGoalsAgainst    int      `json:"power_play_goals_against"`
+	ShorthandedGoalsAgainst  int      `json:"shorthanded_goals_against"`
+	PIM                      *int     `json:"pim"`
+	TOI                      int      `json:"toi"`
+	Starter                  *bool    `json:"starter"`
+	Decision                 *string  `json:"decision"`
+}
+
+// BoxscoreRows flattens a boxscore's player lines, away team first, each
+// team's forwards before its defensemen.
+func BoxscoreRows(box *model.Boxscore) ([]SkaterRow, []GoalieRow) {
+	skaters, goalies := []SkaterRow{}, []GoalieRow{}
+	if box == nil {
+		return skaters, goalies
+	}
+	game := newGameColumns(box.ID, box.Season, box.GameType, box.GameDate, box.AwayTeam.Abbrev, box.HomeTeam.Abbrev)
+	sides := []struct {
+		stats          model.TeamPlayerStats
+		team, opponent string
+		home           bool
+	}{
+		{box.PlayerByGameStats.AwayTeam, game.AwayTeam, game.HomeTeam, false},
+		{box.PlayerByGameStats.HomeTeam, game.HomeTeam, game.AwayTeam, true},
+	}
+	for _, side := range sides {
+		for _, s := range slices.Concat(side.stats.Forwards, side.stats.Defense) {
+			skaters = append(skaters, SkaterRow{
+				GameColumns: game,
+
+				Team:          side.team,
+				Opponent:      side.opponent,
+				Home:          side.home,
+				PlayerID:      s.PlayerID.Int64(),
+				Name:          s.Name.Default,
+				SweaterNumber: s.SweaterNumber,
+				Position:      string(s.Position),
+
+				Goals:              s.Goals,
+				Assists:            s.Assists,
+				Points:             s.Points,
+				PlusMinus:          s.PlusMinus,
+				PIM:                s.PIM,
+				Hits:               s.Hits,
+				PowerPlayGoals:     s.PowerPlayGoals,
+				SOG:                s.SOG,
+				FaceoffWinningPctg: s.FaceoffWinningPctg,
+				TOI:                s.TOI.Seconds(),
+				BlockedShots:       s.BlockedShots,
+				Shifts:             s.Shifts,
+				Giveaways:          s.Giveaways,
+				Takeaways:          s.Takeaways,
+			})
+		}
+		for _, g := range side.stats.Goalies {
+			row := GoalieRow{
+				GameColumns: game,
+
+				Team:          side.team,
+				Opponent:      side.opponent,
+				Home:          side.home,
+				PlayerID:      g.PlayerID.Int64(),
+				Name:          g.Name.Default,
+				SweaterNumber: g.SweaterNumber,
+
+				ShotsAgainst:             g.ShotsAgainst,
+				Saves:                    g.Saves,
+				GoalsAgainst:             g.GoalsAgainst,
+				SavePctg:                 g.SavePctg,
+				EvenStrengthGoalsAgainst: g.EvenStrengthGoalsAgainst,
+				PowerPlayGoalsAgainst:    g.PowerPlayGoalsAgainst,
+				ShorthandedGoalsAgainst:  g.ShorthandedGoalsAgainst,
+				PIM:                      g.PIM,
+				TOI:                      g.TOI.Seconds(),
+				Starter:                  g.Starter,
+			}
+			if g.Decision != nil {
+				decision := string(*g.Decision)
+				row.Decision = &decision
+			}
+			goalies = append(goalies, row)
+		}
+	}
+	return skaters, goalies
+}
+
+// GameLogRow is one game of a player's game log. TOI is in seconds.
+type GameLogRow struct {
+	PlayerID int64  `json:"player_id"`
+	Season   int64  `json:"season"`
+	GameType int    `json:"game_type"`
+	GameID   int64  `json:"game_id"`
+	GameDate string `json:"game_date"`
+	Team     string `json:"team"`
+	Opponent string `json:"opponent"`
+	Home     bool   `json:"home"`
+
+	Goals            int  `json:"goals"`
+	Assists          int  `json:"assists"`
+	Points           int  `json:"points"`
+	PlusMinus        int  `json:"plus_minus"`
+	PowerPlayGoals   int  `json:"power_play_goals"`
+	PowerPlayPoints  int  `json:"power_play_points"`
+	Shots            int  `json:"shots"`
+	Shifts           int  `json:"shifts"`
+	TOI              int  `json:"toi"`
+	GameWinningGoals *int `json:"game_winning_goals"`
+	OTGoals          *int `json:"ot_goals"`
+	PIM              *int `json:"pim"`
+}
+
+// GameLogRows flattens a player's game log, in log order, with the
+// player, season and game type on every row.
+func GameLogRows(log *model.PlayerGameLog) []GameLogRow {
+	if log == nil {
+		return []GameLogRow{}
+	}
+	rows := make([]GameLogRow, 0, len(log.GameLog))
+	for _, g := range log.GameLog {
+		rows = append(rows, GameLogRow{
+			PlayerID: log.PlayerID.Int64(),
+			Season:   log.Season.Int64(),
+			GameType: log.GameType.Int(),
+			GameID:   g.GameID.Int64(),
+			GameDate: g.GameDate,
+			Team:     g.TeamAbbrev,
+			Opponent: g.OpponentAbbrev,
+			Home:     g.HomeRoadFlag == model.HomeRoadHome,
+
+			Goals:            g.Goals,
+			Assists:          g.Assists,
+			Points:           g.Points,
+			PlusMinus:        g.PlusMinus,
+			PowerPlayGoals:   g.PowerPlayGoals,
+			PowerPlayPoints:  g.PowerPlayPoints,
+			Shots:            g.Shots,
+			Shifts:           g.Shifts,
+			TOI:              g.TOI.Seconds(),
+			GameWinningGoals: g.GameWinningGoals,
+			OTGoals:          g.OTGoals,
+			PIM:              g.PIM,
+		})
+	}
+	return rows
+}
+
+func newGameColumns(id model.GameID, season model.Season, gameType model.GameType, date, away, home string) GameColumns {
+	return GameColumns{
+		GameID:   id.Int64(),
+		Season:   season.Int64(),
+		GameType: gameType.Int(),
+		GameDate: date,
+		AwayTeam: away,
+		HomeTeam: home,
+	}
+}
+
+func playerID(id *model.PlayerID) *int64 {
+	if id == nil {
+		return nil
+	}
+	v := id.Int64()
+	return &v
+}
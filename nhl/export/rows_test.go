@@ -0,0 +1,162 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestPlayRows(t *testing.T) {
+	pbp := &model.PlayByPlay{
+		ID:       2023020204,
+		Season:   model.NewSeason(2023),
+		GameType: model.GameTypeRegularSeason,
+		GameDate: "2023-11-11",
+		AwayTeam: model.BoxscoreTeam{ID: 10, Abbrev: "TOR"},
+		HomeTeam: model.BoxscoreTeam{ID: 8, Abbrev: "MTL"},
+		Plays: []model.PlayEvent{
+			{EventID: 1, SortOrder: 8, TypeCode: 520, TypeDescKey: model.PlayEventTypePeriodStart,
+				PeriodDescriptor: model.PeriodDescriptor{Number: 1, PeriodType: model.PeriodTypeRegulation}},
+			{EventID: 54, SortOrder: 90, TypeCode: 505, TypeDescKey: model.PlayEventTypeGoal, TimeInPeriod: "04:12",
+				PeriodDescriptor: model.PeriodDescriptor{Number: 1, PeriodType: model.PeriodTypeRegulation},
+				Details: &model.PlayEventDetails{
+					EventOwnerTeamID: ptr(model.TeamID(8)),
+					XCoord:           ptr(-80),
+					ZoneCode:         ptr(model.ZoneCodeOffensive),
+					ScoringPlayerID:  ptr(model.PlayerID(8480018)),
+					HomeScore:        ptr(1),
+					AwayScore:        ptr(0),
+				}},
+		},
+	}
+
+	rows := PlayRows(pbp)
+	if len(rows) != 2 {
+		t.Fatalf("PlayRows() = %d rows, want 2", len(rows))
+	}
+	start, goal := rows[0], rows[1]
+	if start.GameID != 2023020204 || start.Season != 20232024 || start.GameType != 2 || start.AwayTeam != "TOR" || start.HomeTeam != "MTL" {
+		t.Errorf("game columns = %+v", start.GameColumns)
+	}
+	if start.Team != nil || start.ScoringPlayerID != nil || start.PeriodType != "REG" {
+		t.Errorf("period start = %+v", start)
+	}
+	if goal.Team == nil || *goal.Team != "MTL" || *goal.ScoringPlayerID != 8480018 || *goal.XCoord != -80 ||
+		*goal.ZoneCode != "O" || *goal.HomeScore != 1 || goal.Assist1PlayerID != nil {
+		t.Errorf("goal = %+v", goal)
+	}
+
+	if rows := PlayRows(nil); len(rows) != 0 {
+		t.Errorf("PlayRows(nil) = %+v", rows)
+	}
+}
+
+func TestShiftRows(t *testing.T) {
+	chart := &model.ShiftChart{Data: []model.ShiftEntry{{
+		GameID: 2023020204, TeamAbbrev: "MTL", TeamID: 8, PlayerID: 8481540, FirstName: "Cole", LastName: "Caufield",
+		Period: 2, ShiftNumber: 7, StartTime: "03:10", EndTime: "04:02", Duration: model.TOIFromDuration(52 * time.Second), TypeCode: 517,
+	}}}
+
+	rows := ShiftRows(chart)
+	if len(rows) != 1 {
+		t.Fatalf("ShiftRows() = %d rows, want 1", len(rows))
+	}
+	r := rows[0]
+	if r.Season != 20232024 || r.Team != "MTL" || r.PlayerID != 8481540 || r.Duration != 52 || r.EventDescription != nil {
+		t.Errorf("ShiftRows()[0] = %+v", r)
+	}
+}
+
+func TestBoxscoreRows(t *testing.T) {
+	box := &model.Boxscore{
+		ID:       2023020204,
+		Season:   model.NewSeason(2023),
+		GameType: model.GameTypeRegularSeason,
+		GameDate: "2023-11-11",
+		AwayTeam: model.BoxscoreTeam{Abbrev: "TOR"},
+		HomeTeam: model.BoxscoreTeam{Abbrev: "MTL"},
+		PlayerByGameStats: model.PlayerByGameStats{
+			AwayTeam: model.TeamPlayerStats{
+				Forwards: []model.SkaterStats{{PlayerID: 8479318, Name: model.LocalizedString{Default: "A. Matthews"}, Position: model.PositionCenter, Goals: 1, TOI: model.TOIFromDuration(1230 * time.Second)}},
+				Defense:  []model.SkaterStats{{PlayerID: 8476853, Position: model.PositionDefense}},
+				Goalies:  []model.GoalieStats{{PlayerID: 8479361, ShotsAgainst: 30, Saves: 27, Decision: ptr(model.GoalieDecisionLoss)}},
+			},
+			HomeTeam: model.TeamPlayerStats{
+				Forwards: []model.SkaterStats{{PlayerID: 8480018, Assists: 2}},
+				Goalies:  []model.GoalieStats{{PlayerID: 8477424, SavePctg: ptr(0.9)}},
+			},
+		},
+	}
+
+	skaters, goalies := BoxscoreRows(box)
+	if len(skaters) != 3 || len(goalies) != 2 {
+		t.Fatalf("BoxscoreRows() = %d skaters, %d goalies, want 3 and 2", len(skaters), len(goalies))
+	}
+	if s := skaters[0]; s.Team != "TOR" || s.Opponent != "MTL" || s.Home || s.Goals != 1 || s.TOI != 1230 || s.Position != "C" || s.Season != 20232024 {
+		t.Errorf("first skater = %+v", s)
+	}
+	if s := skaters[2]; s.PlayerID != 8480018 || !s.Home || s.Opponent != "TOR" {
+		t.Errorf("home skater = %+v", s)
+	}
+	if g := goalies[0]; g.Decision == nil || *g.Decision != "L" || g.SavePctg != nil {
+		t.Errorf("away goalie = %+v", g)
+	}
+	if g := goalies[1]; g.Decision != nil || g.SavePctg == nil || !g.Home {
+		t.Errorf("home goalie = %+v", g)
+	}
+}
+
+func TestGameLogRows(t *testing.T) {
+	log := &model.PlayerGameLog{
+		PlayerID: 8478402,
+		Season:   model.NewSeason(2023),
+		GameType: model.GameTypeRegularSeason,
+		GameLog: []model.GameLog{
+			{GameID: 2023020010, GameDate: "2023-10-12", TeamAbbrev: "EDM", OpponentAbbrev: "VAN", HomeRoadFlag: model.HomeRoadRoad, Points: 1, TOI: model.TOIFromDuration(1320 * time.Second)},
+			{GameID: 2023020030, GameDate: "2023-10-14", TeamAbbrev: "EDM", OpponentAbbrev: "VAN", HomeRoadFlag: model.HomeRoadHome, PIM: ptr(2)},
+		},
+	}
+
+	rows := GameLogRows(log)
+	if len(rows) != 2 {
+		t.Fatalf("GameLogRows() = %d rows, want 2", len(rows))
+	}
+	if r := rows[0]; r.PlayerID != 8478402 || r.Season != 20232024 || r.GameType != 2 || r.Home || r.TOI != 1320 || r.PIM != nil {
+		t.Errorf("first game = %+v", r)
+	}
+	if r := rows[1]; !r.Home || r.PIM == nil || *r.PIM != 2 {
+		t.Errorf("second game = %+v", r)
+	}
+}
+
+func TestPlayRows_JSONL(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewRowWriter[PlayRow](&buf, JSONL)
+	if err != nil {
+		t.Fatalf("NewRowWriter() error = %v", err)
+	}
+	row := PlayRow{GameColumns: GameColumns{GameID: 2023020204, Season: 20232024}, EventID: 54, Team: ptr("MTL")}
+	if err := w.Write(row); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("unmarshal %s: %v", buf.String(), err)
+	}
+	if len(got) != len(w.Columns()) {
+		t.Errorf("JSONL row has %d keys, want the %d columns", len(got), len(w.Columns()))
+	}
+	if got["game_id"] != float64(2023020204) || got["team"] != "MTL" || got["x_coord"] != nil {
+		t.Errorf("JSONL row = %s", buf.String())
+	}
+	if _, ok := got["x_coord"]; !ok || !strings.HasSuffix(buf.String(), "}\n") {
+		t.Errorf("JSONL row should keep null columns and end in a newline: %q", buf.String())
+	}
+}
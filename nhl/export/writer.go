@@ -0,0 +1,196 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Format is the file format of a RowWriter.
+type Format int
+
+const (
+	// JSONL writes one JSON object per line. Nil columns are null.
+	JSONL Format = iota + 1
+	// CSV writes a header line of column names, then one line per row.
+	// Nil columns are empty.
+	CSV
+)
+
+// String returns the format's usual file extension, without the dot.
+func (f Format) String() string {
+	switch f {
+	case JSONL:
+		return "jsonl"
+	case CSV:
+		return "csv"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// RowWriter streams flat rows of one type, such as PlayRow or ShiftRow, to
+// a writer as JSON lines or CSV. Columns are the row's fields, named by
+// their JSON tags, in field order, so JSONL and CSV exports of a row type
+// share one schema. Rows are written as they come; call Flush when done.
+type RowWriter[T any] struct {
+	format  Format
+	enc     *json.Encoder
+	csv     *csv.Writer
+	columns []string
+	header  bool
+}
+
+// NewRowWriter returns a writer of T rows to w in the given format. T must
+// be a struct of string, bool, integer and float fields, or pointers to
+// them for nullable columns.
+func NewRowWriter[T any](w io.Writer, format Format) (*RowWriter[T], error) {
+	columns, err := rowColumns(reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+	rw := &RowWriter[T]{format: format, columns: columns}
+	switch format {
+	case JSONL:
+		rw.enc = json.NewEncoder(w)
+		rw.enc.SetEscapeHTML(false)
+	case CSV:
+		rw.csv = csv.NewWriter(w)
+	default:
+		return nil, fmt.Errorf("export: unknown format %s", format)
+	}
+	return rw, nil
+}
+
+// Columns returns the column names, in order.
+func (w *RowWriter[T]) Columns() []string {
+	return w.columns
+}
+
+// Write writes one row. The CSV header goes out with the first row.
+func (w *RowWriter[T]) Write(row T) error {
+	if w.format == JSONL {
+		if err := w.enc.Encode(row); err != nil {
+			return fmt.Errorf("export: encode row: %w", err)
+		}
+		return nil
+	}
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	if err := w.csv.Write(csvRecord(reflect.ValueOf(row))); err != nil {
+		return fmt.Errorf("export: write row: %w", err)
+	}
+	return nil
+}
+
+// WriteAll writes rows in order.
+func (w *RowWriter[T]) WriteAll(rows []T) error {
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes any buffered data, and the CSV header when no row was
+// written, so an empty export still has its schema.
+func (w *RowWriter[T]) Flush() error {
+	if w.format != CSV {
+		return nil
+	}
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		return fmt.Errorf("export: flush: %w", err)
+	}
+	return nil
+}
+
+func (w *RowWriter[T]) writeHeader() error {
+	if w.header {
+		return nil
+	}
+	w.header = true
+	if err := w.csv.Write(w.columns); err != nil {
+		return fmt.Errorf("export: write header: %w", err)
+	}
+	return nil
+}
+
+// rowColumns returns the column names of a row type, checking that every
+// field is a supported scalar. Embedded structs, such as GameColumns,
+// contribute their columns in place, as in JSON.
+func rowColumns(t reflect.Type) ([]string, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("export: row type %s is not a struct", t)
+	}
+	var columns []string
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			embedded, err := rowColumns(f.Type)
+			if err != nil {
+				return nil, err
+			}
+			columns = append(columns, embedded...)
+			continue
+		}
+		kind := f.Type.Kind()
+		if kind == reflect.Pointer {
+			kind = f.Type.Elem().Kind()
+		}
+		switch kind {
+		case reflect.String, reflect.Bool, reflect.Int, reflect.Int64, reflect.Float64:
+		default:
+			return nil, fmt.Errorf("export: row type %s: field %s is a %s", t, f.Name, f.Type)
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" {
+			name = f.Name
+		}
+		columns = append(columns, name)
+	}
+	return columns, nil
+}
+
+func csvRecord(v reflect.Value) []string {
+	var record []string
+	for i := range v.NumField() {
+		f := v.Field(i)
+		if v.Type().Field(i).Anonymous && f.Kind() == reflect.Struct {
+			record = append(record, csvRecord(f)...)
+			continue
+		}
+		record = append(record, csvValue(f))
+	}
+	return record
+}
+
+func csvValue(f reflect.Value) string {
+	if f.Kind() == reflect.Pointer {
+		if f.IsNil() {
+			return ""
+		}
+		f = f.Elem()
+	}
+	switch f.Kind() {
+	case reflect.String:
+		return f.String()
+	case reflect.Bool:
+		return strconv.FormatBool(f.Bool())
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(f.Int(), 10)
+	case reflect.Float64:
+		return strconv.FormatFloat(f.Float(), 'f', -1, 64)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,103 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type testRow struct {
+	GameColumns
+	Name     string   `json:"name"`
+	Count    int      `json:"count,omitempty"`
+	Ratio    *float64 `json:"ratio"`
+	Flag     bool     `json:"flag"`
+	Untagged string
+}
+
+func TestRowWriter_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewRowWriter[testRow](&buf, CSV)
+	if err != nil {
+		t.Fatalf("NewRowWriter() error = %v", err)
+	}
+	ratio := 0.25
+	rows := []testRow{
+		{GameColumns: GameColumns{GameID: 2023020204, Season: 20232024, GameType: 2, AwayTeam: "TOR", HomeTeam: "MTL"}, Name: "a, \"quoted\"", Count: 3, Ratio: &ratio, Flag: true},
+		{Name: "b", Untagged: "x"},
+	}
+	if err := w.WriteAll(rows); err != nil {
+		t.Fatalf("WriteAll() error = %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	want := strings.Join([]string{
+		"game_id,season,game_type,game_date,away_team,home_team,name,count,ratio,flag,Untagged",
+		`2023020204,20232024,2,,TOR,MTL,"a, ""quoted""",3,0.25,true,`,
+		"0,0,0,,,,b,0,,false,x",
+		"",
+	}, "\n")
+	if got := buf.String(); got != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRowWriter_EmptyCSVHasHeader(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewRowWriter[ShiftRow](&buf, CSV)
+	if err != nil {
+		t.Fatalf("NewRowWriter() error = %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if got := buf.String(); !strings.HasPrefix(got, "game_id,season,team,") || strings.Count(got, "\n") != 1 {
+		t.Errorf("empty CSV = %q, want only the header", got)
+	}
+}
+
+func TestRowWriter_JSONL(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewRowWriter[testRow](&buf, JSONL)
+	if err != nil {
+		t.Fatalf("NewRowWriter() error = %v", err)
+	}
+	if err := w.WriteAll([]testRow{{Name: "<a>"}, {Name: "b", Count: 1}}); err != nil {
+		t.Fatalf("WriteAll() error = %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("JSONL = %q, want 2 lines", buf.String())
+	}
+	if !strings.Contains(lines[0], `"name":"<a>"`) || !strings.Contains(lines[0], `"ratio":null`) {
+		t.Errorf("first line = %s", lines[0])
+	}
+}
+
+func TestNewRowWriter_Errors(t *testing.T) {
+	if _, err := NewRowWriter[testRow](&bytes.Buffer{}, Format(9)); err == nil {
+		t.Error("NewRowWriter() should reject an unknown format")
+	}
+	if _, err := NewRowWriter[int](&bytes.Buffer{}, CSV); err == nil {
+		t.Error("NewRowWriter() should reject a non-struct row")
+	}
+	type nested struct {
+		Tags []string `json:"tags"`
+	}
+	if _, err := NewRowWriter[nested](&bytes.Buffer{}, CSV); err == nil {
+		t.Error("NewRowWriter() should reject non-scalar columns")
+	}
+}
+
+func TestFormat_String(t *testing.T) {
+	for f, want := range map[Format]string{JSONL: "jsonl", CSV: "csv", Format(9): "Format(9)"} {
+		if got := f.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", int(f), got, want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package nhl
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"net/http"
+	"slices"
+)
+
+// GameIDs iterates over the IDs of the season's games of one type, in
+// ascending order. The IDs come from the league schedule, so numbers that
+// were never used (playoff series that ended early, games dropped from a
+// shortened season) are skipped and scrapers need not guess where the
+// numbering stops. Scheduled games that have not been played yet are
+// included; check their state before fetching game data.
+//
+// The schedule is fetched when iteration starts, one request per week of
+// the season. A failure is yielded once as a zero GameID with the error,
+// after which iteration stops.
+func (c *Client) GameIDs(ctx context.Context, season Season, gameType GameType) iter.Seq2[GameID, error] {
+	return func(yield func(GameID, error) bool) {
+		games, err := c.FullSeasonSchedule(ctx, season)
+		if err != nil {
+			yield(0, err)
+			return
+		}
+		var ids []GameID
+		for _, game := range games {
+			if game.GameType == gameType {
+				ids = append(ids, game.ID)
+			}
+		}
+		slices.Sort(ids)
+		for _, id := range slices.Compact(ids) {
+			if !yield(id, nil) {
+				return
+			}
+		}
+	}
+}
+
+// GameExists reports the state of a game without fetching its full data,
+// so backfills over constructed ID ranges can skip game numbers that were
+// never scheduled before requesting play-by-play or shift charts. It reads
+// the game's landing page, which is much smaller than the boxscore, and
+// decodes only its game state.
+//
+// A game that does not exist, answered with a 404 APIError, yields an empty
+// GameState and a nil error; IDs that fail Validate are reported that way
+// without a request. Any other failure, including transport errors, is
+// returned as an error. In delayed-data mode a final game
+// whose plays are still withheld is reported as live.
+func (c *Client) GameExists(ctx context.Context, gameID GameID) (GameState, error) {
+	if !gameID.IsValid() {
+		return "", nil
+	}
+	var response struct {
+		GameState GameState `json:"gameState"`
+	}
+	if err := c.fetchGamecenter(ctx, gameID, "landing", &response); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	state := response.GameState
+	if _, _, hide := c.delayed.scores(gameID, state); hide && state.IsFinal() {
+		state = GameStateLive
+	}
+	return state, nil
+}
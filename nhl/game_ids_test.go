@@ -0,0 +1,137 @@
+package nhl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGameIDs(t *testing.T) {
+	game := func(id GameID, gameType GameType) ScheduleGame {
+		return ScheduleGame{ID: id, GameType: gameType, GameState: GameStateFinal}
+	}
+	server, _ := weeklyPages(t, map[string]any{
+		"/schedule/2023-09-01": &WeeklyScheduleResponse{
+			NextStartDate: "2023-09-08",
+			GameWeek: []GameDay{{Date: "2023-09-02", Games: []ScheduleGame{
+				game(2023010001, GameTypePreseason),
+				game(2023020002, GameTypeRegularSeason),
+			}}},
+		},
+		"/schedule/2023-09-08": &WeeklyScheduleResponse{
+			NextStartDate: "2023-09-15",
+			GameWeek: []GameDay{{Date: "2023-09-09", Games: []ScheduleGame{
+				game(2023020001, GameTypeRegularSeason),
+				game(2023030111, GameTypePlayoffs),
+				game(2023030114, GameTypePlayoffs),
+			}}},
+		},
+		"/schedule/2023-09-15": &WeeklyScheduleResponse{
+			NextStartDate: "2023-09-22",
+			GameWeek: []GameDay{{Date: "2023-09-15", Games: []ScheduleGame{
+				game(2023020001, GameTypeRegularSeason),
+				game(2024010001, GameTypePreseason),
+			}}},
+		},
+	})
+	client := NewClientWithBaseURL(server.URL)
+
+	collect := func(gameType GameType) []GameID {
+		var ids []GameID
+		for id, err := range client.GameIDs(context.Background(), NewSeason(2023), gameType) {
+			if err != nil {
+				t.Fatalf("GameIDs() error = %v", err)
+			}
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	if got, want := collect(GameTypeRegularSeason), []GameID{2023020001, 2023020002}; !reflect.DeepEqual(got, want) {
+		t.Errorf("regular season = %v, want %v", got, want)
+	}
+	if got, want := collect(GameTypePlayoffs), []GameID{2023030111, 2023030114}; !reflect.DeepEqual(got, want) {
+		t.Errorf("playoffs = %v, want %v", got, want)
+	}
+	if got := collect(GameTypeAllStar); got != nil {
+		t.Errorf("all-star = %v, want none", got)
+	}
+
+	for id := range client.GameIDs(context.Background(), NewSeason(2023), GameTypeRegularSeason) {
+		if id != 2023020001 {
+			t.Errorf("first ID = %d, want 2023020001", id)
+		}
+		break
+	}
+}
+
+func TestGameIDsError(t *testing.T) {
+	server, _ := weeklyPages(t, map[string]any{})
+	client := NewClientWithBaseURL(server.URL)
+
+	count := 0
+	for id, err := range client.GameIDs(context.Background(), NewSeason(2023), GameTypeRegularSeason) {
+		count++
+		if id != 0 || !errors.Is(err, ErrNotFound) {
+			t.Errorf("got (%d, %v), want (0, ErrNotFound)", id, err)
+		}
+	}
+	if count != 1 {
+		t.Errorf("yielded %d times, want 1", count)
+	}
+}
+
+func TestGameExists(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/gamecenter/2023020001/landing":
+			makeJSONResponse(http.StatusOK, map[string]any{"id": 2023020001, "gameState": "OFF"})(w, r)
+		case "/gamecenter/2023020002/landing":
+			makeJSONResponse(http.StatusOK, map[string]any{"id": 2023020002, "gameState": "FUT"})(w, r)
+		case "/gamecenter/2023020003/landing":
+			makeErrorResponse(http.StatusInternalServerError)(w, r)
+		case "/gamecenter/2023020004/landing":
+			makeErrorResponse(http.StatusForbidden)(w, r)
+		default:
+			makeErrorResponse(http.StatusNotFound)(w, r)
+		}
+	}))
+	defer server.Close()
+	client := NewClientWithBaseURL(server.URL)
+	ctx := context.Background()
+
+	tests := []struct {
+		id   GameID
+		want GameState
+	}{
+		{2023020001, GameStateOff},
+		{2023020002, GameStateFuture},
+		{2023021400, ""},
+	}
+	for _, tt := range tests {
+		got, err := client.GameExists(ctx, tt.id)
+		if err != nil || got != tt.want {
+			t.Errorf("GameExists(%d) = %q, %v; want %q", tt.id, got, err, tt.want)
+		}
+	}
+
+	if _, err := client.GameExists(ctx, 2023020003); !errors.Is(err, ErrServerError) {
+		t.Errorf("GameExists(server error) error = %v, want ErrServerError", err)
+	}
+	if got, err := client.GameExists(ctx, 2023020004); got != "" || err == nil {
+		t.Errorf("GameExists(forbidden) = %q, %v; want an error", got, err)
+	}
+
+	before := requests.Load()
+	if got, err := client.GameExists(ctx, 12345); got != "" || err != nil {
+		t.Errorf("GameExists(invalid) = %q, %v", got, err)
+	}
+	if requests.Load() != before {
+		t.Error("GameExists(invalid) should not make a request")
+	}
+}
@@ -0,0 +1,19 @@
+package nhl
+
+import (
+	"context"
+)
+
+// GameInfo returns a game's officials, head coaches and scratches, from
+// the right-rail payload; GameRightRail and SeasonSeries carry the same
+// block with the rest of the sidebar. Lists the league has not published
+// yet, such as officials before game day, are empty.
+func (c *Client) GameInfo(ctx context.Context, gameID GameID) (*SeriesGameInfo, error) {
+	var response struct {
+		GameInfo SeriesGameInfo `json:"gameInfo"`
+	}
+	if err := c.fetchGamecenter(ctx, gameID, "right-rail", &response); err != nil {
+		return nil, err
+	}
+	return &response.GameInfo, nil
+}
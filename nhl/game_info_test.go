@@ -0,0 +1,53 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GameInfo(t *testing.T) {
+	body := `{
+		"seasonSeries": [],
+		"gameInfo": {
+			"referees": [{"default": "Wes McCauley"}, {"default": "Chris Rooney"}],
+			"linesmen": [{"default": "Steve Barton"}, {"default": "Jonny Murray"}],
+			"awayTeam": {"headCoach": {"default": "Craig Berube"}, "scratches": [
+				{"id": 8480015, "firstName": {"default": "Ryan"}, "lastName": {"default": "Reaves"}}
+			]},
+			"homeTeam": {"headCoach": {"default": "Martin St. Louis"}, "scratches": []}
+		}
+	}`
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	info, err := client.GameInfo(context.Background(), GameID(2024020001))
+	if err != nil {
+		t.Fatalf("GameInfo() error = %v", err)
+	}
+	if gotPath != "/gamecenter/2024020001/right-rail" {
+		t.Errorf("path = %q", gotPath)
+	}
+
+	want := []string{"Wes McCauley", "Chris Rooney", "Steve Barton", "Jonny Murray"}
+	if got := info.Officials(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Officials() = %v, want %v", got, want)
+	}
+	if info.AwayTeam.HeadCoach.Default != "Craig Berube" || info.HomeTeam.HeadCoach.Default != "Martin St. Louis" {
+		t.Errorf("head coaches = %q, %q", info.AwayTeam.HeadCoach.Default, info.HomeTeam.HeadCoach.Default)
+	}
+	if !info.IsScratched(8480015) || info.IsScratched(8478402) {
+		t.Error("IsScratched() should only report the listed scratch")
+	}
+	if got := info.AwayTeam.Scratches[0].FullName(); got != "Ryan Reaves" {
+		t.Errorf("FullName() = %q", got)
+	}
+}
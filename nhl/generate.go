@@ -1,4 +1,3 @@
 package nhl
 
-//go:generate go run ../internal/enumgen
-//go:generate go run ../internal/idgen
+//go:generate go run ../internal/aliasgen
@@ -0,0 +1,77 @@
+package nhl
+
+import (
+	"cmp"
+	"context"
+	"slices"
+)
+
+// GoalClips collects the highlight references of every goal a player
+// scored in the given games, from each game's play-by-play. Goals are
+// ordered by game start time, then by when they were scored; goals whose
+// highlight is not yet published are kept, see HasClip and Playable.
+// Repeated game IDs are fetched once, and the first failed request ends
+// the call.
+func (c *Client) GoalClips(ctx context.Context, gameIDs []GameID, playerID PlayerID) (*GoalPlaylist, error) {
+	playlist := &GoalPlaylist{PlayerID: playerID, Clips: []GoalClip{}}
+	type ordered struct {
+		clip      GoalClip
+		sortOrder int
+	}
+	var goals []ordered
+	seen := make(map[GameID]bool, len(gameIDs))
+	for _, id := range gameIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		pbp, err := c.PlayByPlay(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, play := range pbp.Goals() {
+			d := play.Details
+			if d == nil || d.ScoringPlayerID == nil || *d.ScoringPlayerID != playerID ||
+				play.PeriodDescriptor.PeriodType == PeriodTypeShootout {
+				continue
+			}
+			clip := GoalClip{
+				GameID:       pbp.ID,
+				GameDate:     pbp.GameDate,
+				StartTimeUTC: pbp.StartTimeUTC,
+				EventID:      play.EventID,
+				Period:       play.PeriodDescriptor.Number,
+				PeriodType:   play.PeriodDescriptor.PeriodType,
+				TimeInPeriod: play.TimeInPeriod,
+			}
+			if d.EventOwnerTeamID != nil {
+				clip.Opponent = pbp.HomeTeam.Abbrev
+				if *d.EventOwnerTeamID == pbp.HomeTeam.ID {
+					clip.Opponent = pbp.AwayTeam.Abbrev
+				}
+			}
+			if d.HighlightClip != nil {
+				clip.ClipID = *d.HighlightClip
+			}
+			if d.DiscreteClip != nil {
+				clip.DiscreteClipID = *d.DiscreteClip
+			}
+			if d.HighlightClipSharingURL != nil {
+				clip.SharingURL = *d.HighlightClipSharingURL
+			}
+			goals = append(goals, ordered{clip, play.SortOrder})
+		}
+	}
+
+	slices.SortStableFunc(goals, func(a, b ordered) int {
+		return cmp.Or(
+			cmp.Compare(a.clip.StartTimeUTC, b.clip.StartTimeUTC),
+			cmp.Compare(a.clip.GameID, b.clip.GameID),
+			cmp.Compare(a.sortOrder, b.sortOrder),
+		)
+	})
+	for _, g := range goals {
+		playlist.Clips = append(playlist.Clips, g.clip)
+	}
+	return playlist, nil
+}
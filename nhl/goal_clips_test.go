@@ -0,0 +1,96 @@
+package nhl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGoalClips(t *testing.T) {
+	const scorer = PlayerID(8480018)
+	goal := func(eventID int64, sortOrder, period int, periodType PeriodType, team TeamID, player PlayerID, clip int64) PlayEvent {
+		d := &PlayEventDetails{EventOwnerTeamID: &team, ScoringPlayerID: &player}
+		if clip != 0 {
+			url := fmt.Sprintf("https://nhl.com/video/goal-%d", clip)
+			d.HighlightClip, d.DiscreteClip, d.HighlightClipSharingURL = &clip, &clip, &url
+		}
+		return PlayEvent{
+			EventID:          eventID,
+			SortOrder:        sortOrder,
+			PeriodDescriptor: PeriodDescriptor{Number: period, PeriodType: periodType},
+			TimeInPeriod:     "05:00",
+			TypeDescKey:      PlayEventTypeGoal,
+			Details:          d,
+		}
+	}
+	games := map[string]PlayByPlay{
+		// The later game is listed first.
+		"/gamecenter/2023020200/play-by-play": {
+			ID: 2023020200, GameType: GameTypeRegularSeason, Season: NewSeason(2023),
+			GameState: GameStateOff, GameScheduleState: GameScheduleStateOK, StartTimeUTC: "2023-11-20T00:00:00Z",
+			AwayTeam: BoxscoreTeam{ID: 8, Abbrev: "MTL"}, HomeTeam: BoxscoreTeam{ID: 6, Abbrev: "BOS"},
+			Plays: []PlayEvent{goal(50, 500, 3, PeriodTypeRegulation, 8, scorer, 0)},
+		},
+		"/gamecenter/2023020100/play-by-play": {
+			ID: 2023020100, GameType: GameTypeRegularSeason, Season: NewSeason(2023),
+			GameState: GameStateOff, GameScheduleState: GameScheduleStateOK, StartTimeUTC: "2023-11-01T23:00:00Z",
+			AwayTeam: BoxscoreTeam{ID: 10, Abbrev: "TOR"}, HomeTeam: BoxscoreTeam{ID: 8, Abbrev: "MTL"},
+			Plays: []PlayEvent{
+				goal(30, 300, 2, PeriodTypeRegulation, 8, scorer, 222),
+				goal(10, 100, 1, PeriodTypeRegulation, 8, scorer, 111),
+				goal(20, 200, 1, PeriodTypeRegulation, 10, 8479318, 999),
+				goal(40, 400, 5, PeriodTypeShootout, 8, scorer, 0),
+			},
+		},
+	}
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		pbp, ok := games[r.URL.Path]
+		if !ok {
+			makeErrorResponse(http.StatusNotFound)(w, r)
+			return
+		}
+		makeJSONResponse(http.StatusOK, pbp)(w, r)
+	}))
+	defer server.Close()
+	client := NewClientWithBaseURL(server.URL)
+
+	playlist, err := client.GoalClips(context.Background(), []GameID{2023020200, 2023020100, 2023020200}, scorer)
+	if err != nil {
+		t.Fatalf("GoalClips() error = %v", err)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("requests = %d, want 2", requests.Load())
+	}
+	var events []int64
+	for _, c := range playlist.Clips {
+		events = append(events, c.EventID)
+	}
+	if len(events) != 3 || events[0] != 10 || events[1] != 30 || events[2] != 50 {
+		t.Fatalf("clip events = %v, want [10 30 50]", events)
+	}
+	first := playlist.Clips[0]
+	if first.GameID != 2023020100 || first.Opponent != "TOR" || first.ClipID != 111 || first.SharingURL == "" || first.Period != 1 {
+		t.Errorf("first clip = %+v", first)
+	}
+	if last := playlist.Clips[2]; last.Opponent != "BOS" || last.HasClip() {
+		t.Errorf("last clip = %+v", last)
+	}
+	if playable := playlist.Playable(); len(playable) != 2 {
+		t.Errorf("Playable() = %d clips, want 2", len(playable))
+	}
+
+	_, err = client.GoalClips(context.Background(), []GameID{2023020999}, scorer)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GoalClips(unknown game) error = %v, want ErrNotFound", err)
+	}
+	empty, err := client.GoalClips(context.Background(), nil, scorer)
+	if err != nil || empty.Clips == nil || len(empty.Clips) != 0 {
+		t.Errorf("GoalClips(nil) = %+v, %v", empty, err)
+	}
+}
@@ -0,0 +1,19 @@
+// Package graph exposes the NHL client through a GraphQL schema so that a
+// GraphQL gateway can be stood up with a few lines of code:
+//
+//	schema := graph.NewSchema(nhl.NewClient())
+//	http.Handle("/graphql", schema.Handler())
+//
+// The schema covers games, teams, players and standings with nested
+// resolvers, for example a team's schedule, each game's boxscore and each
+// boxscore line's player. Schema.SDL prints the full schema.
+//
+// Within a single request, identical API calls are made once and shared by
+// every field that needs them, and a concurrency limit bounds how many calls
+// are in flight (see WithMaxConcurrency). Nothing is cached across requests.
+//
+// The package has no dependencies beyond the standard library. It executes
+// the query subset of GraphQL: fields, aliases, arguments, variables,
+// fragments, @skip and @include. Mutations, subscriptions and schema
+// introspection are not supported.
+package graph
@@ -0,0 +1,632 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Request is a GraphQL request as sent by clients over HTTP.
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Response is a GraphQL response. Data is omitted when the request could
+// not be executed at all, for example because it failed to parse.
+type Response struct {
+	Data   any      `json:"data,omitempty"`
+	Errors []*Error `json:"errors,omitempty"`
+}
+
+// Error is a GraphQL error. Path locates the field that failed, when known.
+type Error struct {
+	Message string `json:"message"`
+	Path    []any  `json:"path,omitempty"`
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if len(e.Path) == 0 {
+		return e.Message
+	}
+	parts := make([]string, len(e.Path))
+	for i, p := range e.Path {
+		parts[i] = fmt.Sprint(p)
+	}
+	return fmt.Sprintf("%s: %s", strings.Join(parts, "."), e.Message)
+}
+
+// ===== Type system =====
+
+// resolveFunc resolves a field on a source value.
+type resolveFunc func(ctx context.Context, source any, args map[string]any) (any, error)
+
+type objectType struct {
+	name   string
+	desc   string
+	fields []*fieldDef
+	byName map[string]*fieldDef
+}
+
+type fieldDef struct {
+	name    string
+	desc    string
+	args    []argDef
+	typ     string
+	ref     typeRef
+	resolve resolveFunc
+}
+
+type argDef struct {
+	name         string
+	typ          string
+	defaultValue any
+}
+
+// typeRef is a parsed output or input type such as "[Game!]!".
+type typeRef struct {
+	name        string
+	list        bool
+	nonNull     bool
+	elemNonNull bool
+}
+
+func parseTypeRef(typ string) typeRef {
+	var ref typeRef
+	if strings.HasSuffix(typ, "!") {
+		ref.nonNull = true
+		typ = strings.TrimSuffix(typ, "!")
+	}
+	if strings.HasPrefix(typ, "[") {
+		ref.list = true
+		typ = strings.TrimSuffix(strings.TrimPrefix(typ, "["), "]")
+		if strings.HasSuffix(typ, "!") {
+			ref.elemNonNull = true
+			typ = strings.TrimSuffix(typ, "!")
+		}
+	}
+	ref.name = typ
+	return ref
+}
+
+var scalarTypes = map[string]bool{"String": true, "Int": true, "Float": true, "Boolean": true, "ID": true}
+
+func object(name, desc string, fields ...*fieldDef) *objectType {
+	t := &objectType{name: name, desc: desc, fields: fields, byName: make(map[string]*fieldDef, len(fields))}
+	for _, f := range fields {
+		t.byName[f.name] = f
+	}
+	return t
+}
+
+func field(name, typ, desc string, resolve resolveFunc, args ...argDef) *fieldDef {
+	return &fieldDef{name: name, desc: desc, args: args, typ: typ, ref: parseTypeRef(typ), resolve: resolve}
+}
+
+func arg(name, typ string) argDef {
+	return argDef{name: name, typ: typ}
+}
+
+// ===== Execution =====
+
+// execution holds the state of a single request.
+type execution struct {
+	schema    *Schema
+	doc       *document
+	variables map[string]any
+
+	mu     sync.Mutex
+	errors []*Error
+}
+
+func (e *execution) addError(path []any, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.errors = append(e.errors, &Error{Message: err.Error(), Path: append([]any(nil), path...)})
+}
+
+// Execute runs a GraphQL query against the schema. Field errors are reported
+// in the response alongside partial data; only query operations are
+// supported.
+func (s *Schema) Execute(ctx context.Context, req Request) *Response {
+	doc, err := parseDocument(req.Query)
+	if err != nil {
+		return &Response{Errors: []*Error{{Message: "syntax error: " + err.Error()}}}
+	}
+
+	op, err := selectOperation(doc, req.OperationName)
+	if err != nil {
+		return &Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+	if op.kind != "query" {
+		return &Response{Errors: []*Error{{Message: fmt.Sprintf("%s operations are not supported", op.kind)}}}
+	}
+
+	vars, err := coerceVariables(op.variables, req.Variables)
+	if err != nil {
+		return &Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+
+	root := s.byName[s.query]
+	if errs := s.validate(doc, root, op.selectionSet); len(errs) > 0 {
+		return &Response{Errors: errs}
+	}
+
+	ex := &execution{schema: s, doc: doc, variables: vars}
+	ctx = withLoader(ctx, newLoader(s.client, s.maxConcurrency))
+	data, ok := ex.selectionSet(ctx, root, nil, op.selectionSet, nil)
+	if !ok {
+		return &Response{Data: json.RawMessage("null"), Errors: ex.errors}
+	}
+	return &Response{Data: data, Errors: ex.errors}
+}
+
+func selectOperation(doc *document, name string) (*operation, error) {
+	if name == "" {
+		if len(doc.operations) > 1 {
+			return nil, fmt.Errorf("operationName is required when the document has several operations")
+		}
+		return doc.operations[0], nil
+	}
+	for _, op := range doc.operations {
+		if op.name == name {
+			return op, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown operation %q", name)
+}
+
+func coerceVariables(defs []variableDef, provided map[string]any) (map[string]any, error) {
+	vars := make(map[string]any, len(defs))
+	for _, def := range defs {
+		ref := parseTypeRef(def.typ)
+		raw, ok := provided[def.name]
+		if !ok || raw == nil {
+			if def.defaultValue != nil {
+				v, err := coerceInput(ref, def.defaultValue, nil)
+				if err != nil {
+					return nil, fmt.Errorf("variable $%s: %w", def.name, err)
+				}
+				vars[def.name] = v
+				continue
+			}
+			if ref.nonNull {
+				return nil, fmt.Errorf("variable $%s of type %s is required", def.name, def.typ)
+			}
+			vars[def.name] = nil
+			continue
+		}
+		v, err := coerceInput(ref, raw, nil)
+		if err != nil {
+			return nil, fmt.Errorf("variable $%s: %w", def.name, err)
+		}
+		vars[def.name] = v
+	}
+	return vars, nil
+}
+
+// coerceInput converts a literal or JSON variable value to the Go value
+// passed to resolvers: string, int, float64 or bool.
+func coerceInput(ref typeRef, v any, vars map[string]any) (any, error) {
+	if name, ok := v.(variableRef); ok {
+		return vars[string(name)], nil
+	}
+	if v == nil {
+		if ref.nonNull {
+			return nil, fmt.Errorf("expected non-null %s", ref.name)
+		}
+		return nil, nil
+	}
+	if ref.list {
+		return nil, fmt.Errorf("list arguments are not supported")
+	}
+
+	switch ref.name {
+	case "String":
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case "ID":
+		switch x := v.(type) {
+		case string:
+			return x, nil
+		case int64:
+			return strconv.FormatInt(x, 10), nil
+		case float64:
+			if x == float64(int64(x)) {
+				return strconv.FormatInt(int64(x), 10), nil
+			}
+		}
+	case "Int":
+		switch x := v.(type) {
+		case int64:
+			return int(x), nil
+		case float64:
+			if x == float64(int(x)) {
+				return int(x), nil
+			}
+		}
+	case "Float":
+		switch x := v.(type) {
+		case int64:
+			return float64(x), nil
+		case float64:
+			return x, nil
+		}
+	case "Boolean":
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot use %v as %s", v, ref.name)
+}
+
+// collectedField is a response key with the field nodes merged under it.
+type collectedField struct {
+	key   string
+	nodes []*fieldNode
+}
+
+// collectFields flattens fragments and applies @skip/@include.
+func (e *execution) collectFields(t *objectType, sels []selection, visited map[string]bool, out []*collectedField) []*collectedField {
+	for _, sel := range sels {
+		switch s := sel.(type) {
+		case *fieldNode:
+			if !e.included(s.directives) {
+				continue
+			}
+			key := s.responseKey()
+			found := false
+			for _, cf := range out {
+				if cf.key == key {
+					cf.nodes = append(cf.nodes, s)
+					found = true
+					break
+				}
+			}
+			if !found {
+				out = append(out, &collectedField{key: key, nodes: []*fieldNode{s}})
+			}
+		case *fragmentSpread:
+			if visited[s.name] || !e.included(s.directives) {
+				continue
+			}
+			visited[s.name] = true
+			frag := e.doc.fragments[s.name]
+			if frag == nil || frag.typeCondition != t.name {
+				continue
+			}
+			out = e.collectFields(t, frag.selectionSet, visited, out)
+		case *inlineFragment:
+			if !e.included(s.directives) {
+				continue
+			}
+			if s.typeCondition != "" && s.typeCondition != t.name {
+				continue
+			}
+			out = e.collectFields(t, s.selectionSet, visited, out)
+		}
+	}
+	return out
+}
+
+func (e *execution) included(dirs []directive) bool {
+	for _, d := range dirs {
+		if d.name != "skip" && d.name != "include" {
+			continue
+		}
+		cond := false
+		for _, a := range d.arguments {
+			if a.name == "if" {
+				v, _ := coerceInput(typeRef{name: "Boolean"}, a.value, e.variables)
+				cond, _ = v.(bool)
+			}
+		}
+		if d.name == "skip" && cond {
+			return false
+		}
+		if d.name == "include" && !cond {
+			return false
+		}
+	}
+	return true
+}
+
+// selectionSet resolves the selected fields of an object concurrently and
+// returns them in request order. It reports false when a non-null field
+// resolved to null, in which case the object itself becomes null.
+func (e *execution) selectionSet(ctx context.Context, t *objectType, source any, sels []selection, path []any) (*orderedMap, bool) {
+	fields := e.collectFields(t, sels, map[string]bool{}, nil)
+	result := &orderedMap{keys: make([]string, len(fields)), values: make([]any, len(fields))}
+	valid := make([]bool, len(fields))
+
+	var wg sync.WaitGroup
+	for i, cf := range fields {
+		result.keys[i] = cf.key
+		node := cf.nodes[0]
+		if node.name == "__typename" {
+			result.values[i], valid[i] = t.name, true
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, cf *collectedField) {
+			defer wg.Done()
+			fieldPath := append(append([]any(nil), path...), cf.key)
+			result.values[i], valid[i] = e.resolveField(ctx, t, source, cf, fieldPath)
+		}(i, cf)
+	}
+	wg.Wait()
+	for _, ok := range valid {
+		if !ok {
+			return nil, false
+		}
+	}
+	return result, true
+}
+
+// resolveField resolves and completes one field. Like complete, it reports
+// false when a null must propagate to the parent.
+func (e *execution) resolveField(ctx context.Context, t *objectType, source any, cf *collectedField, path []any) (any, bool) {
+	node := cf.nodes[0]
+	def := t.byName[node.name]
+	fail := func(err error) (any, bool) {
+		e.addError(path, err)
+		return nil, !def.ref.nonNull
+	}
+
+	args := make(map[string]any, len(def.args))
+	for _, a := range def.args {
+		ref := parseTypeRef(a.typ)
+		var raw any = a.defaultValue
+		present := false
+		for _, given := range node.arguments {
+			if given.name == a.name {
+				raw, present = given.value, true
+			}
+		}
+		if !present && a.defaultValue == nil {
+			if ref.nonNull {
+				return fail(fmt.Errorf("argument %q of type %s is required", a.name, a.typ))
+			}
+			continue
+		}
+		v, err := coerceInput(ref, raw, e.variables)
+		if err != nil {
+			return fail(fmt.Errorf("argument %q: %w", a.name, err))
+		}
+		args[a.name] = v
+	}
+
+	value, err := def.resolve(ctx, source, args)
+	if err != nil {
+		return fail(err)
+	}
+
+	var sels []selection
+	for _, n := range cf.nodes {
+		sels = append(sels, n.selectionSet...)
+	}
+	return e.complete(ctx, def.ref, sels, value, path)
+}
+
+// complete converts a resolved Go value into its response form. It reports
+// false when the value is null in a non-null position, so that the null
+// propagates to the nearest nullable parent.
+func (e *execution) complete(ctx context.Context, ref typeRef, sels []selection, value any, path []any) (any, bool) {
+	// Scalars and lists are dereferenced; objects are handed to their
+	// resolvers as returned.
+	rv := reflect.ValueOf(value)
+	if ref.list || scalarTypes[ref.name] {
+		for rv.IsValid() && rv.Kind() == reflect.Pointer && !rv.IsNil() {
+			rv = rv.Elem()
+		}
+	}
+	if !rv.IsValid() || (rv.Kind() == reflect.Pointer && rv.IsNil()) {
+		if ref.nonNull {
+			e.addError(path, fmt.Errorf("non-null field resolved to null"))
+			return nil, false
+		}
+		return nil, true
+	}
+
+	if ref.list {
+		if rv.Kind() != reflect.Slice {
+			e.addError(path, fmt.Errorf("expected a list, got %T", value))
+			return nil, !ref.nonNull
+		}
+		elemRef := typeRef{name: ref.name, nonNull: ref.elemNonNull}
+		items := make([]any, rv.Len())
+		valid := make([]bool, rv.Len())
+		var wg sync.WaitGroup
+		for i := range items {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				itemPath := append(append([]any(nil), path...), i)
+				items[i], valid[i] = e.complete(ctx, elemRef, sels, rv.Index(i).Interface(), itemPath)
+			}(i)
+		}
+		wg.Wait()
+		for _, ok := range valid {
+			if !ok {
+				return nil, !ref.nonNull
+			}
+		}
+		return items, true
+	}
+
+	if scalarTypes[ref.name] {
+		v, err := serializeScalar(ref.name, rv)
+		if err != nil {
+			e.addError(path, err)
+			return nil, !ref.nonNull
+		}
+		return v, true
+	}
+
+	t := e.schema.byName[ref.name]
+	obj, ok := e.selectionSet(ctx, t, rv.Interface(), sels, path)
+	if !ok {
+		return nil, !ref.nonNull
+	}
+	return obj, true
+}
+
+func serializeScalar(name string, rv reflect.Value) (any, error) {
+	switch name {
+	case "ID":
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return strconv.FormatInt(rv.Int(), 10), nil
+		case reflect.String:
+			return rv.String(), nil
+		}
+	case "String":
+		if rv.Kind() == reflect.String {
+			return rv.String(), nil
+		}
+		if s, ok := rv.Interface().(fmt.Stringer); ok {
+			return s.String(), nil
+		}
+	case "Int":
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return rv.Int(), nil
+		}
+	case "Float":
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return rv.Float(), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return float64(rv.Int()), nil
+		}
+	case "Boolean":
+		if rv.Kind() == reflect.Bool {
+			return rv.Bool(), nil
+		}
+	}
+	return nil, fmt.Errorf("cannot serialize %s as %s", rv.Type(), name)
+}
+
+// ===== Validation =====
+
+// validate checks the selection against the schema before execution so
+// that malformed queries fail without touching the API.
+func (s *Schema) validate(doc *document, root *objectType, sels []selection) []*Error {
+	var errs []*Error
+	var walk func(t *objectType, sels []selection, visiting map[string]bool)
+	walk = func(t *objectType, sels []selection, visiting map[string]bool) {
+		for _, sel := range sels {
+			switch n := sel.(type) {
+			case *fieldNode:
+				if n.name == "__typename" {
+					continue
+				}
+				if strings.HasPrefix(n.name, "__") {
+					errs = append(errs, &Error{Message: fmt.Sprintf("introspection field %q is not supported; see Schema.SDL", n.name)})
+					continue
+				}
+				def := t.byName[n.name]
+				if def == nil {
+					errs = append(errs, &Error{Message: fmt.Sprintf("cannot query field %q on type %q", n.name, t.name)})
+					continue
+				}
+				for _, given := range n.arguments {
+					known := false
+					for _, a := range def.args {
+						known = known || a.name == given.name
+					}
+					if !known {
+						errs = append(errs, &Error{Message: fmt.Sprintf("unknown argument %q on field %s.%s", given.name, t.name, n.name)})
+					}
+				}
+				child := s.byName[def.ref.name]
+				switch {
+				case child == nil && len(n.selectionSet) > 0:
+					errs = append(errs, &Error{Message: fmt.Sprintf("field %s.%s of type %s must not have a selection", t.name, n.name, def.typ)})
+				case child != nil && len(n.selectionSet) == 0:
+					errs = append(errs, &Error{Message: fmt.Sprintf("field %s.%s of type %s must have a selection", t.name, n.name, def.typ)})
+				case child != nil:
+					walk(child, n.selectionSet, visiting)
+				}
+			case *fragmentSpread:
+				frag := doc.fragments[n.name]
+				if frag == nil {
+					errs = append(errs, &Error{Message: fmt.Sprintf("unknown fragment %q", n.name)})
+					continue
+				}
+				if visiting[n.name] {
+					errs = append(errs, &Error{Message: fmt.Sprintf("fragment %q spreads itself", n.name)})
+					continue
+				}
+				target := s.byName[frag.typeCondition]
+				if target == nil {
+					errs = append(errs, &Error{Message: fmt.Sprintf("unknown type %q", frag.typeCondition)})
+					continue
+				}
+				visiting[n.name] = true
+				walk(target, frag.selectionSet, visiting)
+				delete(visiting, n.name)
+			case *inlineFragment:
+				target := t
+				if n.typeCondition != "" {
+					if target = s.byName[n.typeCondition]; target == nil {
+						errs = append(errs, &Error{Message: fmt.Sprintf("unknown type %q", n.typeCondition)})
+						continue
+					}
+				}
+				walk(target, n.selectionSet, visiting)
+			}
+		}
+	}
+	walk(root, sels, map[string]bool{})
+	return errs
+}
+
+// ===== Output =====
+
+// orderedMap is a JSON object that preserves the order of its keys, as
+// GraphQL responses must follow the order of the query.
+type orderedMap struct {
+	keys   []string
+	values []any
+}
+
+// Get returns the value for key.
+func (m *orderedMap) Get(key string) (any, bool) {
+	for i, k := range m.keys {
+		if k == key {
+			return m.values[i], true
+		}
+	}
+	return nil, false
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m *orderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(k)
+		buf.Write(key)
+		buf.WriteByte(':')
+		val, err := json.Marshal(m.values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
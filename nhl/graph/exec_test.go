@@ -0,0 +1,161 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// toySchema builds a small schema independent of the NHL API.
+func toySchema() *Schema {
+	s := &Schema{query: "Query", maxConcurrency: DefaultMaxConcurrency}
+	s.types = []*objectType{
+		object("Query", "",
+			field("echo", "String", "",
+				func(_ context.Context, _ any, args map[string]any) (any, error) {
+					return args["s"], nil
+				}, arg("s", "String!")),
+			field("count", "Int!", "",
+				func(_ context.Context, _ any, args map[string]any) (any, error) {
+					n, _ := args["n"].(int)
+					return n, nil
+				}, argDef{name: "n", typ: "Int", defaultValue: int64(7)}),
+			field("item", "Item", "",
+				func(context.Context, any, map[string]any) (any, error) { return "a", nil }),
+			field("items", "[Item!]", "",
+				func(context.Context, any, map[string]any) (any, error) { return []string{"a", "broken"}, nil }),
+		),
+		object("Item", "",
+			field("name", "String!", "",
+				func(_ context.Context, source any, _ map[string]any) (any, error) {
+					if source == "broken" {
+						return nil, errors.New("boom")
+					}
+					return source, nil
+				}),
+			field("ratio", "Float", "",
+				func(context.Context, any, map[string]any) (any, error) { return 0.5, nil }),
+		),
+	}
+	s.byName = make(map[string]*objectType)
+	for _, t := range s.types {
+		s.byName[t.name] = t
+	}
+	return s
+}
+
+func TestExecute(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		vars  map[string]any
+		want  string
+	}{
+		{
+			name:  "aliases and literals",
+			query: `{ a: echo(s: "x") b: echo(s: "y") }`,
+			want:  `{"data":{"a":"x","b":"y"}}`,
+		},
+		{
+			name:  "variables and defaults",
+			query: `query ($s: String!, $n: Int) { echo(s: $s) count(n: $n) other: count }`,
+			vars:  map[string]any{"s": "hi", "n": float64(3)},
+			want:  `{"data":{"echo":"hi","count":3,"other":7}}`,
+		},
+		{
+			name:  "skip and include",
+			query: `query ($yes: Boolean!) { a: echo(s: "a") @skip(if: $yes) b: echo(s: "b") @include(if: $yes) }`,
+			vars:  map[string]any{"yes": true},
+			want:  `{"data":{"b":"b"}}`,
+		},
+		{
+			name:  "fragments merge",
+			query: `{ item { ...F ... on Item { ratio } __typename } } fragment F on Item { name }`,
+			want:  `{"data":{"item":{"name":"a","ratio":0.5,"__typename":"Item"}}}`,
+		},
+		{
+			name:  "non-null error nulls the nearest nullable parent",
+			query: `{ items { name } count }`,
+			want:  `{"data":{"items":null,"count":7},"errors":[{"message":"boom","path":["items",1,"name"]}]}`,
+		},
+		{
+			name:  "unknown field",
+			query: `{ nope }`,
+			want:  `{"errors":[{"message":"cannot query field \"nope\" on type \"Query\""}]}`,
+		},
+		{
+			name:  "missing selection",
+			query: `{ item }`,
+			want:  `{"errors":[{"message":"field Query.item of type Item must have a selection"}]}`,
+		},
+		{
+			name:  "introspection",
+			query: `{ __schema { types { name } } }`,
+			want:  `{"errors":[{"message":"introspection field \"__schema\" is not supported; see Schema.SDL"}]}`,
+		},
+		{
+			name:  "mutation",
+			query: `mutation { echo(s: "x") }`,
+			want:  `{"errors":[{"message":"mutation operations are not supported"}]}`,
+		},
+		{
+			name:  "syntax error",
+			query: `{ echo(s: "x") `,
+			want:  `{"errors":[{"message":"syntax error: unexpected end of document"}]}`,
+		},
+	}
+	s := toySchema()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := s.Execute(context.Background(), Request{Query: tt.query, Variables: tt.vars})
+			data, err := json.Marshal(resp)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("got  %s\nwant %s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteVariableErrors(t *testing.T) {
+	s := toySchema()
+	tests := []struct {
+		name  string
+		query string
+		vars  map[string]any
+		want  string
+	}{
+		{"missing required", `query ($s: String!) { echo(s: $s) }`, nil, "$s"},
+		{"wrong type", `query ($n: Int) { count(n: $n) }`, map[string]any{"n": "three"}, "$n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := s.Execute(context.Background(), Request{Query: tt.query, Variables: tt.vars})
+			if resp.Data != nil || len(resp.Errors) != 1 {
+				t.Fatalf("got %+v, want a single request error", resp)
+			}
+			if !strings.Contains(resp.Errors[0].Message, tt.want) {
+				t.Errorf("error = %q, want it to mention %s", resp.Errors[0].Message, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteOperationName(t *testing.T) {
+	s := toySchema()
+	query := `query A { echo(s: "a") } query B { echo(s: "b") }`
+
+	resp := s.Execute(context.Background(), Request{Query: query, OperationName: "B"})
+	if v, _ := resp.Data.(*orderedMap).Get("echo"); v != "b" {
+		t.Errorf("echo = %v, want b", v)
+	}
+
+	resp = s.Execute(context.Background(), Request{Query: query})
+	if len(resp.Errors) != 1 {
+		t.Errorf("expected an error when the operation is ambiguous, got %+v", resp)
+	}
+}
@@ -0,0 +1,51 @@
+package graph
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// maxRequestBytes bounds the size of a GraphQL request body.
+const maxRequestBytes = 1 << 20
+
+// Handler serves the schema over HTTP. It accepts POST requests with a JSON
+// body of the form {"query": ..., "variables": ..., "operationName": ...}
+// and GET requests with query, variables and operationName URL parameters.
+func (s *Schema) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		switch r.Method {
+		case http.MethodGet:
+			q := r.URL.Query()
+			req.Query = q.Get("query")
+			req.OperationName = q.Get("operationName")
+			if vars := q.Get("variables"); vars != "" {
+				if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+					writeResponse(w, http.StatusBadRequest, &Response{Errors: []*Error{{Message: "invalid variables: " + err.Error()}}})
+					return
+				}
+			}
+		case http.MethodPost:
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(&req); err != nil {
+				writeResponse(w, http.StatusBadRequest, &Response{Errors: []*Error{{Message: "invalid request body: " + err.Error()}}})
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			writeResponse(w, http.StatusMethodNotAllowed, &Response{Errors: []*Error{{Message: "method not allowed"}}})
+			return
+		}
+
+		if req.Query == "" {
+			writeResponse(w, http.StatusBadRequest, &Response{Errors: []*Error{{Message: "missing query"}}})
+			return
+		}
+		writeResponse(w, http.StatusOK, s.Execute(r.Context(), req))
+	})
+}
+
+func writeResponse(w http.ResponseWriter, status int, resp *Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,78 @@
+package graph
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	h := toySchema().Handler()
+	get := func(params url.Values) *http.Request {
+		return httptest.NewRequest(http.MethodGet, "/graphql?"+params.Encode(), nil)
+	}
+	post := func(body string) *http.Request {
+		return httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	}
+
+	tests := []struct {
+		name       string
+		req        *http.Request
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "post",
+			req:        post(`{"query":"query ($s: String!) { echo(s: $s) }","variables":{"s":"hi"}}`),
+			wantStatus: http.StatusOK,
+			wantBody:   `{"data":{"echo":"hi"}}`,
+		},
+		{
+			name:       "get",
+			req:        get(url.Values{"query": {`query ($s: String!) { echo(s: $s) }`}, "variables": {`{"s":"yo"}`}}),
+			wantStatus: http.StatusOK,
+			wantBody:   `{"data":{"echo":"yo"}}`,
+		},
+		{
+			name:       "invalid body",
+			req:        post(`{`),
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `invalid request body`,
+		},
+		{
+			name:       "invalid variables",
+			req:        get(url.Values{"query": {`{ count }`}, "variables": {`[`}}),
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `invalid variables`,
+		},
+		{
+			name:       "missing query",
+			req:        post(`{}`),
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `missing query`,
+		},
+		{
+			name:       "method not allowed",
+			req:        httptest.NewRequest(http.MethodPut, "/graphql", nil),
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody:   `method not allowed`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, tt.req)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+				t.Errorf("Content-Type = %q", ct)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("body = %s, want it to contain %s", rec.Body, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sperano/nhl-api-go/nhl"
+)
+
+// loader deduplicates and caches API calls for the lifetime of one GraphQL
+// request. Resolvers run concurrently, so many fields asking for the same
+// resource (say, every game in a team's schedule resolving that team's
+// roster) share a single in-flight call. A semaphore caps the number of
+// concurrent API calls per request.
+type loader struct {
+	client *nhl.Client
+	sem    chan struct{}
+
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+type call struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+func newLoader(client *nhl.Client, maxConcurrency int) *loader {
+	if maxConcurrency <= 0 {
+		maxConcurrency = DefaultMaxConcurrency
+	}
+	return &loader{
+		client: client,
+		sem:    make(chan struct{}, maxConcurrency),
+		calls:  make(map[string]*call),
+	}
+}
+
+type loaderKey struct{}
+
+func withLoader(ctx context.Context, l *loader) context.Context {
+	return context.WithValue(ctx, loaderKey{}, l)
+}
+
+func loaderFrom(ctx context.Context) *loader {
+	return ctx.Value(loaderKey{}).(*loader)
+}
+
+// load returns the cached result for key, running fetch if this is the
+// first request for it.
+func load[T any](ctx context.Context, key string, fetch func(ctx context.Context, c *nhl.Client) (T, error)) (T, error) {
+	l := loaderFrom(ctx)
+
+	l.mu.Lock()
+	c, ok := l.calls[key]
+	if !ok {
+		c = &call{done: make(chan struct{})}
+		l.calls[key] = c
+	}
+	l.mu.Unlock()
+
+	if !ok {
+		select {
+		case l.sem <- struct{}{}:
+			c.value, c.err = fetch(ctx, l.client)
+			<-l.sem
+		case <-ctx.Done():
+			c.err = ctx.Err()
+		}
+		close(c.done)
+	}
+
+	var zero T
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+	if c.err != nil {
+		return zero, c.err
+	}
+	return c.value.(T), nil
+}
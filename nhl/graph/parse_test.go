@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseDocument(t *testing.T) {
+	doc, err := parseDocument(`
+		# leading comment
+		query Team($abbrev: String!, $withRoster: Boolean = true) {
+			t: team(abbrev: $abbrev) {
+				abbrev
+				roster @include(if: $withRoster) { ...PlayerBits }
+				... on Team { standing { points } }
+			}
+		}
+		fragment PlayerBits on Player { id fullName: lastName }
+	`)
+	if err != nil {
+		t.Fatalf("parseDocument: %v", err)
+	}
+	if len(doc.operations) != 1 || len(doc.fragments) != 1 {
+		t.Fatalf("got %d operations and %d fragments, want 1 and 1", len(doc.operations), len(doc.fragments))
+	}
+
+	op := doc.operations[0]
+	if op.kind != "query" || op.name != "Team" {
+		t.Errorf("operation = %s %s, want query Team", op.kind, op.name)
+	}
+	if len(op.variables) != 2 || op.variables[1].defaultValue != true {
+		t.Errorf("variables = %+v, want two with withRoster defaulting to true", op.variables)
+	}
+
+	team, ok := op.selectionSet[0].(*fieldNode)
+	if !ok {
+		t.Fatalf("first selection is %T, want *fieldNode", op.selectionSet[0])
+	}
+	if team.responseKey() != "t" || team.name != "team" {
+		t.Errorf("alias/name = %s/%s, want t/team", team.responseKey(), team.name)
+	}
+	if _, ok := team.arguments[0].value.(variableRef); !ok {
+		t.Errorf("abbrev argument is %T, want variableRef", team.arguments[0].value)
+	}
+	if len(team.selectionSet) != 3 {
+		t.Fatalf("team has %d selections, want 3", len(team.selectionSet))
+	}
+	if _, ok := team.selectionSet[2].(*inlineFragment); !ok {
+		t.Errorf("third selection is %T, want *inlineFragment", team.selectionSet[2])
+	}
+	if doc.fragments["PlayerBits"] == nil {
+		t.Error("fragment PlayerBits not recorded")
+	}
+}
+
+func TestParseDocumentShorthand(t *testing.T) {
+	doc, err := parseDocument(`{ game(id: "2023020204") { id } }`)
+	if err != nil {
+		t.Fatalf("parseDocument: %v", err)
+	}
+	if op := doc.operations[0]; op.kind != "query" || op.name != "" {
+		t.Errorf("operation = %q %q, want anonymous query", op.kind, op.name)
+	}
+}
+
+func TestParseDocumentValues(t *testing.T) {
+	doc, err := parseDocument(`{ f(a: -12, b: 1.5e2, c: "x\n\"y\"", d: null, e: [1, 2], g: {k: RED}) }`)
+	if err != nil {
+		t.Fatalf("parseDocument: %v", err)
+	}
+	args := doc.operations[0].selectionSet[0].(*fieldNode).arguments
+	got := map[string]any{}
+	for _, a := range args {
+		got[a.name] = a.value
+	}
+	if got["a"] != int64(-12) {
+		t.Errorf("a = %#v, want -12", got["a"])
+	}
+	if got["b"] != 150.0 {
+		t.Errorf("b = %#v, want 150", got["b"])
+	}
+	if got["c"] != "x\n\"y\"" {
+		t.Errorf("c = %#v", got["c"])
+	}
+	if got["d"] != nil {
+		t.Errorf("d = %#v, want nil", got["d"])
+	}
+	if list, ok := got["e"].([]value); !ok || len(list) != 2 {
+		t.Errorf("e = %#v, want two-element list", got["e"])
+	}
+	if obj, ok := got["g"].(map[string]value); !ok || obj["k"] != enumValue("RED") {
+		t.Errorf("g = %#v, want {k: RED}", got["g"])
+	}
+}
+
+func TestParseDocumentErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"empty", ``, "no operations"},
+		{"unclosed selection", `{ game(id: 1) { id }`, "unexpected"},
+		{"unterminated string", `{ team(abbrev: "TOR) { abbrev } }`, "unterminated string"},
+		{"variable in default", `query ($a: Int = $b) { x }`, "variable"},
+		{"bad character", `{ a % b }`, "unexpected character"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseDocument(tt.query)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,583 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sperano/nhl-api-go/nhl"
+	"github.com/sperano/nhl-api-go/nhl/describe"
+)
+
+// DefaultMaxConcurrency is the default limit on concurrent API calls made
+// while executing a single GraphQL request.
+const DefaultMaxConcurrency = 8
+
+// Schema is an executable GraphQL schema over the NHL API. Create one with
+// NewSchema and share it between requests; each request gets its own
+// call cache.
+type Schema struct {
+	client         *nhl.Client
+	maxConcurrency int
+
+	query  string
+	types  []*objectType
+	byName map[string]*objectType
+}
+
+// Option configures a Schema.
+type Option func(*Schema)
+
+// WithMaxConcurrency limits how many API calls a single request may have
+// in flight at once. Non-positive values select DefaultMaxConcurrency.
+func WithMaxConcurrency(n int) Option {
+	return func(s *Schema) {
+		s.maxConcurrency = n
+	}
+}
+
+// NewSchema builds the NHL GraphQL schema backed by client.
+func NewSchema(client *nhl.Client, opts ...Option) *Schema {
+	s := &Schema{client: client, maxConcurrency: DefaultMaxConcurrency, query: "Query"}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.types = []*objectType{
+		queryType(),
+		gameType(),
+		gameTeamType(),
+		teamType(),
+		standingType(),
+		playerType(),
+		boxscoreType(),
+		boxscoreTeamType(),
+		skaterLineType(),
+		goalieLineType(),
+		playType(),
+	}
+	s.byName = make(map[string]*objectType, len(s.types))
+	for _, t := range s.types {
+		s.byName[t.name] = t
+	}
+	return s
+}
+
+// SDL returns the schema in GraphQL schema definition language.
+func (s *Schema) SDL() string {
+	var b strings.Builder
+	for i, t := range s.types {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%q\ntype %s {\n", t.desc, t.name)
+		for _, f := range t.fields {
+			fmt.Fprintf(&b, "  %q\n  %s", f.desc, f.name)
+			if len(f.args) > 0 {
+				args := make([]string, len(f.args))
+				for j, a := range f.args {
+					args[j] = a.name + ": " + a.typ
+				}
+				fmt.Fprintf(&b, "(%s)", strings.Join(args, ", "))
+			}
+			fmt.Fprintf(&b, ": %s\n", f.typ)
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+// ===== Backing values =====
+
+// gameRef identifies a game. Schedule data is used when the game came from
+// a schedule; otherwise fields are resolved from the boxscore.
+type gameRef struct {
+	id    nhl.GameID
+	sched *nhl.ScheduleGame
+}
+
+type gameTeam struct {
+	abbrev string
+	score  *int
+}
+
+type teamRef struct {
+	abbrev string
+}
+
+// playerRef identifies a player. Roster data is used when available;
+// otherwise fields are resolved from the player landing page.
+type playerRef struct {
+	id     nhl.PlayerID
+	roster *nhl.RosterPlayer
+}
+
+type boxscoreSide struct {
+	team  nhl.BoxscoreTeam
+	stats nhl.TeamPlayerStats
+}
+
+type playRef struct {
+	event  *nhl.PlayEvent
+	roster []nhl.RosterSpot
+}
+
+// ===== Loaders =====
+
+func loadBoxscore(ctx context.Context, id nhl.GameID) (*nhl.Boxscore, error) {
+	return load(ctx, "boxscore/"+id.String(), func(ctx context.Context, c *nhl.Client) (*nhl.Boxscore, error) {
+		return c.Boxscore(ctx, id)
+	})
+}
+
+func loadPlayByPlay(ctx context.Context, id nhl.GameID) (*nhl.PlayByPlay, error) {
+	return load(ctx, "play-by-play/"+id.String(), func(ctx context.Context, c *nhl.Client) (*nhl.PlayByPlay, error) {
+		return c.PlayByPlay(ctx, id)
+	})
+}
+
+func loadSchedule(ctx context.Context, date nhl.GameDate) (*nhl.DailySchedule, error) {
+	return load(ctx, "schedule/"+date.APIString(), func(ctx context.Context, c *nhl.Client) (*nhl.DailySchedule, error) {
+		return c.DailySchedule(ctx, date)
+	})
+}
+
+func loadStandings(ctx context.Context, date nhl.GameDate) ([]nhl.Standing, error) {
+	return load(ctx, "standings/"+date.APIString(), func(ctx context.Context, c *nhl.Client) ([]nhl.Standing, error) {
+		return c.LeagueStandingsForDate(ctx, date)
+	})
+}
+
+func loadRoster(ctx context.Context, abbrev string) (*nhl.Roster, error) {
+	return load(ctx, "roster/"+abbrev, func(ctx context.Context, c *nhl.Client) (*nhl.Roster, error) {
+		return c.RosterCurrent(ctx, abbrev)
+	})
+}
+
+func loadTeamSchedule(ctx context.Context, abbrev string, season nhl.Season) (*nhl.TeamScheduleResponse, error) {
+	return load(ctx, "club-schedule/"+abbrev+"/"+season.APIString(), func(ctx context.Context, c *nhl.Client) (*nhl.TeamScheduleResponse, error) {
+		return c.ClubScheduleSeason(ctx, abbrev, season)
+	})
+}
+
+func loadPlayer(ctx context.Context, id nhl.PlayerID) (*nhl.PlayerLanding, error) {
+	return load(ctx, "player/"+id.String(), func(ctx context.Context, c *nhl.Client) (*nhl.PlayerLanding, error) {
+		return c.PlayerLanding(ctx, id)
+	})
+}
+
+// ===== Argument helpers =====
+
+func parseDateArg(args map[string]any, name string) (nhl.GameDate, error) {
+	s, _ := args[name].(string)
+	if s == "" {
+		return nhl.Now(), nil
+	}
+	if s == "now" {
+		return nhl.Now(), nil
+	}
+	date, err := nhl.ParseDate(s)
+	if err != nil {
+		return nhl.GameDate{}, fmt.Errorf("invalid %s %q: expected YYYY-MM-DD", name, s)
+	}
+	return nhl.FromDate(date.Time), nil
+}
+
+func parseIDArg(args map[string]any, name string) (int64, error) {
+	s, _ := args[name].(string)
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", name, s)
+	}
+	return id, nil
+}
+
+// ===== Types =====
+
+func queryType() *objectType {
+	return object("Query", "Entry points into the NHL API.",
+		field("game", "Game", "A game by ID, e.g. 2023020204.",
+			func(ctx context.Context, _ any, args map[string]any) (any, error) {
+				id, err := parseIDArg(args, "id")
+				if err != nil {
+					return nil, err
+				}
+				return &gameRef{id: nhl.GameID(id)}, nil
+			}, arg("id", "ID!")),
+		field("schedule", "[Game!]!", "Games scheduled on a date (YYYY-MM-DD), or today when omitted.",
+			func(ctx context.Context, _ any, args map[string]any) (any, error) {
+				date, err := parseDateArg(args, "date")
+				if err != nil {
+					return nil, err
+				}
+				schedule, err := loadSchedule(ctx, date)
+				if err != nil {
+					return nil, err
+				}
+				return scheduleGames(schedule.Games), nil
+			}, arg("date", "String")),
+		field("standings", "[Standing!]!", "League standings on a date (YYYY-MM-DD), or the current standings when omitted.",
+			func(ctx context.Context, _ any, args map[string]any) (any, error) {
+				date, err := parseDateArg(args, "date")
+				if err != nil {
+					return nil, err
+				}
+				return loadStandings(ctx, date)
+			}, arg("date", "String")),
+		field("team", "Team", "A team by abbreviation, e.g. TOR.",
+			func(ctx context.Context, _ any, args map[string]any) (any, error) {
+				return &teamRef{abbrev: strings.ToUpper(args["abbrev"].(string))}, nil
+			}, arg("abbrev", "String!")),
+		field("player", "Player", "A player by ID, e.g. 8478402.",
+			func(ctx context.Context, _ any, args map[string]any) (any, error) {
+				id, err := parseIDArg(args, "id")
+				if err != nil {
+					return nil, err
+				}
+				return &playerRef{id: nhl.PlayerID(id)}, nil
+			}, arg("id", "ID!")),
+	)
+}
+
+func scheduleGames(games []nhl.ScheduleGame) []*gameRef {
+	refs := make([]*gameRef, len(games))
+	for i := range games {
+		refs[i] = &gameRef{id: games[i].ID, sched: &games[i]}
+	}
+	return refs
+}
+
+// gameField resolves a Game field from schedule data when present, falling
+// back to the boxscore.
+func gameField(fromSchedule func(*nhl.ScheduleGame) any, fromBoxscore func(*nhl.Boxscore) any) resolveFunc {
+	return func(ctx context.Context, source any, _ map[string]any) (any, error) {
+		g := source.(*gameRef)
+		if g.sched != nil {
+			return fromSchedule(g.sched), nil
+		}
+		box, err := loadBoxscore(ctx, g.id)
+		if err != nil {
+			return nil, err
+		}
+		return fromBoxscore(box), nil
+	}
+}
+
+func gameType() *objectType {
+	return object("Game", "An NHL game.",
+		field("id", "ID!", "The 10-digit game ID.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				return source.(*gameRef).id, nil
+			}),
+		field("season", "String", "The season, e.g. 2023-2024.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				season, err := source.(*gameRef).id.Season()
+				if err != nil {
+					return nil, err
+				}
+				return season.String(), nil
+			}),
+		field("gameType", "Int!", "1 for preseason, 2 for regular season, 3 for playoffs.",
+			gameField(
+				func(g *nhl.ScheduleGame) any { return int(g.GameType) },
+				func(b *nhl.Boxscore) any { return int(b.GameType) })),
+		field("date", "String", "The local game date (YYYY-MM-DD).",
+			gameField(
+				func(g *nhl.ScheduleGame) any { return g.GameDate },
+				func(b *nhl.Boxscore) any { return b.GameDate })),
+		field("startTimeUTC", "String!", "The scheduled start time in UTC.",
+			gameField(
+				func(g *nhl.ScheduleGame) any { return g.StartTimeUTC },
+				func(b *nhl.Boxscore) any { return b.StartTimeUTC })),
+		field("state", "String!", "The game state, e.g. FUT, LIVE, OFF.",
+			gameField(
+				func(g *nhl.ScheduleGame) any { return string(g.GameState) },
+				func(b *nhl.Boxscore) any { return string(b.GameState) })),
+		field("awayTeam", "GameTeam!", "The away team.",
+			gameField(
+				func(g *nhl.ScheduleGame) any { return &gameTeam{abbrev: g.AwayTeam.Abbrev, score: g.AwayTeam.Score} },
+				func(b *nhl.Boxscore) any { return &gameTeam{abbrev: b.AwayTeam.Abbrev, score: &b.AwayTeam.Score} })),
+		field("homeTeam", "GameTeam!", "The home team.",
+			gameField(
+				func(g *nhl.ScheduleGame) any { return &gameTeam{abbrev: g.HomeTeam.Abbrev, score: g.HomeTeam.Score} },
+				func(b *nhl.Boxscore) any { return &gameTeam{abbrev: b.HomeTeam.Abbrev, score: &b.HomeTeam.Score} })),
+		field("boxscore", "Boxscore", "The boxscore.",
+			func(ctx context.Context, source any, _ map[string]any) (any, error) {
+				return loadBoxscore(ctx, source.(*gameRef).id)
+			}),
+		field("plays", "[Play!]!", "Play-by-play events in order.",
+			func(ctx context.Context, source any, _ map[string]any) (any, error) {
+				pbp, err := loadPlayByPlay(ctx, source.(*gameRef).id)
+				if err != nil {
+					return nil, err
+				}
+				plays := make([]*playRef, len(pbp.Plays))
+				for i := range pbp.Plays {
+					plays[i] = &playRef{event: &pbp.Plays[i], roster: pbp.RosterSpots}
+				}
+				return plays, nil
+			}),
+	)
+}
+
+func gameTeamType() *objectType {
+	return object("GameTeam", "A team's side of a game.",
+		field("abbrev", "String!", "The team abbreviation.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				return source.(*gameTeam).abbrev, nil
+			}),
+		field("score", "Int", "Goals scored, once the game has started.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				return source.(*gameTeam).score, nil
+			}),
+		field("team", "Team!", "The team.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				return &teamRef{abbrev: source.(*gameTeam).abbrev}, nil
+			}),
+	)
+}
+
+func teamType() *objectType {
+	return object("Team", "An NHL team.",
+		field("abbrev", "String!", "The team abbreviation.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				return source.(*teamRef).abbrev, nil
+			}),
+		field("roster", "[Player!]!", "The current roster.",
+			func(ctx context.Context, source any, _ map[string]any) (any, error) {
+				roster, err := loadRoster(ctx, source.(*teamRef).abbrev)
+				if err != nil {
+					return nil, err
+				}
+				all := roster.AllPlayers()
+				players := make([]*playerRef, len(all))
+				for i := range all {
+					players[i] = &playerRef{id: all[i].ID, roster: &all[i]}
+				}
+				return players, nil
+			}),
+		field("schedule", "[Game!]!", "The team's games for a season (e.g. 20232024), or the current season when omitted.",
+			func(ctx context.Context, source any, args map[string]any) (any, error) {
+				season := nhl.CurrentSeason()
+				if s, _ := args["season"].(string); s != "" {
+					var err error
+					if season, err = nhl.Parse(s); err != nil {
+						return nil, err
+					}
+				}
+				schedule, err := loadTeamSchedule(ctx, source.(*teamRef).abbrev, season)
+				if err != nil {
+					return nil, err
+				}
+				return scheduleGames(schedule.Games), nil
+			}, arg("season", "String")),
+		field("standing", "Standing", "The team's current standing.",
+			func(ctx context.Context, source any, _ map[string]any) (any, error) {
+				standings, err := loadStandings(ctx, nhl.Now())
+				if err != nil {
+					return nil, err
+				}
+				abbrev := source.(*teamRef).abbrev
+				for i := range standings {
+					if standings[i].TeamAbbrev.Default == abbrev {
+						return &standings[i], nil
+					}
+				}
+				return nil, nil
+			}),
+	)
+}
+
+func standingType() *objectType {
+	standing := func(f func(*nhl.Standing) any) resolveFunc {
+		return func(_ context.Context, source any, _ map[string]any) (any, error) {
+			switch s := source.(type) {
+			case *nhl.Standing:
+				return f(s), nil
+			case nhl.Standing:
+				return f(&s), nil
+			}
+			return nil, fmt.Errorf("unexpected standing source %T", source)
+		}
+	}
+	return object("Standing", "A team's standing.",
+		field("team", "Team!", "The team.", standing(func(s *nhl.Standing) any { return &teamRef{abbrev: s.TeamAbbrev.Default} })),
+		field("teamName", "String!", "The team's full name.", standing(func(s *nhl.Standing) any { return s.TeamName.String() })),
+		field("conference", "String", "The conference name, absent for seasons without conferences.", standing(func(s *nhl.Standing) any { return s.ConferenceName })),
+		field("division", "String!", "The division name.", standing(func(s *nhl.Standing) any { return s.DivisionName })),
+		field("wins", "Int!", "Wins.", standing(func(s *nhl.Standing) any { return s.Wins })),
+		field("losses", "Int!", "Regulation losses.", standing(func(s *nhl.Standing) any { return s.Losses })),
+		field("otLosses", "Int!", "Overtime and shootout losses.", standing(func(s *nhl.Standing) any { return s.OTLosses })),
+		field("points", "Int!", "Points.", standing(func(s *nhl.Standing) any { return s.Points })),
+		field("pointsPercentage", "Float!", "Points earned as a fraction of points available.", standing(func(s *nhl.Standing) any { return s.PointsPercentage() })),
+	)
+}
+
+// playerField resolves a Player field from roster data when present,
+// falling back to the player landing page.
+func playerField(fromRoster func(*nhl.RosterPlayer) any, fromLanding func(*nhl.PlayerLanding) any) resolveFunc {
+	return func(ctx context.Context, source any, _ map[string]any) (any, error) {
+		p := source.(*playerRef)
+		if p.roster != nil {
+			return fromRoster(p.roster), nil
+		}
+		landing, err := loadPlayer(ctx, p.id)
+		if err != nil {
+			return nil, err
+		}
+		return fromLanding(landing), nil
+	}
+}
+
+func playerType() *objectType {
+	return object("Player", "An NHL player.",
+		field("id", "ID!", "The player ID.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				return source.(*playerRef).id, nil
+			}),
+		field("firstName", "String!", "First name.",
+			playerField(
+				func(r *nhl.RosterPlayer) any { return r.FirstName.String() },
+				func(l *nhl.PlayerLanding) any { return l.FirstName.String() })),
+		field("lastName", "String!", "Last name.",
+			playerField(
+				func(r *nhl.RosterPlayer) any { return r.LastName.String() },
+				func(l *nhl.PlayerLanding) any { return l.LastName.String() })),
+		field("sweaterNumber", "Int", "Sweater number.",
+			playerField(
+				func(r *nhl.RosterPlayer) any { return r.SweaterNumber },
+				func(l *nhl.PlayerLanding) any { return l.SweaterNumber })),
+		field("position", "String!", "Position code: C, LW, RW, D or G.",
+			playerField(
+				func(r *nhl.RosterPlayer) any { return string(r.Position) },
+				func(l *nhl.PlayerLanding) any { return string(l.Position) })),
+		field("headshot", "String!", "Headshot image URL.",
+			playerField(
+				func(r *nhl.RosterPlayer) any { return r.Headshot },
+				func(l *nhl.PlayerLanding) any { return l.Headshot })),
+		field("team", "Team", "The player's current team.",
+			func(ctx context.Context, source any, _ map[string]any) (any, error) {
+				landing, err := loadPlayer(ctx, source.(*playerRef).id)
+				if err != nil {
+					return nil, err
+				}
+				if landing.CurrentTeamAbbrev == nil {
+					return nil, nil
+				}
+				return &teamRef{abbrev: *landing.CurrentTeamAbbrev}, nil
+			}),
+	)
+}
+
+func boxscoreType() *objectType {
+	return object("Boxscore", "A game boxscore.",
+		field("awayTeam", "BoxscoreTeam!", "Away team totals and player lines.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				b := source.(*nhl.Boxscore)
+				return &boxscoreSide{team: b.AwayTeam, stats: b.PlayerByGameStats.AwayTeam}, nil
+			}),
+		field("homeTeam", "BoxscoreTeam!", "Home team totals and player lines.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				b := source.(*nhl.Boxscore)
+				return &boxscoreSide{team: b.HomeTeam, stats: b.PlayerByGameStats.HomeTeam}, nil
+			}),
+		field("period", "Int!", "The current or final period number.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				return source.(*nhl.Boxscore).PeriodDescriptor.Number, nil
+			}),
+		field("clock", "String!", "Time remaining in the period.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				return source.(*nhl.Boxscore).Clock.TimeRemaining, nil
+			}),
+	)
+}
+
+func boxscoreTeamType() *objectType {
+	return object("BoxscoreTeam", "One team's side of a boxscore.",
+		field("abbrev", "String!", "The team abbreviation.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				return source.(*boxscoreSide).team.Abbrev, nil
+			}),
+		field("score", "Int!", "Goals scored.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				return source.(*boxscoreSide).team.Score, nil
+			}),
+		field("shotsOnGoal", "Int!", "Shots on goal.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				return source.(*boxscoreSide).team.SOG, nil
+			}),
+		field("skaters", "[SkaterLine!]!", "Forwards followed by defensemen.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				stats := source.(*boxscoreSide).stats
+				lines := make([]*nhl.SkaterStats, 0, len(stats.Forwards)+len(stats.Defense))
+				for i := range stats.Forwards {
+					lines = append(lines, &stats.Forwards[i])
+				}
+				for i := range stats.Defense {
+					lines = append(lines, &stats.Defense[i])
+				}
+				return lines, nil
+			}),
+		field("goalies", "[GoalieLine!]!", "Goalies who dressed.",
+			func(_ context.Context, source any, _ map[string]any) (any, error) {
+				stats := source.(*boxscoreSide).stats
+				lines := make([]*nhl.GoalieStats, len(stats.Goalies))
+				for i := range stats.Goalies {
+					lines[i] = &stats.Goalies[i]
+				}
+				return lines, nil
+			}),
+	)
+}
+
+func skaterLineType() *objectType {
+	skater := func(f func(*nhl.SkaterStats) any) resolveFunc {
+		return func(_ context.Context, source any, _ map[string]any) (any, error) {
+			return f(source.(*nhl.SkaterStats)), nil
+		}
+	}
+	return object("SkaterLine", "A skater's boxscore line.",
+		field("player", "Player!", "The player.", skater(func(s *nhl.SkaterStats) any { return &playerRef{id: s.PlayerID} })),
+		field("name", "String!", "The abbreviated name, e.g. C. McDavid.", skater(func(s *nhl.SkaterStats) any { return s.Name.String() })),
+		field("position", "String!", "Position code.", skater(func(s *nhl.SkaterStats) any { return string(s.Position) })),
+		field("goals", "Int!", "Goals.", skater(func(s *nhl.SkaterStats) any { return s.Goals })),
+		field("assists", "Int!", "Assists.", skater(func(s *nhl.SkaterStats) any { return s.Assists })),
+		field("points", "Int!", "Points.", skater(func(s *nhl.SkaterStats) any { return s.Points })),
+		field("plusMinus", "Int!", "Plus/minus.", skater(func(s *nhl.SkaterStats) any { return s.PlusMinus })),
+		field("shots", "Int!", "Shots on goal.", skater(func(s *nhl.SkaterStats) any { return s.SOG })),
+		field("toi", "String!", "Time on ice (MM:SS).", skater(func(s *nhl.SkaterStats) any { return s.TOI.String() })),
+	)
+}
+
+func goalieLineType() *objectType {
+	goalie := func(f func(*nhl.GoalieStats) any) resolveFunc {
+		return func(_ context.Context, source any, _ map[string]any) (any, error) {
+			return f(source.(*nhl.GoalieStats)), nil
+		}
+	}
+	return object("GoalieLine", "A goalie's boxscore line.",
+		field("player", "Player!", "The player.", goalie(func(g *nhl.GoalieStats) any { return &playerRef{id: g.PlayerID} })),
+		field("name", "String!", "The abbreviated name.", goalie(func(g *nhl.GoalieStats) any { return g.Name.String() })),
+		field("saves", "Int!", "Saves.", goalie(func(g *nhl.GoalieStats) any { return g.Saves })),
+		field("shotsAgainst", "Int!", "Shots against.", goalie(func(g *nhl.GoalieStats) any { return g.ShotsAgainst })),
+		field("goalsAgainst", "Int!", "Goals against.", goalie(func(g *nhl.GoalieStats) any { return g.GoalsAgainst })),
+		field("toi", "String!", "Time on ice (MM:SS).", goalie(func(g *nhl.GoalieStats) any { return g.TOI.String() })),
+	)
+}
+
+func playType() *objectType {
+	play := func(f func(*playRef) any) resolveFunc {
+		return func(_ context.Context, source any, _ map[string]any) (any, error) {
+			return f(source.(*playRef)), nil
+		}
+	}
+	return object("Play", "A play-by-play event.",
+		field("eventId", "ID!", "The event ID, unique within the game.", play(func(p *playRef) any { return p.event.EventID })),
+		field("type", "String!", "The event type, e.g. goal or shot-on-goal.", play(func(p *playRef) any { return string(p.event.TypeDescKey) })),
+		field("period", "Int!", "The period number.", play(func(p *playRef) any { return p.event.PeriodDescriptor.Number })),
+		field("timeInPeriod", "String!", "Elapsed time in the period (MM:SS).", play(func(p *playRef) any { return p.event.TimeInPeriod })),
+		field("situationCode", "String!", "The on-ice situation code.", play(func(p *playRef) any { return p.event.SituationCode })),
+		field("description", "String!", "A plain-language description of the play.", play(func(p *playRef) any { return describe.Play(p.event, p.roster) })),
+	)
+}
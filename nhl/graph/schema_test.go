@@ -0,0 +1,183 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl"
+)
+
+// fakeAPI serves a tiny slice of the NHL API and counts requests per path.
+type fakeAPI struct {
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests[r.URL.Path]++
+	f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/schedule/2023-11-10":
+		io.WriteString(w, `{"gameWeek":[{"date":"2023-11-10","games":[
+			{"id":2023020204,"gameType":2,"gameDate":"2023-11-10","startTimeUTC":"2023-11-11T00:00:00Z","gameState":"OFF",
+			 "awayTeam":{"id":10,"abbrev":"TOR","score":3},"homeTeam":{"id":8,"abbrev":"MTL","score":2}}
+		]}]}`)
+	case "/gamecenter/2023020204/boxscore":
+		io.WriteString(w, `{"id":2023020204,"season":20232024,"gameType":2,"gameDate":"2023-11-10","gameState":"OFF","gameScheduleState":"OK",
+			"startTimeUTC":"2023-11-11T00:00:00Z","periodDescriptor":{"number":3,"periodType":"REG"},
+			"clock":{"timeRemaining":"00:00"},
+			"awayTeam":{"id":10,"abbrev":"TOR","score":3,"sog":31},
+			"homeTeam":{"id":8,"abbrev":"MTL","score":2,"sog":28},
+			"playerByGameStats":{"awayTeam":{
+				"forwards":[{"playerId":8478483,"name":{"default":"M. Marner"},"position":"R","goals":1,"assists":1,"points":2,"sog":4,"toi":"21:03"}],
+				"defense":[],
+				"goalies":[{"playerId":8479361,"name":{"default":"J. Woll"},"saves":26,"shotsAgainst":28,"goalsAgainst":2,"toi":"60:00"}]
+			},"homeTeam":{"forwards":[],"defense":[],"goalies":[]}}}`)
+	case "/gamecenter/2023020204/play-by-play":
+		io.WriteString(w, `{"id":2023020204,"season":20232024,"gameType":2,"gameState":"OFF","gameScheduleState":"OK",
+			"plays":[{"eventId":1,"sortOrder":10,"typeDescKey":"period-start","periodDescriptor":{"number":1,"periodType":"REG"},"timeInPeriod":"00:00","situationCode":"1551"}],
+			"rosterSpots":[]}`)
+	case "/roster/TOR/current":
+		io.WriteString(w, `{"forwards":[{"id":8478483,"firstName":{"default":"Mitch"},"lastName":{"default":"Marner"},"sweaterNumber":16,"position":"R","headshot":"h.png"}],
+			"defensemen":[],"goalies":[]}`)
+	case "/player/8478483/landing":
+		io.WriteString(w, `{"playerId":8478483,"firstName":{"default":"Mitch"},"lastName":{"default":"Marner"},"currentTeamAbbrev":"TOR","position":"R"}`)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *fakeAPI) count(path string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[path]
+}
+
+func newTestSchema(t *testing.T, opts ...Option) (*Schema, *fakeAPI) {
+	t.Helper()
+	api := &fakeAPI{requests: make(map[string]int)}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	return NewSchema(nhl.NewClientWithBaseURL(server.URL), opts...), api
+}
+
+// execJSON executes query and returns the response re-encoded as JSON.
+func execJSON(t *testing.T, s *Schema, query string, vars map[string]any) string {
+	t.Helper()
+	resp := s.Execute(context.Background(), Request{Query: query, Variables: vars})
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+	return string(data)
+}
+
+func TestScheduleQuery(t *testing.T) {
+	s, _ := newTestSchema(t)
+	got := execJSON(t, s, `{
+		schedule(date: "2023-11-10") {
+			id season state
+			awayTeam { abbrev score }
+			homeTeam { abbrev score }
+		}
+	}`, nil)
+	want := `{"data":{"schedule":[{"id":"2023020204","season":"2023-2024","state":"OFF",` +
+		`"awayTeam":{"abbrev":"TOR","score":3},"homeTeam":{"abbrev":"MTL","score":2}}]}}`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestGameQueryFallsBackToBoxscore(t *testing.T) {
+	s, api := newTestSchema(t)
+	got := execJSON(t, s, `query ($id: ID!) {
+		game(id: $id) {
+			date state
+			homeTeam { abbrev }
+			boxscore {
+				period clock
+				awayTeam {
+					shotsOnGoal
+					skaters { name goals points toi player { id } }
+					goalies { name saves }
+				}
+			}
+			plays { type period description }
+		}
+	}`, map[string]any{"id": "2023020204"})
+	want := `{"data":{"game":{"date":"2023-11-10","state":"OFF","homeTeam":{"abbrev":"MTL"},` +
+		`"boxscore":{"period":3,"clock":"00:00","awayTeam":{"shotsOnGoal":31,` +
+		`"skaters":[{"name":"M. Marner","goals":1,"points":2,"toi":"21:03","player":{"id":"8478483"}}],` +
+		`"goalies":[{"name":"J. Woll","saves":26}]}},` +
+		`"plays":[{"type":"period-start","period":1,"description":"Start of the 1st period."}]}}}`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+	if n := api.count("/gamecenter/2023020204/boxscore"); n != 1 {
+		t.Errorf("boxscore fetched %d times, want 1", n)
+	}
+}
+
+func TestTeamRosterIsFetchedOnce(t *testing.T) {
+	s, api := newTestSchema(t)
+	got := execJSON(t, s, `{
+		a: team(abbrev: "tor") { abbrev roster { lastName } }
+		b: team(abbrev: "TOR") { roster { sweaterNumber position } }
+	}`, nil)
+	want := `{"data":{"a":{"abbrev":"TOR","roster":[{"lastName":"Marner"}]},` +
+		`"b":{"roster":[{"sweaterNumber":16,"position":"RW"}]}}}`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+	if n := api.count("/roster/TOR/current"); n != 1 {
+		t.Errorf("roster fetched %d times, want 1", n)
+	}
+}
+
+func TestPlayerQueryUsesLanding(t *testing.T) {
+	s, api := newTestSchema(t)
+	got := execJSON(t, s, `{ player(id: "8478483") { firstName lastName team { abbrev } } }`, nil)
+	want := `{"data":{"player":{"firstName":"Mitch","lastName":"Marner","team":{"abbrev":"TOR"}}}}`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+	if n := api.count("/player/8478483/landing"); n != 1 {
+		t.Errorf("landing fetched %d times, want 1", n)
+	}
+}
+
+func TestFieldErrorsArePartial(t *testing.T) {
+	s, _ := newTestSchema(t)
+	got := execJSON(t, s, `{ ok: game(id: "2023020204") { state } missing: game(id: "2023020999") { state } }`, nil)
+	if !strings.HasPrefix(got, `{"data":{"ok":{"state":"OFF"},"missing":null},"errors":[{"message":`) {
+		t.Errorf("unexpected response %s", got)
+	}
+	if !strings.Contains(got, `"path":["missing","state"]`) {
+		t.Errorf("error path missing from %s", got)
+	}
+}
+
+func TestSDL(t *testing.T) {
+	s, _ := newTestSchema(t)
+	sdl := s.SDL()
+	for _, want := range []string{
+		"type Query {",
+		"  game(id: ID!): Game\n",
+		"  schedule(season: String): [Game!]!\n",
+		"type Standing {",
+		"  pointsPercentage: Float!\n",
+	} {
+		if !strings.Contains(sdl, want) {
+			t.Errorf("SDL missing %q", want)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package nhl
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg" // headshots may be served as JPEG
+	"image/png"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"sync"
+)
+
+// headshotConcurrency caps the downloads DownloadHeadshots runs at once.
+const headshotConcurrency = 4
+
+// headshotManifest is the file in a headshot directory recording what each
+// image was made from, so unchanged images are not downloaded again.
+const headshotManifest = "headshots.json"
+
+// HeadshotFile is a player's headshot saved by DownloadHeadshots.
+type HeadshotFile struct {
+	PlayerID PlayerID
+	// Path is the image file, named after the player ID and size.
+	Path string
+	// SHA256 is the hex SHA-256 digest of the file's contents.
+	SHA256 string
+	// Cached is true when the file was already up to date and nothing
+	// was downloaded.
+	Cached bool
+}
+
+// headshotEntry records the source of one saved headshot.
+type headshotEntry struct {
+	URL    string `json:"url"`
+	Size   int    `json:"size"`
+	File   string `json:"file"`
+	SHA256 string `json:"sha256"`
+}
+
+// DownloadHeadshots saves the headshot of every player on roster to dir,
+// creating it if needed, for offline apps and print or video work. size is
+// the longest edge in pixels: larger images are scaled down and saved as
+// PNG, and 0 keeps the images as served. Players without a headshot URL
+// are skipped.
+//
+// Downloads run a few at a time. The directory keeps a manifest of each
+// file's source URL and SHA-256 digest, so a later call for the same
+// roster and size downloads only the images whose URL changed or whose
+// file is missing or altered; a downloaded image identical to the file on
+// disk is not rewritten. Files are returned in roster order. When some
+// downloads fail, the others are still saved and returned along with the
+// joined errors.
+func (c *Client) DownloadHeadshots(ctx context.Context, roster *Roster, dir string, size int) ([]HeadshotFile, error) {
+	if size < 0 {
+		return nil, fmt.Errorf("%w: headshot size %d", ErrInvalidArgument, size)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating headshot directory: %w", err)
+	}
+	manifest := readHeadshotManifest(dir)
+
+	players := roster.AllPlayers()
+	files := make([]HeadshotFile, len(players))
+	entries := make([]*headshotEntry, len(players))
+	errs := make([]error, len(players))
+	sem := make(chan struct{}, headshotConcurrency)
+	var wg sync.WaitGroup
+	for i, p := range players {
+		if p.Headshot == "" {
+			continue
+		}
+		key := p.ID.String() + sizeSuffix(size)
+		if entry, ok := manifest[key]; ok && entry.URL == p.Headshot && entry.Size == size && fileDigest(filepath.Join(dir, entry.File)) == entry.SHA256 {
+			files[i] = HeadshotFile{PlayerID: p.ID, Path: filepath.Join(dir, entry.File), SHA256: entry.SHA256, Cached: true}
+			entries[i] = &entry
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, p RosterPlayer, key string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = fmt.Errorf("headshot of player %d: %w", p.ID, ctx.Err())
+				return
+			}
+			entry, err := c.saveHeadshot(ctx, p.Headshot, dir, key, size)
+			if err != nil {
+				errs[i] = fmt.Errorf("headshot of player %d: %w", p.ID, err)
+				return
+			}
+			files[i] = HeadshotFile{PlayerID: p.ID, Path: filepath.Join(dir, entry.File), SHA256: entry.SHA256}
+			entries[i] = entry
+		}(i, p, key)
+	}
+	wg.Wait()
+
+	var saved []HeadshotFile
+	for i, f := range files {
+		if entries[i] == nil {
+			continue
+		}
+		saved = append(saved, f)
+		manifest[f.PlayerID.String()+sizeSuffix(size)] = *entries[i]
+	}
+	if err := writeHeadshotManifest(dir, manifest); err != nil {
+		errs = append(errs, err)
+	}
+	return saved, errors.Join(errs...)
+}
+
+// sizeSuffix returns the part of a headshot's manifest key and file name
+// that names its size.
+func sizeSuffix(size int) string {
+	if size == 0 {
+		return ""
+	}
+	return "-" + strconv.Itoa(size)
+}
+
+// saveHeadshot downloads one image, scales it to size and writes it to dir
+// under key, unless the file already holds the same bytes.
+func (c *Client) saveHeadshot(ctx context.Context, rawURL, dir, key string, size int) (*headshotEntry, error) {
+	data, err := c.fetchAsset(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	ext := path.Ext(rawURL)
+	if ext == "" {
+		ext = ".png"
+	}
+	if size > 0 {
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, &DecodeError{Resource: rawURL, Err: fmt.Errorf("decoding image: %w", err)}
+		}
+		if scaled := scaleImage(img, size); scaled != nil {
+			var buf bytes.Buffer
+			if err := png.Encode(&buf, scaled); err != nil {
+				return nil, fmt.Errorf("encoding %s: %w", rawURL, err)
+			}
+			data, ext = buf.Bytes(), ".png"
+		}
+	}
+
+	sum := sha256.Sum256(data)
+	entry := &headshotEntry{URL: rawURL, Size: size, File: key + ext, SHA256: hex.EncodeToString(sum[:])}
+	file := filepath.Join(dir, entry.File)
+	if fileDigest(file) == entry.SHA256 {
+		return entry, nil
+	}
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, file); err != nil {
+		os.Remove(tmp)
+		return nil, err
+	}
+	return entry, nil
+}
+
+// fetchAsset returns the body of a successful GET to an absolute asset URL,
+// such as an image on the NHL's asset host. It goes through the same fetch
+// path as API calls, so request IDs, timeouts, hedging and error types
+// apply alike, with the URL as the resource.
+func (c *Client) fetchAsset(ctx context.Context, rawURL string) ([]byte, error) {
+	body, err := c.fetch(ctx, EndpointAssets, rawURL, rawURL)
+	if err != nil {
+		return nil, withRequestID(ctx, err)
+	}
+	return body, nil
+}
+
+// scaleImage shrinks img to fit in a size by size square, keeping its
+// aspect ratio, by averaging the source pixels under each target pixel.
+// It returns nil when img already fits.
+func scaleImage(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= size && h <= size {
+		return nil
+	}
+	dw, dh := size, size
+	if w > h {
+		dh = max(h*size/w, 1)
+	} else {
+		dw = max(w*size/h, 1)
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for y := range dh {
+		y0, y1 := b.Min.Y+y*h/dh, b.Min.Y+(y+1)*h/dh
+		for x := range dw {
+			x0, x1 := b.Min.X+x*w/dw, b.Min.X+(x+1)*w/dw
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := img.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{uint16(r / n), uint16(g / n), uint16(bl / n), uint16(a / n)})
+		}
+	}
+	return dst
+}
+
+// fileDigest returns the hex SHA-256 digest of a file, or "" when it
+// cannot be read.
+func fileDigest(file string) string {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// readHeadshotManifest loads the manifest of dir. A missing or unreadable
+// manifest is empty, so every image is downloaded again.
+func readHeadshotManifest(dir string) map[string]headshotEntry {
+	manifest := make(map[string]headshotEntry)
+	if data, err := os.ReadFile(filepath.Join(dir, headshotManifest)); err == nil {
+		json.Unmarshal(data, &manifest)
+	}
+	return manifest
+}
+
+func writeHeadshotManifest(dir string, manifest map[string]headshotEntry) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, headshotManifest), append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("writing headshot manifest: %w", err)
+	}
+	return nil
+}
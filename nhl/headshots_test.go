@@ -0,0 +1,100 @@
+package nhl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDownloadHeadshots(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+	for x := range 4 {
+		for y := range 2 {
+			src.Set(x, y, color.NRGBA{R: uint8(x * 60), A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, src); err != nil {
+		t.Fatal(err)
+	}
+	var requests atomic.Int32
+	var requestID atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		requestID.Store(r.Header.Get(RequestIDHeader))
+		if r.URL.Path == "/mugs/8479318.png" {
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(buf.Bytes())
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	roster := &Roster{
+		Forwards:   []RosterPlayer{{ID: 8479318, Headshot: server.URL + "/mugs/8479318.png"}},
+		Defensemen: []RosterPlayer{{ID: 8480000}},
+		Goalies:    []RosterPlayer{{ID: 8481000, Headshot: server.URL + "/mugs/8481000.png"}},
+	}
+	client := NewClientWithBaseURL(server.URL)
+	dir := t.TempDir()
+	ctx := WithRequestID(context.Background(), "req-42")
+
+	files, err := client.DownloadHeadshots(ctx, roster, dir, 0)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound || apiErr.Endpoint != EndpointAssets || apiErr.RequestID != "req-42" {
+		t.Errorf("DownloadHeadshots() error = %v, want not found for the goalie from the assets endpoint", err)
+	}
+	if id := requestID.Load(); id != "req-42" {
+		t.Errorf("request ID header = %v, want req-42", id)
+	}
+	if len(files) != 1 || files[0].PlayerID != 8479318 || files[0].Cached {
+		t.Fatalf("DownloadHeadshots() = %+v", files)
+	}
+	if data, _ := os.ReadFile(files[0].Path); !bytes.Equal(data, buf.Bytes()) {
+		t.Error("original headshot was not saved as served")
+	}
+	if filepath.Base(files[0].Path) != "8479318.png" || files[0].SHA256 != fileDigest(files[0].Path) {
+		t.Errorf("file = %+v", files[0])
+	}
+
+	roster.Goalies = nil
+	before := requests.Load()
+	files, err = client.DownloadHeadshots(ctx, roster, dir, 0)
+	if err != nil || len(files) != 1 || !files[0].Cached || requests.Load() != before {
+		t.Errorf("second DownloadHeadshots() = %+v, %v after %d requests", files, err, requests.Load()-before)
+	}
+
+	files, err = client.DownloadHeadshots(ctx, roster, dir, 2)
+	if err != nil || len(files) != 1 || files[0].Cached {
+		t.Fatalf("DownloadHeadshots(size 2) = %+v, %v", files, err)
+	}
+	f, err := os.Open(files[0].Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	scaled, err := png.Decode(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := scaled.Bounds(); b.Dx() != 2 || b.Dy() != 1 {
+		t.Errorf("scaled headshot is %dx%d, want 2x1", b.Dx(), b.Dy())
+	}
+	if r, _, _, _ := scaled.At(1, 0).RGBA(); r>>8 != 150 {
+		t.Errorf("scaled pixel red = %d, want the average 150", r>>8)
+	}
+
+	if _, err := client.DownloadHeadshots(ctx, roster, dir, -1); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("DownloadHeadshots(negative size) error = %v, want ErrInvalidArgument", err)
+	}
+}
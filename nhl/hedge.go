@@ -0,0 +1,305 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxHedgesInFlight caps the hedged attempts running at once across a
+// client, so a slow API is not hit with a doubled request rate.
+const maxHedgesInFlight = 2
+
+// DefaultRateLimitPause is how long to back off after a 429 that carries
+// no usable Retry-After header: hedging stays off that long, and package
+// batch waits that long before retrying a task.
+const DefaultRateLimitPause = 30 * time.Second
+
+// MethodCategory groups client methods that share a timeout and hedging
+// policy. The category of a call is derived from the resource it requests.
+type MethodCategory int
+
+const (
+	// CategoryDefault covers every method not in another category.
+	CategoryDefault MethodCategory = iota
+	// CategoryScoreboard covers DailyScores and other live score feeds.
+	CategoryScoreboard
+	// CategorySchedule covers the league and club schedule methods.
+	CategorySchedule
+	// CategoryGameData covers the gamecenter methods: Boxscore, PlayByPlay,
+	// Landing, GameStory, SeasonSeries, GameRightRail and ShiftChart.
+	CategoryGameData
+	// CategoryStandings covers the standings methods.
+	CategoryStandings
+	// CategoryPlayer covers player profiles, game logs, search, the player
+	// spotlight and milestones.
+	CategoryPlayer
+)
+
+// String returns the category name.
+func (m MethodCategory) String() string {
+	switch m {
+	case CategoryDefault:
+		return "default"
+	case CategoryScoreboard:
+		return "scoreboard"
+	case CategorySchedule:
+		return "schedule"
+	case CategoryGameData:
+		return "game-data"
+	case CategoryStandings:
+		return "standings"
+	case CategoryPlayer:
+		return "player"
+	default:
+		return fmt.Sprintf("MethodCategory(%d)", int(m))
+	}
+}
+
+// MethodPolicy is the per-category request policy.
+type MethodPolicy struct {
+	// Timeout bounds a whole call, hedged attempt included. Zero leaves
+	// only the client-wide Timeout.
+	Timeout time.Duration
+
+	// HedgeAfter enables request hedging when positive: if the first
+	// attempt has not succeeded after HedgeAfter, a second identical
+	// request is sent and the first success wins. Hedges are skipped while
+	// maxHedgesInFlight are already running and for a while after the API
+	// answers 429 Too Many Requests.
+	HedgeAfter time.Duration
+}
+
+// categoryFor maps a request to its method category.
+func categoryFor(endpoint Endpoint, resource string) MethodCategory {
+	switch endpoint {
+	case EndpointSearchV1:
+		return CategoryPlayer
+	case EndpointAssets:
+		return CategoryDefault
+	case EndpointAPIStats:
+		if strings.HasSuffix(resource, "/shiftcharts") {
+			return CategoryGameData
+		}
+		if strings.Contains(resource, "/milestones/") {
+			return CategoryPlayer
+		}
+		return CategoryDefault
+	}
+	prefix, _, _ := strings.Cut(resource, "/")
+	switch prefix {
+	case "score", "scoreboard":
+		return CategoryScoreboard
+	case "schedule", "club-schedule", "club-schedule-season":
+		return CategorySchedule
+	case "gamecenter", "wsc":
+		return CategoryGameData
+	case "standings", "standings-season":
+		return CategoryStandings
+	case "player", "player-spotlight":
+		return CategoryPlayer
+	default:
+		return CategoryDefault
+	}
+}
+
+// hedgeLimiter is the client-wide budget for hedged attempts. A nil
+// *hedgeLimiter allows no hedges.
+type hedgeLimiter struct {
+	now func() time.Time
+
+	mu          sync.Mutex
+	inflight    int
+	pausedUntil time.Time
+}
+
+func newHedgeLimiter() *hedgeLimiter {
+	return &hedgeLimiter{now: time.Now}
+}
+
+// acquire reserves a hedge slot, reporting false when hedging is paused or
+// the budget is spent.
+func (h *hedgeLimiter) acquire() bool {
+	if h == nil {
+		return false
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.now().Before(h.pausedUntil) || h.inflight >= maxHedgesInFlight {
+		return false
+	}
+	h.inflight++
+	return true
+}
+
+// release returns a slot reserved by acquire.
+func (h *hedgeLimiter) release() {
+	h.mu.Lock()
+	h.inflight--
+	h.mu.Unlock()
+}
+
+// pause turns hedging off for d.
+func (h *hedgeLimiter) pause(d time.Duration) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if until := h.now().Add(d); until.After(h.pausedUntil) {
+		h.pausedUntil = until
+	}
+}
+
+// retryAfter returns the delay of a Retry-After header, or
+// DefaultRateLimitPause when it has none.
+func retryAfter(header http.Header) time.Duration {
+	if d, ok := parseRetryAfter(header, time.Now()); ok {
+		return d
+	}
+	return DefaultRateLimitPause
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an
+// HTTP date, reporting false when it is missing, malformed or not in the
+// future.
+func parseRetryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := strings.TrimSpace(header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs <= 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	at, err := http.ParseTime(value)
+	if err != nil || !at.After(now) {
+		return 0, false
+	}
+	return at.Sub(now), true
+}
+
+// fetch returns the body of a successful GET to fullURL, applying the
+// method policy of the request's category.
+func (c *Client) fetch(ctx context.Context, endpoint Endpoint, resource, fullURL string) ([]byte, error) {
+	policy := c.policies[categoryFor(endpoint, resource)]
+	if policy.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, policy.Timeout)
+		defer cancel()
+	}
+	if policy.HedgeAfter <= 0 {
+		return c.fetchOnce(ctx, endpoint, resource, fullURL)
+	}
+	return c.fetchHedged(ctx, endpoint, resource, fullURL, policy.HedgeAfter)
+}
+
+// fetchHedged races a second attempt against the first once hedgeAfter has
+// passed without a success. It returns the first success, or the first
+// attempt's error when every attempt fails.
+func (c *Client) fetchHedged(ctx context.Context, endpoint Endpoint, resource, fullURL string, hedgeAfter time.Duration) ([]byte, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		body    []byte
+		err     error
+		primary bool
+	}
+	results := make(chan result, 2)
+	attempt := func(primary bool) {
+		body, err := c.fetchOnce(ctx, endpoint, resource, fullURL)
+		results <- result{body, err, primary}
+	}
+
+	go attempt(true)
+	pending := 1
+	timer := time.NewTimer(hedgeAfter)
+	defer timer.Stop()
+
+	var firstErr error
+	for {
+		select {
+		case <-timer.C:
+			if c.hedges.acquire() {
+				pending++
+				go func() {
+					defer c.hedges.release()
+					attempt(false)
+				}()
+			}
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				return r.body, nil
+			}
+			if firstErr == nil || r.primary {
+				firstErr = r.err
+			}
+			// Hedging is not retrying: a primary that fails before the
+			// hedge fires fails the call.
+			if pending == 0 {
+				return nil, firstErr
+			}
+		}
+	}
+}
+
+// fetchOnce performs a single GET and classifies its failure.
+func (c *Client) fetchOnce(ctx context.Context, endpoint Endpoint, resource, fullURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, &TransportError{Endpoint: endpoint, Resource: resource, Err: fmt.Errorf("creating request: %w", err)}
+	}
+
+	if endpoint == EndpointAssets {
+		req.Header.Set("Accept", "*/*")
+	} else {
+		req.Header.Set("Accept", "application/json")
+	}
+	req.Header.Set("User-Agent", defaultUserAgent)
+	if id, ok := RequestIDFromContext(ctx); ok {
+		req.Header.Set(RequestIDHeader, id)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, &TransportError{Endpoint: endpoint, Resource: resource, Err: fmt.Errorf("executing request to %s: %w", fullURL, err)}
+	}
+	defer resp.Body.Close()
+
+	// Check for HTTP errors
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if resp.StatusCode == http.StatusTooManyRequests {
+			c.hedges.pause(retryAfter(resp.Header))
+		}
+		apiErr := &APIError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("Request to %s failed", resource),
+			Endpoint:   endpoint,
+			Resource:   resource,
+			Method:     req.Method,
+			URL:        fullURL,
+		}
+		apiErr.RetryAfter, _ = parseRetryAfter(resp.Header, time.Now())
+		// A body that cannot be read in full is kept as far as it got.
+		apiErr.Body, _ = io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		if len(apiErr.Body) == 0 {
+			apiErr.Body = nil
+		}
+		return nil, apiErr
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &TransportError{Endpoint: endpoint, Resource: resource, Err: fmt.Errorf("reading response body: %w", err)}
+	}
+	return body, nil
+}
@@ -0,0 +1,263 @@
+package nhl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCategoryFor(t *testing.T) {
+	tests := []struct {
+		endpoint Endpoint
+		resource string
+		want     MethodCategory
+	}{
+		{EndpointAPIWebV1, "score/2024-01-15", CategoryScoreboard},
+		{EndpointAPIWebV1, "scoreboard/now", CategoryScoreboard},
+		{EndpointAPIWebV1, "schedule/2024-01-15", CategorySchedule},
+		{EndpointAPIWebV1, "club-schedule-season/MTL/20232024", CategorySchedule},
+		{EndpointAPIWebV1, "gamecenter/2023020001/boxscore", CategoryGameData},
+		{EndpointAPIWebV1, "wsc/game-story/2023020001", CategoryGameData},
+		{EndpointAPIStats, "en/shiftcharts", CategoryGameData},
+		{EndpointAPIWebV1, "standings/now", CategoryStandings},
+		{EndpointAPIWebV1, "player/8478402/landing", CategoryPlayer},
+		{EndpointSearchV1, "search/player", CategoryPlayer},
+		{EndpointAPIWebV1, "player-spotlight", CategoryPlayer},
+		{EndpointAPIStats, "en/milestones/skaters", CategoryPlayer},
+		{EndpointAPIStats, "en/franchise", CategoryDefault},
+		{EndpointAPIWebV1, "roster/MTL/current", CategoryDefault},
+	}
+	for _, tt := range tests {
+		if got := categoryFor(tt.endpoint, tt.resource); got != tt.want {
+			t.Errorf("categoryFor(%v, %q) = %v, want %v", tt.endpoint, tt.resource, got, tt.want)
+		}
+	}
+}
+
+func TestMethodCategory_String(t *testing.T) {
+	if got := CategoryScoreboard.String(); got != "scoreboard" {
+		t.Errorf("String() = %q, want scoreboard", got)
+	}
+	if got := MethodCategory(42).String(); got != "MethodCategory(42)" {
+		t.Errorf("String() = %q, want MethodCategory(42)", got)
+	}
+}
+
+func TestMethodPolicyOptions(t *testing.T) {
+	cfg := NewClientConfig(
+		WithConfigMethodTimeout(CategoryScoreboard, 2*time.Second),
+		WithConfigHedging(CategoryScoreboard, 150*time.Millisecond),
+	)
+	want := MethodPolicy{Timeout: 2 * time.Second, HedgeAfter: 150 * time.Millisecond}
+	if got := cfg.MethodPolicies[CategoryScoreboard]; got != want {
+		t.Errorf("policy = %+v, want %+v", got, want)
+	}
+
+	client := NewClientWithConfig(cfg)
+	cfg.MethodPolicies[CategoryScoreboard] = MethodPolicy{}
+	if got := client.policies[CategoryScoreboard]; got != want {
+		t.Errorf("client policy changed with config: %+v", got)
+	}
+}
+
+// slowFirstServer stalls its first request until the request is canceled
+// or the test ends, and answers every later request immediately.
+func slowFirstServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var count atomic.Int32
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if count.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-done:
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"currentDate": "2024-01-15"}`))
+	}))
+	t.Cleanup(func() {
+		close(done)
+		server.Close()
+	})
+	return server, &count
+}
+
+func hedgingClient(baseURL string, policy MethodPolicy) *Client {
+	client := NewClientWithBaseURL(baseURL)
+	client.policies = map[MethodCategory]MethodPolicy{CategoryScoreboard: policy}
+	client.hedges = newHedgeLimiter()
+	return client
+}
+
+func TestHedging_SecondAttemptWins(t *testing.T) {
+	server, count := slowFirstServer(t)
+	client := hedgingClient(server.URL, MethodPolicy{HedgeAfter: 20 * time.Millisecond})
+
+	var out map[string]any
+	start := time.Now()
+	if err := client.getJSON(context.Background(), EndpointAPIWebV1, "score/2024-01-15", nil, &out); err != nil {
+		t.Fatalf("getJSON() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("hedged call took %v", elapsed)
+	}
+	if out["currentDate"] != "2024-01-15" {
+		t.Errorf("out = %v", out)
+	}
+	if got := count.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestHedging_OnlyForConfiguredCategory(t *testing.T) {
+	server, count := slowFirstServer(t)
+	client := hedgingClient(server.URL, MethodPolicy{HedgeAfter: 20 * time.Millisecond})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	var out map[string]any
+	err := client.getJSON(ctx, EndpointAPIWebV1, "standings/now", nil, &out)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("getJSON() error = %v, want deadline exceeded", err)
+	}
+	if got := count.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestHedging_FastPrimaryNoHedge(t *testing.T) {
+	var count atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	client := hedgingClient(server.URL, MethodPolicy{HedgeAfter: time.Second})
+
+	var out map[string]any
+	if err := client.getJSON(context.Background(), EndpointAPIWebV1, "score/now", nil, &out); err != nil {
+		t.Fatalf("getJSON() error = %v", err)
+	}
+	if got := count.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestHedging_FailingPrimaryReturnsError(t *testing.T) {
+	server := httptest.NewServer(makeErrorResponse(http.StatusNotFound))
+	defer server.Close()
+	client := hedgingClient(server.URL, MethodPolicy{HedgeAfter: time.Second})
+
+	var out map[string]any
+	err := client.getJSON(context.Background(), EndpointAPIWebV1, "score/now", nil, &out)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("getJSON() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestHedging_PausedAfterRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	client := hedgingClient(server.URL, MethodPolicy{HedgeAfter: time.Second})
+	now := time.Date(2024, 1, 15, 19, 0, 0, 0, time.UTC)
+	client.hedges.now = func() time.Time { return now }
+
+	var out map[string]any
+	err := client.getJSON(context.Background(), EndpointAPIWebV1, "score/now", nil, &out)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("getJSON() error = %v, want ErrRateLimited", err)
+	}
+	if client.hedges.acquire() {
+		t.Error("hedging should pause after a 429")
+	}
+	now = now.Add(61 * time.Second)
+	if !client.hedges.acquire() {
+		t.Error("hedging should resume after Retry-After")
+	}
+}
+
+func TestMethodTimeout(t *testing.T) {
+	server, _ := slowFirstServer(t)
+	client := NewClientWithBaseURL(server.URL)
+	client.policies = map[MethodCategory]MethodPolicy{CategoryGameData: {Timeout: 20 * time.Millisecond}}
+
+	var out map[string]any
+	err := client.getJSON(context.Background(), EndpointAPIWebV1, "gamecenter/2023020001/boxscore", nil, &out)
+	var transportErr *TransportError
+	if !errors.As(err, &transportErr) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("getJSON() error = %v, want TransportError wrapping deadline exceeded", err)
+	}
+}
+
+func TestHedgeLimiter(t *testing.T) {
+	var nilLimiter *hedgeLimiter
+	if nilLimiter.acquire() {
+		t.Error("nil limiter should not allow hedges")
+	}
+	nilLimiter.pause(time.Second)
+
+	h := newHedgeLimiter()
+	for i := 0; i < maxHedgesInFlight; i++ {
+		if !h.acquire() {
+			t.Fatalf("acquire %d should succeed", i)
+		}
+	}
+	if h.acquire() {
+		t.Error("acquire beyond maxHedgesInFlight should fail")
+	}
+	h.release()
+	if !h.acquire() {
+		t.Error("acquire after release should succeed")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 15, 19, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"", 0, false},
+		{"30", 30 * time.Second, true},
+		{"0", 0, false},
+		{now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second, true},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0, false},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		header := http.Header{}
+		header.Set("Retry-After", tt.value)
+		got, ok := parseRetryAfter(header, now)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("parseRetryAfter(%q) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[string]time.Duration{
+		"":      DefaultRateLimitPause,
+		"12":    12 * time.Second,
+		"-3":    DefaultRateLimitPause,
+		"later": DefaultRateLimitPause,
+	}
+	for value, want := range tests {
+		header := http.Header{}
+		if value != "" {
+			header.Set("Retry-After", value)
+		}
+		if got := retryAfter(header); got != want {
+			t.Errorf("retryAfter(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package nhl
+
+import (
+	"context"
+)
+
+// SeasonImportantDates returns the key dates of a season. It reads the
+// full league schedule, one request per week of the season; callers that
+// need the dates repeatedly should keep the result.
+func (c *Client) SeasonImportantDates(ctx context.Context, season Season) (*SeasonDates, error) {
+	games, err := c.FullSeasonSchedule(ctx, season)
+	if err != nil {
+		return nil, err
+	}
+	return SeasonDatesFromSchedule(season, games), nil
+}
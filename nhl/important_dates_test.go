@@ -0,0 +1,63 @@
+package nhl
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSeasonImportantDates(t *testing.T) {
+	game := func(id GameID, gameType GameType) ScheduleGame {
+		return ScheduleGame{ID: id, GameType: gameType, GameState: GameStateFinal}
+	}
+	winterClassic := game(2023020605, GameTypeRegularSeason)
+	winterClassic.SpecialEvent = &SpecialEvent{Name: LocalizedString{Default: "2024 Discover NHL Winter Classic"}}
+	globalSeries := game(2023020001, GameTypeRegularSeason)
+	globalSeries.SpecialEvent = &SpecialEvent{Name: LocalizedString{Default: "NHL Global Series"}}
+
+	server, _ := weeklyPages(t, map[string]any{
+		"/schedule/2023-09-01": &WeeklyScheduleResponse{
+			NextStartDate: "2023-09-08",
+			GameWeek: []GameDay{
+				{Date: "2023-09-23", Games: []ScheduleGame{game(2023010001, GameTypePreseason)}},
+				{Date: "2023-10-10", Games: []ScheduleGame{globalSeries}},
+				{Date: "2024-01-01", Games: []ScheduleGame{winterClassic}},
+			},
+		},
+		"/schedule/2023-09-08": &WeeklyScheduleResponse{
+			GameWeek: []GameDay{
+				{Date: "2024-01-31", Games: []ScheduleGame{game(2023020800, GameTypeRegularSeason)}},
+				{Date: "2024-02-03", Games: []ScheduleGame{game(2023040001, GameTypeAllStar)}},
+				{Date: "2024-02-06", Games: []ScheduleGame{game(2023020801, GameTypeRegularSeason)}},
+				{Date: "2024-04-18", Games: []ScheduleGame{game(2023021312, GameTypeRegularSeason)}},
+				{Date: "2024-04-20", Games: []ScheduleGame{game(2023030111, GameTypePlayoffs)}},
+				{Date: "2024-06-24", Games: []ScheduleGame{game(2023030417, GameTypePlayoffs)}},
+			},
+		},
+	})
+	client := NewClientWithBaseURL(server.URL)
+
+	dates, err := client.SeasonImportantDates(context.Background(), NewSeason(2023))
+	if err != nil {
+		t.Fatalf("SeasonImportantDates() error = %v", err)
+	}
+
+	check := func(name string, got *Date, want string) {
+		t.Helper()
+		if got == nil || got.String() != want {
+			t.Errorf("%s = %v, want %s", name, got, want)
+		}
+	}
+	check("PreseasonStart", dates.PreseasonStart, "2023-09-23")
+	check("RegularSeasonStart", &dates.RegularSeasonStart, "2023-10-10")
+	check("RegularSeasonEnd", &dates.RegularSeasonEnd, "2024-04-18")
+	check("PlayoffsStart", dates.PlayoffsStart, "2024-04-20")
+	check("PlayoffsEnd", dates.PlayoffsEnd, "2024-06-24")
+	check("AllStarGame", dates.AllStarGame, "2024-02-03")
+	check("AllStarBreakStart", dates.AllStarBreakStart, "2024-02-01")
+	check("AllStarBreakEnd", dates.AllStarBreakEnd, "2024-02-05")
+	check("TradeDeadline", dates.TradeDeadline, "2024-03-08")
+
+	if len(dates.OutdoorGames) != 1 || dates.OutdoorGames[0].ID != 2023020605 {
+		t.Errorf("OutdoorGames = %v, want the Winter Classic only", dates.OutdoorGames)
+	}
+}
@@ -0,0 +1,30 @@
+package nhl
+
+import (
+	"context"
+)
+
+// Linescore returns a game's linescore: goals by period, totals and, for
+// games decided in a shootout, the shootout round by round. The grid comes
+// from the right rail; the landing payload is also fetched when the game
+// went to a shootout, for the rounds, or when the right rail has no
+// linescore, to build one with LinescoreFromLanding. Before puck drop the
+// grid is empty.
+func (c *Client) Linescore(ctx context.Context, gameID GameID) (*Linescore, error) {
+	rail, err := c.GameRightRail(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	if rail.Linescore != nil && !rail.Linescore.WentToShootout() {
+		return rail.Linescore, nil
+	}
+	landing, err := c.Landing(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	if rail.Linescore == nil {
+		return LinescoreFromLanding(landing), nil
+	}
+	rail.Linescore.Shootout = landing.ShootoutRounds()
+	return rail.Linescore, nil
+}
@@ -0,0 +1,84 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const shootoutLanding = `{
+	"id": 2023020300, "gameState": "OFF",
+	"periodDescriptor": {"number": 5, "periodType": "SO", "maxRegulationPeriods": 3},
+	"awayTeam": {"id": 10, "abbrev": "TOR", "score": 3},
+	"homeTeam": {"id": 8, "abbrev": "MTL", "score": 2},
+	"summary": {
+		"scoring": [
+			{"periodDescriptor": {"number": 1, "periodType": "REG"}, "goals": [{"isHome": false}, {"isHome": true}]},
+			{"periodDescriptor": {"number": 3, "periodType": "REG"}, "goals": [{"isHome": true}, {"isHome": false}]}
+		],
+		"shootout": [
+			{"sequence": 3, "teamAbbrev": {"default": "TOR"}, "result": "goal"},
+			{"sequence": 1, "teamAbbrev": {"default": "TOR"}, "result": "save"},
+			{"sequence": 2, "teamAbbrev": {"default": "MTL"}, "result": "save"},
+			{"sequence": 4, "teamAbbrev": {"default": "MTL"}, "result": "miss"}
+		],
+		"penalties": []
+	}
+}`
+
+func TestClient_Linescore(t *testing.T) {
+	var landingRequests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gamecenter/2023020100/right-rail":
+			w.Write([]byte(`{"linescore": {"byPeriod": [
+				{"periodDescriptor": {"number": 1, "periodType": "REG"}, "away": 2, "home": 0},
+				{"periodDescriptor": {"number": 2, "periodType": "REG"}, "away": 0, "home": 1},
+				{"periodDescriptor": {"number": 3, "periodType": "REG"}, "away": 1, "home": 0}
+			], "totals": {"away": 3, "home": 1}}}`))
+		case "/gamecenter/2023020300/right-rail":
+			w.Write([]byte(`{"linescore": {"byPeriod": [
+				{"periodDescriptor": {"number": 5, "periodType": "SO"}, "away": 1, "home": 0}
+			], "totals": {"away": 3, "home": 2}}}`))
+		case "/gamecenter/2023020400/right-rail":
+			w.Write([]byte(`{}`))
+		case "/gamecenter/2023020300/landing", "/gamecenter/2023020400/landing":
+			landingRequests++
+			w.Write([]byte(shootoutLanding))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	client := NewClientWithBaseURL(server.URL)
+	ctx := context.Background()
+
+	l, err := client.Linescore(ctx, 2023020100)
+	if err != nil {
+		t.Fatalf("Linescore() error = %v", err)
+	}
+	if len(l.ByPeriod) != 3 || l.Totals.Away != 3 || l.WentToShootout() || landingRequests != 0 {
+		t.Errorf("regulation linescore = %+v, %d landing requests", l, landingRequests)
+	}
+
+	l, err = client.Linescore(ctx, 2023020300)
+	if err != nil {
+		t.Fatalf("Linescore() error = %v", err)
+	}
+	if len(l.ByPeriod) != 1 || len(l.Shootout) != 2 || landingRequests != 1 {
+		t.Errorf("shootout linescore = %+v, %d landing requests", l, landingRequests)
+	}
+
+	l, err = client.Linescore(ctx, 2023020400)
+	if err != nil {
+		t.Fatalf("Linescore() error = %v", err)
+	}
+	if len(l.ByPeriod) != 5 || l.Totals.Home != 2 || landingRequests != 2 {
+		t.Errorf("landing linescore = %+v, %d landing requests", l, landingRequests)
+	}
+
+	if _, err := client.Linescore(ctx, 2023020500); err == nil {
+		t.Error("Linescore(missing game) error = nil")
+	}
+}
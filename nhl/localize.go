@@ -0,0 +1,49 @@
+package nhl
+
+import "reflect"
+
+var localizedStringType = reflect.TypeOf(LocalizedString{})
+
+// localizeStrings walks a decoded response and sets lang on every
+// LocalizedString, so that String reads its variant for lang when the
+// payload has one. Default keeps the English text.
+func localizeStrings(v any, lang Language) {
+	if lang == LanguageEnglish || lang == "" {
+		return
+	}
+	localizeValue(reflect.ValueOf(v), lang)
+}
+
+func localizeValue(v reflect.Value, lang Language) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			localizeValue(v.Elem(), lang)
+		}
+	case reflect.Struct:
+		if v.Type() == localizedStringType {
+			if v.CanSet() {
+				v.Addr().Interface().(*LocalizedString).SetLanguage(lang)
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				localizeValue(v.Field(i), lang)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			localizeValue(v.Index(i), lang)
+		}
+	case reflect.Map:
+		// Map values are not addressable: localize a copy and store it back.
+		iter := v.MapRange()
+		for iter.Next() {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			elem.Set(iter.Value())
+			localizeValue(elem, lang)
+			v.SetMapIndex(iter.Key(), elem)
+		}
+	}
+}
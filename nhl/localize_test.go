@@ -0,0 +1,93 @@
+package nhl
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLocalizedString_AllLanguages(t *testing.T) {
+	var ls LocalizedString
+	payload := `{"default": "Czechia", "fr": "Tchéquie", "cs": "Česko", "de": "Tschechien", "es": "Chequia", "fi": "Tšekki", "sk": "Česko", "sv": "Tjeckien"}`
+	if err := json.Unmarshal([]byte(payload), &ls); err != nil {
+		t.Fatalf("Unmarshal error = %v", err)
+	}
+	want := map[Language]string{
+		LanguageEnglish: "Czechia",
+		LanguageFrench:  "Tchéquie",
+		LanguageCzech:   "Česko",
+		LanguageGerman:  "Tschechien",
+		LanguageSpanish: "Chequia",
+		LanguageFinnish: "Tšekki",
+		LanguageSlovak:  "Česko",
+		LanguageSwedish: "Tjeckien",
+	}
+	for lang, name := range want {
+		if got := ls.Get(lang); got != name {
+			t.Errorf("Get(%s) = %q, want %q", lang, got, name)
+		}
+	}
+
+	data, err := json.Marshal(ls)
+	if err != nil {
+		t.Fatalf("Marshal error = %v", err)
+	}
+	var decoded LocalizedString
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != ls {
+		t.Errorf("round trip = %+v, %v; want %+v", decoded, err, ls)
+	}
+	if data, _ := json.Marshal(LocalizedString{Default: "Oilers"}); string(data) != `{"default":"Oilers"}` {
+		t.Errorf("Marshal(default only) = %s", data)
+	}
+
+	localizeStrings(&ls, LanguageSwedish)
+	if ls.String() != "Tjeckien" {
+		t.Errorf("String() after localizing to Swedish = %q", ls.String())
+	}
+}
+
+func TestLocalizeStrings(t *testing.T) {
+	roster := &Roster{
+		Forwards: []RosterPlayer{
+			{
+				FirstName: LocalizedString{Default: "Nick", Fr: "Nicolas"},
+				LastName:  LocalizedString{Default: "Suzuki"},
+				BirthCity: &LocalizedString{Default: "London", Fr: "Londres"},
+			},
+		},
+	}
+
+	localizeStrings(roster, LanguageFrench)
+
+	player := roster.Forwards[0]
+	if player.FirstName.String() != "Nicolas" {
+		t.Errorf("FirstName.String() = %q, want Nicolas", player.FirstName.String())
+	}
+	if got := player.FirstName.Get(LanguageEnglish); got != "Nick" {
+		t.Errorf("FirstName.Get(en) = %q, want the English Nick kept", got)
+	}
+	if player.LastName.String() != "Suzuki" {
+		t.Errorf("LastName.String() = %q, want Suzuki (no French variant)", player.LastName.String())
+	}
+	if player.BirthCity.String() != "Londres" || player.BirthCity.Default != "London" {
+		t.Errorf("BirthCity = %q, default %q; want Londres, London", player.BirthCity.String(), player.BirthCity.Default)
+	}
+}
+
+func TestLocalizeStrings_MapValues(t *testing.T) {
+	landing := &EdgeSkaterLanding{Leaders: map[string]EdgeSkaterLeader{
+		"hardestShot": {Player: EdgeSkaterPlayer{FirstName: LocalizedString{Default: "Nick", Fr: "Nicolas"}}},
+	}}
+	localizeStrings(landing, LanguageFrench)
+	name := landing.Leaders["hardestShot"].Player.FirstName
+	if name.String() != "Nicolas" || name.Get(LanguageEnglish) != "Nick" {
+		t.Errorf("leader name = %q, English %q; want Nicolas, Nick", name.String(), name.Get(LanguageEnglish))
+	}
+}
+
+func TestLocalizeStrings_EnglishIsNoop(t *testing.T) {
+	ls := &LocalizedString{Default: "Montreal", Fr: "Montréal"}
+	localizeStrings(ls, LanguageEnglish)
+	if ls.Default != "Montreal" {
+		t.Errorf("Default = %q, want unchanged", ls.Default)
+	}
+}
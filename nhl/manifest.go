@@ -0,0 +1,56 @@
+package nhl
+
+import (
+	"sync"
+	"time"
+)
+
+// manifestTTL is how long the standings-season manifest is reused. The
+// manifest only gains an entry when a new season is scheduled.
+const manifestTTL = 24 * time.Hour
+
+// manifestCache holds the most recent standings-season manifest. The zero
+// value is an empty cache.
+type manifestCache struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	seasons []SeasonInfo
+	fetched time.Time
+}
+
+// get returns the cached manifest, or false when it is missing or stale.
+func (m *manifestCache) get() ([]SeasonInfo, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.seasons == nil || m.clock().Sub(m.fetched) >= manifestTTL {
+		return nil, false
+	}
+	return m.seasons, true
+}
+
+// set replaces the cached manifest.
+func (m *manifestCache) set(seasons []SeasonInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.seasons = seasons
+	m.fetched = m.clock()
+}
+
+func (m *manifestCache) clock() time.Time {
+	if m.now != nil {
+		return m.now()
+	}
+	return time.Now()
+}
+
+// findSeason returns the manifest entry for season, if any.
+func findSeason(seasons []SeasonInfo, season Season) (SeasonInfo, bool) {
+	seasonID := season.Int64()
+	for _, info := range seasons {
+		if info.ID.Int64() == seasonID {
+			return info, true
+		}
+	}
+	return SeasonInfo{}, false
+}
@@ -0,0 +1,150 @@
+package nhl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func manifestServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var manifestRequests atomic.Int32
+	seasons := SeasonsResponse{Seasons: []SeasonInfo{
+		{ID: NewSeason(2022), StandingsStart: NewDateYMD(2022, 10, 7), StandingsEnd: NewDateYMD(2023, 4, 14)},
+		{ID: NewSeason(2023), StandingsStart: NewDateYMD(2023, 10, 10), StandingsEnd: NewDateYMD(2024, 4, 18)},
+	}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/standings-season", func(w http.ResponseWriter, r *http.Request) {
+		manifestRequests.Add(1)
+		makeJSONResponse(http.StatusOK, seasons)(w, r)
+	})
+	mux.HandleFunc("/standings/", makeJSONResponse(http.StatusOK, StandingsResponse{}))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &manifestRequests
+}
+
+func TestLeagueStandingsForSeason_CachesManifest(t *testing.T) {
+	server, manifestRequests := manifestServer(t)
+	client := NewClientWithBaseURL(server.URL)
+	ctx := context.Background()
+
+	for _, season := range []Season{NewSeason(2022), NewSeason(2023), NewSeason(2022)} {
+		if _, err := client.LeagueStandingsForSeason(ctx, season); err != nil {
+			t.Fatalf("LeagueStandingsForSeason(%s) error = %v", season, err)
+		}
+	}
+	if got := manifestRequests.Load(); got != 1 {
+		t.Errorf("manifest requests = %d, want 1", got)
+	}
+}
+
+func TestStandingsEndDate(t *testing.T) {
+	server, manifestRequests := manifestServer(t)
+	client := NewClientWithBaseURL(server.URL)
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	client.manifest.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	end, err := client.StandingsEndDate(ctx, NewSeason(2023))
+	if err != nil {
+		t.Fatalf("StandingsEndDate() error = %v", err)
+	}
+	if !end.Equal(NewDateYMD(2024, 4, 18)) {
+		t.Errorf("StandingsEndDate() = %s, want 2024-04-18", end)
+	}
+
+	_, err = client.StandingsEndDate(ctx, NewSeason(1990))
+	if !errors.Is(err, ErrInvalidArgument) || !strings.Contains(err.Error(), "not in the standings manifest") {
+		t.Errorf("StandingsEndDate(1990) error = %v, want ErrInvalidArgument", err)
+	}
+	if got := manifestRequests.Load(); got != 2 {
+		t.Errorf("manifest requests = %d, want 2 (a miss refetches once)", got)
+	}
+
+	now = now.Add(manifestTTL)
+	if _, err := client.StandingsEndDate(ctx, NewSeason(2022)); err != nil {
+		t.Fatalf("StandingsEndDate() error = %v", err)
+	}
+	if got := manifestRequests.Load(); got != 3 {
+		t.Errorf("manifest requests after TTL = %d, want 3", got)
+	}
+}
+
+func TestStandingsEndDate_RefetchesNewSeason(t *testing.T) {
+	var manifestRequests atomic.Int32
+	seasons := []SeasonInfo{{ID: NewSeason(2023), StandingsEnd: NewDateYMD(2024, 4, 18)}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if manifestRequests.Add(1) > 1 {
+			seasons = append(seasons, SeasonInfo{ID: NewSeason(2024), StandingsEnd: NewDateYMD(2025, 4, 17)})
+		}
+		makeJSONResponse(http.StatusOK, SeasonsResponse{Seasons: seasons})(w, r)
+	}))
+	defer server.Close()
+	client := NewClientWithBaseURL(server.URL)
+	ctx := context.Background()
+
+	if _, err := client.StandingsEndDate(ctx, NewSeason(2023)); err != nil {
+		t.Fatalf("StandingsEndDate(2023) error = %v", err)
+	}
+	end, err := client.StandingsEndDate(ctx, NewSeason(2024))
+	if err != nil {
+		t.Fatalf("StandingsEndDate(2024) error = %v, want the refreshed manifest", err)
+	}
+	if !end.Equal(NewDateYMD(2025, 4, 17)) || manifestRequests.Load() != 2 {
+		t.Errorf("StandingsEndDate(2024) = %s after %d manifest requests", end, manifestRequests.Load())
+	}
+}
+
+func TestStandingsEndDate_ErrorNotCached(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			makeErrorResponse(http.StatusServiceUnavailable)(w, r)
+			return
+		}
+		makeJSONResponse(http.StatusOK, SeasonsResponse{Seasons: []SeasonInfo{
+			{ID: NewSeason(2023), StandingsEnd: NewDateYMD(2024, 4, 18)},
+		}})(w, r)
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	ctx := context.Background()
+	if _, err := client.StandingsEndDate(ctx, NewSeason(2023)); err == nil {
+		t.Fatal("StandingsEndDate() should fail while the manifest is unavailable")
+	}
+	fail.Store(false)
+	if _, err := client.StandingsEndDate(ctx, NewSeason(2023)); err != nil {
+		t.Errorf("StandingsEndDate() error = %v after recovery", err)
+	}
+}
+
+func TestSeasonStandingManifest_RefreshesCache(t *testing.T) {
+	server, manifestRequests := manifestServer(t)
+	client := NewClientWithBaseURL(server.URL)
+	ctx := context.Background()
+
+	seasons, err := client.SeasonStandingManifest(ctx)
+	if err != nil {
+		t.Fatalf("SeasonStandingManifest() error = %v", err)
+	}
+	seasons[1].StandingsEnd = NewDateYMD(1999, 1, 1)
+
+	end, err := client.StandingsEndDate(ctx, NewSeason(2023))
+	if err != nil {
+		t.Fatalf("StandingsEndDate() error = %v", err)
+	}
+	if !end.Equal(NewDateYMD(2024, 4, 18)) {
+		t.Errorf("cached manifest was modified through the returned slice: %s", end)
+	}
+	if got := manifestRequests.Load(); got != 1 {
+		t.Errorf("manifest requests = %d, want 1", got)
+	}
+}
@@ -0,0 +1,65 @@
+package nhl
+
+import "net/http"
+
+// RoundTripFunc performs a single HTTP request. It is the unit that
+// middleware wraps.
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the round trip of every request a client sends, to add
+// logging, metrics or headers, or to answer requests itself. A middleware
+// calls next to continue the chain and may inspect or replace the
+// response; returning without calling next short-circuits the request.
+//
+// Middleware runs below the client's error classification: a response it
+// returns is handled like one from the network, and an error becomes a
+// *TransportError. Hedged calls run the chain once per attempt.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// chainMiddleware wraps base so that the first middleware sees each
+// request first and each response last.
+func chainMiddleware(base http.RoundTripper, middlewares []Middleware) http.RoundTripper {
+	if len(middlewares) == 0 {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	next := RoundTripFunc(base.RoundTrip)
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		next = middlewares[i](next)
+	}
+	return next
+}
+
+// OnRequest returns a middleware that calls fn with each outgoing request,
+// for example to set an authentication header. fn gets a clone of the
+// request, which is what continues down the chain, so its changes never
+// touch the request the client made.
+func OnRequest(fn func(*http.Request)) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			fn(req)
+			return next(req)
+		}
+	}
+}
+
+// OnResponse returns a middleware that calls fn with each request and its
+// outcome once the round trip completes. resp is nil when err is not. fn
+// must not consume resp.Body.
+func OnResponse(fn func(req *http.Request, resp *http.Response, err error)) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			fn(req, resp, err)
+			return resp, err
+		}
+	}
+}
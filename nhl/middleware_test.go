@@ -0,0 +1,141 @@
+package nhl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		makeJSONResponse(http.StatusOK, StandingsResponse{})(w, r)
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				order = append(order, name+" in")
+				mu.Unlock()
+				resp, err := next(req)
+				mu.Lock()
+				order = append(order, name+" out")
+				mu.Unlock()
+				return resp, err
+			}
+		}
+	}
+	var status int
+	var path string
+	client := NewClientWithConfig(NewClientConfig(
+		WithConfigMiddleware(trace("outer"), trace("inner")),
+		WithConfigMiddleware(
+			OnRequest(func(req *http.Request) { req.Header.Set("Authorization", "Bearer token") }),
+			OnResponse(func(req *http.Request, resp *http.Response, err error) {
+				path, status = req.URL.Path, resp.StatusCode
+			}),
+		),
+	))
+	client.baseURLOverride = server.URL
+
+	if _, err := client.LeagueStandingsForDate(context.Background(), FromYMD(2024, 1, 15)); err != nil {
+		t.Fatalf("LeagueStandingsForDate() error = %v", err)
+	}
+	want := []string{"outer in", "inner in", "inner out", "outer out"}
+	if strings.Join(order, ",") != strings.Join(want, ",") {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+	if auth != "Bearer token" {
+		t.Errorf("Authorization = %q", auth)
+	}
+	if path != "/standings/2024-01-15" || status != http.StatusOK {
+		t.Errorf("OnResponse saw %s %d", path, status)
+	}
+}
+
+func TestOnRequestClones(t *testing.T) {
+	var auth, leaked string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		makeJSONResponse(http.StatusOK, StandingsResponse{})(w, r)
+	}))
+	defer server.Close()
+
+	outer := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			leaked = req.Header.Get("Authorization")
+			return resp, err
+		}
+	}
+	client := NewClientWithConfig(NewClientConfig(WithConfigMiddleware(
+		outer,
+		OnRequest(func(req *http.Request) { req.Header.Set("Authorization", "Bearer token") }),
+	)))
+	client.baseURLOverride = server.URL
+
+	if _, err := client.LeagueStandingsForDate(context.Background(), FromYMD(2024, 1, 15)); err != nil {
+		t.Fatalf("LeagueStandingsForDate() error = %v", err)
+	}
+	if auth != "Bearer token" || leaked != "" {
+		t.Errorf("server saw %q, caller's request got %q; want the header on the clone only", auth, leaked)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	canned := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/boxscore") {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader(`{"message":"no such game"}`)),
+					Request:    req,
+				}, nil
+			}
+			return nil, errors.New("offline")
+		}
+	}
+	client := NewClientWithConfig(NewClientConfig(WithConfigMiddleware(canned)))
+	client.baseURLOverride = "http://nhl.invalid"
+	ctx := context.Background()
+
+	_, err := client.Boxscore(ctx, GameID(2023020001))
+	var apiErr *APIError
+	if !errors.Is(err, ErrNotFound) || !errors.As(err, &apiErr) || string(apiErr.Body) != `{"message":"no such game"}` {
+		t.Errorf("Boxscore() error = %v", err)
+	}
+
+	_, err = client.PlayByPlay(ctx, GameID(2023020001))
+	var transportErr *TransportError
+	if !errors.As(err, &transportErr) {
+		t.Errorf("PlayByPlay() error = %v, want *TransportError", err)
+	}
+}
+
+func TestChainMiddlewareWithoutMiddleware(t *testing.T) {
+	base := &http.Transport{}
+	if got := chainMiddleware(base, nil); got != base {
+		t.Errorf("chainMiddleware(base, nil) = %v, want base", got)
+	}
+}
+
+func TestClientConfig_CloneMiddlewares(t *testing.T) {
+	noop := func(next RoundTripFunc) RoundTripFunc { return next }
+	original := NewClientConfig(WithConfigMiddleware(noop))
+	cloned := original.Clone()
+	cloned.Middlewares = append(cloned.Middlewares[:0], nil)
+	if original.Middlewares[0] == nil {
+		t.Error("modifying cloned Middlewares should not affect original")
+	}
+}
@@ -0,0 +1,77 @@
+package model
+
+import "strconv"
+
+// AssetsBaseURL is the host of the league's static images: headshots,
+// action shots and logos.
+const AssetsBaseURL = "https://assets.nhle.com"
+
+// HeadshotURL returns the URL of a player's headshot for a team and
+// season, e.g. https://assets.nhle.com/mugs/nhl/20232024/EDM/8478402.png.
+// Headshots are shot per team and season, so a traded player has one per
+// club; the URL may not exist for a team or season the player did not
+// play for.
+func HeadshotURL(teamAbbrev TeamAbbrev, season Season, playerID PlayerID) string {
+	return AssetsBaseURL + "/mugs/nhl/" + season.APIString() + "/" + string(teamAbbrev) + "/" + strconv.FormatInt(int64(playerID), 10) + ".png"
+}
+
+// HeroImageURL returns the URL of a player's 1296x729 action shot, e.g.
+// https://assets.nhle.com/mugs/actionshots/1296x729/8478402.jpg. Not every
+// player has one.
+func HeroImageURL(playerID PlayerID) string {
+	return AssetsBaseURL + "/mugs/actionshots/1296x729/" + strconv.FormatInt(int64(playerID), 10) + ".jpg"
+}
+
+// HeadshotURLFor returns the player's headshot for their current team in
+// season, or "" when they have no current team.
+func (p *PlayerLanding) HeadshotURLFor(season Season) string {
+	if p.CurrentTeamAbbrev == nil || *p.CurrentTeamAbbrev == "" {
+		return ""
+	}
+	return HeadshotURL(TeamAbbrev(*p.CurrentTeamAbbrev), season, p.PlayerID)
+}
+
+// HeroImageURL returns the player's action shot: the one the API sent, or
+// the URL it is published under.
+func (p *PlayerLanding) HeroImageURL() string {
+	if p.HeroImage != nil && *p.HeroImage != "" {
+		return *p.HeroImage
+	}
+	return HeroImageURL(p.PlayerID)
+}
+
+// RefreshAssetURLs points Headshot at the current team's headshot for
+// season and fills in a missing HeroImage. A cached profile of a traded
+// player otherwise keeps showing the old club's headshot. Headshot is
+// left alone for a player without a current team.
+func (p *PlayerLanding) RefreshAssetURLs(season Season) {
+	if url := p.HeadshotURLFor(season); url != "" {
+		p.Headshot = url
+	}
+	if p.HeroImage == nil || *p.HeroImage == "" {
+		hero := HeroImageURL(p.PlayerID)
+		p.HeroImage = &hero
+	}
+}
+
+// HeadshotURLFor returns the player's headshot for a team and season; a
+// RosterPlayer does not carry its team.
+func (p *RosterPlayer) HeadshotURLFor(teamAbbrev TeamAbbrev, season Season) string {
+	return HeadshotURL(teamAbbrev, season, p.ID)
+}
+
+// HeroImageURL returns the URL of the player's action shot.
+func (p *RosterPlayer) HeroImageURL() string {
+	return HeroImageURL(p.ID)
+}
+
+// RefreshHeadshots points every player's Headshot at the team's headshot
+// for season, e.g. to show a roster read for one season with the photos of
+// another.
+func (r *Roster) RefreshHeadshots(teamAbbrev TeamAbbrev, season Season) {
+	for _, players := range [][]RosterPlayer{r.Forwards, r.Defensemen, r.Goalies} {
+		for i := range players {
+			players[i].Headshot = players[i].HeadshotURLFor(teamAbbrev, season)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package model
+
+import "testing"
+
+func TestAssetURLs(t *testing.T) {
+	if got, want := HeadshotURL(TeamEDM, NewSeason(2023), 8478402), "https://assets.nhle.com/mugs/nhl/20232024/EDM/8478402.png"; got != want {
+		t.Errorf("HeadshotURL() = %q, want %q", got, want)
+	}
+	if got, want := HeroImageURL(8478402), "https://assets.nhle.com/mugs/actionshots/1296x729/8478402.jpg"; got != want {
+		t.Errorf("HeroImageURL() = %q, want %q", got, want)
+	}
+}
+
+func TestPlayerLanding_RefreshAssetURLs(t *testing.T) {
+	team := "NJD"
+	p := PlayerLanding{
+		PlayerID:          8478402,
+		CurrentTeamAbbrev: &team,
+		Headshot:          "https://assets.nhle.com/mugs/nhl/20232024/EDM/8478402.png",
+	}
+	p.RefreshAssetURLs(NewSeason(2024))
+	if want := "https://assets.nhle.com/mugs/nhl/20242025/NJD/8478402.png"; p.Headshot != want {
+		t.Errorf("Headshot = %q, want %q", p.Headshot, want)
+	}
+	if p.HeroImage == nil || *p.HeroImage != HeroImageURL(8478402) {
+		t.Errorf("HeroImage = %v, want the action shot URL", p.HeroImage)
+	}
+
+	sent := "https://example.com/hero.jpg"
+	free := PlayerLanding{PlayerID: 8478402, Headshot: "kept", HeroImage: &sent}
+	free.RefreshAssetURLs(NewSeason(2024))
+	if free.Headshot != "kept" || free.HeroImageURL() != sent {
+		t.Errorf("free agent = %q, %q, want URLs unchanged", free.Headshot, free.HeroImageURL())
+	}
+	if free.HeadshotURLFor(NewSeason(2024)) != "" {
+		t.Error("HeadshotURLFor() without a team should be empty")
+	}
+}
+
+func TestRoster_RefreshHeadshots(t *testing.T) {
+	r := Roster{
+		Forwards: []RosterPlayer{{ID: 8478402}},
+		Goalies:  []RosterPlayer{{ID: 8479973, Headshot: "old"}},
+	}
+	r.RefreshHeadshots(TeamEDM, NewSeason(2023))
+	if got := r.Goalies[0].Headshot; got != HeadshotURL(TeamEDM, NewSeason(2023), 8479973) {
+		t.Errorf("goalie Headshot = %q", got)
+	}
+	if r.Forwards[0].Headshot == "" {
+		t.Error("forward Headshot not set")
+	}
+	if got := r.Forwards[0].HeroImageURL(); got != HeroImageURL(8478402) {
+		t.Errorf("HeroImageURL() = %q", got)
+	}
+}
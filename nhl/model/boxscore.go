@@ -1,4 +1,12 @@
-package nhl
+package model
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+)
 
 // Boxscore represents the boxscore response with detailed game and player statistics.
 type Boxscore struct {
@@ -21,6 +29,29 @@ type Boxscore struct {
 	HomeTeam          BoxscoreTeam      `json:"homeTeam"`
 	Clock             GameClock         `json:"clock"`
 	PlayerByGameStats PlayerByGameStats `json:"playerByGameStats"`
+	Linescore         *Linescore        `json:"linescore,omitempty"`
+	ShotsByPeriod     []PeriodScore     `json:"shotsByPeriod,omitempty"`
+	TeamGameStats     []TeamGameStat    `json:"teamGameStats,omitempty"`
+	// Attendance is the paid attendance, or nil when the feed does not
+	// report it, as for games not final yet.
+	Attendance *int `json:"attendance,omitempty"`
+}
+
+// PeriodScore is one period of a linescore or of the shots-by-period
+// table: goals or shots on goal for each team.
+type PeriodScore struct {
+	PeriodDescriptor PeriodDescriptor `json:"periodDescriptor"`
+	Away             int              `json:"away"`
+	Home             int              `json:"home"`
+}
+
+// TeamGameStat is one row of the official team stats comparison, such as
+// "sog" or "powerPlay". Values are kept as sent: numbers for most
+// categories, "goals/opportunities" strings for "powerPlay".
+type TeamGameStat struct {
+	Category  string          `json:"category"`
+	AwayValue json.RawMessage `json:"awayValue"`
+	HomeValue json.RawMessage `json:"homeValue"`
 }
 
 // TVBroadcast represents TV broadcast information for a game.
@@ -166,6 +197,169 @@ func (t *TeamGameStats) PowerPlayPercentage() float64 {
 	return 0.0
 }
 
+// OfficialTeamGameStats parses TeamGameStats into each team's totals. It
+// reports false when the boxscore carries no team stats, in which case
+// FromTeamPlayerStats gives an estimate from player stats. Faceoffs are
+// published as a percentage only, so FaceoffWins and FaceoffTotal stay
+// zero; values that do not parse are left zero as well.
+func (b *Boxscore) OfficialTeamGameStats() (away, home TeamGameStats, ok bool) {
+	return officialTeamGameStats(b.TeamGameStats)
+}
+
+// officialTeamGameStats parses a team stats comparison table.
+func officialTeamGameStats(stats []TeamGameStat) (away, home TeamGameStats, ok bool) {
+	if len(stats) == 0 {
+		return away, home, false
+	}
+	for _, stat := range stats {
+		applyTeamGameStat(&away, stat.Category, stat.AwayValue)
+		applyTeamGameStat(&home, stat.Category, stat.HomeValue)
+	}
+	return away, home, true
+}
+
+// applyTeamGameStat stores one category value; unknown categories are
+// ignored.
+func applyTeamGameStat(stats *TeamGameStats, category string, raw json.RawMessage) {
+	var field *int
+	switch category {
+	case "sog":
+		field = &stats.ShotsOnGoal
+	case "pim":
+		field = &stats.PenaltyMinutes
+	case "hits":
+		field = &stats.Hits
+	case "blockedShots":
+		field = &stats.BlockedShots
+	case "giveaways":
+		field = &stats.Giveaways
+	case "takeaways":
+		field = &stats.Takeaways
+	case "powerPlay":
+		var s string
+		if json.Unmarshal(raw, &s) != nil {
+			return
+		}
+		goals, opportunities, _ := strings.Cut(s, "/")
+		stats.PowerPlayGoals, _ = strconv.Atoi(goals)
+		stats.PowerPlayOpportunities, _ = strconv.Atoi(opportunities)
+		return
+	default:
+		return
+	}
+	json.Unmarshal(raw, field)
+}
+
+// PeriodStats is one period of a game's breakdown: goals and shots on goal
+// for each team.
+type PeriodStats struct {
+	PeriodDescriptor PeriodDescriptor
+	AwayGoals        int
+	HomeGoals        int
+	AwaySOG          int
+	HomeSOG          int
+}
+
+// ByPeriod returns goals and shots on goal per period, the standard
+// linescore table, ordered by period. Goals come from the boxscore's
+// Linescore, else from the scoring summary of landing, else from the goals
+// in pbp; shots come from ShotsByPeriod, else from the shots and goals in
+// pbp. landing and pbp are optional and only consulted for what the
+// boxscore lacks. Every period played so far is listed, scoreless ones
+// with zeros. Shootout attempts are not counted as goals or shots.
+func (b *Boxscore) ByPeriod(landing *GameMatchup, pbp *PlayByPlay) []PeriodStats {
+	periods := make(map[int]*PeriodStats)
+	period := func(pd PeriodDescriptor) *PeriodStats {
+		p := periods[pd.Number]
+		if p == nil {
+			p = &PeriodStats{PeriodDescriptor: pd}
+			periods[pd.Number] = p
+		}
+		return p
+	}
+
+	goalsKnown, shotsKnown := false, false
+	if b.Linescore != nil && len(b.Linescore.ByPeriod) > 0 {
+		for _, ps := range b.Linescore.ByPeriod {
+			p := period(ps.PeriodDescriptor)
+			p.AwayGoals, p.HomeGoals = ps.Away, ps.Home
+		}
+		goalsKnown = true
+	}
+	if len(b.ShotsByPeriod) > 0 {
+		for _, ps := range b.ShotsByPeriod {
+			p := period(ps.PeriodDescriptor)
+			p.AwaySOG, p.HomeSOG = ps.Away, ps.Home
+		}
+		shotsKnown = true
+	}
+	if !goalsKnown && landing != nil && landing.Summary != nil {
+		for _, ps := range landing.Summary.Scoring {
+			p := period(ps.PeriodDescriptor)
+			for _, g := range ps.Goals {
+				if g.IsHome {
+					p.HomeGoals++
+				} else {
+					p.AwayGoals++
+				}
+			}
+		}
+		goalsKnown = true
+	}
+	if pbp != nil && (!goalsKnown || !shotsKnown) {
+		for _, play := range pbp.Plays {
+			pd := play.PeriodDescriptor
+			if pd.PeriodType == PeriodTypeShootout || pd.Number == 0 {
+				continue
+			}
+			p := period(pd)
+			if play.Details == nil || play.Details.EventOwnerTeamID == nil {
+				continue
+			}
+			home := *play.Details.EventOwnerTeamID == pbp.HomeTeam.ID
+			goal := play.TypeDescKey == PlayEventTypeGoal
+			if !goalsKnown && goal {
+				if home {
+					p.HomeGoals++
+				} else {
+					p.AwayGoals++
+				}
+			}
+			if !shotsKnown && (goal || play.TypeDescKey == PlayEventTypeShotOnGoal) {
+				if home {
+					p.HomeSOG++
+				} else {
+					p.AwaySOG++
+				}
+			}
+		}
+	}
+
+	// Scoreless periods may be missing from every source.
+	regulation := cmp.Or(b.PeriodDescriptor.MaxRegulationPeriods, 3)
+	for n := 1; n <= b.PeriodDescriptor.Number; n++ {
+		if periods[n] != nil {
+			continue
+		}
+		pd := PeriodDescriptor{Number: n, PeriodType: PeriodTypeRegulation, MaxRegulationPeriods: regulation}
+		if n == b.PeriodDescriptor.Number {
+			pd = b.PeriodDescriptor
+		} else if n > regulation {
+			pd.PeriodType = PeriodTypeOvertime
+		}
+		period(pd)
+	}
+
+	result := make([]PeriodStats, 0, len(periods))
+	for _, p := range periods {
+		result = append(result, *p)
+	}
+	slices.SortFunc(result, func(a, b PeriodStats) int {
+		return cmp.Compare(a.PeriodDescriptor.Number, b.PeriodDescriptor.Number)
+	})
+	return result
+}
+
 // SkaterStats represents skater (forward/defense) statistics.
 type SkaterStats struct {
 	PlayerID           PlayerID        `json:"playerId"`
@@ -181,7 +375,7 @@ type SkaterStats struct {
 	PowerPlayGoals     int             `json:"powerPlayGoals"`
 	SOG                int             `json:"sog"`
 	FaceoffWinningPctg float64         `json:"faceoffWinningPctg"`
-	TOI                string          `json:"toi"`
+	TOI                TimeOnIce       `json:"toi"`
 	BlockedShots       int             `json:"blockedShots"`
 	Shifts             int             `json:"shifts"`
 	Giveaways          int             `json:"giveaways"`
@@ -194,17 +388,17 @@ type GoalieStats struct {
 	SweaterNumber            int             `json:"sweaterNumber"`
 	Name                     LocalizedString `json:"name"`
 	Position                 Position        `json:"position"`
-	EvenStrengthShotsAgainst string          `json:"evenStrengthShotsAgainst"`
-	PowerPlayShotsAgainst    string          `json:"powerPlayShotsAgainst"`
-	ShorthandedShotsAgainst  string          `json:"shorthandedShotsAgainst"`
-	SaveShotsAgainst         string          `json:"saveShotsAgainst"`
+	EvenStrengthShotsAgainst ShotsFraction   `json:"evenStrengthShotsAgainst"`
+	PowerPlayShotsAgainst    ShotsFraction   `json:"powerPlayShotsAgainst"`
+	ShorthandedShotsAgainst  ShotsFraction   `json:"shorthandedShotsAgainst"`
+	SaveShotsAgainst         ShotsFraction   `json:"saveShotsAgainst"`
 	SavePctg                 *float64        `json:"savePctg,omitempty"`
 	EvenStrengthGoalsAgainst int             `json:"evenStrengthGoalsAgainst"`
 	PowerPlayGoalsAgainst    int             `json:"powerPlayGoalsAgainst"`
 	ShorthandedGoalsAgainst  int             `json:"shorthandedGoalsAgainst"`
 	PIM                      *int            `json:"pim,omitempty"`
 	GoalsAgainst             int             `json:"goalsAgainst"`
-	TOI                      string          `json:"toi"`
+	TOI                      TimeOnIce       `json:"toi"`
 	Starter                  *bool           `json:"starter,omitempty"`
 	Decision                 *GoalieDecision `json:"decision,omitempty"`
 	ShotsAgainst             int             `json:"shotsAgainst"`
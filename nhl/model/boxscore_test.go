@@ -1,8 +1,9 @@
-package nhl
+package model
 
 import (
 	"encoding/json"
 	"testing"
+	"time"
 )
 
 func TestBoxscore_Deserialization(t *testing.T) {
@@ -758,7 +759,7 @@ func TestTeamGameStats_FromSkaters(t *testing.T) {
 				PowerPlayGoals:     1,
 				SOG:                4,
 				FaceoffWinningPctg: 0.6,
-				TOI:                "18:00",
+				TOI:                TimeOnIce(18 * time.Minute),
 				BlockedShots:       2,
 				Shifts:             25,
 				Giveaways:          1,
@@ -780,7 +781,7 @@ func TestTeamGameStats_FromSkaters(t *testing.T) {
 				PowerPlayGoals:     0,
 				SOG:                3,
 				FaceoffWinningPctg: 0.0,
-				TOI:                "22:00",
+				TOI:                TimeOnIce(22 * time.Minute),
 				BlockedShots:       5,
 				Shifts:             30,
 				Giveaways:          2,
@@ -826,17 +827,17 @@ func TestTeamGameStats_WithGoalies(t *testing.T) {
 				SweaterNumber:            35,
 				Name:                     LocalizedString{Default: "Goalie 1"},
 				Position:                 PositionGoalie,
-				EvenStrengthShotsAgainst: "20/22",
-				PowerPlayShotsAgainst:    "3/5",
-				ShorthandedShotsAgainst:  "0/0",
-				SaveShotsAgainst:         "23/27",
+				EvenStrengthShotsAgainst: ShotsFraction{Saves: 20, Attempts: 22},
+				PowerPlayShotsAgainst:    ShotsFraction{Saves: 3, Attempts: 5},
+				ShorthandedShotsAgainst:  ShotsFraction{},
+				SaveShotsAgainst:         ShotsFraction{Saves: 23, Attempts: 27},
 				SavePctg:                 floatPtr(0.852),
 				EvenStrengthGoalsAgainst: 2,
 				PowerPlayGoalsAgainst:    2,
 				ShorthandedGoalsAgainst:  0,
 				PIM:                      &pim,
 				GoalsAgainst:             4,
-				TOI:                      "60:00",
+				TOI:                      TimeOnIce(time.Hour),
 				Starter:                  boolPtr(true),
 				Decision:                 goalieDecisionPtr(GoalieDecisionLoss),
 				ShotsAgainst:             27,
@@ -1080,6 +1081,139 @@ func TestBoxscore_RoundTripJSON(t *testing.T) {
 	}
 }
 
+func TestBoxscore_LinescoreAndTeamGameStats(t *testing.T) {
+	jsonData := `{
+		"id": 2023020204,
+		"linescore": {
+			"byPeriod": [
+				{"periodDescriptor": {"number": 1, "periodType": "REG", "maxRegulationPeriods": 3}, "away": 1, "home": 0},
+				{"periodDescriptor": {"number": 4, "periodType": "OT", "maxRegulationPeriods": 3}, "away": 0, "home": 1}
+			],
+			"totals": {"away": 2, "home": 3}
+		},
+		"shotsByPeriod": [
+			{"periodDescriptor": {"number": 1, "periodType": "REG", "maxRegulationPeriods": 3}, "away": 10, "home": 12}
+		],
+		"teamGameStats": [
+			{"category": "sog", "awayValue": 28, "homeValue": 31},
+			{"category": "faceoffWinningPctg", "awayValue": 0.48, "homeValue": 0.52},
+			{"category": "powerPlay", "awayValue": "1/3", "homeValue": "0/2"},
+			{"category": "pim", "awayValue": 8, "homeValue": 12},
+			{"category": "hits", "awayValue": 21, "homeValue": 17},
+			{"category": "blockedShots", "awayValue": 14, "homeValue": 9},
+			{"category": "giveaways", "awayValue": 6, "homeValue": 11},
+			{"category": "takeaways", "awayValue": 4, "homeValue": 7}
+		]
+	}`
+
+	var boxscore Boxscore
+	if err := json.Unmarshal([]byte(jsonData), &boxscore); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	ls := boxscore.Linescore
+	if ls == nil || len(ls.ByPeriod) != 2 || ls.Totals.Home != 3 {
+		t.Fatalf("Linescore = %+v", ls)
+	}
+	if ot := ls.ByPeriod[1]; ot.PeriodDescriptor.PeriodType != PeriodTypeOvertime || ot.Home != 1 {
+		t.Errorf("overtime period = %+v", ot)
+	}
+	if len(boxscore.ShotsByPeriod) != 1 || boxscore.ShotsByPeriod[0].Home != 12 {
+		t.Errorf("ShotsByPeriod = %+v", boxscore.ShotsByPeriod)
+	}
+
+	away, home, ok := boxscore.OfficialTeamGameStats()
+	if !ok {
+		t.Fatal("OfficialTeamGameStats() ok = false")
+	}
+	wantAway := TeamGameStats{ShotsOnGoal: 28, PowerPlayGoals: 1, PowerPlayOpportunities: 3, PenaltyMinutes: 8, Hits: 21, BlockedShots: 14, Giveaways: 6, Takeaways: 4}
+	wantHome := TeamGameStats{ShotsOnGoal: 31, PowerPlayGoals: 0, PowerPlayOpportunities: 2, PenaltyMinutes: 12, Hits: 17, BlockedShots: 9, Giveaways: 11, Takeaways: 7}
+	if away != wantAway {
+		t.Errorf("away = %+v, want %+v", away, wantAway)
+	}
+	if home != wantHome {
+		t.Errorf("home = %+v, want %+v", home, wantHome)
+	}
+
+	if _, _, ok := (&Boxscore{}).OfficialTeamGameStats(); ok {
+		t.Error("OfficialTeamGameStats() should report false without team stats")
+	}
+}
+
+func TestBoxscore_ByPeriod(t *testing.T) {
+	reg := func(n int) PeriodDescriptor {
+		return PeriodDescriptor{Number: n, PeriodType: PeriodTypeRegulation, MaxRegulationPeriods: 3}
+	}
+	ot := PeriodDescriptor{Number: 4, PeriodType: PeriodTypeOvertime, MaxRegulationPeriods: 3}
+
+	box := &Boxscore{
+		PeriodDescriptor: ot,
+		Linescore: &Linescore{ByPeriod: []PeriodScore{
+			{PeriodDescriptor: reg(1), Away: 1}, {PeriodDescriptor: reg(2)}, {PeriodDescriptor: reg(3), Home: 1}, {PeriodDescriptor: ot, Home: 1},
+		}},
+		ShotsByPeriod: []PeriodScore{
+			{PeriodDescriptor: reg(1), Away: 10, Home: 8}, {PeriodDescriptor: reg(2), Away: 9, Home: 12},
+			{PeriodDescriptor: reg(3), Away: 7, Home: 11}, {PeriodDescriptor: ot, Away: 1, Home: 2},
+		},
+	}
+	got := box.ByPeriod(nil, nil)
+	want := []PeriodStats{
+		{PeriodDescriptor: reg(1), AwayGoals: 1, AwaySOG: 10, HomeSOG: 8},
+		{PeriodDescriptor: reg(2), AwaySOG: 9, HomeSOG: 12},
+		{PeriodDescriptor: reg(3), HomeGoals: 1, AwaySOG: 7, HomeSOG: 11},
+		{PeriodDescriptor: ot, HomeGoals: 1, AwaySOG: 1, HomeSOG: 2},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ByPeriod() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ByPeriod()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	// Without the tables, goals come from the landing summary and shots
+	// from the play-by-play, skipping the shootout.
+	home, away := TeamID(8), TeamID(10)
+	play := func(pd PeriodDescriptor, kind PlayEventType, team TeamID) PlayEvent {
+		return PlayEvent{PeriodDescriptor: pd, TypeDescKey: kind, Details: &PlayEventDetails{EventOwnerTeamID: &team}}
+	}
+	so := PeriodDescriptor{Number: 5, PeriodType: PeriodTypeShootout, MaxRegulationPeriods: 3}
+	pbp := &PlayByPlay{
+		HomeTeam: BoxscoreTeam{ID: home},
+		AwayTeam: BoxscoreTeam{ID: away},
+		Plays: []PlayEvent{
+			{PeriodDescriptor: reg(1), TypeDescKey: PlayEventTypePeriodStart},
+			play(reg(1), PlayEventTypeShotOnGoal, away),
+			play(reg(1), PlayEventTypeGoal, away),
+			play(reg(1), PlayEventTypeMissedShot, home),
+			play(reg(2), PlayEventTypeShotOnGoal, home),
+			play(so, PlayEventTypeGoal, home),
+		},
+	}
+	landing := &GameMatchup{Summary: &GameSummary{Scoring: []PeriodScoring{
+		{PeriodDescriptor: reg(1), Goals: []GoalSummary{{IsHome: true}, {IsHome: true}}},
+	}}}
+	live := &Boxscore{PeriodDescriptor: reg(3)}
+	got = live.ByPeriod(landing, pbp)
+	want = []PeriodStats{
+		{PeriodDescriptor: reg(1), HomeGoals: 2, AwaySOG: 2},
+		{PeriodDescriptor: reg(2), HomeSOG: 1},
+		{PeriodDescriptor: reg(3)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ByPeriod() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ByPeriod()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if got := live.ByPeriod(nil, pbp); got[0].AwayGoals != 1 || got[0].HomeGoals != 0 {
+		t.Errorf("ByPeriod(pbp only) period 1 = %+v, want the away goal", got[0])
+	}
+}
+
 // Helper functions for creating pointers to values
 func floatPtr(f float64) *float64 {
 	return &f
@@ -0,0 +1,73 @@
+package model
+
+import "strings"
+
+// Broadcast market codes used in TVBroadcast.Market.
+const (
+	MarketNational = "N"
+	MarketHome     = "H"
+	MarketAway     = "A"
+)
+
+// IsNational reports whether the broadcast covers the whole country rather
+// than one team's regional market.
+func (b TVBroadcast) IsNational() bool {
+	return b.Market == MarketNational
+}
+
+// BroadcastsIn returns the broadcasts available in a country, given as an
+// ISO 3166 alpha-2 code such as "CA" or "US" (case-insensitive), in
+// sequence order as listed by the API.
+func BroadcastsIn(broadcasts []TVBroadcast, countryCode string) []TVBroadcast {
+	var matched []TVBroadcast
+	for _, b := range broadcasts {
+		if strings.EqualFold(b.CountryCode, countryCode) {
+			matched = append(matched, b)
+		}
+	}
+	return matched
+}
+
+// BroadcastsIn returns the game's TV broadcasts available in a country.
+func (s ScheduleGame) BroadcastsIn(countryCode string) []TVBroadcast {
+	return BroadcastsIn(s.TVBroadcasts, countryCode)
+}
+
+// StreamingOption is a streaming or broadcast service listed by the
+// where-to-watch endpoint.
+type StreamingOption struct {
+	ID          int64  `json:"id"`
+	CountryCode string `json:"countryCode"`
+	Name        string `json:"name"`
+	URL         string `json:"url,omitempty"`
+	LogoURL     string `json:"logoUrl,omitempty"`
+}
+
+// StreamingOptions is the where-to-watch listing.
+type StreamingOptions []StreamingOption
+
+// ForCountry returns the options available in a country (case-insensitive
+// ISO 3166 alpha-2 code).
+func (o StreamingOptions) ForCountry(countryCode string) StreamingOptions {
+	var matched StreamingOptions
+	for _, option := range o {
+		if strings.EqualFold(option.CountryCode, countryCode) {
+			matched = append(matched, option)
+		}
+	}
+	return matched
+}
+
+// Countries returns the distinct country codes in the listing, in order of
+// first appearance.
+func (o StreamingOptions) Countries() []string {
+	seen := make(map[string]bool)
+	var countries []string
+	for _, option := range o {
+		if !seen[option.CountryCode] {
+			seen[option.CountryCode] = true
+			countries = append(countries, option.CountryCode)
+		}
+	}
+	return countries
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestScheduleGame_BroadcastsIn(t *testing.T) {
+	var game ScheduleGame
+	payload := `{"id": 2023020204, "gameType": 2, "startTimeUTC": "2023-11-09T00:00:00Z",
+		"awayTeam": {"id": 8, "abbrev": "MTL"}, "homeTeam": {"id": 10, "abbrev": "TOR"}, "gameState": "FUT",
+		"tvBroadcasts": [
+			{"id": 28, "market": "A", "countryCode": "CA", "network": "RDS", "sequenceNumber": 1},
+			{"id": 4, "market": "H", "countryCode": "CA", "network": "TSN4", "sequenceNumber": 2},
+			{"id": 519, "market": "N", "countryCode": "US", "network": "ESPN+", "sequenceNumber": 3}
+		]}`
+	if err := json.Unmarshal([]byte(payload), &game); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	ca := game.BroadcastsIn("ca")
+	if len(ca) != 2 || ca[0].Network != "RDS" || ca[1].Network != "TSN4" {
+		t.Errorf("BroadcastsIn(ca) = %+v", ca)
+	}
+	us := game.BroadcastsIn("US")
+	if len(us) != 1 || !us[0].IsNational() {
+		t.Errorf("BroadcastsIn(US) = %+v, want one national broadcast", us)
+	}
+	if ca[0].IsNational() {
+		t.Error("away-market broadcast should not be national")
+	}
+	if got := BroadcastsIn(nil, "CA"); got != nil {
+		t.Errorf("BroadcastsIn(nil) = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+)
+
+// CareerGame is one game of a career game log, with the season and game
+// type of the log it came from.
+type CareerGame struct {
+	Season   Season
+	GameType GameType
+	GameLog
+}
+
+// CareerSeasons returns, in order, the NHL seasons in which the player has
+// a season total of gameType. A season split between clubs is listed
+// once; seasons in other leagues are left out since the game-log endpoint
+// only covers NHL games.
+func (p *PlayerLanding) CareerSeasons(gameType GameType) []Season {
+	var seasons []Season
+	for _, t := range p.SeasonTotals {
+		if t.LeagueAbbrev == "NHL" && t.GameType == gameType && !slices.Contains(seasons, t.Season) {
+			seasons = append(seasons, t.Season)
+		}
+	}
+	slices.SortFunc(seasons, func(a, b Season) int { return cmp.Compare(a.StartYear(), b.StartYear()) })
+	return seasons
+}
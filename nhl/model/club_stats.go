@@ -1,8 +1,10 @@
-package nhl
+package model
 
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 )
 
@@ -88,6 +90,65 @@ type ClubStats struct {
 	Goalies  []ClubGoalieStats `json:"goalies"`
 }
 
+// SortSkaters sorts the skaters in place, keeping the API order of skaters
+// for which less reports neither before the other.
+func (c *ClubStats) SortSkaters(less func(a, b ClubSkaterStats) bool) {
+	sort.SliceStable(c.Skaters, func(i, j int) bool {
+		return less(c.Skaters[i], c.Skaters[j])
+	})
+}
+
+// SortGoalies sorts the goalies in place, keeping the API order of goalies
+// for which less reports neither before the other.
+func (c *ClubStats) SortGoalies(less func(a, b ClubGoalieStats) bool) {
+	sort.SliceStable(c.Goalies, func(i, j int) bool {
+		return less(c.Goalies[i], c.Goalies[j])
+	})
+}
+
+// TopSkatersByPoints returns the n skaters with the most points, breaking
+// ties by goals, then by fewer games played. It returns every skater when
+// n exceeds their number and leaves c unchanged.
+func (c *ClubStats) TopSkatersByPoints(n int) []ClubSkaterStats {
+	skaters := slices.Clone(c.Skaters)
+	sort.SliceStable(skaters, func(i, j int) bool {
+		a, b := skaters[i], skaters[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.Goals != b.Goals {
+			return a.Goals > b.Goals
+		}
+		return a.GamesPlayed < b.GamesPlayed
+	})
+	return skaters[:clampTop(n, len(skaters))]
+}
+
+// TopGoaliesBySavePct returns the n goalies with the best save percentage
+// among those with at least minGames games played, breaking ties by goals
+// against average. It leaves c unchanged.
+func (c *ClubStats) TopGoaliesBySavePct(n, minGames int) []ClubGoalieStats {
+	var goalies []ClubGoalieStats
+	for _, g := range c.Goalies {
+		if g.GamesPlayed >= minGames {
+			goalies = append(goalies, g)
+		}
+	}
+	sort.SliceStable(goalies, func(i, j int) bool {
+		a, b := goalies[i], goalies[j]
+		if a.SavePercentage != b.SavePercentage {
+			return a.SavePercentage > b.SavePercentage
+		}
+		return a.GoalsAgainstAverage < b.GoalsAgainstAverage
+	})
+	return goalies[:clampTop(n, len(goalies))]
+}
+
+// clampTop bounds a requested top-n count to [0, length].
+func clampTop(n, length int) int {
+	return max(0, min(n, length))
+}
+
 // SeasonGameTypes represents season game type availability for a team.
 type SeasonGameTypes struct {
 	Season    Season     `json:"season"`
@@ -1,8 +1,9 @@
-package nhl
+package model
 
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"testing"
 )
 
@@ -779,3 +780,73 @@ func TestSeasonGameTypes_UnmarshalJSON_InvalidGameType(t *testing.T) {
 		t.Error("UnmarshalJSON() should error on invalid game type")
 	}
 }
+
+func TestClubStatsTopSkatersByPoints(t *testing.T) {
+	stats := ClubStats{Skaters: []ClubSkaterStats{
+		{PlayerID: 1, Points: 40, Goals: 10, GamesPlayed: 60},
+		{PlayerID: 2, Points: 55, Goals: 20, GamesPlayed: 70},
+		{PlayerID: 3, Points: 40, Goals: 15, GamesPlayed: 65},
+		{PlayerID: 4, Points: 40, Goals: 15, GamesPlayed: 50},
+	}}
+
+	top := stats.TopSkatersByPoints(3)
+	var ids []PlayerID
+	for _, s := range top {
+		ids = append(ids, s.PlayerID)
+	}
+	if want := []PlayerID{2, 4, 3}; !slices.Equal(ids, want) {
+		t.Errorf("TopSkatersByPoints(3) = %v, want %v", ids, want)
+	}
+	if stats.Skaters[0].PlayerID != 1 {
+		t.Error("TopSkatersByPoints should not reorder the receiver")
+	}
+	if got := len(stats.TopSkatersByPoints(10)); got != 4 {
+		t.Errorf("len(TopSkatersByPoints(10)) = %d, want 4", got)
+	}
+	if got := len(stats.TopSkatersByPoints(-1)); got != 0 {
+		t.Errorf("len(TopSkatersByPoints(-1)) = %d, want 0", got)
+	}
+}
+
+func TestClubStatsTopGoaliesBySavePct(t *testing.T) {
+	stats := ClubStats{Goalies: []ClubGoalieStats{
+		{PlayerID: 1, GamesPlayed: 50, SavePercentage: 0.910, GoalsAgainstAverage: 2.8},
+		{PlayerID: 2, GamesPlayed: 3, SavePercentage: 0.950, GoalsAgainstAverage: 1.5},
+		{PlayerID: 3, GamesPlayed: 30, SavePercentage: 0.915, GoalsAgainstAverage: 2.6},
+		{PlayerID: 4, GamesPlayed: 20, SavePercentage: 0.910, GoalsAgainstAverage: 2.5},
+	}}
+
+	top := stats.TopGoaliesBySavePct(5, 10)
+	var ids []PlayerID
+	for _, g := range top {
+		ids = append(ids, g.PlayerID)
+	}
+	if want := []PlayerID{3, 4, 1}; !slices.Equal(ids, want) {
+		t.Errorf("TopGoaliesBySavePct(5, 10) = %v, want %v", ids, want)
+	}
+	if got := stats.TopGoaliesBySavePct(1, 0); len(got) != 1 || got[0].PlayerID != 2 {
+		t.Errorf("TopGoaliesBySavePct(1, 0) = %v, want goalie 2", got)
+	}
+}
+
+func TestClubStatsSort(t *testing.T) {
+	stats := ClubStats{
+		Skaters: []ClubSkaterStats{
+			{PlayerID: 1, PenaltyMinutes: 10},
+			{PlayerID: 2, PenaltyMinutes: 30},
+			{PlayerID: 3, PenaltyMinutes: 10},
+		},
+		Goalies: []ClubGoalieStats{
+			{PlayerID: 4, Wins: 12},
+			{PlayerID: 5, Wins: 30},
+		},
+	}
+	stats.SortSkaters(func(a, b ClubSkaterStats) bool { return a.PenaltyMinutes > b.PenaltyMinutes })
+	if stats.Skaters[0].PlayerID != 2 || stats.Skaters[1].PlayerID != 1 || stats.Skaters[2].PlayerID != 3 {
+		t.Errorf("SortSkaters() = %v, want stable order 2, 1, 3", stats.Skaters)
+	}
+	stats.SortGoalies(func(a, b ClubGoalieStats) bool { return a.Wins > b.Wins })
+	if stats.Goalies[0].PlayerID != 5 {
+		t.Errorf("SortGoalies() = %v, want goalie 5 first", stats.Goalies)
+	}
+}
@@ -0,0 +1,169 @@
+package model
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// StatDiscrepancy is a season total of ClubStats that disagrees with the
+// sum of the player's game log.
+type StatDiscrepancy struct {
+	PlayerID PlayerID
+	Name     string
+	// Stat is the ClubStats JSON field, e.g. "goals".
+	Stat      string
+	ClubStats int
+	GameLog   int
+}
+
+// String returns a description like
+// "Cole Caufield (8481540) goals: club stats 28, game log 27".
+func (d StatDiscrepancy) String() string {
+	return fmt.Sprintf("%s (%d) %s: club stats %d, game log %d", d.Name, d.PlayerID, d.Stat, d.ClubStats, d.GameLog)
+}
+
+// ValidateClubStats cross-checks the season totals of clubStats against the
+// summed game logs of its players and returns the totals that disagree,
+// ordered by player ID. A discrepancy usually means one side was
+// corrected upstream after the other was fetched, or a bug in code that
+// aggregates logs.
+//
+// Game logs cover every club a player dressed for, so only the games
+// played for the club are summed. The club is the team most games in logs
+// were played for, since ClubStats does not name it. Players without a
+// log or with a nil one, and logs of players absent from clubStats, are
+// not checked. Stats
+// the game log lacks, such as a goalie's saves, are not checked, and a
+// game-winning, overtime goal or penalty-minute total is only checked when
+// every game of the log reports it.
+//
+// A log of another season or game type is an error.
+func ValidateClubStats(clubStats *ClubStats, logs map[PlayerID]*PlayerGameLog) ([]StatDiscrepancy, error) {
+	for id, log := range logs {
+		if log == nil {
+			continue
+		}
+		if log.Season != clubStats.Season || log.GameType != clubStats.GameType {
+			return nil, fmt.Errorf("game log of player %d is for %s %s, club stats for %s %s",
+				id, log.Season, log.GameType, clubStats.Season, clubStats.GameType)
+		}
+	}
+	team := clubOfLogs(clubStats, logs)
+
+	var found []StatDiscrepancy
+	compare := func(id PlayerID, first, last LocalizedString, stat string, club, logged int) {
+		if club != logged {
+			found = append(found, StatDiscrepancy{
+				PlayerID:  id,
+				Name:      first.Default + " " + last.Default,
+				Stat:      stat,
+				ClubStats: club,
+				GameLog:   logged,
+			})
+		}
+	}
+	for _, s := range clubStats.Skaters {
+		log := logs[s.PlayerID]
+		if log == nil {
+			continue
+		}
+		sum := sumGameLog(log, team)
+		check := func(stat string, club, logged int) { compare(s.PlayerID, s.FirstName, s.LastName, stat, club, logged) }
+		check("gamesPlayed", s.GamesPlayed, sum.games)
+		check("goals", s.Goals, sum.Goals)
+		check("assists", s.Assists, sum.Assists)
+		check("points", s.Points, sum.Points)
+		check("plusMinus", s.PlusMinus, sum.PlusMinus)
+		check("powerPlayGoals", s.PowerPlayGoals, sum.PowerPlayGoals)
+		check("shots", s.Shots, sum.Shots)
+		if sum.GameWinningGoals != nil {
+			check("gameWinningGoals", s.GameWinningGoals, *sum.GameWinningGoals)
+		}
+		if sum.OTGoals != nil {
+			check("overtimeGoals", s.OvertimeGoals, *sum.OTGoals)
+		}
+		if sum.PIM != nil {
+			check("penaltyMinutes", s.PenaltyMinutes, *sum.PIM)
+		}
+	}
+	for _, g := range clubStats.Goalies {
+		log := logs[g.PlayerID]
+		if log == nil {
+			continue
+		}
+		sum := sumGameLog(log, team)
+		check := func(stat string, club, logged int) { compare(g.PlayerID, g.FirstName, g.LastName, stat, club, logged) }
+		check("gamesPlayed", g.GamesPlayed, sum.games)
+		check("goals", g.Goals, sum.Goals)
+		check("assists", g.Assists, sum.Assists)
+		check("points", g.Points, sum.Points)
+		if sum.PIM != nil {
+			check("penaltyMinutes", g.PenaltyMinutes, *sum.PIM)
+		}
+	}
+
+	slices.SortStableFunc(found, func(a, b StatDiscrepancy) int { return cmp.Compare(a.PlayerID, b.PlayerID) })
+	return found, nil
+}
+
+// gameLogSum is a game log summed over the games of one club. Optional
+// totals are nil when some game does not report them.
+type gameLogSum struct {
+	GameLog
+	games int
+}
+
+func sumGameLog(log *PlayerGameLog, team string) gameLogSum {
+	sum := gameLogSum{}
+	sum.GameWinningGoals, sum.OTGoals, sum.PIM = new(int), new(int), new(int)
+	add := func(total **int, v *int) {
+		if *total == nil || v == nil {
+			*total = nil
+			return
+		}
+		**total += *v
+	}
+	for _, g := range log.GameLog {
+		if team != "" && g.TeamAbbrev != team {
+			continue
+		}
+		sum.games++
+		sum.Goals += g.Goals
+		sum.Assists += g.Assists
+		sum.Points += g.Points
+		sum.PlusMinus += g.PlusMinus
+		sum.PowerPlayGoals += g.PowerPlayGoals
+		sum.Shots += g.Shots
+		add(&sum.GameWinningGoals, g.GameWinningGoals)
+		add(&sum.OTGoals, g.OTGoals)
+		add(&sum.PIM, g.PIM)
+	}
+	return sum
+}
+
+// clubOfLogs returns the team most games were played for across the logs
+// of the players in clubStats, or "" when there are none.
+func clubOfLogs(clubStats *ClubStats, logs map[PlayerID]*PlayerGameLog) string {
+	counts := make(map[string]int)
+	count := func(id PlayerID) {
+		if log := logs[id]; log != nil {
+			for _, g := range log.GameLog {
+				counts[g.TeamAbbrev]++
+			}
+		}
+	}
+	for _, s := range clubStats.Skaters {
+		count(s.PlayerID)
+	}
+	for _, g := range clubStats.Goalies {
+		count(g.PlayerID)
+	}
+	best := ""
+	for team, n := range counts {
+		if n > counts[best] || (n == counts[best] && team < best) {
+			best = team
+		}
+	}
+	return best
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidateClubStats(t *testing.T) {
+	season := NewSeason(2024)
+	pim := func(n int) *int { return &n }
+	stats := &ClubStats{
+		Season:   season,
+		GameType: GameTypeRegularSeason,
+		Skaters: []ClubSkaterStats{
+			{PlayerID: 8481540, FirstName: LocalizedString{Default: "Cole"}, LastName: LocalizedString{Default: "Caufield"},
+				GamesPlayed: 2, Goals: 3, Assists: 1, Points: 4, Shots: 9, PenaltyMinutes: 2},
+			{PlayerID: 8480018, FirstName: LocalizedString{Default: "Nick"}, LastName: LocalizedString{Default: "Suzuki"},
+				GamesPlayed: 1, Goals: 1, Points: 1},
+			{PlayerID: 8476875, GamesPlayed: 9},
+		},
+		Goalies: []ClubGoalieStats{
+			{PlayerID: 8478470, FirstName: LocalizedString{Default: "Sam"}, LastName: LocalizedString{Default: "Montembeault"},
+				GamesPlayed: 1, Assists: 1, Points: 1},
+		},
+	}
+	logs := map[PlayerID]*PlayerGameLog{
+		8481540: {Season: season, GameType: GameTypeRegularSeason, GameLog: []GameLog{
+			{TeamAbbrev: "MTL", Goals: 2, Assists: 1, Points: 3, Shots: 5, PIM: pim(2)},
+			{TeamAbbrev: "MTL", Goals: 1, Points: 1, Shots: 3, PIM: pim(0)},
+		}},
+		// Traded in: the game for another club does not count.
+		8480018: {Season: season, GameType: GameTypeRegularSeason, GameLog: []GameLog{
+			{TeamAbbrev: "CHI", Goals: 4, Points: 4},
+			{TeamAbbrev: "MTL", Goals: 1, Points: 1},
+		}},
+		8478470: {Season: season, GameType: GameTypeRegularSeason, GameLog: []GameLog{
+			{TeamAbbrev: "MTL", Assists: 1, Points: 1},
+		}},
+		// A nil log is skipped like a missing one.
+		8476875: nil,
+	}
+
+	got, err := ValidateClubStats(stats, logs)
+	if err != nil {
+		t.Fatalf("ValidateClubStats() error = %v", err)
+	}
+	want := "[Cole Caufield (8481540) shots: club stats 9, game log 8]"
+	if fmt.Sprint(got) != want {
+		t.Errorf("ValidateClubStats() = %v, want %v", got, want)
+	}
+}
+
+func TestValidateClubStats_SeasonMismatch(t *testing.T) {
+	stats := &ClubStats{Season: NewSeason(2024), GameType: GameTypeRegularSeason}
+	logs := map[PlayerID]*PlayerGameLog{
+		8481540: {Season: NewSeason(2024), GameType: GameTypePlayoffs},
+	}
+	if _, err := ValidateClubStats(stats, logs); err == nil || !strings.Contains(err.Error(), "8481540") {
+		t.Errorf("ValidateClubStats() error = %v, want game type mismatch", err)
+	}
+}
@@ -1,20 +1,72 @@
-package nhl
+package model
 
 import (
 	"encoding/json"
 	"fmt"
+	"iter"
+	"slices"
 	"strings"
 	"time"
 )
 
 // LocalizedString represents a localized string from the NHL API.
-// The NHL API returns localized strings in the format: {"default": "value"}
+// The NHL API returns localized strings in the format: {"default": "value"},
+// optionally with language variants such as {"default": "value", "fr": "valeur"}.
+// Every variant the API is known to send is kept; a variant the payload
+// lacks is empty.
 type LocalizedString struct {
 	Default string `json:"default"`
+	Fr      string `json:"fr,omitempty"`
+	Cs      string `json:"cs,omitempty"`
+	De      string `json:"de,omitempty"`
+	Es      string `json:"es,omitempty"`
+	Fi      string `json:"fi,omitempty"`
+	Sk      string `json:"sk,omitempty"`
+	Sv      string `json:"sv,omitempty"`
+
+	lang Language // the language String reads; see SetLanguage
 }
 
-// String returns the default localized string value.
+// localizedStringJSON has the fields and tags of LocalizedString without
+// its methods, for encoding the object form.
+type localizedStringJSON LocalizedString
+
+// String returns the variant for the language set with SetLanguage, or
+// Default. Clients configured with a language other than English set it
+// on every decoded string, see WithConfigLanguage; Default keeps the
+// English text.
 func (l LocalizedString) String() string {
+	return l.Get(l.lang)
+}
+
+// SetLanguage sets the language that String reads.
+func (l *LocalizedString) SetLanguage(lang Language) {
+	l.lang = lang
+}
+
+// Get returns the variant for the given language, falling back to Default
+// when the payload has no variant for it.
+func (l LocalizedString) Get(lang Language) string {
+	var variant string
+	switch lang {
+	case LanguageFrench:
+		variant = l.Fr
+	case LanguageCzech:
+		variant = l.Cs
+	case LanguageGerman:
+		variant = l.De
+	case LanguageSpanish:
+		variant = l.Es
+	case LanguageFinnish:
+		variant = l.Fi
+	case LanguageSlovak:
+		variant = l.Sk
+	case LanguageSwedish:
+		variant = l.Sv
+	}
+	if variant != "" {
+		return variant
+	}
 	return l.Default
 }
 
@@ -22,11 +74,9 @@ func (l LocalizedString) String() string {
 // It handles both the standard {"default": "value"} format and plain string values.
 func (l *LocalizedString) UnmarshalJSON(data []byte) error {
 	// Try to unmarshal as an object first
-	var obj struct {
-		Default string `json:"default"`
-	}
+	var obj localizedStringJSON
 	if err := json.Unmarshal(data, &obj); err == nil {
-		l.Default = obj.Default
+		*l = LocalizedString(obj)
 		return nil
 	}
 
@@ -35,17 +85,13 @@ func (l *LocalizedString) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &s); err != nil {
 		return fmt.Errorf("failed to unmarshal LocalizedString: %w", err)
 	}
-	l.Default = s
+	*l = LocalizedString{Default: s}
 	return nil
 }
 
 // MarshalJSON implements custom JSON marshaling for LocalizedString.
 func (l LocalizedString) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		Default string `json:"default"`
-	}{
-		Default: l.Default,
-	})
+	return json.Marshal(localizedStringJSON(l))
 }
 
 // Conference represents an NHL conference.
@@ -68,6 +114,44 @@ type Franchise struct {
 	TeamPlaceName  string `json:"teamPlaceName"`
 }
 
+// LeagueIDNHL is the stats API's league ID for the NHL.
+const LeagueIDNHL = 133
+
+// StatsTeam is a row of the stats API team table, which lists every club
+// in league history, including defunct and relocated ones. FranchiseID is
+// nil for entries outside any franchise, such as placeholder teams.
+type StatsTeam struct {
+	ID          TeamID `json:"id"`
+	FranchiseID *int64 `json:"franchiseId"`
+	FullName    string `json:"fullName"`
+	LeagueID    int64  `json:"leagueId"`
+	RawTricode  string `json:"rawTricode"`
+	Tricode     string `json:"triCode"`
+}
+
+// IsNHL reports whether the team played in the NHL.
+func (t StatsTeam) IsNHL() bool {
+	return t.LeagueID == LeagueIDNHL
+}
+
+// ToTeam converts the row to a Team. The table has no names by part, logo,
+// conference or division, so those are left empty.
+func (t StatsTeam) ToTeam() Team {
+	team := Team{
+		ID:         t.ID,
+		FullName:   t.FullName,
+		RawTricode: t.RawTricode,
+		Tricode:    t.Tricode,
+	}
+	if t.FranchiseID != nil {
+		team.FranchiseID = *t.FranchiseID
+	}
+	if t.IsNHL() {
+		team.LeagueAbbrev = "NHL"
+	}
+	return team
+}
+
 // Team represents an NHL team with all its metadata.
 type Team struct {
 	ID             TeamID          `json:"id"`
@@ -88,6 +172,10 @@ type Roster struct {
 	Forwards   []RosterPlayer `json:"forwards"`
 	Defensemen []RosterPlayer `json:"defensemen"`
 	Goalies    []RosterPlayer `json:"goalies"`
+	// FranchiseID is the team's franchise, matching Franchise.ID. The API
+	// does not send it; RosterCurrent and RosterSeason set it from
+	// DefaultTeams, and leave it zero for a team the registry does not know.
+	FranchiseID int64 `json:"franchiseId,omitempty"`
 }
 
 // AllPlayers returns all players on the roster in a single slice.
@@ -104,6 +192,62 @@ func (r *Roster) PlayerCount() int {
 	return len(r.Forwards) + len(r.Defensemen) + len(r.Goalies)
 }
 
+// All iterates over every player on the roster: forwards, then defensemen,
+// then goalies.
+func (r *Roster) All() iter.Seq[RosterPlayer] {
+	return concatPlayers(r.Forwards, r.Defensemen, r.Goalies)
+}
+
+// Skaters iterates over the forwards, then the defensemen.
+func (r *Roster) Skaters() iter.Seq[RosterPlayer] {
+	return concatPlayers(r.Forwards, r.Defensemen)
+}
+
+// ByPosition returns the players at a position. PositionForward returns
+// all forwards; PositionCenter, PositionLeftWing and PositionRightWing
+// return the forwards listed at that position.
+func (r *Roster) ByPosition(position Position) []RosterPlayer {
+	switch position {
+	case PositionForward:
+		return slices.Clone(r.Forwards)
+	case PositionDefense:
+		return slices.Clone(r.Defensemen)
+	case PositionGoalie:
+		return slices.Clone(r.Goalies)
+	}
+	var players []RosterPlayer
+	for _, p := range r.Forwards {
+		if p.Position == position {
+			players = append(players, p)
+		}
+	}
+	return players
+}
+
+// Find returns the player with the given ID, reporting whether the player
+// is on the roster.
+func (r *Roster) Find(id PlayerID) (RosterPlayer, bool) {
+	for p := range r.All() {
+		if p.ID == id {
+			return p, true
+		}
+	}
+	return RosterPlayer{}, false
+}
+
+// concatPlayers iterates over groups in order.
+func concatPlayers(groups ...[]RosterPlayer) iter.Seq[RosterPlayer] {
+	return func(yield func(RosterPlayer) bool) {
+		for _, group := range groups {
+			for _, p := range group {
+				if !yield(p) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // RosterPlayer represents a player on a team's roster.
 type RosterPlayer struct {
 	ID                 PlayerID         `json:"id"`
@@ -118,7 +262,7 @@ type RosterPlayer struct {
 	BirthDate          string           `json:"birthDate"`
 	BirthCity          *LocalizedString `json:"birthCity,omitempty"`
 	BirthStateProvince *LocalizedString `json:"birthStateProvince,omitempty"`
-	BirthCountry       string           `json:"birthCountry"`
+	BirthCountry       Country          `json:"birthCountry"`
 }
 
 // FullName returns the player's full name (first name + last name).
@@ -170,8 +314,18 @@ func (p *RosterPlayer) BirthPlace() string {
 	}
 
 	if p.BirthCountry != "" {
-		parts = append(parts, p.BirthCountry)
+		parts = append(parts, string(p.BirthCountry))
 	}
 
 	return strings.Join(parts, ", ")
 }
+
+// FranchisesResponse represents the API response for franchises.
+type FranchisesResponse struct {
+	Data []Franchise `json:"data"`
+}
+
+// StatsTeamsResponse represents the API response for the stats team table.
+type StatsTeamsResponse struct {
+	Data []StatsTeam `json:"data"`
+}
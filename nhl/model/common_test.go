@@ -1,7 +1,9 @@
-package nhl
+package model
 
 import (
 	"encoding/json"
+	"iter"
+	"slices"
 	"testing"
 	"time"
 )
@@ -715,6 +717,68 @@ func TestRoster_AllPlayers(t *testing.T) {
 	}
 }
 
+// TestRoster_Iterators tests All, Skaters, ByPosition and Find.
+func TestRoster_Iterators(t *testing.T) {
+	roster := Roster{
+		Forwards: []RosterPlayer{
+			{ID: 1, Position: PositionCenter},
+			{ID: 2, Position: PositionLeftWing},
+			{ID: 3, Position: PositionCenter},
+		},
+		Defensemen: []RosterPlayer{
+			{ID: 4, Position: PositionDefense},
+		},
+		Goalies: []RosterPlayer{
+			{ID: 5, Position: PositionGoalie},
+		},
+	}
+
+	ids := func(seq iter.Seq[RosterPlayer]) []PlayerID {
+		var out []PlayerID
+		for p := range seq {
+			out = append(out, p.ID)
+		}
+		return out
+	}
+	if got := ids(roster.All()); !slices.Equal(got, []PlayerID{1, 2, 3, 4, 5}) {
+		t.Errorf("All() = %v", got)
+	}
+	if got := ids(roster.Skaters()); !slices.Equal(got, []PlayerID{1, 2, 3, 4}) {
+		t.Errorf("Skaters() = %v", got)
+	}
+	for p := range roster.All() {
+		if p.ID != 1 {
+			t.Errorf("iteration should stop on break, got %d", p.ID)
+		}
+		break
+	}
+
+	byPosition := map[Position][]PlayerID{
+		PositionForward:   {1, 2, 3},
+		PositionCenter:    {1, 3},
+		PositionLeftWing:  {2},
+		PositionRightWing: nil,
+		PositionDefense:   {4},
+		PositionGoalie:    {5},
+	}
+	for position, want := range byPosition {
+		if got := ids(slices.Values(roster.ByPosition(position))); !slices.Equal(got, want) {
+			t.Errorf("ByPosition(%s) = %v, want %v", position, got, want)
+		}
+	}
+	roster.ByPosition(PositionGoalie)[0].ID = 99
+	if roster.Goalies[0].ID != 5 {
+		t.Error("ByPosition should return a copy")
+	}
+
+	if p, ok := roster.Find(4); !ok || p.Position != PositionDefense {
+		t.Errorf("Find(4) = %+v, %v", p, ok)
+	}
+	if _, ok := roster.Find(42); ok {
+		t.Error("Find(42) should report false")
+	}
+}
+
 // TestRoster_PlayerCount tests the PlayerCount method.
 func TestRoster_PlayerCount(t *testing.T) {
 	tests := []struct {
@@ -835,3 +899,39 @@ func TestRoster_MarshalUnmarshalRoundTrip(t *testing.T) {
 		t.Errorf("expected forward name 'David Pastrnak', got %q", decoded.Forwards[0].FullName())
 	}
 }
+
+func TestLocalizedString_French(t *testing.T) {
+	var ls LocalizedString
+	if err := json.Unmarshal([]byte(`{"default": "Montreal Canadiens", "fr": "Canadiens de Montréal"}`), &ls); err != nil {
+		t.Fatalf("Unmarshal error = %v", err)
+	}
+
+	if ls.Default != "Montreal Canadiens" {
+		t.Errorf("Default = %q, want %q", ls.Default, "Montreal Canadiens")
+	}
+	if got := ls.Get(LanguageFrench); got != "Canadiens de Montréal" {
+		t.Errorf("Get(fr) = %q, want %q", got, "Canadiens de Montréal")
+	}
+	if got := ls.Get(LanguageEnglish); got != "Montreal Canadiens" {
+		t.Errorf("Get(en) = %q, want %q", got, "Montreal Canadiens")
+	}
+
+	data, err := json.Marshal(ls)
+	if err != nil {
+		t.Fatalf("Marshal error = %v", err)
+	}
+	var decoded LocalizedString
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal round trip error = %v", err)
+	}
+	if decoded != ls {
+		t.Errorf("round trip = %+v, want %+v", decoded, ls)
+	}
+}
+
+func TestLocalizedString_GetFallback(t *testing.T) {
+	ls := LocalizedString{Default: "McDavid"}
+	if got := ls.Get(LanguageFrench); got != "McDavid" {
+		t.Errorf("Get(fr) = %q, want fallback to Default", got)
+	}
+}
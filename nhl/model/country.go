@@ -0,0 +1,176 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Country is a three-letter country code as the API uses for birthplaces,
+// e.g. "CAN" or "SWE" (ISO 3166-1 alpha-3). Decoding keeps any code as
+// sent, since the API occasionally uses codes outside the known set;
+// Validate or CountryFromString check a code strictly and
+// CountryFromStringLenient accepts rare ones.
+type Country string
+
+// The countries most NHL players come from.
+const (
+	CountryCanada        Country = "CAN"
+	CountryUSA           Country = "USA"
+	CountrySweden        Country = "SWE"
+	CountryFinland       Country = "FIN"
+	CountryRussia        Country = "RUS"
+	CountryCzechia       Country = "CZE"
+	CountrySlovakia      Country = "SVK"
+	CountrySwitzerland   Country = "CHE"
+	CountryGermany       Country = "DEU"
+	CountryLatvia        Country = "LVA"
+	CountryDenmark       Country = "DNK"
+	CountryNorway        Country = "NOR"
+	CountryAustria       Country = "AUT"
+	CountryBelarus       Country = "BLR"
+	CountrySlovenia      Country = "SVN"
+	CountryFrance        Country = "FRA"
+	CountryKazakhstan    Country = "KAZ"
+	CountryUkraine       Country = "UKR"
+	CountryUnitedKingdom Country = "GBR"
+)
+
+// countryInfo is a known country's ISO 3166-1 alpha-2 code, used for its
+// flag, and English name.
+type countryInfo struct {
+	alpha2 string
+	name   string
+}
+
+var knownCountries = map[Country]countryInfo{
+	CountryCanada:        {"CA", "Canada"},
+	CountryUSA:           {"US", "United States"},
+	CountrySweden:        {"SE", "Sweden"},
+	CountryFinland:       {"FI", "Finland"},
+	CountryRussia:        {"RU", "Russia"},
+	CountryCzechia:       {"CZ", "Czechia"},
+	CountrySlovakia:      {"SK", "Slovakia"},
+	CountrySwitzerland:   {"CH", "Switzerland"},
+	CountryGermany:       {"DE", "Germany"},
+	CountryLatvia:        {"LV", "Latvia"},
+	CountryDenmark:       {"DK", "Denmark"},
+	CountryNorway:        {"NO", "Norway"},
+	CountryAustria:       {"AT", "Austria"},
+	CountryBelarus:       {"BY", "Belarus"},
+	CountrySlovenia:      {"SI", "Slovenia"},
+	CountryFrance:        {"FR", "France"},
+	CountryKazakhstan:    {"KZ", "Kazakhstan"},
+	CountryUkraine:       {"UA", "Ukraine"},
+	CountryUnitedKingdom: {"GB", "United Kingdom"},
+	"AUS":                {"AU", "Australia"},
+	"BEL":                {"BE", "Belgium"},
+	"BRA":                {"BR", "Brazil"},
+	"CHN":                {"CN", "China"},
+	"EST":                {"EE", "Estonia"},
+	"HRV":                {"HR", "Croatia"},
+	"HUN":                {"HU", "Hungary"},
+	"IRL":                {"IE", "Ireland"},
+	"ITA":                {"IT", "Italy"},
+	"JAM":                {"JM", "Jamaica"},
+	"JPN":                {"JP", "Japan"},
+	"KOR":                {"KR", "South Korea"},
+	"LTU":                {"LT", "Lithuania"},
+	"MEX":                {"MX", "Mexico"},
+	"NGA":                {"NG", "Nigeria"},
+	"NLD":                {"NL", "Netherlands"},
+	"POL":                {"PL", "Poland"},
+	"SRB":                {"RS", "Serbia"},
+	"TWN":                {"TW", "Taiwan"},
+	"TZA":                {"TZ", "Tanzania"},
+	"VEN":                {"VE", "Venezuela"},
+	"ZAF":                {"ZA", "South Africa"},
+}
+
+// String returns the code.
+func (c Country) String() string {
+	return string(c)
+}
+
+// IsKnown reports whether c is one of the countries this package knows.
+func (c Country) IsKnown() bool {
+	_, ok := knownCountries[c]
+	return ok
+}
+
+// Validate reports an empty, malformed or unknown code.
+func (c Country) Validate() error {
+	switch {
+	case c == "":
+		return fmt.Errorf("country code is empty")
+	case !wellFormedCountry(string(c)):
+		return fmt.Errorf("invalid country code %q (expected three upper-case letters)", string(c))
+	case !c.IsKnown():
+		return fmt.Errorf("unknown country code %q", string(c))
+	}
+	return nil
+}
+
+// Name returns the country's English name, e.g. "Czechia", or the code
+// itself for an unknown country.
+func (c Country) Name() string {
+	if info, ok := knownCountries[c]; ok {
+		return info.name
+	}
+	return string(c)
+}
+
+// FlagEmoji returns the country's flag as regional indicator symbols, e.g.
+// "🇨🇦" for CAN, or "" for an unknown country.
+func (c Country) FlagEmoji() string {
+	info, ok := knownCountries[c]
+	if !ok {
+		return ""
+	}
+	var b strings.Builder
+	for _, r := range info.alpha2 {
+		b.WriteRune('🇦' + r - 'A')
+	}
+	return b.String()
+}
+
+// CountryFromString parses a known country's code or English name in any
+// case, e.g. "swe" or "Sweden".
+func CountryFromString(s string) (Country, error) {
+	s = strings.TrimSpace(s)
+	if c := Country(strings.ToUpper(s)); c.IsKnown() {
+		return c, nil
+	}
+	for c, info := range knownCountries {
+		if strings.EqualFold(info.name, s) {
+			return c, nil
+		}
+	}
+	return "", fmt.Errorf("unknown country: %q", s)
+}
+
+// CountryFromStringLenient parses a country like CountryFromString, but
+// also accepts any three-letter code, for the rare countries this package
+// does not list.
+func CountryFromStringLenient(s string) (Country, error) {
+	if c, err := CountryFromString(s); err == nil {
+		return c, nil
+	}
+	code := strings.ToUpper(strings.TrimSpace(s))
+	if !wellFormedCountry(code) {
+		return "", fmt.Errorf("invalid country: %q", s)
+	}
+	return Country(code), nil
+}
+
+// wellFormedCountry reports whether s is three upper-case ASCII letters.
+func wellFormedCountry(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for i := range len(s) {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCountry(t *testing.T) {
+	if CountryCanada.Name() != "Canada" || CountryCanada.FlagEmoji() != "🇨🇦" {
+		t.Errorf("CAN = %s %s", CountryCanada.Name(), CountryCanada.FlagEmoji())
+	}
+	if CountryCzechia.FlagEmoji() != "🇨🇿" {
+		t.Errorf("CZE flag = %s", CountryCzechia.FlagEmoji())
+	}
+	rare := Country("XKX")
+	if rare.IsKnown() || rare.Name() != "XKX" || rare.FlagEmoji() != "" {
+		t.Errorf("unknown country = %v %s %q", rare.IsKnown(), rare.Name(), rare.FlagEmoji())
+	}
+	for _, c := range []Country{"", "ca", "CANA", "XKX"} {
+		if c.Validate() == nil {
+			t.Errorf("Validate(%q) = nil", c)
+		}
+	}
+	if err := CountrySweden.Validate(); err != nil {
+		t.Errorf("Validate(SWE) = %v", err)
+	}
+}
+
+func TestCountryFromString(t *testing.T) {
+	tests := []struct {
+		in          string
+		strict      Country
+		lenient     Country
+		lenientFail bool
+	}{
+		{"CAN", CountryCanada, CountryCanada, false},
+		{"swe", CountrySweden, CountrySweden, false},
+		{"Finland", CountryFinland, CountryFinland, false},
+		{"xkx", "", "XKX", false},
+		{"Atlantis", "", "", true},
+		{"", "", "", true},
+	}
+	for _, tt := range tests {
+		got, err := CountryFromString(tt.in)
+		if got != tt.strict || (err != nil) != (tt.strict == "") {
+			t.Errorf("CountryFromString(%q) = %q, %v", tt.in, got, err)
+		}
+		got, err = CountryFromStringLenient(tt.in)
+		if got != tt.lenient || (err != nil) != tt.lenientFail {
+			t.Errorf("CountryFromStringLenient(%q) = %q, %v", tt.in, got, err)
+		}
+	}
+}
+
+func TestCountry_JSON(t *testing.T) {
+	var p RosterPlayer
+	if err := json.Unmarshal([]byte(`{"birthCountry":"XKX"}`), &p); err != nil {
+		t.Fatalf("Unmarshal(rare code) error = %v", err)
+	}
+	if p.BirthCountry != "XKX" {
+		t.Errorf("BirthCountry = %q", p.BirthCountry)
+	}
+	var r PlayerSearchResult
+	if err := json.Unmarshal([]byte(`{"birthCountry":"SVK"}`), &r); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if r.BirthCountry == nil || r.BirthCountry.Name() != "Slovakia" {
+		t.Errorf("BirthCountry = %v", r.BirthCountry)
+	}
+}
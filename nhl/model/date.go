@@ -1,10 +1,11 @@
-package nhl
+package model
 
 import (
 	"bytes"
 	"encoding/gob"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"strconv"
 	"strings"
 	"time"
@@ -34,7 +35,9 @@ func DateFromTime(t time.Time) Date {
 	return NewDate(t.Year(), t.Month(), t.Day())
 }
 
-// ParseDate parses a date string in YYYY-MM-DD format.
+// ParseDate parses a date string in YYYY-MM-DD format. Unlike decoding a
+// GameDate from JSON, it rejects out-of-range months and days and any other
+// layout.
 func ParseDate(s string) (Date, error) {
 	t, err := time.Parse(DateLayout, s)
 	if err != nil {
@@ -137,10 +140,30 @@ func (gd GameDate) Date() time.Time {
 }
 
 // APIString converts the GameDate to the API format (YYYY-MM-DD).
-// If IsNow is true, uses the current date.
+// If IsNow is true, uses the current date. ParseDate reads it back.
 func (gd GameDate) APIString() string {
-	d := gd.Date()
-	return fmt.Sprintf("%04d-%02d-%02d", d.Year(), d.Month(), d.Day())
+	return gd.Date().Format(DateLayout)
+}
+
+// MonthLayout is the format of the month in API paths, such as monthly
+// team schedules.
+const MonthLayout = "2006-01"
+
+// APIMonthString returns the month of the GameDate in the API format
+// (YYYY-MM). If IsNow is true, uses the current month. ParseAPIMonth reads
+// it back.
+func (gd GameDate) APIMonthString() string {
+	return gd.Date().Format(MonthLayout)
+}
+
+// ParseAPIMonth parses a month in YYYY-MM form as the first day of the
+// month. It rejects out-of-range months and any other layout.
+func ParseAPIMonth(s string) (GameDate, error) {
+	t, err := time.Parse(MonthLayout, s)
+	if err != nil {
+		return GameDate{}, fmt.Errorf("invalid month %q: %w", s, err)
+	}
+	return FromDate(t), nil
 }
 
 // AddDays returns a new GameDate with the specified number of days added.
@@ -151,6 +174,64 @@ func (gd GameDate) AddDays(days int) GameDate {
 	return FromDate(newDate)
 }
 
+// Next returns the following day.
+func (gd GameDate) Next() GameDate {
+	return gd.AddDays(1)
+}
+
+// Prev returns the previous day.
+func (gd GameDate) Prev() GameDate {
+	return gd.AddDays(-1)
+}
+
+// Before reports whether gd falls on an earlier calendar day than other.
+// Times of day are ignored; Now resolves to the current date.
+func (gd GameDate) Before(other GameDate) bool {
+	return gd.day().Before(other.day())
+}
+
+// After reports whether gd falls on a later calendar day than other.
+// Times of day are ignored; Now resolves to the current date.
+func (gd GameDate) After(other GameDate) bool {
+	return gd.day().After(other.day())
+}
+
+// Validate reports a GameDate that was never set or that falls before the
+// league's first season. Now is always valid.
+func (gd GameDate) Validate() error {
+	if gd.isNow {
+		return nil
+	}
+	if gd.date.IsZero() {
+		return fmt.Errorf("game date is not set")
+	}
+	if gd.date.Year() < firstSeasonStartYear {
+		return fmt.Errorf("game date %s is before the first NHL season (%d)", gd, firstSeasonStartYear)
+	}
+	return nil
+}
+
+// day returns midnight UTC of the date's calendar day.
+func (gd GameDate) day() time.Time {
+	d := gd.Date()
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// ParseGameDate parses a date in YYYY-MM-DD form, or "now" for Now.
+//
+// Deprecated: Use ParseDate, which validates the same way, and FromDate;
+// callers that accept "now" should map it to Now themselves.
+func ParseGameDate(s string) (GameDate, error) {
+	if s == "now" {
+		return Now(), nil
+	}
+	d, err := ParseDate(s)
+	if err != nil {
+		return GameDate{}, err
+	}
+	return FromDate(d.Time), nil
+}
+
 // String implements the fmt.Stringer interface.
 func (gd GameDate) String() string {
 	if gd.isNow {
@@ -226,11 +307,75 @@ func (gd *GameDate) GobDecode(data []byte) error {
 	return dec.Decode(&gd.date)
 }
 
+// DateRange is an inclusive range of calendar days.
+type DateRange struct {
+	Start GameDate
+	End   GameDate
+}
+
+// NewDateRange returns the range from start to end, both included. An end
+// before start is an error.
+func NewDateRange(start, end GameDate) (DateRange, error) {
+	if end.Before(start) {
+		return DateRange{}, fmt.Errorf("invalid date range: %s is before %s", end, start)
+	}
+	return DateRange{Start: start, End: end}, nil
+}
+
+// Days returns the number of days in the range, or 0 when End is before
+// Start.
+func (r DateRange) Days() int {
+	end, start := r.End.day(), r.Start.day()
+	if end.Before(start) {
+		return 0
+	}
+	return int(end.Sub(start).Hours()/24) + 1
+}
+
+// Contains reports whether d falls within the range.
+func (r DateRange) Contains(d GameDate) bool {
+	return !d.Before(r.Start) && !d.After(r.End)
+}
+
+// All iterates over the days of the range in order, as dates at midnight
+// UTC.
+func (r DateRange) All() iter.Seq[GameDate] {
+	return func(yield func(GameDate) bool) {
+		end := r.End.day()
+		for d := r.Start.day(); !d.After(end); d = d.AddDate(0, 0, 1) {
+			if !yield(FromDate(d)) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the range as "2024-01-08..2024-01-14".
+func (r DateRange) String() string {
+	return r.Start.String() + ".." + r.End.String()
+}
+
 // Season represents an NHL season.
 type Season struct {
 	startYear int
 }
 
+// firstSeasonStartYear is the start year of the league's first season,
+// 1917-1918.
+const firstSeasonStartYear = 1917
+
+// Validate reports a season that starts before the league's first season
+// or whose ID would not have eight digits.
+func (s Season) Validate() error {
+	if s.startYear < firstSeasonStartYear {
+		return fmt.Errorf("season %s is before the first NHL season (%d-%d)", s, firstSeasonStartYear, firstSeasonStartYear+1)
+	}
+	if s.startYear > 9998 {
+		return fmt.Errorf("season start year %d is out of range", s.startYear)
+	}
+	return nil
+}
+
 // NewSeason creates a new Season from a start year.
 func NewSeason(startYear int) Season {
 	return Season{startYear: startYear}
@@ -259,8 +404,24 @@ func (s Season) EndYear() int {
 
 // APIString converts the Season to the API format (YYYYYYYY).
 // For example, the 2023-2024 season is represented as "20232024".
+// ParseAPISeason reads it back.
 func (s Season) APIString() string {
-	return fmt.Sprintf("%d%d", s.startYear, s.EndYear())
+	return strconv.Itoa(s.ID())
+}
+
+// ParseAPISeason parses a season in the API format (YYYYYYYY), such as
+// "20232024". Unlike Parse, it accepts no other layout, and it rejects
+// signs and years that do not follow each other.
+func ParseAPISeason(s string) (Season, error) {
+	if len(s) != 8 || strings.Trim(s, "0123456789") != "" {
+		return Season{}, fmt.Errorf("invalid season %q: expected YYYYYYYY", s)
+	}
+	startYear, _ := strconv.Atoi(s[:4])
+	endYear, _ := strconv.Atoi(s[4:])
+	if endYear != startYear+1 {
+		return Season{}, fmt.Errorf("invalid season %q: %d does not follow %d", s, endYear, startYear)
+	}
+	return NewSeason(startYear), nil
 }
 
 // String implements the fmt.Stringer interface.
@@ -325,8 +486,66 @@ func Parse(s string) (Season, error) {
 	return Season{}, fmt.Errorf("invalid season format: %s", s)
 }
 
-// Current returns the current NHL season based on the current date.
-// The NHL season typically starts in October and ends in June.
+// Prev returns the season before s.
+func (s Season) Prev() Season {
+	return Season{startYear: s.startYear - 1}
+}
+
+// Next returns the season after s.
+func (s Season) Next() Season {
+	return Season{startYear: s.startYear + 1}
+}
+
+// Contains reports whether date falls within the season, as decided by
+// SeasonOf.
+func (s Season) Contains(date GameDate) bool {
+	return SeasonOf(date) == s
+}
+
+// seasonRolloverMonth is the first month of a season. Preseason games start
+// in late September and the regular season in October; until then the
+// league's "now" endpoints still serve the season that ended in June.
+const seasonRolloverMonth = time.September
+
+// SeasonOf returns the season a date belongs to. A season runs from
+// September 1 of its start year to August 31 of its end year, so the
+// offseason belongs to the season that just ended. Seasons moved by the
+// pandemic, such as the 2019-2020 playoffs played into late September
+// 2020, do not follow this boundary.
+func SeasonOf(date GameDate) Season {
+	d := date.Date()
+	if d.Month() < seasonRolloverMonth {
+		return NewSeason(d.Year() - 1)
+	}
+	return NewSeason(d.Year())
+}
+
+// CurrentSeason returns the season of today's date in UTC. It rolls over on
+// September 1, in time for the preseason, so in July and August it is the
+// season that just ended, matching what the API serves for "now".
+func CurrentSeason() Season {
+	return SeasonOf(Now())
+}
+
+// SeasonsBetween returns the seasons from a through b inclusive, in order,
+// or nil when b is before a. The 2004-2005 season, cancelled by the
+// lockout, is included.
+func SeasonsBetween(a, b Season) []Season {
+	if b.startYear < a.startYear {
+		return nil
+	}
+	seasons := make([]Season, 0, b.startYear-a.startYear+1)
+	for s := a; s.startYear <= b.startYear; s = s.Next() {
+		seasons = append(seasons, s)
+	}
+	return seasons
+}
+
+// Current returns the current NHL season based on the current date,
+// rolling over on July 1.
+//
+// Deprecated: Use CurrentSeason, which keeps the season that just ended
+// current through the summer, as the API does.
 func Current() Season {
 	now := time.Now()
 	year := now.Year()
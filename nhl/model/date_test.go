@@ -1,4 +1,4 @@
-package nhl
+package model
 
 import (
 	"bytes"
@@ -232,6 +232,111 @@ func TestGameDate_AddDays(t *testing.T) {
 	})
 }
 
+func TestGameDate_NextPrevCompare(t *testing.T) {
+	d := FromYMD(2024, 2, 28)
+	if got := d.Next().Next().APIString(); got != "2024-03-01" {
+		t.Errorf("Next().Next() = %q, want 2024-03-01", got)
+	}
+	if got := FromYMD(2024, 1, 1).Prev().APIString(); got != "2023-12-31" {
+		t.Errorf("Prev() = %q, want 2023-12-31", got)
+	}
+
+	evening := FromDate(time.Date(2024, 2, 28, 23, 30, 0, 0, time.UTC))
+	if d.Before(evening) || d.After(evening) {
+		t.Error("dates on the same day should be neither before nor after each other")
+	}
+	if !d.Before(d.Next()) || !d.Next().After(d) || d.After(d.Next()) {
+		t.Error("Before/After disagree with Next")
+	}
+	if !Now().After(FromYMD(2000, 1, 1)) {
+		t.Error("Now() should be after 2000-01-01")
+	}
+}
+
+func TestParseGameDate(t *testing.T) {
+	got, err := ParseGameDate("2024-01-08")
+	if err != nil || got.APIString() != "2024-01-08" || got.IsNow() {
+		t.Errorf("ParseGameDate(2024-01-08) = %v, %v", got, err)
+	}
+	if got, err := ParseGameDate("now"); err != nil || !got.IsNow() {
+		t.Errorf("ParseGameDate(now) = %v, %v", got, err)
+	}
+	for _, bad := range []string{"", "2024-1-8", "2024-13-01", "2024-02-30", "08/01/2024", "2024-01-08T00:00:00Z"} {
+		if _, err := ParseGameDate(bad); err == nil {
+			t.Errorf("ParseGameDate(%q) error = nil", bad)
+		}
+	}
+}
+
+func TestAPIMonthString(t *testing.T) {
+	if got := FromYMD(2024, 3, 31).APIMonthString(); got != "2024-03" {
+		t.Errorf("APIMonthString() = %q, want 2024-03", got)
+	}
+	got, err := ParseAPIMonth("2024-03")
+	if err != nil || got.APIString() != "2024-03-01" {
+		t.Errorf("ParseAPIMonth(2024-03) = %v, %v", got, err)
+	}
+	for _, bad := range []string{"", "2024-3", "2024-13", "2024-03-01", "now"} {
+		if _, err := ParseAPIMonth(bad); err == nil {
+			t.Errorf("ParseAPIMonth(%q) error = nil", bad)
+		}
+	}
+}
+
+func TestParseAPISeason(t *testing.T) {
+	for _, season := range []Season{NewSeason(1917), NewSeason(1999), NewSeason(2023)} {
+		got, err := ParseAPISeason(season.APIString())
+		if err != nil || got != season {
+			t.Errorf("ParseAPISeason(%q) = %v, %v", season.APIString(), got, err)
+		}
+	}
+	for _, bad := range []string{"", "2023-2024", "2023202", "202320245", "20232023", "20232025", "+2032024", "2023 2024"} {
+		if _, err := ParseAPISeason(bad); err == nil {
+			t.Errorf("ParseAPISeason(%q) error = nil", bad)
+		}
+	}
+}
+
+func TestDateRange(t *testing.T) {
+	r, err := NewDateRange(FromYMD(2024, 2, 27), FromYMD(2024, 3, 2))
+	if err != nil {
+		t.Fatalf("NewDateRange() error = %v", err)
+	}
+	if r.Days() != 5 {
+		t.Errorf("Days() = %d, want 5", r.Days())
+	}
+	var days []string
+	for d := range r.All() {
+		days = append(days, d.APIString())
+	}
+	if got := strings.Join(days, ","); got != "2024-02-27,2024-02-28,2024-02-29,2024-03-01,2024-03-02" {
+		t.Errorf("All() = %s", got)
+	}
+	for d := range r.All() {
+		if d.APIString() != "2024-02-27" {
+			t.Errorf("All() after break = %s", d)
+		}
+		break
+	}
+	if !r.Contains(FromYMD(2024, 2, 29)) || !r.Contains(FromYMD(2024, 3, 2)) || r.Contains(FromYMD(2024, 3, 3)) {
+		t.Error("Contains() is wrong at the edges")
+	}
+	if got := r.String(); got != "2024-02-27..2024-03-02" {
+		t.Errorf("String() = %q", got)
+	}
+
+	if _, err := NewDateRange(FromYMD(2024, 3, 2), FromYMD(2024, 3, 1)); err == nil {
+		t.Error("NewDateRange(end before start) error = nil")
+	}
+	single, _ := NewDateRange(FromYMD(2024, 3, 2), FromYMD(2024, 3, 2))
+	if single.Days() != 1 {
+		t.Errorf("single-day Days() = %d, want 1", single.Days())
+	}
+	if (DateRange{Start: FromYMD(2024, 3, 2), End: FromYMD(2024, 3, 1)}).Days() != 0 {
+		t.Error("Days() of an inverted range should be 0")
+	}
+}
+
 func TestGameDate_String(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -511,6 +616,58 @@ func TestCurrent(t *testing.T) {
 	}
 }
 
+func TestSeasonOf(t *testing.T) {
+	tests := []struct {
+		date GameDate
+		want int
+	}{
+		{FromYMD(2023, 10, 10), 2023},
+		{FromYMD(2024, 6, 24), 2023},
+		{FromYMD(2024, 8, 31), 2023},
+		{FromYMD(2024, 9, 1), 2024},
+		{FromYMD(2024, 12, 31), 2024},
+		{FromYMD(2025, 1, 1), 2024},
+	}
+	for _, tt := range tests {
+		got := SeasonOf(tt.date)
+		if got.StartYear() != tt.want {
+			t.Errorf("SeasonOf(%s) = %s, want start year %d", tt.date, got, tt.want)
+		}
+		if !NewSeason(tt.want).Contains(tt.date) || NewSeason(tt.want).Next().Contains(tt.date) {
+			t.Errorf("Contains(%s) disagrees with SeasonOf", tt.date)
+		}
+	}
+	if CurrentSeason() != SeasonOf(Today()) {
+		t.Errorf("CurrentSeason() = %s, want %s", CurrentSeason(), SeasonOf(Today()))
+	}
+}
+
+func TestSeason_PrevNext(t *testing.T) {
+	s := NewSeason(2023)
+	if s.Prev().String() != "2022-2023" || s.Next().String() != "2024-2025" || s.Next().Prev() != s {
+		t.Errorf("Prev() = %s, Next() = %s", s.Prev(), s.Next())
+	}
+}
+
+func TestSeasonsBetween(t *testing.T) {
+	got := SeasonsBetween(NewSeason(2003), NewSeason(2006))
+	want := []string{"2003-2004", "2004-2005", "2005-2006", "2006-2007"}
+	if len(got) != len(want) {
+		t.Fatalf("SeasonsBetween() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Errorf("SeasonsBetween()[%d] = %s, want %s", i, got[i], want[i])
+		}
+	}
+	if got := SeasonsBetween(NewSeason(2023), NewSeason(2023)); len(got) != 1 {
+		t.Errorf("SeasonsBetween(same) = %v, want one season", got)
+	}
+	if got := SeasonsBetween(NewSeason(2023), NewSeason(2022)); got != nil {
+		t.Errorf("SeasonsBetween(reversed) = %v, want nil", got)
+	}
+}
+
 func TestSeason_JSON(t *testing.T) {
 	t.Run("marshal", func(t *testing.T) {
 		season := NewSeason(2023)
@@ -1037,3 +1194,28 @@ func BenchmarkSeason_ID(b *testing.B) {
 		_ = season.ID()
 	}
 }
+
+func TestSeasonAndGameDate_Validate(t *testing.T) {
+	for _, s := range []Season{NewSeason(1917), NewSeason(2024), Current()} {
+		if err := s.Validate(); err != nil {
+			t.Errorf("%s.Validate() error = %v", s, err)
+		}
+	}
+	for _, s := range []Season{{}, NewSeason(1916), NewSeason(10000)} {
+		if err := s.Validate(); err == nil {
+			t.Errorf("%s.Validate() error = nil", s)
+		}
+	}
+
+	for _, d := range []GameDate{Now(), Today(), FromYMD(1917, 12, 19)} {
+		if err := d.Validate(); err != nil {
+			t.Errorf("%s.Validate() error = %v", d, err)
+		}
+	}
+	if err := (GameDate{}).Validate(); err == nil || !strings.Contains(err.Error(), "not set") {
+		t.Errorf("zero GameDate.Validate() error = %v", err)
+	}
+	if err := FromYMD(1900, 1, 1).Validate(); err == nil {
+		t.Error("1900-01-01 Validate() error = nil")
+	}
+}
@@ -0,0 +1,4 @@
+// Package model holds the NHL API response types, enums and IDs, and the
+// pure helpers over them. It does not import the HTTP client, so data
+// pipelines can depend on it alone; package nhl aliases every name.
+package model
@@ -0,0 +1,160 @@
+package model
+
+import "fmt"
+
+// DraftProspectCategory selects one of Central Scouting's prospect ranking
+// lists.
+type DraftProspectCategory int
+
+const (
+	// DraftNorthAmericanSkater ranks skaters playing in North America.
+	DraftNorthAmericanSkater DraftProspectCategory = 1
+	// DraftInternationalSkater ranks skaters playing outside North America.
+	DraftInternationalSkater DraftProspectCategory = 2
+	// DraftNorthAmericanGoalie ranks goalies playing in North America.
+	DraftNorthAmericanGoalie DraftProspectCategory = 3
+	// DraftInternationalGoalie ranks goalies playing outside North America.
+	DraftInternationalGoalie DraftProspectCategory = 4
+)
+
+// String returns the category's display name.
+func (c DraftProspectCategory) String() string {
+	switch c {
+	case DraftNorthAmericanSkater:
+		return "North American Skater"
+	case DraftInternationalSkater:
+		return "International Skater"
+	case DraftNorthAmericanGoalie:
+		return "North American Goalie"
+	case DraftInternationalGoalie:
+		return "International Goalie"
+	default:
+		return fmt.Sprintf("Unknown(%d)", int(c))
+	}
+}
+
+// IsValid returns true if the category is one of the known ranking lists.
+func (c DraftProspectCategory) IsValid() bool {
+	return c >= DraftNorthAmericanSkater && c <= DraftInternationalGoalie
+}
+
+// DraftRankings is a Central Scouting ranking list for one draft year and
+// category.
+type DraftRankings struct {
+	DraftYear   int             `json:"draftYear"`
+	CategoryID  int             `json:"categoryId"`
+	CategoryKey string          `json:"categoryKey"`
+	DraftYears  []int           `json:"draftYears"`
+	Categories  []DraftCategory `json:"categories"`
+	Rankings    []DraftProspect `json:"rankings"`
+}
+
+// DraftCategory describes a ranking list.
+type DraftCategory struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	ConsumerKey string `json:"consumerKey"`
+}
+
+// DraftProspect is a ranked draft-eligible player. Ranks are nil until the
+// corresponding list has been published.
+type DraftProspect struct {
+	FirstName          string     `json:"firstName"`
+	LastName           string     `json:"lastName"`
+	Position           Position   `json:"positionCode"`
+	ShootsCatches      Handedness `json:"shootsCatches"`
+	HeightInInches     *int       `json:"heightInInches,omitempty"`
+	WeightInPounds     *int       `json:"weightInPounds,omitempty"`
+	LastAmateurClub    string     `json:"lastAmateurClub"`
+	LastAmateurLeague  string     `json:"lastAmateurLeague"`
+	BirthDate          string     `json:"birthDate"`
+	BirthCity          string     `json:"birthCity"`
+	BirthStateProvince *string    `json:"birthStateProvince,omitempty"`
+	BirthCountry       string     `json:"birthCountry"`
+	MidtermRank        *int       `json:"midtermRank,omitempty"`
+	FinalRank          *int       `json:"finalRank,omitempty"`
+}
+
+// FullName returns the prospect's first and last name.
+func (p DraftProspect) FullName() string {
+	return p.FirstName + " " + p.LastName
+}
+
+// DraftPicks is the list of picks made in a draft, for one round or all
+// rounds.
+type DraftPicks struct {
+	BroadcastStartTimeUTC string      `json:"broadcastStartTimeUTC"`
+	DraftYear             int         `json:"draftYear"`
+	DraftYears            []int       `json:"draftYears"`
+	SelectableRounds      []int       `json:"selectableRounds"`
+	State                 string      `json:"state"`
+	Picks                 []DraftPick `json:"picks"`
+}
+
+// DraftPick is a single selection. Player fields are empty for picks that
+// have not been made yet.
+type DraftPick struct {
+	Round                        int             `json:"round"`
+	PickInRound                  int             `json:"pickInRound"`
+	OverallPick                  int             `json:"overallPick"`
+	TeamID                       TeamID          `json:"teamId"`
+	TeamAbbrev                   string          `json:"teamAbbrev"`
+	TeamName                     LocalizedString `json:"teamName"`
+	TeamCommonName               LocalizedString `json:"teamCommonName"`
+	TeamPlaceNameWithPreposition LocalizedString `json:"teamPlaceNameWithPreposition"`
+	DisplayAbbrev                LocalizedString `json:"displayAbbrev"`
+	TeamLogoLight                string          `json:"teamLogoLight"`
+	TeamLogoDark                 string          `json:"teamLogoDark"`
+	TeamPickHistory              string          `json:"teamPickHistory"`
+	FirstName                    LocalizedString `json:"firstName"`
+	LastName                     LocalizedString `json:"lastName"`
+	Position                     Position        `json:"positionCode"`
+	CountryCode                  string          `json:"countryCode"`
+	Height                       *int            `json:"height,omitempty"`
+	Weight                       *int            `json:"weight,omitempty"`
+	AmateurLeague                string          `json:"amateurLeague"`
+	AmateurClubName              string          `json:"amateurClubName"`
+}
+
+// IsTraded returns true if the pick was made by a team other than its
+// original owner. TeamPickHistory lists every owner, separated by dashes.
+func (p DraftPick) IsTraded() bool {
+	return p.TeamPickHistory != "" && p.TeamPickHistory != p.TeamAbbrev
+}
+
+// String implements fmt.Stringer for DraftPick.
+// Returns a formatted string like "#1 SJS: Macklin Celebrini (C)" or
+// "#12 PHI: -" for a pick not yet made.
+func (p DraftPick) String() string {
+	if p.LastName.Default == "" {
+		return fmt.Sprintf("#%d %s: -", p.OverallPick, p.TeamAbbrev)
+	}
+	return fmt.Sprintf("#%d %s: %s %s (%s)", p.OverallPick, p.TeamAbbrev, p.FirstName.Default, p.LastName.Default, p.Position.Code())
+}
+
+// DraftTracker is the live state of the current draft's round in progress.
+type DraftTracker struct {
+	LogoURL   string             `json:"logoUrl"`
+	LogoFrURL string             `json:"logoFrUrl"`
+	State     string             `json:"state"`
+	Round     int                `json:"round"`
+	Picks     []DraftTrackerPick `json:"picks"`
+}
+
+// DraftTrackerPick is a pick in the draft tracker. State is "confirmed"
+// once the selection has been announced; player fields are empty before.
+type DraftTrackerPick struct {
+	PickInRound                  int             `json:"pickInRound"`
+	OverallPick                  int             `json:"overallPick"`
+	TeamID                       TeamID          `json:"teamId"`
+	TeamAbbrev                   string          `json:"teamAbbrev"`
+	TeamFullName                 LocalizedString `json:"teamFullName"`
+	TeamCommonName               LocalizedString `json:"teamCommonName"`
+	TeamPlaceNameWithPreposition LocalizedString `json:"teamPlaceNameWithPreposition"`
+	TeamLogoLight                string          `json:"teamLogoLight"`
+	TeamLogoDark                 string          `json:"teamLogoDark"`
+	State                        string          `json:"state"`
+	FirstName                    LocalizedString `json:"firstName"`
+	LastName                     LocalizedString `json:"lastName"`
+	Position                     Position        `json:"positionCode"`
+}
@@ -0,0 +1,61 @@
+package model
+
+import "time"
+
+// DraftEligible reports whether a player born on birthDate (YYYY-MM-DD) is
+// of draft age in draftYear: 18 by September 15 of that year and not yet
+// 21 by December 31. For the 2024 draft, that is players born from January
+// 1, 2004 to September 15, 2006. An unparsable birth date is not eligible.
+//
+// Age is the only test: a player already drafted and still under contract
+// rights is not selectable again, and undrafted players past the window,
+// most often from European leagues, can still be picked.
+func DraftEligible(birthDate string, draftYear int) bool {
+	born, err := time.Parse(time.DateOnly, birthDate)
+	if err != nil {
+		return false
+	}
+	cutoff := time.Date(draftYear-18, time.September, 15, 0, 0, 0, 0, time.UTC)
+	oldest := time.Date(draftYear-20, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return !born.After(cutoff) && !born.Before(oldest)
+}
+
+// FirstDraftYear returns the first draft a player born on birthDate
+// (YYYY-MM-DD) is eligible for, the year they turn 18 by September 15, or
+// false when the date is unparsable.
+func FirstDraftYear(birthDate string) (int, bool) {
+	born, err := time.Parse(time.DateOnly, birthDate)
+	if err != nil {
+		return 0, false
+	}
+	year := born.Year() + 18
+	if born.After(time.Date(born.Year(), time.September, 15, 0, 0, 0, 0, time.UTC)) {
+		year++
+	}
+	return year, true
+}
+
+// DraftEligible reports whether the prospect is of draft age in draftYear;
+// see DraftEligible.
+func (p DraftProspect) DraftEligible(draftYear int) bool {
+	return DraftEligible(p.BirthDate, draftYear)
+}
+
+// FirstDraftYear returns the first draft the prospect is eligible for; see
+// FirstDraftYear.
+func (p DraftProspect) FirstDraftYear() (int, bool) {
+	return FirstDraftYear(p.BirthDate)
+}
+
+// IsOverage reports whether draftYear is not the prospect's first eligible
+// draft, meaning they already went unselected at least once.
+func (p DraftProspect) IsOverage(draftYear int) bool {
+	first, ok := p.FirstDraftYear()
+	return ok && first < draftYear
+}
+
+// DraftEligible reports whether the prospect is of draft age in draftYear;
+// see DraftEligible. A team's prospects have usually been drafted already.
+func (p *ProspectPlayer) DraftEligible(draftYear int) bool {
+	return DraftEligible(p.BirthDate, draftYear)
+}
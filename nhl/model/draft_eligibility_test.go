@@ -0,0 +1,52 @@
+package model
+
+import "testing"
+
+func TestDraftEligible(t *testing.T) {
+	tests := []struct {
+		birthDate string
+		year      int
+		want      bool
+	}{
+		{"2006-09-15", 2024, true},
+		{"2006-09-16", 2024, false},
+		{"2006-09-16", 2025, true},
+		{"2004-01-01", 2024, true},
+		{"2003-12-31", 2024, false},
+		{"2005-02-28", 2024, true},
+		{"not a date", 2024, false},
+	}
+	for _, tt := range tests {
+		if got := DraftEligible(tt.birthDate, tt.year); got != tt.want {
+			t.Errorf("DraftEligible(%q, %d) = %v, want %v", tt.birthDate, tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestFirstDraftYear(t *testing.T) {
+	for birthDate, want := range map[string]int{"2006-09-15": 2024, "2006-09-16": 2025, "2006-01-03": 2024} {
+		if got, ok := FirstDraftYear(birthDate); !ok || got != want {
+			t.Errorf("FirstDraftYear(%q) = %d, %v, want %d", birthDate, got, ok, want)
+		}
+	}
+	if _, ok := FirstDraftYear(""); ok {
+		t.Error("FirstDraftYear(\"\") ok = true")
+	}
+}
+
+func TestDraftProspect_Eligibility(t *testing.T) {
+	p := DraftProspect{BirthDate: "2005-10-01"}
+	if !p.DraftEligible(2025) || !p.IsOverage(2025) {
+		t.Errorf("prospect born 2005-10-01 should be an eligible overage in 2025")
+	}
+	if p.IsOverage(2024) || !p.DraftEligible(2024) {
+		t.Errorf("prospect born 2005-10-01 should be first eligible in 2024")
+	}
+	if p.DraftEligible(2023) {
+		t.Errorf("prospect born 2005-10-01 is too young for 2023")
+	}
+	pp := &ProspectPlayer{BirthDate: "2006-03-02"}
+	if !pp.DraftEligible(2024) {
+		t.Error("ProspectPlayer.DraftEligible(2024) = false")
+	}
+}
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const draftRankingsJSON = `{
+	"draftYear": 2024,
+	"categoryId": 1,
+	"categoryKey": "north-american-skater",
+	"draftYears": [2024, 2023],
+	"categories": [{"id": 1, "name": "North American Skater", "consumerKey": "north-american-skater"}],
+	"rankings": [
+		{"lastName": "Celebrini", "firstName": "Macklin", "positionCode": "C", "shootsCatches": "L",
+		 "heightInInches": 72, "weightInPounds": 190, "lastAmateurClub": "Boston University", "lastAmateurLeague": "H-EAST",
+		 "birthDate": "2006-06-13", "birthCity": "Vancouver", "birthStateProvince": "BC", "birthCountry": "CAN",
+		 "midtermRank": 1, "finalRank": 1},
+		{"lastName": "Smith", "firstName": "Jo", "positionCode": "D", "shootsCatches": "R",
+		 "lastAmateurClub": "Somewhere", "birthCountry": "USA", "midtermRank": 40}
+	]
+}`
+
+const draftPicksJSON = `{
+	"broadcastStartTimeUTC": "2024-06-28T23:00:00Z",
+	"draftYear": 2024,
+	"draftYears": [2024],
+	"selectableRounds": [1, 2, 3, 4, 5, 6, 7],
+	"state": "over",
+	"picks": [
+		{"round": 1, "pickInRound": 1, "overallPick": 1, "teamId": 28, "teamAbbrev": "SJS",
+		 "teamName": {"default": "San Jose Sharks"}, "teamPickHistory": "SJS",
+		 "firstName": {"default": "Macklin"}, "lastName": {"default": "Celebrini"}, "positionCode": "C",
+		 "countryCode": "CAN", "height": 72, "weight": 190, "amateurLeague": "H-EAST", "amateurClubName": "Boston University"},
+		{"round": 1, "pickInRound": 2, "overallPick": 2, "teamId": 33, "teamAbbrev": "CHI",
+		 "teamPickHistory": "PHI-CHI", "firstName": {"default": "Artyom"}, "lastName": {"default": "Levshunov"}, "positionCode": "D"}
+	]
+}`
+
+const draftTrackerJSON = `{
+	"logoUrl": "https://assets.nhle.com/logos/nhl/svg/NHL_light.svg",
+	"state": "during",
+	"round": 1,
+	"picks": [
+		{"pickInRound": 1, "overallPick": 1, "teamId": 28, "teamAbbrev": "SJS", "state": "confirmed",
+		 "firstName": {"default": "Macklin"}, "lastName": {"default": "Celebrini"}, "positionCode": "C"},
+		{"pickInRound": 2, "overallPick": 2, "teamId": 33, "teamAbbrev": "CHI", "state": "onTheClock"}
+	]
+}`
+
+func TestDraftProspectCategory(t *testing.T) {
+	tests := []struct {
+		category DraftProspectCategory
+		want     string
+		valid    bool
+	}{
+		{DraftNorthAmericanSkater, "North American Skater", true},
+		{DraftInternationalSkater, "International Skater", true},
+		{DraftNorthAmericanGoalie, "North American Goalie", true},
+		{DraftInternationalGoalie, "International Goalie", true},
+		{DraftProspectCategory(0), "Unknown(0)", false},
+		{DraftProspectCategory(5), "Unknown(5)", false},
+	}
+	for _, tt := range tests {
+		if got := tt.category.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+		if got := tt.category.IsValid(); got != tt.valid {
+			t.Errorf("%v.IsValid() = %v, want %v", tt.category, got, tt.valid)
+		}
+	}
+}
+
+func TestDraftRankingsDeserialization(t *testing.T) {
+	var rankings DraftRankings
+	if err := json.Unmarshal([]byte(draftRankingsJSON), &rankings); err != nil {
+		t.Fatalf("failed to unmarshal DraftRankings: %v", err)
+	}
+	if len(rankings.Rankings) != 2 || rankings.Categories[0].ConsumerKey != "north-american-skater" {
+		t.Fatalf("unexpected rankings %+v", rankings)
+	}
+
+	top := rankings.Rankings[0]
+	if top.FullName() != "Macklin Celebrini" || top.Position != PositionCenter || top.ShootsCatches != HandednessLeft {
+		t.Errorf("unexpected prospect %+v", top)
+	}
+	if *top.FinalRank != 1 || *top.HeightInInches != 72 {
+		t.Errorf("FinalRank = %d, HeightInInches = %d", *top.FinalRank, *top.HeightInInches)
+	}
+	if other := rankings.Rankings[1]; other.FinalRank != nil || other.BirthStateProvince != nil {
+		t.Errorf("missing ranks and province should be nil, got %+v", other)
+	}
+}
+
+func TestDraftPicksDeserialization(t *testing.T) {
+	var picks DraftPicks
+	if err := json.Unmarshal([]byte(draftPicksJSON), &picks); err != nil {
+		t.Fatalf("failed to unmarshal DraftPicks: %v", err)
+	}
+	if len(picks.Picks) != 2 || picks.State != "over" || len(picks.SelectableRounds) != 7 {
+		t.Fatalf("unexpected picks %+v", picks)
+	}
+	if got := picks.Picks[0].String(); got != "#1 SJS: Macklin Celebrini (C)" {
+		t.Errorf("String() = %q", got)
+	}
+	if picks.Picks[0].IsTraded() || !picks.Picks[1].IsTraded() {
+		t.Error("only the second pick changed hands")
+	}
+	if got := (DraftPick{OverallPick: 12, TeamAbbrev: "PHI"}).String(); got != "#12 PHI: -" {
+		t.Errorf("String() for an unmade pick = %q", got)
+	}
+}
+
+func TestDraftTrackerDeserialization(t *testing.T) {
+	var tracker DraftTracker
+	if err := json.Unmarshal([]byte(draftTrackerJSON), &tracker); err != nil {
+		t.Fatalf("failed to unmarshal DraftTracker: %v", err)
+	}
+	if tracker.Round != 1 || len(tracker.Picks) != 2 {
+		t.Fatalf("unexpected tracker %+v", tracker)
+	}
+	if tracker.Picks[0].State != "confirmed" || tracker.Picks[1].LastName.Default != "" {
+		t.Errorf("unexpected picks %+v", tracker.Picks)
+	}
+}
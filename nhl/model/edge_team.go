@@ -1,4 +1,4 @@
-package nhl
+package model
 
 // ===== Edge Team Types =====
 
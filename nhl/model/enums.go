@@ -1,4 +1,4 @@
-package nhl
+package model
 
 // Custom domain methods for enum types. The type declarations, constants,
 // and boilerplate methods (String, IsValid, FromString, JSON) are generated
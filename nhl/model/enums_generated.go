@@ -1,6 +1,6 @@
 // Code generated by internal/enumgen; DO NOT EDIT.
 
-package nhl
+package model
 
 import (
 	"encoding/json"
@@ -396,7 +396,10 @@ func (v *PeriodType) UnmarshalJSON(data []byte) error {
 // Empty strings are allowed because the NHL API omits periodType for unplayed games,
 // leaving the Go zero value which must round-trip through JSON.
 func (v PeriodType) MarshalJSON() ([]byte, error) {
-	if v != "" && !v.IsValid() {
+	if v == "" {
+		return json.Marshal("")
+	}
+	if !v.IsValid() {
 		return nil, fmt.Errorf("cannot marshal invalid period type: %q", string(v))
 	}
 	return json.Marshal(string(v))
@@ -908,3 +911,126 @@ func (v GameState) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(string(v))
 }
+
+// Language represents a content language supported by the NHL API.
+type Language string
+
+const (
+	// LanguageEnglish represents English content (the API default).
+	LanguageEnglish Language = "en"
+	// LanguageFrench represents French content.
+	LanguageFrench Language = "fr"
+	// LanguageCzech represents Czech content.
+	LanguageCzech Language = "cs"
+	// LanguageGerman represents German content.
+	LanguageGerman Language = "de"
+	// LanguageSpanish represents Spanish content.
+	LanguageSpanish Language = "es"
+	// LanguageFinnish represents Finnish content.
+	LanguageFinnish Language = "fi"
+	// LanguageSlovak represents Slovak content.
+	LanguageSlovak Language = "sk"
+	// LanguageSwedish represents Swedish content.
+	LanguageSwedish Language = "sv"
+)
+
+// Code returns the language code.
+func (v Language) Code() string {
+	return string(v)
+}
+
+// Name returns the full name of the language.
+func (v Language) Name() string {
+	switch v {
+	case LanguageEnglish:
+		return "English"
+	case LanguageFrench:
+		return "French"
+	case LanguageCzech:
+		return "Czech"
+	case LanguageGerman:
+		return "German"
+	case LanguageSpanish:
+		return "Spanish"
+	case LanguageFinnish:
+		return "Finnish"
+	case LanguageSlovak:
+		return "Slovak"
+	case LanguageSwedish:
+		return "Swedish"
+	default:
+		return fmt.Sprintf("Unknown(%s)", string(v))
+	}
+}
+
+// String returns the full name of the language.
+func (v Language) String() string {
+	return v.Name()
+}
+
+// IsValid returns true if the Language is one of the known valid values.
+func (v Language) IsValid() bool {
+	switch v {
+	case LanguageEnglish, LanguageFrench, LanguageCzech, LanguageGerman, LanguageSpanish, LanguageFinnish, LanguageSlovak, LanguageSwedish:
+		return true
+	default:
+		return false
+	}
+}
+
+// LanguageFromString parses a string into a Language.
+// Returns an error if the string is not a valid Language.
+func LanguageFromString(s string) (Language, error) {
+	switch s {
+	case "en", "en-us", "en-ca", "English":
+		return LanguageEnglish, nil
+	case "fr", "fr-ca", "fr-fr", "French":
+		return LanguageFrench, nil
+	case "cs", "cs-cz", "Czech":
+		return LanguageCzech, nil
+	case "de", "de-de", "German":
+		return LanguageGerman, nil
+	case "es", "es-es", "Spanish":
+		return LanguageSpanish, nil
+	case "fi", "fi-fi", "Finnish":
+		return LanguageFinnish, nil
+	case "sk", "sk-sk", "Slovak":
+		return LanguageSlovak, nil
+	case "sv", "sv-se", "Swedish":
+		return LanguageSwedish, nil
+	default:
+		return "", fmt.Errorf("invalid language: %q", s)
+	}
+}
+
+// MustLanguageFromString parses a string into a Language.
+// Panics if the string is not a valid Language.
+func MustLanguageFromString(s string) Language {
+	v, err := LanguageFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling for Language.
+func (v *Language) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := LanguageFromString(s)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// MarshalJSON implements custom JSON marshaling for Language.
+func (v Language) MarshalJSON() ([]byte, error) {
+	if !v.IsValid() {
+		return nil, fmt.Errorf("cannot marshal invalid language: %q", string(v))
+	}
+	return json.Marshal(string(v))
+}
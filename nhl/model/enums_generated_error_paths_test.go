@@ -1,4 +1,4 @@
-package nhl
+package model
 
 import (
 	"encoding/json"
@@ -35,6 +35,7 @@ func TestEnumsGenerated_NonStringJSONRejected(t *testing.T) {
 		{"DefendingSide", func() error { var v DefendingSide; return json.Unmarshal([]byte(nonStringJSONInput), &v) }},
 		{"GameScheduleState", func() error { var v GameScheduleState; return json.Unmarshal([]byte(nonStringJSONInput), &v) }},
 		{"PlayEventType", func() error { var v PlayEventType; return json.Unmarshal([]byte(nonStringJSONInput), &v) }},
+		{"Language", func() error { var v Language; return json.Unmarshal([]byte(nonStringJSONInput), &v) }},
 	}
 
 	for _, c := range cases {
@@ -1,4 +1,4 @@
-package nhl
+package model
 
 import (
 	"encoding/json"
@@ -1699,3 +1699,34 @@ func TestPlayEventType_UnmarshalJSON_InvalidValue(t *testing.T) {
 		t.Error("UnmarshalJSON() should error on invalid play event type value")
 	}
 }
+
+func TestLanguageFromString(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Language
+		wantErr bool
+	}{
+		{"en", LanguageEnglish, false},
+		{"en-us", LanguageEnglish, false},
+		{"English", LanguageEnglish, false},
+		{"fr", LanguageFrench, false},
+		{"fr-ca", LanguageFrench, false},
+		{"French", LanguageFrench, false},
+		{"cs-cz", LanguageCzech, false},
+		{"Slovak", LanguageSlovak, false},
+		{"sv", LanguageSwedish, false},
+		{"xx", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := LanguageFromString(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LanguageFromString(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("LanguageFromString(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
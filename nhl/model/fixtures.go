@@ -1,4 +1,4 @@
-package nhl
+package model
 
 // Test fixtures for constructing valid NHL objects that survive json.Marshal round-trips.
 // NHL enum types (GameType, GameState, PeriodType, GameScheduleState) reject zero values
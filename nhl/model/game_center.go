@@ -1,4 +1,4 @@
-package nhl
+package model
 
 import (
 	"fmt"
@@ -241,6 +241,19 @@ type PlayEvent struct {
 	PPTReplayURL          *string           `json:"pptReplayUrl,omitempty"`
 }
 
+// PlayKey identifies a play event for deduplication. The API occasionally
+// re-sequences an event, which changes its sort order; such an event is
+// treated as new.
+type PlayKey struct {
+	EventID   int64
+	SortOrder int
+}
+
+// Key returns the play's deduplication key.
+func (p *PlayEvent) Key() PlayKey {
+	return PlayKey{EventID: p.EventID, SortOrder: p.SortOrder}
+}
+
 // Situation parses the situation code into a GameSituation.
 // Returns nil if the situation code is invalid.
 func (p *PlayEvent) Situation() *GameSituation {
@@ -465,31 +478,76 @@ type ShiftChart struct {
 
 // ShiftEntry represents an individual shift entry for a player.
 type ShiftEntry struct {
-	ID               int64    `json:"id"`
-	DetailCode       int      `json:"detailCode"`
-	Duration         string   `json:"duration"`
-	EndTime          string   `json:"endTime"`
-	EventDescription *string  `json:"eventDescription,omitempty"`
-	EventNumber      int64    `json:"eventNumber"`
-	FirstName        string   `json:"firstName"`
-	GameID           GameID   `json:"gameId"`
-	HexValue         string   `json:"hexValue"`
-	LastName         string   `json:"lastName"`
-	Period           int      `json:"period"`
-	PlayerID         PlayerID `json:"playerId"`
-	ShiftNumber      int      `json:"shiftNumber"`
-	StartTime        string   `json:"startTime"`
-	TeamAbbrev       string   `json:"teamAbbrev"`
-	TeamID           TeamID   `json:"teamId"`
-	TeamName         string   `json:"teamName"`
-	TypeCode         int      `json:"typeCode"`
+	ID               int64     `json:"id"`
+	DetailCode       int       `json:"detailCode"`
+	Duration         TimeOnIce `json:"duration"`
+	EndTime          string    `json:"endTime"`
+	EventDescription *string   `json:"eventDescription,omitempty"`
+	EventNumber      int64     `json:"eventNumber"`
+	FirstName        string    `json:"firstName"`
+	GameID           GameID    `json:"gameId"`
+	HexValue         string    `json:"hexValue"`
+	LastName         string    `json:"lastName"`
+	Period           int       `json:"period"`
+	PlayerID         PlayerID  `json:"playerId"`
+	ShiftNumber      int       `json:"shiftNumber"`
+	StartTime        string    `json:"startTime"`
+	TeamAbbrev       string    `json:"teamAbbrev"`
+	TeamID           TeamID    `json:"teamId"`
+	TeamName         string    `json:"teamName"`
+	TypeCode         int       `json:"typeCode"`
 }
 
 // SeasonSeriesMatchup represents season series matchup.
 type SeasonSeriesMatchup struct {
-	SeasonSeries     []SeriesGame   `json:"seasonSeries"`
-	SeasonSeriesWins SeriesWins     `json:"seasonSeriesWins"`
-	GameInfo         SeriesGameInfo `json:"gameInfo"`
+	SeasonSeries     []SeriesGame `json:"seasonSeries"`
+	SeasonSeriesWins SeriesWins   `json:"seasonSeriesWins"`
+	// GameInfo lists the officials, head coaches and scratches; see
+	// nhl.Client.GameInfo to fetch it alone.
+	GameInfo SeriesGameInfo `json:"gameInfo"`
+}
+
+// GameRightRail is the gamecenter sidebar: the season series and game info
+// of SeasonSeriesMatchup, plus the linescore, shots by period, official team
+// stats, the teams' season stats, video recaps and report links. Sections
+// not yet published for the game, e.g. before puck drop, are nil or empty.
+type GameRightRail struct {
+	SeasonSeriesMatchup
+	GameVideo       *GameVideo      `json:"gameVideo,omitempty"`
+	Linescore       *Linescore      `json:"linescore,omitempty"`
+	ShotsByPeriod   []PeriodScore   `json:"shotsByPeriod,omitempty"`
+	TeamGameStats   []TeamGameStat  `json:"teamGameStats,omitempty"`
+	TeamSeasonStats *TeamComparison `json:"teamSeasonStats,omitempty"`
+	GameReports     *GameReports    `json:"gameReports,omitempty"`
+}
+
+// OfficialTeamGameStats parses TeamGameStats into each team's totals, like
+// Boxscore.OfficialTeamGameStats.
+func (r *GameRightRail) OfficialTeamGameStats() (away, home TeamGameStats, ok bool) {
+	return officialTeamGameStats(r.TeamGameStats)
+}
+
+// GameVideo holds the IDs of a game's video recaps. Zero means the video
+// is not available yet.
+type GameVideo struct {
+	ThreeMinRecap   int64 `json:"threeMinRecap,omitempty"`
+	ThreeMinRecapFr int64 `json:"threeMinRecapFr,omitempty"`
+	CondensedGame   int64 `json:"condensedGame,omitempty"`
+	CondensedGameFr int64 `json:"condensedGameFr,omitempty"`
+}
+
+// GameReports holds links to the official game reports.
+type GameReports struct {
+	GameSummary       string `json:"gameSummary,omitempty"`
+	EventSummary      string `json:"eventSummary,omitempty"`
+	PlayByPlay        string `json:"playByPlay,omitempty"`
+	FaceoffSummary    string `json:"faceoffSummary,omitempty"`
+	FaceoffComparison string `json:"faceoffComparison,omitempty"`
+	Rosters           string `json:"rosters,omitempty"`
+	ShotSummary       string `json:"shotSummary,omitempty"`
+	ShiftChart        string `json:"shiftChart,omitempty"`
+	ToiAway           string `json:"toiAway,omitempty"`
+	ToiHome           string `json:"toiHome,omitempty"`
 }
 
 // SeriesGame represents an individual game in the season series.
@@ -1,4 +1,4 @@
-package nhl
+package model
 
 import (
 	"encoding/json"
@@ -655,8 +655,8 @@ func TestShiftEntry_Deserialization(t *testing.T) {
 	if shift.DetailCode != 0 {
 		t.Errorf("DetailCode = %d, want 0", shift.DetailCode)
 	}
-	if shift.Duration != "17:15" {
-		t.Errorf("Duration = %q, want %q", shift.Duration, "17:15")
+	if shift.Duration.String() != "17:15" {
+		t.Errorf("Duration = %v, want %q", shift.Duration, "17:15")
 	}
 	if shift.EndTime != "17:15" {
 		t.Errorf("EndTime = %q, want %q", shift.EndTime, "17:15")
@@ -1,7 +1,24 @@
-package nhl
+package model
 
 import "fmt"
 
+// GameIDFromParts builds a game ID in the YYYYTTNNNN format from its
+// season, game type and game number, e.g. (20232024, regular season, 1)
+// gives 2023020001. It is the inverse of Season, GameType and GameNumber.
+func GameIDFromParts(season Season, gameType GameType, gameNumber int) (GameID, error) {
+	startYear := season.StartYear()
+	if startYear < 1000 || startYear > 9999 {
+		return 0, fmt.Errorf("invalid season for game ID: %s", season)
+	}
+	if !gameType.IsValid() {
+		return 0, fmt.Errorf("invalid game type: %d", gameType.Int())
+	}
+	if gameNumber < 1 || gameNumber > 9999 {
+		return 0, fmt.Errorf("game number must be between 1 and 9999, got: %d", gameNumber)
+	}
+	return GameID(int64(startYear)*1000000 + int64(gameType.Int())*10000 + int64(gameNumber)), nil
+}
+
 // Season extracts the season from the game ID.
 // Returns the season in YYYYYYYY format (e.g., 20232024).
 func (g GameID) Season() (Season, error) {
@@ -64,3 +81,8 @@ func (g GameID) Validate() error {
 
 	return nil
 }
+
+// IsValid reports whether the GameID passes Validate.
+func (g GameID) IsValid() bool {
+	return g.Validate() == nil
+}
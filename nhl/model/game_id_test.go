@@ -1,4 +1,4 @@
-package nhl
+package model
 
 import (
 	"encoding/json"
@@ -549,3 +549,55 @@ func TestGameID_Validate_InvalidGameType(t *testing.T) {
 		t.Error("Validate() should error on invalid game type")
 	}
 }
+
+func TestGameIDFromParts(t *testing.T) {
+	tests := []struct {
+		season     Season
+		gameType   GameType
+		gameNumber int
+		want       GameID
+	}{
+		{NewSeason(2023), GameTypeRegularSeason, 1, 2023020001},
+		{NewSeason(2023), GameTypePlayoffs, 417, 2023030417},
+		{NewSeason(1995), GameTypePreseason, 9999, 1995019999},
+		{NewSeason(2024), GameTypePWHLShowcase, 2, 2024120002},
+	}
+	for _, tt := range tests {
+		got, err := GameIDFromParts(tt.season, tt.gameType, tt.gameNumber)
+		if err != nil || got != tt.want {
+			t.Errorf("GameIDFromParts(%s, %d, %d) = %d, %v; want %d", tt.season, tt.gameType, tt.gameNumber, got, err, tt.want)
+			continue
+		}
+		season, _ := got.Season()
+		gameType, _ := got.GameType()
+		number, _ := got.GameNumber()
+		if season != tt.season || GameType(gameType) != tt.gameType || number != tt.gameNumber || !got.IsValid() {
+			t.Errorf("%d does not decompose to its parts", got)
+		}
+	}
+
+	invalid := []struct {
+		season     Season
+		gameType   GameType
+		gameNumber int
+	}{
+		{NewSeason(2023), GameType(5), 1},
+		{NewSeason(2023), GameTypeRegularSeason, 0},
+		{NewSeason(2023), GameTypeRegularSeason, 10000},
+		{NewSeason(999), GameTypeRegularSeason, 1},
+	}
+	for _, tt := range invalid {
+		if got, err := GameIDFromParts(tt.season, tt.gameType, tt.gameNumber); err == nil {
+			t.Errorf("GameIDFromParts(%d, %d, %d) = %d, want error", tt.season.StartYear(), tt.gameType, tt.gameNumber, got)
+		}
+	}
+}
+
+func TestGameID_IsValid(t *testing.T) {
+	if !GameID(2023020001).IsValid() {
+		t.Error("2023020001 should be valid")
+	}
+	if GameID(2023050001).IsValid() || GameID(202302001).IsValid() {
+		t.Error("malformed game IDs should not be valid")
+	}
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"fmt"
+)
+
+// regularSeasonGames lists the number of regular-season games from a
+// season on, by start year: the league size times games per team, halved,
+// with the lockout and pandemic seasons cut short.
+var regularSeasonGames = []struct {
+	fromYear int
+	games    int
+}{
+	{1994, 624},  // 26 teams, 48 games (lockout)
+	{1995, 1066}, // 26 teams
+	{1998, 1107}, // 27 teams
+	{1999, 1148}, // 28 teams
+	{2000, 1230}, // 30 teams
+	{2004, 0},    // cancelled (lockout)
+	{2005, 1230},
+	{2012, 720}, // 48 games (lockout)
+	{2013, 1230},
+	{2017, 1271}, // 31 teams
+	{2019, 1082}, // paused in March 2020 and not resumed
+	{2020, 868},  // 56 games
+	{2021, 1312}, // 32 teams
+}
+
+// playoffRounds is the number of series in each round of the 16-team
+// playoff format.
+var playoffRounds = []int{8, 4, 2, 1}
+
+// GameIDsForSeason returns every game ID the league can have assigned to
+// games of one type in a season, in ascending order, without a request:
+//
+//   - regular season: numbers 1 through the season's game count, e.g. 1312
+//     since 2021-22, 1271 with 31 teams and 1230 with 30, with the
+//     shortened 1994-95, 2012-13, 2019-20 and 2020-21 seasons cut to the
+//     games actually scheduled
+//   - playoffs: round, series and game digits (0RSG), eight series in the
+//     first round down to one in the final, seven games each; 2019-20 adds
+//     its best-of-five qualifying round as round 0
+//
+// Playoff IDs cover every game a series could need, so series that ended
+// early leave unused numbers; GameIDs lists only the games actually
+// scheduled, and GameExists checks a single ID. Seasons before 1994-95,
+// the cancelled 2004-05 season and other game types, whose numbering has
+// no fixed bound, return an error.
+func GameIDsForSeason(season Season, gameType GameType) ([]GameID, error) {
+	year := season.StartYear()
+	if year < regularSeasonGames[0].fromYear {
+		return nil, fmt.Errorf("game numbering before %d is not supported: %s", regularSeasonGames[0].fromYear, season)
+	}
+
+	var numbers []int
+	switch gameType {
+	case GameTypeRegularSeason:
+		games := 0
+		for _, era := range regularSeasonGames {
+			if era.fromYear <= year {
+				games = era.games
+			}
+		}
+		for n := 1; n <= games; n++ {
+			numbers = append(numbers, n)
+		}
+	case GameTypePlayoffs:
+		if year == 2004 {
+			break
+		}
+		if year == 2019 {
+			numbers = appendPlayoffRound(numbers, 0, 8, 5)
+		}
+		for i, series := range playoffRounds {
+			numbers = appendPlayoffRound(numbers, i+1, series, 7)
+		}
+	default:
+		return nil, fmt.Errorf("game numbering has no fixed range for %s games", gameType)
+	}
+	if len(numbers) == 0 {
+		return nil, fmt.Errorf("no %s games in %s", gameType, season)
+	}
+
+	ids := make([]GameID, 0, len(numbers))
+	for _, n := range numbers {
+		id, err := GameIDFromParts(season, gameType, n)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// appendPlayoffRound appends the game numbers of one playoff round.
+func appendPlayoffRound(numbers []int, round, series, games int) []int {
+	for s := 1; s <= series; s++ {
+		for g := 1; g <= games; g++ {
+			numbers = append(numbers, round*100+s*10+g)
+		}
+	}
+	return numbers
+}
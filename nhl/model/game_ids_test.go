@@ -0,0 +1,64 @@
+package model
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGameIDsForSeason(t *testing.T) {
+	tests := []struct {
+		season      Season
+		gameType    GameType
+		count       int
+		first, last GameID
+	}{
+		{NewSeason(2023), GameTypeRegularSeason, 1312, 2023020001, 2023021312},
+		{NewSeason(2018), GameTypeRegularSeason, 1271, 2018020001, 2018021271},
+		{NewSeason(2015), GameTypeRegularSeason, 1230, 2015020001, 2015021230},
+		{NewSeason(2012), GameTypeRegularSeason, 720, 2012020001, 2012020720},
+		{NewSeason(2020), GameTypeRegularSeason, 868, 2020020001, 2020020868},
+		{NewSeason(1994), GameTypeRegularSeason, 624, 1994020001, 1994020624},
+		{NewSeason(2023), GameTypePlayoffs, 105, 2023030111, 2023030417},
+		{NewSeason(2019), GameTypePlayoffs, 145, 2019030011, 2019030417},
+	}
+	for _, tt := range tests {
+		ids, err := GameIDsForSeason(tt.season, tt.gameType)
+		if err != nil {
+			t.Errorf("GameIDsForSeason(%s, %s) error = %v", tt.season, tt.gameType, err)
+			continue
+		}
+		if len(ids) != tt.count || ids[0] != tt.first || ids[len(ids)-1] != tt.last {
+			t.Errorf("GameIDsForSeason(%s, %s) = %d IDs %d..%d, want %d IDs %d..%d",
+				tt.season, tt.gameType, len(ids), ids[0], ids[len(ids)-1], tt.count, tt.first, tt.last)
+		}
+		if !slices.IsSorted(ids) {
+			t.Errorf("GameIDsForSeason(%s, %s) is not sorted", tt.season, tt.gameType)
+		}
+	}
+
+	playoffs, _ := GameIDsForSeason(NewSeason(2023), GameTypePlayoffs)
+	for _, id := range []GameID{2023030187, 2023030247, 2023030327} {
+		if !slices.Contains(playoffs, id) {
+			t.Errorf("playoff IDs missing %d", id)
+		}
+	}
+	for _, id := range []GameID{2023030191, 2023030251, 2023030118} {
+		if slices.Contains(playoffs, id) {
+			t.Errorf("playoff IDs include %d", id)
+		}
+	}
+
+	for _, tt := range []struct {
+		season   Season
+		gameType GameType
+	}{
+		{NewSeason(2004), GameTypeRegularSeason},
+		{NewSeason(2004), GameTypePlayoffs},
+		{NewSeason(1990), GameTypeRegularSeason},
+		{NewSeason(2023), GameTypePreseason},
+	} {
+		if ids, err := GameIDsForSeason(tt.season, tt.gameType); err == nil {
+			t.Errorf("GameIDsForSeason(%s, %s) = %d IDs, want error", tt.season, tt.gameType, len(ids))
+		}
+	}
+}
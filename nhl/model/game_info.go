@@ -0,0 +1,27 @@
+package model
+
+import (
+	"slices"
+)
+
+// Officials returns the names of the game's officials, referees first,
+// then linesmen.
+func (i *SeriesGameInfo) Officials() []string {
+	names := make([]string, 0, len(i.Referees)+len(i.Linesmen))
+	for _, official := range slices.Concat(i.Referees, i.Linesmen) {
+		names = append(names, official.Default)
+	}
+	return names
+}
+
+// IsScratched reports whether the player is a scratch for either team.
+func (i *SeriesGameInfo) IsScratched(playerID PlayerID) bool {
+	scratched := func(p ScratchedPlayer) bool { return p.ID == playerID }
+	return slices.ContainsFunc(i.AwayTeam.Scratches, scratched) ||
+		slices.ContainsFunc(i.HomeTeam.Scratches, scratched)
+}
+
+// FullName returns the player's full name (first name + last name).
+func (p ScratchedPlayer) FullName() string {
+	return p.FirstName.Default + " " + p.LastName.Default
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestSeriesGameInfo_Empty(t *testing.T) {
+	var info SeriesGameInfo
+	if got := info.Officials(); len(got) != 0 {
+		t.Errorf("Officials() = %v, want none", got)
+	}
+	if info.IsScratched(8478402) {
+		t.Error("IsScratched() should be false without scratches")
+	}
+}
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// SortGameLogs returns a copy of logs in the order the games were played.
+// The API lists a season's games most recent first.
+func SortGameLogs(logs []GameLog) []GameLog {
+	sorted := slices.Clone(logs)
+	slices.SortStableFunc(sorted, func(a, b GameLog) int {
+		return cmp.Or(cmp.Compare(a.GameDate, b.GameDate), cmp.Compare(a.GameID, b.GameID))
+	})
+	return sorted
+}
+
+// LastGames returns the n most recent games of logs, oldest first, or all
+// of them when there are fewer, e.g. for a last-10-games split.
+func LastGames(logs []GameLog, n int) []GameLog {
+	sorted := SortGameLogs(logs)
+	return sorted[len(sorted)-clampTop(n, len(sorted)):]
+}
+
+// SumGameLogs totals game logs into skater stats, as in a season-to-date
+// line: counting stats are summed, ShootingPctg is goals over shots and
+// AvgTOI the average time on ice. Game logs carry no shorthanded or goalie
+// stats, so those fields are nil, as is ShootingPctg without shots and PIM
+// when no game reports it.
+func SumGameLogs(logs []GameLog) PlayerStats {
+	var goals, assists, points, plusMinus, ppGoals, ppPoints, shots int
+	var pim *int
+	var toi time.Duration
+	for _, g := range logs {
+		goals += g.Goals
+		assists += g.Assists
+		points += g.Points
+		plusMinus += g.PlusMinus
+		ppGoals += g.PowerPlayGoals
+		ppPoints += g.PowerPlayPoints
+		shots += g.Shots
+		toi += g.TOI.Duration()
+		if g.PIM != nil {
+			if pim == nil {
+				pim = new(int)
+			}
+			*pim += *g.PIM
+		}
+	}
+	games := len(logs)
+	stats := PlayerStats{
+		GamesPlayed:     &games,
+		Goals:           &goals,
+		Assists:         &assists,
+		Points:          &points,
+		PlusMinus:       &plusMinus,
+		PIM:             pim,
+		PowerPlayGoals:  &ppGoals,
+		PowerPlayPoints: &ppPoints,
+		Shots:           &shots,
+	}
+	if shots > 0 {
+		pctg := float64(goals) / float64(shots)
+		stats.ShootingPctg = &pctg
+	}
+	if games > 0 {
+		avg := TOIFromDuration(toi / time.Duration(games))
+		stats.AvgTOI = &avg
+	}
+	return stats
+}
+
+// GameLogAverages is the per-game average of game logs.
+type GameLogAverages struct {
+	Games           int
+	Goals           float64
+	Assists         float64
+	Points          float64
+	PlusMinus       float64
+	PowerPlayGoals  float64
+	PowerPlayPoints float64
+	Shots           float64
+	Shifts          float64
+	TOI             TimeOnIce
+}
+
+// PerGameAverages returns the per-game averages of logs, all zero when
+// there are none.
+func PerGameAverages(logs []GameLog) GameLogAverages {
+	avg := GameLogAverages{Games: len(logs)}
+	if avg.Games == 0 {
+		return avg
+	}
+	var toi time.Duration
+	for _, g := range logs {
+		avg.Goals += float64(g.Goals)
+		avg.Assists += float64(g.Assists)
+		avg.Points += float64(g.Points)
+		avg.PlusMinus += float64(g.PlusMinus)
+		avg.PowerPlayGoals += float64(g.PowerPlayGoals)
+		avg.PowerPlayPoints += float64(g.PowerPlayPoints)
+		avg.Shots += float64(g.Shots)
+		avg.Shifts += float64(g.Shifts)
+		toi += g.TOI.Duration()
+	}
+	n := float64(avg.Games)
+	avg.Goals /= n
+	avg.Assists /= n
+	avg.Points /= n
+	avg.PlusMinus /= n
+	avg.PowerPlayGoals /= n
+	avg.PowerPlayPoints /= n
+	avg.Shots /= n
+	avg.Shifts /= n
+	avg.TOI = TOIFromDuration(toi / time.Duration(avg.Games))
+	return avg
+}
+
+// RollingWindow returns, for each game of logs in the order played, the
+// totals of the window of n games ending with it. The first n-1 windows
+// hold fewer games. A non-positive n yields nil.
+func RollingWindow(logs []GameLog, n int) []PlayerStats {
+	if n <= 0 {
+		return nil
+	}
+	sorted := SortGameLogs(logs)
+	windows := make([]PlayerStats, len(sorted))
+	for i := range sorted {
+		windows[i] = SumGameLogs(sorted[max(0, i-n+1) : i+1])
+	}
+	return windows
+}
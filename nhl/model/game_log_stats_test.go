@@ -0,0 +1,75 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// gameLogs returns three games, most recent first as the API lists them.
+func gameLogs() []GameLog {
+	pim := func(n int) *int { return &n }
+	return []GameLog{
+		{GameID: 2024020030, GameDate: "2024-10-20", Goals: 0, Assists: 2, Points: 2, Shots: 1, Shifts: 20, TOI: TOIFromDuration(20 * time.Minute)},
+		{GameID: 2024020020, GameDate: "2024-10-15", Goals: 2, Assists: 0, Points: 2, PowerPlayGoals: 1, PowerPlayPoints: 1, Shots: 5, Shifts: 22, TOI: TOIFromDuration(22 * time.Minute), PIM: pim(2)},
+		{GameID: 2024020010, GameDate: "2024-10-10", Goals: 1, Assists: 1, Points: 2, PlusMinus: -1, Shots: 4, Shifts: 18, TOI: TOIFromDuration(18 * time.Minute)},
+	}
+}
+
+func TestSumGameLogs(t *testing.T) {
+	s := SumGameLogs(gameLogs())
+	if *s.GamesPlayed != 3 || *s.Goals != 3 || *s.Points != 6 || *s.PlusMinus != -1 || *s.PowerPlayGoals != 1 || *s.Shots != 10 {
+		t.Errorf("SumGameLogs() counts = %d GP, %d G, %d P, %d +/-, %d PPG, %d S", *s.GamesPlayed, *s.Goals, *s.Points, *s.PlusMinus, *s.PowerPlayGoals, *s.Shots)
+	}
+	if s.PIM == nil || *s.PIM != 2 {
+		t.Errorf("PIM = %v, want 2", s.PIM)
+	}
+	if s.ShootingPctg == nil || *s.ShootingPctg != 0.3 {
+		t.Errorf("ShootingPctg = %v, want 0.3", s.ShootingPctg)
+	}
+	if s.AvgTOI == nil || s.AvgTOI.String() != "20:00" {
+		t.Errorf("AvgTOI = %v, want 20:00", s.AvgTOI)
+	}
+	if s.ShortHandedGoals != nil || s.Wins != nil {
+		t.Error("stats the game log lacks should be nil")
+	}
+
+	empty := SumGameLogs(nil)
+	if *empty.GamesPlayed != 0 || empty.ShootingPctg != nil || empty.AvgTOI != nil || empty.PIM != nil {
+		t.Errorf("SumGameLogs(nil) = %+v", empty)
+	}
+}
+
+func TestPerGameAverages(t *testing.T) {
+	avg := PerGameAverages(gameLogs())
+	if avg.Games != 3 || avg.Goals != 1 || avg.Points != 2 || avg.Shifts != 20 || avg.TOI.String() != "20:00" {
+		t.Errorf("PerGameAverages() = %+v", avg)
+	}
+	if got := PerGameAverages(nil); got != (GameLogAverages{}) {
+		t.Errorf("PerGameAverages(nil) = %+v", got)
+	}
+}
+
+func TestLastGamesAndRollingWindow(t *testing.T) {
+	last := LastGames(gameLogs(), 2)
+	if len(last) != 2 || last[0].GameID != 2024020020 || last[1].GameID != 2024020030 {
+		t.Errorf("LastGames(2) = %v", last)
+	}
+	if len(LastGames(gameLogs(), 10)) != 3 || len(LastGames(gameLogs(), -1)) != 0 {
+		t.Error("LastGames() should clamp n")
+	}
+
+	windows := RollingWindow(gameLogs(), 2)
+	var goals []int
+	for _, w := range windows {
+		goals = append(goals, *w.Goals)
+	}
+	if len(goals) != 3 || goals[0] != 1 || goals[1] != 3 || goals[2] != 2 {
+		t.Errorf("RollingWindow(2) goals = %v, want [1 3 2]", goals)
+	}
+	if *windows[0].GamesPlayed != 1 || *windows[2].GamesPlayed != 2 {
+		t.Error("RollingWindow() windows should grow to n games")
+	}
+	if RollingWindow(gameLogs(), 0) != nil {
+		t.Error("RollingWindow(0) should be nil")
+	}
+}
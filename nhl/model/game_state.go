@@ -1,4 +1,4 @@
-package nhl
+package model
 
 // Custom domain methods for GameState. The type declaration, constants,
 // and boilerplate methods are generated in enums_generated.go.
@@ -0,0 +1,4 @@
+package model
+
+//go:generate go run ../../internal/enumgen
+//go:generate go run ../../internal/idgen
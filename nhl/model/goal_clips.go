@@ -0,0 +1,44 @@
+package model
+
+// GoalClip is the highlight of one goal.
+type GoalClip struct {
+	GameID       GameID
+	GameDate     string
+	StartTimeUTC string
+	EventID      int64
+	Period       int
+	PeriodType   PeriodType
+	TimeInPeriod string
+	// Opponent is the abbreviation of the team scored against.
+	Opponent string
+
+	// ClipID is the highlight video ID and DiscreteClipID the ID of the
+	// goal-only cut; either is 0 when not published. SharingURL is the
+	// league's web page for the highlight, or "" when unavailable.
+	ClipID         int64
+	DiscreteClipID int64
+	SharingURL     string
+}
+
+// HasClip reports whether a highlight has been published for the goal.
+func (c GoalClip) HasClip() bool {
+	return c.ClipID != 0 || c.DiscreteClipID != 0 || c.SharingURL != ""
+}
+
+// GoalPlaylist is a player's goals across games, in the order they were
+// scored.
+type GoalPlaylist struct {
+	PlayerID PlayerID
+	Clips    []GoalClip
+}
+
+// Playable returns the clips that have a published highlight, in order.
+func (p *GoalPlaylist) Playable() []GoalClip {
+	var clips []GoalClip
+	for _, c := range p.Clips {
+		if c.HasClip() {
+			clips = append(clips, c)
+		}
+	}
+	return clips
+}
@@ -1,6 +1,6 @@
 // Code generated by internal/idgen; DO NOT EDIT.
 
-package nhl
+package model
 
 import (
 	"encoding/json"
@@ -0,0 +1,155 @@
+package model
+
+import (
+	"slices"
+	"strings"
+)
+
+// SeasonDates are the key dates of a season, derived from its schedule.
+// Optional dates are nil when the season has no such date or it has not
+// been scheduled yet, e.g. the playoffs before the regular season ends.
+type SeasonDates struct {
+	Season Season
+
+	PreseasonStart     *Date
+	RegularSeasonStart Date
+	RegularSeasonEnd   Date
+	PlayoffsStart      *Date
+	// PlayoffsEnd is the last scheduled playoff game, which moves as the
+	// playoffs progress.
+	PlayoffsEnd *Date
+
+	AllStarGame *Date
+	// AllStarBreakStart and AllStarBreakEnd bound the days around the
+	// All-Star Game without regular-season games.
+	AllStarBreakStart *Date
+	AllStarBreakEnd   *Date
+	// Breaks are the stretches of four days or more inside the
+	// regular season without a regular-season game, in order: the All-Star
+	// break and international pauses such as the 2025 4 Nations Face-Off
+	// and the 2026 Olympics, which have no All-Star Game.
+	Breaks []DateRange
+
+	// TradeDeadline is the last day trades may be made, when known. The
+	// API does not publish it, so it comes from a table of announced
+	// deadlines.
+	TradeDeadline *Date
+
+	// OutdoorGames are the Winter Classic, Stadium Series and Heritage
+	// Classic games, in schedule order.
+	OutdoorGames []ScheduleGame
+}
+
+// tradeDeadlines holds the announced trade deadlines by season start year.
+// Neither the schedule nor the season manifests carry the deadline, so each
+// season's date is added here once the league announces it.
+var tradeDeadlines = map[int]Date{
+	2018: NewDateYMD(2019, 2, 25),
+	2019: NewDateYMD(2020, 2, 24),
+	2020: NewDateYMD(2021, 4, 12),
+	2021: NewDateYMD(2022, 3, 21),
+	2022: NewDateYMD(2023, 3, 3),
+	2023: NewDateYMD(2024, 3, 8),
+	2024: NewDateYMD(2025, 3, 7),
+	2025: NewDateYMD(2026, 3, 6),
+}
+
+// minBreakDays is the shortest run of days without regular-season games
+// counted as a break, long enough to skip the Christmas pause.
+const minBreakDays = 4
+
+// outdoorEvents are the special-event names of the outdoor series.
+var outdoorEvents = []string{"winter classic", "stadium series", "heritage classic"}
+
+// SeasonDatesFromSchedule computes SeasonDates from a season's full
+// schedule, as returned by the client's FullSeasonSchedule.
+func SeasonDatesFromSchedule(season Season, games []ScheduleGame) *SeasonDates {
+	dates := &SeasonDates{Season: season}
+	if deadline, ok := tradeDeadlines[season.StartYear()]; ok {
+		dates.TradeDeadline = &deadline
+	}
+
+	byType := make(map[GameType][]Date)
+	for _, g := range games {
+		if day, ok := scheduleGameDay(g); ok {
+			byType[g.GameType] = append(byType[g.GameType], day)
+		}
+		if isOutdoorGame(g) {
+			dates.OutdoorGames = append(dates.OutdoorGames, g)
+		}
+	}
+	for _, days := range byType {
+		slices.SortFunc(days, func(a, b Date) int { return a.Compare(b.Time) })
+	}
+
+	first := func(t GameType) *Date {
+		if days := byType[t]; len(days) > 0 {
+			return &days[0]
+		}
+		return nil
+	}
+	last := func(t GameType) *Date {
+		if days := byType[t]; len(days) > 0 {
+			return &days[len(days)-1]
+		}
+		return nil
+	}
+
+	dates.PreseasonStart = first(GameTypePreseason)
+	if start := first(GameTypeRegularSeason); start != nil {
+		dates.RegularSeasonStart = *start
+		dates.RegularSeasonEnd = *last(GameTypeRegularSeason)
+	}
+	dates.Breaks = scheduleBreaks(byType[GameTypeRegularSeason])
+	dates.PlayoffsStart = first(GameTypePlayoffs)
+	dates.PlayoffsEnd = last(GameTypePlayoffs)
+
+	dates.AllStarGame = first(GameTypeAllStar)
+	if dates.AllStarGame != nil {
+		asg := *dates.AllStarGame
+		regular := byType[GameTypeRegularSeason]
+		i, _ := slices.BinarySearchFunc(regular, asg, func(d, target Date) int { return d.Compare(target.Time) })
+		if i > 0 && i < len(regular) {
+			start := DateFromTime(regular[i-1].AddDate(0, 0, 1))
+			end := DateFromTime(regular[i].AddDate(0, 0, -1))
+			dates.AllStarBreakStart, dates.AllStarBreakEnd = &start, &end
+		}
+	}
+	return dates
+}
+
+// scheduleBreaks returns the runs of at least minBreakDays days between
+// two sorted game days.
+func scheduleBreaks(days []Date) []DateRange {
+	var breaks []DateRange
+	for i := 1; i < len(days); i++ {
+		start, end := days[i-1].AddDate(0, 0, 1), days[i].AddDate(0, 0, -1)
+		if int(end.Sub(start).Hours()/24)+1 >= minBreakDays {
+			breaks = append(breaks, DateRange{Start: FromDate(start), End: FromDate(end)})
+		}
+	}
+	return breaks
+}
+
+// scheduleGameDay returns the date a game is played on.
+func scheduleGameDay(g ScheduleGame) (Date, bool) {
+	if g.GameDate == nil {
+		return Date{}, false
+	}
+	day, err := ParseDate(*g.GameDate)
+	return day, err == nil
+}
+
+// isOutdoorGame reports whether g is part of an outdoor series.
+func isOutdoorGame(g ScheduleGame) bool {
+	if g.SpecialEvent == nil {
+		return false
+	}
+	name := strings.ToLower(g.SpecialEvent.Name.Default)
+	for _, event := range outdoorEvents {
+		if strings.Contains(name, event) {
+			return true
+		}
+	}
+	return false
+}
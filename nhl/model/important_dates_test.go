@@ -0,0 +1,39 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestSeasonDatesFromScheduleUnscheduled(t *testing.T) {
+	date := "2030-10-08"
+	dates := SeasonDatesFromSchedule(NewSeason(2030), []ScheduleGame{
+		{ID: 2030020001, GameType: GameTypeRegularSeason, GameDate: &date},
+	})
+	if dates.PlayoffsStart != nil || dates.AllStarGame != nil || dates.AllStarBreakStart != nil || dates.TradeDeadline != nil {
+		t.Errorf("unscheduled dates should be nil: %+v", dates)
+	}
+	if dates.RegularSeasonStart.String() != date || dates.RegularSeasonEnd.String() != date {
+		t.Errorf("regular season = %s to %s, want %s", dates.RegularSeasonStart, dates.RegularSeasonEnd, date)
+	}
+}
+
+func TestScheduleBreaks(t *testing.T) {
+	var games []ScheduleGame
+	for i, day := range []string{"2025-12-22", "2025-12-23", "2025-12-27", "2026-02-04", "2026-02-05", "2026-02-25", "2026-02-26"} {
+		games = append(games, ScheduleGame{ID: GameID(2025020001 + i), GameType: GameTypeRegularSeason, GameDate: &day})
+	}
+	dates := SeasonDatesFromSchedule(NewSeason(2025), games)
+	if len(dates.Breaks) != 2 || dates.Breaks[0].String() != "2025-12-28..2026-02-03" || dates.Breaks[1].String() != "2026-02-06..2026-02-24" {
+		t.Errorf("Breaks = %v, want the January gap and the Olympic break, not Christmas", dates.Breaks)
+	}
+	if dates.AllStarBreakStart != nil {
+		t.Errorf("AllStarBreakStart = %v without an All-Star Game", dates.AllStarBreakStart)
+	}
+}
+
+func TestTradeDeadlinesCurrentSeason(t *testing.T) {
+	dates := SeasonDatesFromSchedule(NewSeason(2025), nil)
+	if dates.TradeDeadline == nil || dates.TradeDeadline.String() != "2026-03-06" {
+		t.Errorf("2025-26 TradeDeadline = %v, want 2026-03-06", dates.TradeDeadline)
+	}
+}
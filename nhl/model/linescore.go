@@ -0,0 +1,129 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Linescore is the official goals-by-period table of a game.
+type Linescore struct {
+	ByPeriod []PeriodScore  `json:"byPeriod"`
+	Totals   LinescoreTotal `json:"totals"`
+	// Shootout is the shootout round by round, empty unless the game went
+	// to one. The right-rail payload does not carry it; nhl.Client.Linescore
+	// and LinescoreFromLanding fill it from the landing summary.
+	Shootout []ShootoutRound `json:"shootout,omitempty"`
+}
+
+// LinescoreTotal is the final score of a linescore.
+type LinescoreTotal struct {
+	Away int `json:"away"`
+	Home int `json:"home"`
+}
+
+// ShootoutRound is one round of a shootout. Away or Home is nil when the
+// team did not shoot, as in a round decided before the second shooter.
+type ShootoutRound struct {
+	Round int              `json:"round"`
+	Away  *ShootoutAttempt `json:"away,omitempty"`
+	Home  *ShootoutAttempt `json:"home,omitempty"`
+}
+
+// Scored reports whether a shootout attempt was a goal.
+func (a *ShootoutAttempt) Scored() bool {
+	return a != nil && a.Result == "goal"
+}
+
+// Period returns the row of period number n.
+func (l *Linescore) Period(n int) (PeriodScore, bool) {
+	for _, ps := range l.ByPeriod {
+		if ps.PeriodDescriptor.Number == n {
+			return ps, true
+		}
+	}
+	return PeriodScore{}, false
+}
+
+// WentToShootout reports whether the game was decided by a shootout.
+func (l *Linescore) WentToShootout() bool {
+	if len(l.Shootout) > 0 {
+		return true
+	}
+	return slices.ContainsFunc(l.ByPeriod, func(ps PeriodScore) bool {
+		return ps.PeriodDescriptor.PeriodType == PeriodTypeShootout
+	})
+}
+
+// ShootoutScore returns the goals each team scored in the shootout.
+func (l *Linescore) ShootoutScore() (away, home int) {
+	for _, r := range l.Shootout {
+		if r.Away.Scored() {
+			away++
+		}
+		if r.Home.Scored() {
+			home++
+		}
+	}
+	return away, home
+}
+
+// LinescoreFromLanding builds a linescore from a game's landing payload,
+// for games whose right rail has none: goals by period from the scoring
+// summary, scoreless periods with zeros, totals from the team scores and
+// the shootout round by round. As in the official linescore, the shootout
+// row credits its winner with the deciding goal.
+func LinescoreFromLanding(m *GameMatchup) *Linescore {
+	box := Boxscore{PeriodDescriptor: m.PeriodDescriptor}
+	l := &Linescore{
+		ByPeriod: []PeriodScore{},
+		Totals:   LinescoreTotal{Away: m.AwayTeam.Score, Home: m.HomeTeam.Score},
+		Shootout: m.ShootoutRounds(),
+	}
+	for _, p := range box.ByPeriod(m, nil) {
+		ps := PeriodScore{PeriodDescriptor: p.PeriodDescriptor, Away: p.AwayGoals, Home: p.HomeGoals}
+		if ps.PeriodDescriptor.PeriodType == PeriodTypeShootout {
+			ps.Away, ps.Home = 0, 0
+			switch away, home := l.ShootoutScore(); {
+			case away > home:
+				ps.Away = 1
+			case home > away:
+				ps.Home = 1
+			}
+		}
+		l.ByPeriod = append(l.ByPeriod, ps)
+	}
+	return l
+}
+
+// ShootoutRounds pairs the landing summary's shootout attempts into
+// rounds, in shooting order.
+func (m *GameMatchup) ShootoutRounds() []ShootoutRound {
+	if m.Summary == nil || m.Summary.Shootout == nil {
+		return nil
+	}
+	attempts := slices.Clone(*m.Summary.Shootout)
+	slices.SortStableFunc(attempts, func(a, b ShootoutAttempt) int {
+		return cmp.Compare(a.Sequence, b.Sequence)
+	})
+	var rounds []ShootoutRound
+	var awayShots, homeShots int
+	for i := range attempts {
+		a := &attempts[i]
+		home := a.TeamAbbrev.Default == m.HomeTeam.Abbrev
+		n := awayShots
+		if home {
+			n = homeShots
+		}
+		if n == len(rounds) {
+			rounds = append(rounds, ShootoutRound{Round: n + 1})
+		}
+		if home {
+			rounds[n].Home = a
+			homeShots++
+		} else {
+			rounds[n].Away = a
+			awayShots++
+		}
+	}
+	return rounds
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const shootoutLanding = `{
+	"id": 2023020300, "gameState": "OFF",
+	"periodDescriptor": {"number": 5, "periodType": "SO", "maxRegulationPeriods": 3},
+	"awayTeam": {"id": 10, "abbrev": "TOR", "score": 3},
+	"homeTeam": {"id": 8, "abbrev": "MTL", "score": 2},
+	"summary": {
+		"scoring": [
+			{"periodDescriptor": {"number": 1, "periodType": "REG"}, "goals": [{"isHome": false}, {"isHome": true}]},
+			{"periodDescriptor": {"number": 3, "periodType": "REG"}, "goals": [{"isHome": true}, {"isHome": false}]}
+		],
+		"shootout": [
+			{"sequence": 3, "teamAbbrev": {"default": "TOR"}, "result": "goal"},
+			{"sequence": 1, "teamAbbrev": {"default": "TOR"}, "result": "save"},
+			{"sequence": 2, "teamAbbrev": {"default": "MTL"}, "result": "save"},
+			{"sequence": 4, "teamAbbrev": {"default": "MTL"}, "result": "miss"}
+		],
+		"penalties": []
+	}
+}`
+
+func TestLinescoreFromLanding(t *testing.T) {
+	var m GameMatchup
+	if err := json.Unmarshal([]byte(shootoutLanding), &m); err != nil {
+		t.Fatalf("unmarshal landing: %v", err)
+	}
+	l := LinescoreFromLanding(&m)
+	want := [][2]int{{1, 1}, {0, 0}, {1, 1}, {0, 0}, {1, 0}}
+	if len(l.ByPeriod) != len(want) {
+		t.Fatalf("ByPeriod = %+v", l.ByPeriod)
+	}
+	for i, ps := range l.ByPeriod {
+		if ps.PeriodDescriptor.Number != i+1 || ps.Away != want[i][0] || ps.Home != want[i][1] {
+			t.Errorf("period %d = %+v, want %v", i+1, ps, want[i])
+		}
+	}
+	if l.ByPeriod[3].PeriodDescriptor.PeriodType != PeriodTypeOvertime || l.ByPeriod[4].PeriodDescriptor.PeriodType != PeriodTypeShootout {
+		t.Errorf("period types = %s, %s", l.ByPeriod[3].PeriodDescriptor.PeriodType, l.ByPeriod[4].PeriodDescriptor.PeriodType)
+	}
+	if l.Totals != (LinescoreTotal{Away: 3, Home: 2}) {
+		t.Errorf("Totals = %+v", l.Totals)
+	}
+	if !l.WentToShootout() || len(l.Shootout) != 2 {
+		t.Fatalf("Shootout = %+v", l.Shootout)
+	}
+	if r := l.Shootout[1]; r.Round != 2 || !r.Away.Scored() || r.Home.Scored() || r.Home.Sequence != 4 {
+		t.Errorf("round 2 = %+v", r)
+	}
+	if away, home := l.ShootoutScore(); away != 1 || home != 0 {
+		t.Errorf("ShootoutScore() = %d-%d, want 1-0", away, home)
+	}
+	if ps, ok := l.Period(3); !ok || ps.Home != 1 {
+		t.Errorf("Period(3) = %+v, %v", ps, ok)
+	}
+	if _, ok := l.Period(6); ok {
+		t.Error("Period(6) found")
+	}
+}
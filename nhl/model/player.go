@@ -1,4 +1,6 @@
-package nhl
+package model
+
+import "strings"
 
 // PlayerLanding represents comprehensive player profile data from the NHL API.
 type PlayerLanding struct {
@@ -17,7 +19,7 @@ type PlayerLanding struct {
 	BirthDate          string           `json:"birthDate"`
 	BirthCity          *LocalizedString `json:"birthCity,omitempty"`
 	BirthStateProvince *LocalizedString `json:"birthStateProvince,omitempty"`
-	BirthCountry       *string          `json:"birthCountry,omitempty"`
+	BirthCountry       *Country         `json:"birthCountry,omitempty"`
 	ShootsCatches      Handedness       `json:"shootsCatches"`
 	DraftDetails       *DraftDetails    `json:"draftDetails,omitempty"`
 	PlayerSlug         *string          `json:"playerSlug,omitempty"`
@@ -56,19 +58,19 @@ type PlayerStats struct {
 	GamesPlayed *int `json:"gamesPlayed,omitempty"`
 
 	// Skater stats
-	Goals             *int     `json:"goals,omitempty"`
-	Assists           *int     `json:"assists,omitempty"`
-	Points            *int     `json:"points,omitempty"`
-	PlusMinus         *int     `json:"plusMinus,omitempty"`
-	PIM               *int     `json:"pim,omitempty"`
-	PowerPlayGoals    *int     `json:"powerPlayGoals,omitempty"`
-	PowerPlayPoints   *int     `json:"powerPlayPoints,omitempty"`
-	ShortHandedGoals  *int     `json:"shortHandedGoals,omitempty"`
-	ShortHandedPoints *int     `json:"shortHandedPoints,omitempty"`
-	Shots             *int     `json:"shots,omitempty"`
-	ShootingPctg      *float64 `json:"shootingPctg,omitempty"`
-	FaceoffWinPctg    *float64 `json:"faceoffWinPctg,omitempty"`
-	AvgTOI            *string  `json:"avgToi,omitempty"`
+	Goals             *int       `json:"goals,omitempty"`
+	Assists           *int       `json:"assists,omitempty"`
+	Points            *int       `json:"points,omitempty"`
+	PlusMinus         *int       `json:"plusMinus,omitempty"`
+	PIM               *int       `json:"pim,omitempty"`
+	PowerPlayGoals    *int       `json:"powerPlayGoals,omitempty"`
+	PowerPlayPoints   *int       `json:"powerPlayPoints,omitempty"`
+	ShortHandedGoals  *int       `json:"shortHandedGoals,omitempty"`
+	ShortHandedPoints *int       `json:"shortHandedPoints,omitempty"`
+	Shots             *int       `json:"shots,omitempty"`
+	ShootingPctg      *float64   `json:"shootingPctg,omitempty"`
+	FaceoffWinPctg    *float64   `json:"faceoffWinPctg,omitempty"`
+	AvgTOI            *TimeOnIce `json:"avgToi,omitempty"`
 
 	// Goalie stats
 	Wins            *int     `json:"wins,omitempty"`
@@ -108,23 +110,23 @@ type AwardSeason struct {
 
 // GameLog represents a game log entry for a single game.
 type GameLog struct {
-	GameID           GameID   `json:"gameId"`
-	GameDate         string   `json:"gameDate"`
-	TeamAbbrev       string   `json:"teamAbbrev"`
-	HomeRoadFlag     HomeRoad `json:"homeRoadFlag"`
-	OpponentAbbrev   string   `json:"opponentAbbrev"`
-	Goals            int      `json:"goals"`
-	Assists          int      `json:"assists"`
-	Points           int      `json:"points"`
-	PlusMinus        int      `json:"plusMinus"`
-	PowerPlayGoals   int      `json:"powerPlayGoals"`
-	PowerPlayPoints  int      `json:"powerPlayPoints"`
-	Shots            int      `json:"shots"`
-	Shifts           int      `json:"shifts"`
-	TOI              string   `json:"toi"`
-	GameWinningGoals *int     `json:"gameWinningGoals,omitempty"`
-	OTGoals          *int     `json:"otGoals,omitempty"`
-	PIM              *int     `json:"pim,omitempty"`
+	GameID           GameID    `json:"gameId"`
+	GameDate         string    `json:"gameDate"`
+	TeamAbbrev       string    `json:"teamAbbrev"`
+	HomeRoadFlag     HomeRoad  `json:"homeRoadFlag"`
+	OpponentAbbrev   string    `json:"opponentAbbrev"`
+	Goals            int       `json:"goals"`
+	Assists          int       `json:"assists"`
+	Points           int       `json:"points"`
+	PlusMinus        int       `json:"plusMinus"`
+	PowerPlayGoals   int       `json:"powerPlayGoals"`
+	PowerPlayPoints  int       `json:"powerPlayPoints"`
+	Shots            int       `json:"shots"`
+	Shifts           int       `json:"shifts"`
+	TOI              TimeOnIce `json:"toi"`
+	GameWinningGoals *int      `json:"gameWinningGoals,omitempty"`
+	OTGoals          *int      `json:"otGoals,omitempty"`
+	PIM              *int      `json:"pim,omitempty"`
 }
 
 // PlayerGameLog represents a player's game log for a season.
@@ -148,5 +150,97 @@ type PlayerSearchResult struct {
 	Height             *string  `json:"height,omitempty"`
 	BirthCity          *string  `json:"birthCity,omitempty"`
 	BirthStateProvince *string  `json:"birthStateProvince,omitempty"`
-	BirthCountry       *string  `json:"birthCountry,omitempty"`
+	BirthCountry       *Country `json:"birthCountry,omitempty"`
+}
+
+// PlayerSpotlight is a player featured in the league's player spotlight.
+type PlayerSpotlight struct {
+	PlayerID      PlayerID        `json:"playerId"`
+	Name          LocalizedString `json:"name"`
+	PlayerSlug    string          `json:"playerSlug"`
+	Position      Position        `json:"position"`
+	SweaterNumber int             `json:"sweaterNumber"`
+	TeamID        TeamID          `json:"teamId"`
+	Headshot      string          `json:"headshot"`
+	TeamTriCode   string          `json:"teamTriCode"`
+	TeamLogo      string          `json:"teamLogo"`
+	SortID        int             `json:"sortId"`
+}
+
+// MilestoneKind selects the skater or goalie milestones list.
+type MilestoneKind string
+
+const (
+	// MilestoneSkaters lists skaters nearing a goals, assists, points or
+	// games played milestone.
+	MilestoneSkaters MilestoneKind = "skaters"
+	// MilestoneGoalies lists goalies nearing a wins, shutouts or games
+	// played milestone.
+	MilestoneGoalies MilestoneKind = "goalies"
+)
+
+// IsValid reports whether k is a known milestones list.
+func (k MilestoneKind) IsValid() bool {
+	return k == MilestoneSkaters || k == MilestoneGoalies
+}
+
+// Milestone is a player approaching a career milestone. Skater lists fill
+// the scoring counts and goalie lists Wins and Shutouts.
+type Milestone struct {
+	ID              int64    `json:"id"`
+	PlayerID        PlayerID `json:"playerId"`
+	FirstName       string   `json:"firstName"`
+	LastName        string   `json:"lastName"`
+	PlayerFullName  string   `json:"playerFullName"`
+	CurrentTeamID   TeamID   `json:"currentTeamId"`
+	TeamAbbrev      string   `json:"teamAbbrev"`
+	TeamFullName    string   `json:"teamFullName"`
+	TeamCommonName  string   `json:"teamCommonName"`
+	TeamPlaceName   string   `json:"teamPlaceName"`
+	GameType        GameType `json:"gameTypeId"`
+	GamesPlayed     int      `json:"gamesPlayed"`
+	Goals           int      `json:"goals"`
+	Assists         int      `json:"assists"`
+	Points          int      `json:"points"`
+	Wins            int      `json:"wins"`
+	Shutouts        int      `json:"so"`
+	Milestone       string   `json:"milestone"`
+	MilestoneAmount int      `json:"milestoneAmount"`
+}
+
+// Current returns the player's career total in the milestone's category,
+// and false when the category is not recognized.
+func (m *Milestone) Current() (int, bool) {
+	switch strings.ToLower(strings.ReplaceAll(m.Milestone, " ", "")) {
+	case "goals":
+		return m.Goals, true
+	case "assists":
+		return m.Assists, true
+	case "points":
+		return m.Points, true
+	case "gamesplayed", "games":
+		return m.GamesPlayed, true
+	case "wins":
+		return m.Wins, true
+	case "shutouts", "so":
+		return m.Shutouts, true
+	default:
+		return 0, false
+	}
+}
+
+// Remaining returns how far the player is from the milestone, and false
+// when the milestone's category is not recognized.
+func (m *Milestone) Remaining() (int, bool) {
+	current, ok := m.Current()
+	if !ok {
+		return 0, false
+	}
+	return max(0, m.MilestoneAmount-current), true
+}
+
+// MilestonesResponse represents the API response for milestones.
+type MilestonesResponse struct {
+	Data  []Milestone `json:"data"`
+	Total int         `json:"total"`
 }
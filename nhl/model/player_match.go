@@ -0,0 +1,173 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// MinPlayerMatchScore is the lowest score MatchPlayerName reports.
+const MinPlayerMatchScore = 0.7
+
+// PlayerMatch is a search result ranked by MatchPlayerName.
+type PlayerMatch struct {
+	Player PlayerSearchResult
+	// Score is 1 for an exact match after normalization and decreases
+	// with each typo; it is never below MinPlayerMatchScore.
+	Score float64
+}
+
+// MatchPlayerName ranks candidates by how well their name matches query,
+// best first, dropping those scoring below MinPlayerMatchScore. Matching
+// ignores case, diacritics and punctuation ("Stutzle" matches "Stützle"),
+// tolerates typos and transpositions ("Hishier" matches "Hischier"), and
+// accepts a last name or name prefix on its own. Ties keep active players
+// first, then the candidates' order.
+func MatchPlayerName(query string, candidates []PlayerSearchResult) []PlayerMatch {
+	queryTokens := strings.Fields(normalizeName(query))
+	if len(queryTokens) == 0 {
+		return nil
+	}
+
+	var matches []PlayerMatch
+	for _, candidate := range candidates {
+		score := nameScore(queryTokens, strings.Fields(normalizeName(candidate.Name)))
+		if score >= MinPlayerMatchScore {
+			matches = append(matches, PlayerMatch{Player: candidate, Score: score})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b PlayerMatch) int {
+		switch {
+		case a.Score != b.Score:
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		case a.Player.Active != b.Player.Active:
+			if a.Player.Active {
+				return -1
+			}
+			return 1
+		}
+		return 0
+	})
+	return matches
+}
+
+// nameScore compares a query with a candidate name, both tokenized. It is
+// the better of the whole-name similarity and a per-token score, where
+// each query token is paired with its closest name token and names whose
+// tokens were not all matched score slightly lower.
+func nameScore(query, name []string) float64 {
+	if len(name) == 0 {
+		return 0
+	}
+	whole := similarity(strings.Join(query, " "), strings.Join(name, " "))
+
+	var total float64
+	matched := make(map[int]bool)
+	for _, q := range query {
+		best, bestIndex := 0.0, -1
+		for i, n := range name {
+			if s := tokenSimilarity(q, n); s > best {
+				best, bestIndex = s, i
+			}
+		}
+		total += best
+		if bestIndex >= 0 {
+			matched[bestIndex] = true
+		}
+	}
+	coverage := float64(len(matched)) / float64(len(name))
+	perToken := 0.9*total/float64(len(query)) + 0.1*coverage
+
+	return max(whole, perToken)
+}
+
+// tokenSimilarity scores two name tokens, treating a query token of at
+// least three letters that prefixes the name token as a strong match.
+func tokenSimilarity(q, n string) float64 {
+	s := similarity(q, n)
+	if len(q) >= 3 && strings.HasPrefix(n, q) {
+		s = max(s, 0.85+0.15*float64(len(q))/float64(len(n)))
+	}
+	return s
+}
+
+// similarity is 1 minus the edit distance normalized by the longer string.
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(editDistance(ra, rb))/float64(longest)
+}
+
+// editDistance is the optimal string alignment distance: insertions,
+// deletions, substitutions and adjacent transpositions each cost one.
+func editDistance(a, b []rune) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+// diacriticFolds maps accented and special Latin letters found in player
+// and team names to plain ASCII.
+var diacriticFolds = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a", 'ą': "a",
+	'æ': "ae", 'ç': "c", 'č': "c", 'ć': "c", 'ď': "d", 'đ': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ě': "e", 'ę': "e", 'ē': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ł': "l", 'ľ': "l", 'ĺ': "l",
+	'ñ': "n", 'ň': "n", 'ń': "n", 'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ő': "o",
+	'œ': "oe", 'ř': "r", 'š': "s", 'ś': "s", 'ß': "ss", 'ť': "t",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ů': "u", 'ű': "u",
+	'ý': "y", 'ÿ': "y", 'ž': "z", 'ź': "z", 'ż': "z",
+}
+
+// foldDiacritics lowercases s and replaces accented letters with their
+// ASCII forms.
+func foldDiacritics(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if folded, ok := diacriticFolds[r]; ok {
+			b.WriteString(folded)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// normalizeName folds diacritics and turns punctuation and hyphens into
+// spaces, keeping apostrophes out so "O'Reilly" matches "OReilly".
+func normalizeName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return r
+		case r == '\'' || r == '’' || r == '.':
+			return -1
+		default:
+			return ' '
+		}
+	}, foldDiacritics(s))
+}
@@ -0,0 +1,114 @@
+package model
+
+import "testing"
+
+func searchResults(names ...string) []PlayerSearchResult {
+	results := make([]PlayerSearchResult, len(names))
+	for i, name := range names {
+		results[i] = PlayerSearchResult{PlayerID: PlayerID(8470000 + i), Name: name, Active: true}
+	}
+	return results
+}
+
+func TestMatchPlayerName(t *testing.T) {
+	candidates := searchResults("Nico Hischier", "Tim Stützle", "Connor McDavid", "Ryan O'Reilly", "Nick Suzuki", "Brady Tkachuk", "Matthew Tkachuk")
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"Hishier", "Nico Hischier"},
+		{"hischeir", "Nico Hischier"},
+		{"Stutzle", "Tim Stützle"},
+		{"tim stutzle", "Tim Stützle"},
+		{"McDav", "Connor McDavid"},
+		{"conor mcdavid", "Connor McDavid"},
+		{"OReilly", "Ryan O'Reilly"},
+		{"suzuky", "Nick Suzuki"},
+		{"Matthew Tkachuk", "Matthew Tkachuk"},
+	}
+	for _, tt := range tests {
+		matches := MatchPlayerName(tt.query, candidates)
+		if len(matches) == 0 {
+			t.Errorf("MatchPlayerName(%q) found nothing, want %s", tt.query, tt.want)
+			continue
+		}
+		if got := matches[0].Player.Name; got != tt.want {
+			t.Errorf("MatchPlayerName(%q) best = %s (%.2f), want %s", tt.query, got, matches[0].Score, tt.want)
+		}
+	}
+}
+
+func TestMatchPlayerName_Ranking(t *testing.T) {
+	candidates := searchResults("Brady Tkachuk", "Matthew Tkachuk", "Keith Tkachuk")
+	candidates[2].Active = false
+
+	matches := MatchPlayerName("Tkachuk", candidates)
+	if len(matches) != 3 {
+		t.Fatalf("len(matches) = %d, want 3", len(matches))
+	}
+	if matches[2].Player.Name != "Keith Tkachuk" {
+		t.Errorf("inactive player should rank last on a tie, got %+v", matches)
+	}
+
+	exact := MatchPlayerName("Matthew Tkachuk", candidates)
+	if exact[0].Score != 1 || exact[0].Player.Name != "Matthew Tkachuk" {
+		t.Errorf("exact match = %+v, want score 1", exact[0])
+	}
+	for i := 1; i < len(exact); i++ {
+		if exact[i].Score >= exact[0].Score {
+			t.Errorf("partial match %+v should score below the exact match", exact[i])
+		}
+	}
+}
+
+func TestMatchPlayerName_NoMatch(t *testing.T) {
+	candidates := searchResults("Nico Hischier", "Nick Suzuki")
+	if got := MatchPlayerName("Gretzky", candidates); got != nil {
+		t.Errorf("MatchPlayerName(Gretzky) = %+v, want nil", got)
+	}
+	if got := MatchPlayerName("  ", candidates); got != nil {
+		t.Errorf("MatchPlayerName(blank) = %+v, want nil", got)
+	}
+	for _, m := range MatchPlayerName("Nic", candidates) {
+		if m.Score < MinPlayerMatchScore {
+			t.Errorf("match %+v below MinPlayerMatchScore", m)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"hischier", "hishier", 1},
+		{"hischier", "hischeir", 1},
+		{"kitten", "sitting", 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance([]rune(tt.a), []rune(tt.b)); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	tests := map[string]string{
+		"Tim Stützle":        "tim stutzle",
+		"Ryan O'Reilly":      "ryan oreilly",
+		"Jesperi Kotkaniemi": "jesperi kotkaniemi",
+		"Pierre-Luc Dubois":  "pierre luc dubois",
+		"J.T. Miller":        "jt miller",
+		"Juraj Slafkovský":   "juraj slafkovsky",
+		"Leon Draisaitl":     "leon draisaitl",
+		"Lukáš Dostál":       "lukas dostal",
+	}
+	for input, want := range tests {
+		if got := normalizeName(input); got != want {
+			t.Errorf("normalizeName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PlayoffBracket is the postseason bracket for a season. Series appear as
+// soon as the league announces them; teams of series whose participants are
+// not yet decided are nil.
+type PlayoffBracket struct {
+	BracketLogo   string                 `json:"bracketLogo"`
+	BracketLogoFr string                 `json:"bracketLogoFr"`
+	Series        []PlayoffBracketSeries `json:"series"`
+}
+
+// PlayoffBracketSeries is one series in the bracket with its current record.
+type PlayoffBracketSeries struct {
+	SeriesURL            string              `json:"seriesUrl"`
+	SeriesTitle          string              `json:"seriesTitle"`
+	SeriesAbbrev         string              `json:"seriesAbbrev"`
+	SeriesLetter         string              `json:"seriesLetter"`
+	PlayoffRound         int                 `json:"playoffRound"`
+	TopSeedRank          int                 `json:"topSeedRank"`
+	TopSeedRankAbbrev    string              `json:"topSeedRankAbbrev"`
+	TopSeedWins          int                 `json:"topSeedWins"`
+	BottomSeedRank       int                 `json:"bottomSeedRank"`
+	BottomSeedRankAbbrev string              `json:"bottomSeedRankAbbrev"`
+	BottomSeedWins       int                 `json:"bottomSeedWins"`
+	WinningTeamID        *TeamID             `json:"winningTeamId,omitempty"`
+	LosingTeamID         *TeamID             `json:"losingTeamId,omitempty"`
+	TopSeedTeam          *PlayoffBracketTeam `json:"topSeedTeam,omitempty"`
+	BottomSeedTeam       *PlayoffBracketTeam `json:"bottomSeedTeam,omitempty"`
+	SeriesLogo           string              `json:"seriesLogo"`
+	SeriesLogoFr         string              `json:"seriesLogoFr"`
+	ConferenceAbbrev     string              `json:"conferenceAbbrev,omitempty"`
+	ConferenceName       string              `json:"conferenceName,omitempty"`
+}
+
+// IsComplete returns true once a winner has been decided.
+func (s PlayoffBracketSeries) IsComplete() bool {
+	return s.WinningTeamID != nil
+}
+
+// String implements fmt.Stringer for PlayoffBracketSeries.
+// Returns a formatted string like "R1 A: FLA 4 - TBL 1" or "SCF O: TBD".
+func (s PlayoffBracketSeries) String() string {
+	if s.TopSeedTeam == nil || s.BottomSeedTeam == nil {
+		return fmt.Sprintf("%s %s: TBD", s.SeriesAbbrev, s.SeriesLetter)
+	}
+	return fmt.Sprintf("%s %s: %s %d - %s %d", s.SeriesAbbrev, s.SeriesLetter,
+		s.TopSeedTeam.Abbrev, s.TopSeedWins, s.BottomSeedTeam.Abbrev, s.BottomSeedWins)
+}
+
+// PlayoffBracketTeam is a team as shown in the bracket.
+type PlayoffBracketTeam struct {
+	ID                       TeamID          `json:"id"`
+	Abbrev                   string          `json:"abbrev"`
+	Name                     LocalizedString `json:"name"`
+	CommonName               LocalizedString `json:"commonName"`
+	PlaceNameWithPreposition LocalizedString `json:"placeNameWithPreposition"`
+	Logo                     string          `json:"logo"`
+	DarkLogo                 string          `json:"darkLogo"`
+}
+
+// PlayoffRound groups the bracket's series for one round.
+type PlayoffRound struct {
+	Number int
+	Title  string
+	Abbrev string
+	Series []PlayoffBracketSeries
+}
+
+// Rounds groups the bracket's series by round, in round order with each
+// round's series ordered by letter.
+func (b *PlayoffBracket) Rounds() []PlayoffRound {
+	byNumber := make(map[int]*PlayoffRound)
+	var rounds []*PlayoffRound
+	for _, s := range b.Series {
+		r := byNumber[s.PlayoffRound]
+		if r == nil {
+			r = &PlayoffRound{Number: s.PlayoffRound, Title: s.SeriesTitle, Abbrev: s.SeriesAbbrev}
+			byNumber[s.PlayoffRound] = r
+			rounds = append(rounds, r)
+		}
+		r.Series = append(r.Series, s)
+	}
+
+	sort.Slice(rounds, func(i, j int) bool { return rounds[i].Number < rounds[j].Number })
+	result := make([]PlayoffRound, len(rounds))
+	for i, r := range rounds {
+		sort.SliceStable(r.Series, func(i, j int) bool { return r.Series[i].SeriesLetter < r.Series[j].SeriesLetter })
+		result[i] = *r
+	}
+	return result
+}
+
+// PlayoffSeries is a single playoff series with its games.
+type PlayoffSeries struct {
+	Round          int                 `json:"round"`
+	RoundAbbrev    string              `json:"roundAbbrev"`
+	RoundLabel     string              `json:"roundLabel"`
+	SeriesLetter   string              `json:"seriesLetter"`
+	SeriesLogo     string              `json:"seriesLogo"`
+	SeriesLogoFr   string              `json:"seriesLogoFr"`
+	NeededToWin    int                 `json:"neededToWin"`
+	Length         int                 `json:"length"`
+	TopSeedTeam    PlayoffSeriesTeam   `json:"topSeedTeam"`
+	BottomSeedTeam PlayoffSeriesTeam   `json:"bottomSeedTeam"`
+	Games          []PlayoffSeriesGame `json:"games"`
+}
+
+// Winner returns the team that has clinched the series, or nil while the
+// series is undecided.
+func (s *PlayoffSeries) Winner() *PlayoffSeriesTeam {
+	if s.NeededToWin <= 0 {
+		return nil
+	}
+	switch {
+	case s.TopSeedTeam.SeriesWins >= s.NeededToWin:
+		return &s.TopSeedTeam
+	case s.BottomSeedTeam.SeriesWins >= s.NeededToWin:
+		return &s.BottomSeedTeam
+	default:
+		return nil
+	}
+}
+
+// PlayoffSeriesTeam is a series participant with its regular-season record
+// and series wins.
+type PlayoffSeriesTeam struct {
+	ID             TeamID          `json:"id"`
+	Name           LocalizedString `json:"name"`
+	Abbrev         string          `json:"abbrev"`
+	PlaceName      LocalizedString `json:"placeName"`
+	Conference     *Conference     `json:"conference,omitempty"`
+	Record         string          `json:"record"`
+	SeriesWins     int             `json:"seriesWins"`
+	DivisionAbbrev string          `json:"divisionAbbrev"`
+	Seed           int             `json:"seed"`
+	Logo           string          `json:"logo"`
+	DarkLogo       string          `json:"darkLogo"`
+}
+
+// PlayoffSeriesGame is one game of a playoff series. Games that will only
+// be played if needed have IfNecessary set.
+type PlayoffSeriesGame struct {
+	ID                GameID               `json:"id"`
+	Season            Season               `json:"season"`
+	GameType          GameType             `json:"gameType"`
+	GameNumber        int                  `json:"gameNumber"`
+	IfNecessary       bool                 `json:"ifNecessary"`
+	Venue             LocalizedString      `json:"venue"`
+	NeutralSite       bool                 `json:"neutralSite"`
+	StartTimeUTC      string               `json:"startTimeUTC"`
+	EasternUTCOffset  string               `json:"easternUTCOffset"`
+	VenueUTCOffset    string               `json:"venueUTCOffset"`
+	VenueTimezone     string               `json:"venueTimezone"`
+	GameState         GameState            `json:"gameState"`
+	GameScheduleState GameScheduleState    `json:"gameScheduleState"`
+	TVBroadcasts      []TVBroadcast        `json:"tvBroadcasts"`
+	AwayTeam          PlayoffGameTeam      `json:"awayTeam"`
+	HomeTeam          PlayoffGameTeam      `json:"homeTeam"`
+	GameCenterLink    string               `json:"gameCenterLink"`
+	PeriodDescriptor  *PeriodDescriptor    `json:"periodDescriptor,omitempty"`
+	SeriesStatus      *PlayoffSeriesStatus `json:"seriesStatus,omitempty"`
+	GameOutcome       *GameOutcome         `json:"gameOutcome,omitempty"`
+}
+
+// PlayoffGameTeam is a team in a playoff series game. Score is present once
+// the game has started.
+type PlayoffGameTeam struct {
+	ID                       TeamID          `json:"id"`
+	CommonName               LocalizedString `json:"commonName"`
+	PlaceName                LocalizedString `json:"placeName"`
+	PlaceNameWithPreposition LocalizedString `json:"placeNameWithPreposition"`
+	Abbrev                   string          `json:"abbrev"`
+	Score                    *int            `json:"score,omitempty"`
+}
+
+// PlayoffSeriesStatus is the series record going into or out of a game.
+type PlayoffSeriesStatus struct {
+	Round                int    `json:"round"`
+	SeriesAbbrev         string `json:"seriesAbbrev"`
+	SeriesTitle          string `json:"seriesTitle"`
+	SeriesLetter         string `json:"seriesLetter"`
+	NeededToWin          int    `json:"neededToWin"`
+	TopSeedTeamAbbrev    string `json:"topSeedTeamAbbrev"`
+	TopSeedWins          int    `json:"topSeedWins"`
+	BottomSeedTeamAbbrev string `json:"bottomSeedTeamAbbrev"`
+	BottomSeedWins       int    `json:"bottomSeedWins"`
+	GameNumberOfSeries   int    `json:"gameNumberOfSeries"`
+}
@@ -0,0 +1,203 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const bracketJSON = `{
+	"bracketLogo": "https://assets.nhle.com/logos/playoffs/png/scp-20232024-horizontal-banner-en.png",
+	"bracketLogoFr": "https://assets.nhle.com/logos/playoffs/png/scp-20232024-horizontal-banner-fr.png",
+	"series": [
+		{
+			"seriesUrl": "/schedule/playoff-series/2024/series-o/stanleycupfinal",
+			"seriesTitle": "Stanley Cup Final",
+			"seriesAbbrev": "SCF",
+			"seriesLetter": "O",
+			"playoffRound": 4,
+			"topSeedRank": 1,
+			"topSeedRankAbbrev": "D1",
+			"topSeedWins": 4,
+			"bottomSeedRank": 1,
+			"bottomSeedRankAbbrev": "D1",
+			"bottomSeedWins": 3,
+			"winningTeamId": 13,
+			"losingTeamId": 22,
+			"topSeedTeam": {"id": 13, "abbrev": "FLA", "name": {"default": "Florida Panthers"}, "commonName": {"default": "Panthers"}},
+			"bottomSeedTeam": {"id": 22, "abbrev": "EDM", "name": {"default": "Edmonton Oilers"}, "commonName": {"default": "Oilers"}},
+			"seriesLogo": "https://assets.nhle.com/logos/playoffs/png/scf-20232024.png"
+		},
+		{
+			"seriesUrl": "/schedule/playoff-series/2024/series-b/tampabaylightning-vs-floridapanthers",
+			"seriesTitle": "1st Round",
+			"seriesAbbrev": "R1",
+			"seriesLetter": "B",
+			"playoffRound": 1,
+			"topSeedRank": 2,
+			"topSeedWins": 2,
+			"bottomSeedRank": 3,
+			"bottomSeedWins": 1,
+			"conferenceAbbrev": "E",
+			"conferenceName": "Eastern"
+		},
+		{
+			"seriesTitle": "1st Round",
+			"seriesAbbrev": "R1",
+			"seriesLetter": "A",
+			"playoffRound": 1,
+			"topSeedWins": 4,
+			"bottomSeedWins": 1,
+			"winningTeamId": 13,
+			"losingTeamId": 14,
+			"topSeedTeam": {"id": 13, "abbrev": "FLA"},
+			"bottomSeedTeam": {"id": 14, "abbrev": "TBL"},
+			"conferenceAbbrev": "E",
+			"conferenceName": "Eastern"
+		}
+	]
+}`
+
+const playoffSeriesJSON = `{
+	"round": 1,
+	"roundAbbrev": "R1",
+	"roundLabel": "1st-round",
+	"seriesLetter": "A",
+	"neededToWin": 4,
+	"length": 7,
+	"topSeedTeam": {"id": 13, "name": {"default": "Florida Panthers"}, "abbrev": "FLA",
+		"conference": {"abbrev": "E", "name": "Eastern"}, "record": "52-24-6", "seriesWins": 4, "divisionAbbrev": "A", "seed": 1},
+	"bottomSeedTeam": {"id": 14, "name": {"default": "Tampa Bay Lightning"}, "abbrev": "TBL",
+		"record": "45-29-8", "seriesWins": 1, "seed": 4},
+	"games": [
+		{"id": 2023030111, "season": 20232024, "gameType": 3, "gameNumber": 1, "ifNecessary": false,
+		 "venue": {"default": "Amerant Bank Arena"}, "startTimeUTC": "2024-04-21T16:30:00Z",
+		 "gameState": "OFF", "gameScheduleState": "OK",
+		 "awayTeam": {"id": 14, "abbrev": "TBL", "score": 2}, "homeTeam": {"id": 13, "abbrev": "FLA", "score": 3},
+		 "periodDescriptor": {"number": 3, "periodType": "REG", "maxRegulationPeriods": 3},
+		 "seriesStatus": {"round": 1, "seriesAbbrev": "R1", "seriesLetter": "A", "neededToWin": 4,
+			"topSeedTeamAbbrev": "FLA", "topSeedWins": 1, "bottomSeedTeamAbbrev": "TBL", "bottomSeedWins": 0, "gameNumberOfSeries": 1},
+		 "gameOutcome": {"lastPeriodType": "REG"}},
+		{"id": 2023030116, "season": 20232024, "gameType": 3, "gameNumber": 6, "ifNecessary": true,
+		 "gameState": "FUT", "gameScheduleState": "OK",
+		 "awayTeam": {"id": 13, "abbrev": "FLA"}, "homeTeam": {"id": 14, "abbrev": "TBL"}}
+	]
+}`
+
+func TestPlayoffBracketDeserialization(t *testing.T) {
+	var bracket PlayoffBracket
+	if err := json.Unmarshal([]byte(bracketJSON), &bracket); err != nil {
+		t.Fatalf("failed to unmarshal PlayoffBracket: %v", err)
+	}
+	if len(bracket.Series) != 3 {
+		t.Fatalf("got %d series, want 3", len(bracket.Series))
+	}
+
+	final := bracket.Series[0]
+	if !final.IsComplete() || *final.WinningTeamID != 13 {
+		t.Errorf("final should be won by team 13, got %v", final.WinningTeamID)
+	}
+	if final.TopSeedTeam.Name.Default != "Florida Panthers" {
+		t.Errorf("TopSeedTeam.Name = %q", final.TopSeedTeam.Name.Default)
+	}
+
+	undecided := bracket.Series[1]
+	if undecided.IsComplete() || undecided.TopSeedTeam != nil {
+		t.Errorf("series B should be undecided with no teams, got %+v", undecided)
+	}
+}
+
+func TestPlayoffBracketSeriesString(t *testing.T) {
+	var bracket PlayoffBracket
+	if err := json.Unmarshal([]byte(bracketJSON), &bracket); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		series PlayoffBracketSeries
+		want   string
+	}{
+		{bracket.Series[0], "SCF O: FLA 4 - EDM 3"},
+		{bracket.Series[1], "R1 B: TBD"},
+	}
+	for _, tt := range tests {
+		if got := tt.series.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestPlayoffBracketRounds(t *testing.T) {
+	var bracket PlayoffBracket
+	if err := json.Unmarshal([]byte(bracketJSON), &bracket); err != nil {
+		t.Fatal(err)
+	}
+
+	rounds := bracket.Rounds()
+	if len(rounds) != 2 {
+		t.Fatalf("got %d rounds, want 2", len(rounds))
+	}
+	if rounds[0].Number != 1 || rounds[0].Title != "1st Round" || rounds[1].Abbrev != "SCF" {
+		t.Errorf("unexpected rounds %+v", rounds)
+	}
+	var letters []string
+	for _, s := range rounds[0].Series {
+		letters = append(letters, s.SeriesLetter)
+	}
+	if !reflect.DeepEqual(letters, []string{"A", "B"}) {
+		t.Errorf("first round letters = %v, want [A B]", letters)
+	}
+
+	if got := (&PlayoffBracket{}).Rounds(); len(got) != 0 {
+		t.Errorf("empty bracket should have no rounds, got %v", got)
+	}
+}
+
+func TestPlayoffSeriesDeserialization(t *testing.T) {
+	var series PlayoffSeries
+	if err := json.Unmarshal([]byte(playoffSeriesJSON), &series); err != nil {
+		t.Fatalf("failed to unmarshal PlayoffSeries: %v", err)
+	}
+	if series.TopSeedTeam.Record != "52-24-6" || series.TopSeedTeam.Conference.Name != "Eastern" {
+		t.Errorf("unexpected top seed %+v", series.TopSeedTeam)
+	}
+	if len(series.Games) != 2 {
+		t.Fatalf("got %d games, want 2", len(series.Games))
+	}
+	first := series.Games[0]
+	if *first.HomeTeam.Score != 3 || first.SeriesStatus.TopSeedWins != 1 || first.GameOutcome.LastPeriodType != PeriodTypeRegulation {
+		t.Errorf("unexpected first game %+v", first)
+	}
+	if last := series.Games[1]; !last.IfNecessary || last.AwayTeam.Score != nil {
+		t.Errorf("game 6 should be if-necessary with no score, got %+v", last)
+	}
+}
+
+func TestPlayoffSeriesWinner(t *testing.T) {
+	tests := []struct {
+		name        string
+		top, bottom int
+		needed      int
+		want        string
+	}{
+		{"top seed clinched", 4, 1, 4, "FLA"},
+		{"bottom seed clinched", 2, 4, 4, "TBL"},
+		{"in progress", 3, 3, 4, ""},
+		{"unknown length", 0, 0, 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := PlayoffSeries{
+				NeededToWin:    tt.needed,
+				TopSeedTeam:    PlayoffSeriesTeam{Abbrev: "FLA", SeriesWins: tt.top},
+				BottomSeedTeam: PlayoffSeriesTeam{Abbrev: "TBL", SeriesWins: tt.bottom},
+			}
+			got := ""
+			if w := s.Winner(); w != nil {
+				got = w.Abbrev
+			}
+			if got != tt.want {
+				t.Errorf("Winner() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
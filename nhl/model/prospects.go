@@ -0,0 +1,55 @@
+package model
+
+import "iter"
+
+// Prospects is a team's prospect pipeline: players in its system who are
+// not on the NHL roster, grouped by position like Roster.
+type Prospects struct {
+	Forwards   []ProspectPlayer `json:"forwards"`
+	Defensemen []ProspectPlayer `json:"defensemen"`
+	Goalies    []ProspectPlayer `json:"goalies"`
+}
+
+// PlayerCount returns the total number of prospects.
+func (p *Prospects) PlayerCount() int {
+	return len(p.Forwards) + len(p.Defensemen) + len(p.Goalies)
+}
+
+// All iterates over every prospect: forwards, then defensemen, then
+// goalies.
+func (p *Prospects) All() iter.Seq[ProspectPlayer] {
+	return func(yield func(ProspectPlayer) bool) {
+		for _, group := range [][]ProspectPlayer{p.Forwards, p.Defensemen, p.Goalies} {
+			for _, player := range group {
+				if !yield(player) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ProspectPlayer represents a prospect in a team's system. Prospects who
+// have not been given a number have a nil SweaterNumber.
+type ProspectPlayer struct {
+	ID                  PlayerID         `json:"id"`
+	Headshot            string           `json:"headshot"`
+	FirstName           LocalizedString  `json:"firstName"`
+	LastName            LocalizedString  `json:"lastName"`
+	SweaterNumber       *int             `json:"sweaterNumber,omitempty"`
+	Position            Position         `json:"positionCode"`
+	ShootsCatches       Handedness       `json:"shootsCatches"`
+	HeightInInches      int              `json:"heightInInches"`
+	WeightInPounds      int              `json:"weightInPounds"`
+	HeightInCentimeters int              `json:"heightInCentimeters"`
+	WeightInKilograms   int              `json:"weightInKilograms"`
+	BirthDate           string           `json:"birthDate"`
+	BirthCity           *LocalizedString `json:"birthCity,omitempty"`
+	BirthStateProvince  *LocalizedString `json:"birthStateProvince,omitempty"`
+	BirthCountry        string           `json:"birthCountry"`
+}
+
+// FullName returns the prospect's full name (first name + last name).
+func (p *ProspectPlayer) FullName() string {
+	return p.FirstName.Default + " " + p.LastName.Default
+}
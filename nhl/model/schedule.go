@@ -1,4 +1,4 @@
-package nhl
+package model
 
 import "fmt"
 
@@ -11,6 +11,25 @@ type ScheduleGame struct {
 	AwayTeam     ScheduleTeam `json:"awayTeam"`
 	HomeTeam     ScheduleTeam `json:"homeTeam"`
 	GameState    GameState    `json:"gameState"`
+	// GameScheduleState is nil when the schedule does not say, as in stubs
+	// for seasons not yet published in full.
+	GameScheduleState *GameScheduleState `json:"gameScheduleState,omitempty"`
+	Venue             *LocalizedString   `json:"venue,omitempty"`
+	TVBroadcasts      []TVBroadcast      `json:"tvBroadcasts,omitempty"`
+	// SpecialEvent is set for branded games such as the Winter Classic.
+	SpecialEvent *SpecialEvent `json:"specialEvent,omitempty"`
+}
+
+// IsTimeTBD reports whether the game's start time is still to be
+// determined: the schedule marks it TBD, or StartTimeUTC is missing or not
+// a time. StartTimeUTC is then at most a placeholder, so apps should
+// render something like "TBD" instead of a time.
+func (s ScheduleGame) IsTimeTBD() bool {
+	if s.GameScheduleState != nil && *s.GameScheduleState == GameScheduleStateTBD {
+		return true
+	}
+	_, err := s.StartTime()
+	return err != nil
 }
 
 // String implements fmt.Stringer for ScheduleGame.
@@ -57,9 +76,12 @@ type GameDay struct {
 }
 
 // TeamScheduleResponse represents a team-specific schedule response.
-// Used for monthly or weekly team schedules.
+// Used for monthly or weekly team schedules. The navigation dates are only
+// present on weekly responses.
 type TeamScheduleResponse struct {
-	Games []ScheduleGame `json:"games"`
+	NextStartDate     string         `json:"nextStartDate,omitempty"`
+	PreviousStartDate string         `json:"previousStartDate,omitempty"`
+	Games             []ScheduleGame `json:"games"`
 }
 
 // DailyScores represents game scores for a specific day.
@@ -74,11 +96,12 @@ type DailyScores struct {
 // GameScore represents a single game's score information.
 // Similar to ScheduleGame but focused on score display.
 type GameScore struct {
-	ID        GameID       `json:"id"`
-	GameType  GameType     `json:"gameType"`
-	GameState GameState    `json:"gameState"`
-	AwayTeam  ScheduleTeam `json:"awayTeam"`
-	HomeTeam  ScheduleTeam `json:"homeTeam"`
+	ID               GameID            `json:"id"`
+	GameType         GameType          `json:"gameType"`
+	GameState        GameState         `json:"gameState"`
+	AwayTeam         ScheduleTeam      `json:"awayTeam"`
+	HomeTeam         ScheduleTeam      `json:"homeTeam"`
+	PeriodDescriptor *PeriodDescriptor `json:"periodDescriptor,omitempty"`
 }
 
 // String implements fmt.Stringer for GameScore.
@@ -0,0 +1,138 @@
+package model
+
+import "fmt"
+
+// ScheduleChangeKind is the kind of change reported by a ScheduleChange.
+type ScheduleChangeKind int
+
+const (
+	// ScheduleChangePostponed reports a game the schedule marks postponed.
+	ScheduleChangePostponed ScheduleChangeKind = iota + 1
+	// ScheduleChangeCancelled reports a game the schedule marks cancelled.
+	ScheduleChangeCancelled
+	// ScheduleChangeRescheduled reports a game moved to another date or
+	// start time. A start time announced for a game whose time was TBD is
+	// not a move.
+	ScheduleChangeRescheduled
+	// ScheduleChangeRelocated reports a game moved to another venue or
+	// hosted by the other team.
+	ScheduleChangeRelocated
+)
+
+// String returns the kind name.
+func (k ScheduleChangeKind) String() string {
+	switch k {
+	case ScheduleChangePostponed:
+		return "postponed"
+	case ScheduleChangeCancelled:
+		return "cancelled"
+	case ScheduleChangeRescheduled:
+		return "rescheduled"
+	case ScheduleChangeRelocated:
+		return "relocated"
+	default:
+		return fmt.Sprintf("ScheduleChangeKind(%d)", int(k))
+	}
+}
+
+// ScheduleChange is one change to a game between two schedule snapshots.
+type ScheduleChange struct {
+	Kind   ScheduleChangeKind
+	GameID GameID
+	// Previous and Current are the game as seen by the two snapshots; their
+	// StartTime and Venue give the old and new datetimes and venues.
+	Previous ScheduleGame
+	Current  ScheduleGame
+}
+
+// String returns a short description such as
+// "rescheduled: BUF @ TOR 2024-01-10T00:00:00Z -> 2024-02-21T00:30:00Z".
+func (c ScheduleChange) String() string {
+	game := fmt.Sprintf("%s @ %s", c.Current.AwayTeam.Abbrev, c.Current.HomeTeam.Abbrev)
+	switch c.Kind {
+	case ScheduleChangeRescheduled:
+		return fmt.Sprintf("%s: %s %s -> %s", c.Kind, game, c.Previous.StartTimeUTC, c.Current.StartTimeUTC)
+	case ScheduleChangeRelocated:
+		return fmt.Sprintf("%s: %s %s -> %s", c.Kind, game, venueName(c.Previous), venueName(c.Current))
+	default:
+		return fmt.Sprintf("%s: %s %s", c.Kind, game, c.Previous.StartTimeUTC)
+	}
+}
+
+// DiffSchedules returns the changes between two snapshots of a schedule,
+// game by game in the order of current. A game reports becoming postponed
+// or cancelled once, then whether it was rescheduled, then whether it was
+// relocated. Games missing from either snapshot are ignored, so snapshots
+// may cover different date ranges.
+func DiffSchedules(previous, current []ScheduleGame) []ScheduleChange {
+	before := make(map[GameID]ScheduleGame, len(previous))
+	for _, g := range previous {
+		before[g.ID] = g
+	}
+
+	var changes []ScheduleChange
+	for _, cur := range current {
+		prev, ok := before[cur.ID]
+		if !ok {
+			continue
+		}
+		add := func(kind ScheduleChangeKind) {
+			changes = append(changes, ScheduleChange{Kind: kind, GameID: cur.ID, Previous: prev, Current: cur})
+		}
+
+		prevState, curState := scheduleStateOf(prev), scheduleStateOf(cur)
+		if curState != prevState {
+			switch curState {
+			case GameScheduleStatePostponed:
+				add(ScheduleChangePostponed)
+			case GameScheduleStateCancelled:
+				add(ScheduleChangeCancelled)
+			}
+		}
+		if rescheduled(prev, cur) {
+			add(ScheduleChangeRescheduled)
+		}
+		if prev.HomeTeam.ID != cur.HomeTeam.ID ||
+			(prev.Venue != nil && cur.Venue != nil && prev.Venue.Default != cur.Venue.Default) {
+			add(ScheduleChangeRelocated)
+		}
+	}
+	return changes
+}
+
+// Games returns the games of every day of the week, in order.
+func (w *WeeklyScheduleResponse) Games() []ScheduleGame {
+	var games []ScheduleGame
+	for _, day := range w.GameWeek {
+		games = append(games, day.Games...)
+	}
+	return games
+}
+
+func scheduleStateOf(g ScheduleGame) GameScheduleState {
+	if g.GameScheduleState == nil {
+		return ""
+	}
+	return *g.GameScheduleState
+}
+
+// rescheduled reports whether cur moved to another date, or to another
+// start time from a known one.
+func rescheduled(prev, cur ScheduleGame) bool {
+	if prev.GameDate != nil && cur.GameDate != nil && *prev.GameDate != *cur.GameDate {
+		return true
+	}
+	if prev.IsTimeTBD() || cur.IsTimeTBD() {
+		return false
+	}
+	prevStart, _ := prev.StartTime()
+	curStart, _ := cur.StartTime()
+	return !prevStart.Equal(curStart)
+}
+
+func venueName(g ScheduleGame) string {
+	if g.Venue == nil {
+		return "?"
+	}
+	return g.Venue.Default
+}
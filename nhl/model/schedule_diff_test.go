@@ -0,0 +1,89 @@
+package model
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiffSchedules(t *testing.T) {
+	state := func(s GameScheduleState) *GameScheduleState { return &s }
+	venue := func(name string) *LocalizedString { return &LocalizedString{Default: name} }
+	game := func(id GameID, date, start string, home TeamID) ScheduleGame {
+		return ScheduleGame{
+			ID: id, GameType: GameTypeRegularSeason, GameDate: &date, StartTimeUTC: start,
+			AwayTeam: ScheduleTeam{ID: 7, Abbrev: "BUF"}, HomeTeam: ScheduleTeam{ID: home, Abbrev: "TOR"},
+			GameState: GameStateFuture, GameScheduleState: state(GameScheduleStateOK), Venue: venue("Scotiabank Arena"),
+		}
+	}
+
+	previous := []ScheduleGame{
+		game(1, "2024-01-09", "2024-01-10T00:00:00Z", 10),
+		game(2, "2024-01-11", "2024-01-12T00:00:00Z", 10),
+		game(3, "2024-01-13", "2024-01-14T00:00:00Z", 10),
+		game(4, "2024-01-15", "2024-01-16T00:00:00Z", 10),
+		game(5, "2024-01-17", "2024-01-18T00:00:00Z", 10),
+		game(6, "2024-01-19", "2024-01-20T00:00:00Z", 10),
+		game(8, "2024-01-23", "2024-01-24T00:00:00Z", 10),
+	}
+	previous[5].GameScheduleState = state(GameScheduleStateTBD)
+
+	unchanged := game(1, "2024-01-09", "2024-01-10T00:00:00Z", 10)
+	postponed := game(2, "2024-01-11", "2024-01-12T00:00:00Z", 10)
+	postponed.GameScheduleState = state(GameScheduleStatePostponed)
+	moved := game(3, "2024-02-20", "2024-02-21T00:30:00Z", 10)
+	relocated := game(4, "2024-01-15", "2024-01-16T00:00:00Z", 10)
+	relocated.Venue = venue("Tim Hortons Field")
+	cancelled := game(5, "2024-01-17", "2024-01-18T00:00:00Z", 10)
+	cancelled.GameScheduleState = state(GameScheduleStateCancelled)
+	timeSet := game(6, "2024-01-19", "2024-01-20T01:00:00Z", 10)
+	added := game(7, "2024-01-21", "2024-01-22T00:00:00Z", 10)
+	swapped := game(8, "2024-01-24", "2024-01-25T00:00:00Z", 7)
+
+	current := []ScheduleGame{swapped, unchanged, postponed, moved, relocated, cancelled, timeSet, added}
+	changes := DiffSchedules(previous, current)
+
+	type change struct {
+		kind ScheduleChangeKind
+		id   GameID
+	}
+	var got []change
+	for _, c := range changes {
+		got = append(got, change{c.Kind, c.GameID})
+	}
+	want := []change{
+		{ScheduleChangeRescheduled, 8},
+		{ScheduleChangeRelocated, 8},
+		{ScheduleChangePostponed, 2},
+		{ScheduleChangeRescheduled, 3},
+		{ScheduleChangeRelocated, 4},
+		{ScheduleChangeCancelled, 5},
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("DiffSchedules() = %v, want %v", got, want)
+	}
+
+	if s := changes[3].String(); s != "rescheduled: BUF @ TOR 2024-01-14T00:00:00Z -> 2024-02-21T00:30:00Z" {
+		t.Errorf("String() = %q", s)
+	}
+	if s := changes[4].String(); s != "relocated: BUF @ TOR Scotiabank Arena -> Tim Hortons Field" {
+		t.Errorf("String() = %q", s)
+	}
+	if len(DiffSchedules(current, current)) != 0 {
+		t.Error("DiffSchedules() of identical snapshots is not empty")
+	}
+}
+
+func TestWeeklyScheduleResponseGames(t *testing.T) {
+	w := WeeklyScheduleResponse{GameWeek: []GameDay{
+		{Date: "2024-01-09", Games: []ScheduleGame{{ID: 1}, {ID: 2}}},
+		{Date: "2024-01-10"},
+		{Date: "2024-01-11", Games: []ScheduleGame{{ID: 3}}},
+	}}
+	var ids []GameID
+	for _, g := range w.Games() {
+		ids = append(ids, g.ID)
+	}
+	if !slices.Equal(ids, []GameID{1, 2, 3}) {
+		t.Errorf("Games() = %v", ids)
+	}
+}
@@ -1,7 +1,8 @@
-package nhl
+package model
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -281,6 +282,66 @@ func TestScheduleGameDeserializationWithoutGameDate(t *testing.T) {
 	}
 }
 
+func TestScheduleGameFutureSeasonStub(t *testing.T) {
+	jsonData := `[
+		{
+			"id": 2027020001,
+			"gameType": 2,
+			"gameDate": "2027-10-07",
+			"startTimeUTC": "2027-10-07T04:00:00Z",
+			"awayTeam": {"id": 7, "abbrev": "BUF"},
+			"homeTeam": {"id": 10, "abbrev": "TOR"},
+			"gameState": "FUT",
+			"gameScheduleState": "TBD"
+		},
+		{
+			"id": 2027020002,
+			"gameType": 2,
+			"startTimeUTC": "TBD",
+			"awayTeam": {"id": 8},
+			"homeTeam": {"id": 6},
+			"gameState": "FUT"
+		},
+		{
+			"id": 2027020003,
+			"gameType": 2,
+			"awayTeam": {"id": 8},
+			"homeTeam": {"id": 6},
+			"gameState": "FUT"
+		},
+		{
+			"id": 2027020004,
+			"gameType": 2,
+			"startTimeUTC": "2027-10-08T23:00:00Z",
+			"awayTeam": {"id": 8},
+			"homeTeam": {"id": 6},
+			"gameState": "FUT",
+			"gameScheduleState": "OK"
+		}
+	]`
+
+	var games []ScheduleGame
+	if err := json.Unmarshal([]byte(jsonData), &games); err != nil {
+		t.Fatalf("failed to unmarshal schedule stubs: %v", err)
+	}
+	for i, want := range []bool{true, true, true, false} {
+		if got := games[i].IsTimeTBD(); got != want {
+			t.Errorf("game %d: IsTimeTBD() = %v, want %v", games[i].ID, got, want)
+		}
+	}
+	if games[1].GameScheduleState != nil || games[1].TVBroadcasts != nil {
+		t.Errorf("missing fields decoded as %v, %v", games[1].GameScheduleState, games[1].TVBroadcasts)
+	}
+
+	data, err := json.Marshal(games[2])
+	if err != nil {
+		t.Fatalf("failed to marshal stub: %v", err)
+	}
+	if strings.Contains(string(data), "gameScheduleState") {
+		t.Errorf("marshaled stub has a schedule state: %s", data)
+	}
+}
+
 func TestGameScoreDisplayWithScores(t *testing.T) {
 	game := newGameScoreBuilder("BUF", "TOR").
 		withAwayScore(3).
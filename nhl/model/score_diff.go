@@ -0,0 +1,121 @@
+package model
+
+import (
+	"fmt"
+)
+
+// ScoreUpdateKind is the kind of change reported by a ScoreUpdate.
+type ScoreUpdateKind int
+
+const (
+	// ScoreUpdateGameStart reports a game that went live.
+	ScoreUpdateGameStart ScoreUpdateKind = iota + 1
+	// ScoreUpdateGoal reports a goal; Team is the scoring team.
+	ScoreUpdateGoal
+	// ScoreUpdateGoalRemoved reports a score going down, typically a goal
+	// overturned on review; Team is the team that lost the goal.
+	ScoreUpdateGoalRemoved
+	// ScoreUpdatePeriodChange reports a new period, including overtime and
+	// the shootout.
+	ScoreUpdatePeriodChange
+	// ScoreUpdateGameEnd reports a game that became final.
+	ScoreUpdateGameEnd
+)
+
+// String returns the kind name.
+func (k ScoreUpdateKind) String() string {
+	switch k {
+	case ScoreUpdateGameStart:
+		return "game-start"
+	case ScoreUpdateGoal:
+		return "goal"
+	case ScoreUpdateGoalRemoved:
+		return "goal-removed"
+	case ScoreUpdatePeriodChange:
+		return "period-change"
+	case ScoreUpdateGameEnd:
+		return "game-end"
+	default:
+		return fmt.Sprintf("ScoreUpdateKind(%d)", int(k))
+	}
+}
+
+// ScoreUpdate is one change to a game between two DailyScores polls.
+type ScoreUpdate struct {
+	Kind   ScoreUpdateKind
+	GameID GameID
+	// Team is the abbreviation of the team concerned by a goal update, and
+	// empty for other kinds.
+	Team string
+	// Previous and Current are the game as seen by the two polls.
+	Previous GameScore
+	Current  GameScore
+}
+
+// String returns a short description such as "goal MTL: MTL 2 @ TOR 1 [LIVE]".
+func (u ScoreUpdate) String() string {
+	if u.Team != "" {
+		return fmt.Sprintf("%s %s: %s", u.Kind, u.Team, u.Current)
+	}
+	return fmt.Sprintf("%s: %s", u.Kind, u.Current)
+}
+
+// DiffScores returns the changes between two polls of the same day's
+// scores, game by game in the order of current. Within a game, updates
+// come in the order start, goals, period change, end; two goals by the
+// same team between polls yield two goal updates. Games missing from
+// previous are ignored.
+func DiffScores(previous, current *DailyScores) []ScoreUpdate {
+	if previous == nil || current == nil {
+		return nil
+	}
+	before := make(map[GameID]GameScore, len(previous.Games))
+	for _, g := range previous.Games {
+		before[g.ID] = g
+	}
+
+	var updates []ScoreUpdate
+	for _, cur := range current.Games {
+		prev, ok := before[cur.ID]
+		if !ok {
+			continue
+		}
+		add := func(kind ScoreUpdateKind, team string) {
+			updates = append(updates, ScoreUpdate{Kind: kind, GameID: cur.ID, Team: team, Previous: prev, Current: cur})
+		}
+
+		if !prev.GameState.HasStarted() && cur.GameState.HasStarted() {
+			add(ScoreUpdateGameStart, "")
+		}
+		for _, side := range []struct{ prev, cur ScheduleTeam }{{prev.AwayTeam, cur.AwayTeam}, {prev.HomeTeam, cur.HomeTeam}} {
+			delta := scoreOf(side.cur) - scoreOf(side.prev)
+			for ; delta > 0; delta-- {
+				add(ScoreUpdateGoal, side.cur.Abbrev)
+			}
+			for ; delta < 0; delta++ {
+				add(ScoreUpdateGoalRemoved, side.cur.Abbrev)
+			}
+		}
+		if periodOf(cur) > periodOf(prev) && periodOf(prev) > 0 {
+			add(ScoreUpdatePeriodChange, "")
+		}
+		if !prev.GameState.IsFinal() && cur.GameState.IsFinal() {
+			add(ScoreUpdateGameEnd, "")
+		}
+	}
+	return updates
+}
+
+func scoreOf(t ScheduleTeam) int {
+	if t.Score == nil {
+		return 0
+	}
+	return *t.Score
+}
+
+func periodOf(g GameScore) int {
+	if g.PeriodDescriptor == nil {
+		return 0
+	}
+	return g.PeriodDescriptor.Number
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// scoreGame is one game of a daily scores payload: state, period and the
+// away and home scores. A period of 0 omits the period descriptor and a
+// negative score omits the score.
+type scoreGame struct {
+	id         int64
+	state      string
+	period     int
+	away, home int
+}
+
+func scoresJSON(games ...scoreGame) string {
+	parts := make([]string, len(games))
+	for i, g := range games {
+		team := func(id int, abbrev string, score int) string {
+			if score < 0 {
+				return fmt.Sprintf(`{"id":%d,"abbrev":%q}`, id, abbrev)
+			}
+			return fmt.Sprintf(`{"id":%d,"abbrev":%q,"score":%d}`, id, abbrev, score)
+		}
+		period := ""
+		if g.period > 0 {
+			periodType := "REG"
+			if g.period == 4 {
+				periodType = "OT"
+			}
+			period = fmt.Sprintf(`,"periodDescriptor":{"number":%d,"periodType":%q}`, g.period, periodType)
+		}
+		parts[i] = fmt.Sprintf(`{"id":%d,"gameType":2,"gameState":%q,"awayTeam":%s,"homeTeam":%s%s}`,
+			g.id, g.state, team(8, "MTL", g.away), team(10, "TOR", g.home), period)
+	}
+	return fmt.Sprintf(`{"currentDate":"2024-01-15","games":[%s]}`, strings.Join(parts, ","))
+}
+
+func TestDiffScores(t *testing.T) {
+	decodeScores := func(payload string) *DailyScores {
+		t.Helper()
+		var s DailyScores
+		if err := json.Unmarshal([]byte(payload), &s); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return &s
+	}
+	prev := decodeScores(scoresJSON(scoreGame{1, "LIVE", 2, 2, 1}, scoreGame{2, "LIVE", 3, 0, 0}))
+	cur := decodeScores(scoresJSON(scoreGame{3, "LIVE", 1, 1, 0}, scoreGame{2, "FINAL", 3, 0, 1}, scoreGame{1, "LIVE", 2, 1, 1}))
+
+	updates := DiffScores(prev, cur)
+	var got []string
+	for _, u := range updates {
+		got = append(got, fmt.Sprintf("%d %s %s", u.GameID, u.Kind, u.Team))
+	}
+	want := []string{"2 goal TOR", "2 game-end ", "1 goal-removed MTL"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("DiffScores() = %v, want %v", got, want)
+	}
+	if updates[0].Previous.HomeTeam.Score == nil || *updates[0].Current.HomeTeam.Score != 1 {
+		t.Errorf("update should carry both polls: %+v", updates[0])
+	}
+	if got := updates[0].String(); got != "goal TOR: MTL 0 @ TOR 1 [FINAL]" {
+		t.Errorf("String() = %q", got)
+	}
+	if DiffScores(nil, cur) != nil {
+		t.Error("DiffScores(nil, cur) should be nil")
+	}
+}
+
+func TestScoreUpdateKind_String(t *testing.T) {
+	if got := ScoreUpdateGoal.String(); got != "goal" {
+		t.Errorf("String() = %q, want goal", got)
+	}
+	if got := ScoreUpdateKind(0).String(); got != "ScoreUpdateKind(0)" {
+		t.Errorf("String() = %q", got)
+	}
+}
@@ -0,0 +1,85 @@
+package model
+
+// TeamScoreboard is a team's scoreboard: its games on the dates around
+// FocusedDate, the team's current game day, with live state.
+type TeamScoreboard struct {
+	FocusedDate      string           `json:"focusedDate"`
+	FocusedDateCount int              `json:"focusedDateCount"`
+	ClubTimeZone     string           `json:"clubTimeZone"`
+	ClubUTCOffset    string           `json:"clubUTCOffset"`
+	ClubScheduleLink string           `json:"clubScheduleLink"`
+	GamesByDate      []ScoreboardDate `json:"gamesByDate"`
+}
+
+// ScoreboardDate is one date of a team scoreboard.
+type ScoreboardDate struct {
+	Date  string           `json:"date"`
+	Games []ScoreboardGame `json:"games"`
+}
+
+// ScoreboardGame is one game of a team scoreboard. Scores are nil before
+// the game starts; PeriodDescriptor is set once it has, and GameOutcome
+// once it is final.
+type ScoreboardGame struct {
+	ID                GameID            `json:"id"`
+	Season            Season            `json:"season"`
+	GameType          GameType          `json:"gameType"`
+	GameDate          string            `json:"gameDate"`
+	Venue             LocalizedString   `json:"venue"`
+	StartTimeUTC      string            `json:"startTimeUTC"`
+	EasternUTCOffset  string            `json:"easternUTCOffset"`
+	VenueUTCOffset    string            `json:"venueUTCOffset"`
+	TVBroadcasts      []TVBroadcast     `json:"tvBroadcasts,omitempty"`
+	GameState         GameState         `json:"gameState"`
+	GameScheduleState GameScheduleState `json:"gameScheduleState"`
+	AwayTeam          ScheduleTeam      `json:"awayTeam"`
+	HomeTeam          ScheduleTeam      `json:"homeTeam"`
+	PeriodDescriptor  *PeriodDescriptor `json:"periodDescriptor,omitempty"`
+	GameOutcome       *GameOutcome      `json:"gameOutcome,omitempty"`
+	GameCenterLink    string            `json:"gameCenterLink"`
+}
+
+// String returns a short description like "BUF 3 @ TOR 2 [FINAL]".
+func (g ScoreboardGame) String() string {
+	return GameScore{ID: g.ID, GameState: g.GameState, AwayTeam: g.AwayTeam, HomeTeam: g.HomeTeam}.String()
+}
+
+// Games returns the scoreboard's games in date order.
+func (s *TeamScoreboard) Games() []ScoreboardGame {
+	var games []ScoreboardGame
+	for _, d := range s.GamesByDate {
+		games = append(games, d.Games...)
+	}
+	return games
+}
+
+// Live returns the team's game in progress.
+func (s *TeamScoreboard) Live() (ScoreboardGame, bool) {
+	for _, g := range s.Games() {
+		if g.GameState.IsLive() {
+			return g, true
+		}
+	}
+	return ScoreboardGame{}, false
+}
+
+// Last returns the team's most recent final game.
+func (s *TeamScoreboard) Last() (ScoreboardGame, bool) {
+	games := s.Games()
+	for i := len(games) - 1; i >= 0; i-- {
+		if games[i].GameState.IsFinal() {
+			return games[i], true
+		}
+	}
+	return ScoreboardGame{}, false
+}
+
+// Next returns the team's next game that has not started.
+func (s *TeamScoreboard) Next() (ScoreboardGame, bool) {
+	for _, g := range s.Games() {
+		if !g.GameState.HasStarted() {
+			return g, true
+		}
+	}
+	return ScoreboardGame{}, false
+}
@@ -0,0 +1,95 @@
+package model
+
+import "fmt"
+
+// Phase is a part of the hockey calendar, as returned by SeasonPhase.
+type Phase int
+
+const (
+	// PhaseOffseason is the time between seasons, before the first
+	// preseason game and after the last playoff game.
+	PhaseOffseason Phase = iota + 1
+	// PhasePreseason runs from the first preseason game to the eve of the
+	// regular season.
+	PhasePreseason
+	// PhaseRegularSeason runs from the first to the last regular-season
+	// game, except breaks.
+	PhaseRegularSeason
+	// PhaseBreak is the All-Star break or another league-wide pause in the
+	// regular season, such as the Olympic break; see SeasonDates.Breaks.
+	PhaseBreak
+	// PhasePlayoffs runs from the day after the regular season to the last
+	// scheduled playoff game.
+	PhasePlayoffs
+)
+
+// String returns the phase name.
+func (p Phase) String() string {
+	switch p {
+	case PhaseOffseason:
+		return "offseason"
+	case PhasePreseason:
+		return "preseason"
+	case PhaseRegularSeason:
+		return "regular-season"
+	case PhaseBreak:
+		return "break"
+	case PhasePlayoffs:
+		return "playoffs"
+	default:
+		return fmt.Sprintf("Phase(%d)", int(p))
+	}
+}
+
+// SeasonPhase returns the phase of the season that date falls in, from the
+// season's key dates; Now resolves to the current date. See
+// SeasonDates.Phase.
+func SeasonPhase(date GameDate, seasonDates *SeasonDates) Phase {
+	return seasonDates.Phase(DateFromTime(date.Date()))
+}
+
+// Phase returns the phase of the season that date falls in. Phases follow
+// the dates as scheduled: until the playoff schedule is published, and
+// after the last playoff game scheduled so far, days past the regular
+// season are PhaseOffseason, so SeasonDates read during the playoffs
+// should be refreshed as rounds are added. Dates of another season are
+// PhaseOffseason.
+func (d *SeasonDates) Phase(date Date) Phase {
+	if d.RegularSeasonStart.IsZero() {
+		return PhaseOffseason
+	}
+	start := d.RegularSeasonStart
+	if d.PreseasonStart != nil {
+		start = *d.PreseasonStart
+	}
+	switch {
+	case date.Before(start.Time):
+		return PhaseOffseason
+	case date.Before(d.RegularSeasonStart.Time):
+		return PhasePreseason
+	case d.inBreak(date):
+		return PhaseBreak
+	case !date.After(d.RegularSeasonEnd.Time):
+		return PhaseRegularSeason
+	case d.PlayoffsEnd != nil && !date.After(d.PlayoffsEnd.Time):
+		return PhasePlayoffs
+	default:
+		return PhaseOffseason
+	}
+}
+
+// inBreak reports whether date falls in the All-Star break or one of
+// Breaks. A break with only one of its bounds set is ignored.
+func (d *SeasonDates) inBreak(date Date) bool {
+	if d.AllStarBreakStart != nil && d.AllStarBreakEnd != nil &&
+		!date.Before(d.AllStarBreakStart.Time) && !date.After(d.AllStarBreakEnd.Time) {
+		return true
+	}
+	day := FromDate(date.Time)
+	for _, b := range d.Breaks {
+		if b.Contains(day) {
+			return true
+		}
+	}
+	return false
+}
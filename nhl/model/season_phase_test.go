@@ -0,0 +1,75 @@
+package model
+
+import "testing"
+
+func TestSeasonPhase(t *testing.T) {
+	date := func(s string) *Date {
+		d := MustParseDate(s)
+		return &d
+	}
+	dates := &SeasonDates{
+		Season:             NewSeason(2023),
+		PreseasonStart:     date("2023-09-23"),
+		RegularSeasonStart: *date("2023-10-10"),
+		RegularSeasonEnd:   *date("2024-04-18"),
+		PlayoffsStart:      date("2024-04-20"),
+		PlayoffsEnd:        date("2024-06-24"),
+		AllStarBreakStart:  date("2024-01-31"),
+		AllStarBreakEnd:    date("2024-02-05"),
+	}
+
+	tests := []struct {
+		date string
+		want Phase
+	}{
+		{"2023-07-01", PhaseOffseason},
+		{"2023-09-23", PhasePreseason},
+		{"2023-10-09", PhasePreseason},
+		{"2023-10-10", PhaseRegularSeason},
+		{"2024-01-30", PhaseRegularSeason},
+		{"2024-01-31", PhaseBreak},
+		{"2024-02-05", PhaseBreak},
+		{"2024-02-06", PhaseRegularSeason},
+		{"2024-04-18", PhaseRegularSeason},
+		{"2024-04-19", PhasePlayoffs},
+		{"2024-06-24", PhasePlayoffs},
+		{"2024-06-25", PhaseOffseason},
+	}
+	for _, tt := range tests {
+		if got := SeasonPhase(FromDate(MustParseDate(tt.date).Time), dates); got != tt.want {
+			t.Errorf("SeasonPhase(%s) = %s, want %s", tt.date, got, tt.want)
+		}
+	}
+
+	// Before the playoff schedule is out, and without a preseason.
+	early := &SeasonDates{RegularSeasonStart: *date("2023-10-10"), RegularSeasonEnd: *date("2024-04-18")}
+	if got := early.Phase(*date("2023-10-01")); got != PhaseOffseason {
+		t.Errorf("Phase(before season) = %s", got)
+	}
+	if got := early.Phase(*date("2024-04-20")); got != PhaseOffseason {
+		t.Errorf("Phase(after season) = %s", got)
+	}
+	if got := (&SeasonDates{}).Phase(*date("2024-01-01")); got != PhaseOffseason {
+		t.Errorf("Phase(no games) = %s", got)
+	}
+	// A half-filled All-Star break is ignored rather than dereferenced.
+	half := &SeasonDates{RegularSeasonStart: *date("2023-10-10"), RegularSeasonEnd: *date("2024-04-18"), AllStarBreakStart: date("2024-01-31")}
+	if got := half.Phase(*date("2024-02-02")); got != PhaseRegularSeason {
+		t.Errorf("Phase(half-filled break) = %s", got)
+	}
+
+	// The 2026 Olympic break has no All-Star Game.
+	olympics := &SeasonDates{
+		RegularSeasonStart: *date("2025-10-07"),
+		RegularSeasonEnd:   *date("2026-04-16"),
+		Breaks:             []DateRange{{Start: FromYMD(2026, 2, 6), End: FromYMD(2026, 2, 24)}},
+	}
+	for day, want := range map[string]Phase{"2026-02-05": PhaseRegularSeason, "2026-02-06": PhaseBreak, "2026-02-24": PhaseBreak, "2026-02-25": PhaseRegularSeason} {
+		if got := olympics.Phase(*date(day)); got != want {
+			t.Errorf("Phase(%s) = %s, want %s", day, got, want)
+		}
+	}
+	if got := Phase(0).String(); got != "Phase(0)" {
+		t.Errorf("String() = %s", got)
+	}
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ShotsFraction is a goalie's saves over shots faced, which the API writes
+// as "25/26". It decodes from and encodes back to that form.
+type ShotsFraction struct {
+	Saves    int
+	Attempts int
+	// Missing is set for a fraction the feed left empty, which encodes
+	// back to "" rather than "0/0".
+	Missing bool
+}
+
+// ParseShotsFraction parses a "saves/shots" string such as "25/26" and
+// validates it. An empty string is a Missing fraction.
+func ParseShotsFraction(s string) (ShotsFraction, error) {
+	f, err := parseShotsFraction(s)
+	if err != nil {
+		return ShotsFraction{}, err
+	}
+	if err := f.Validate(); err != nil {
+		return ShotsFraction{}, err
+	}
+	return f, nil
+}
+
+// parseShotsFraction is ParseShotsFraction without validation.
+func parseShotsFraction(s string) (ShotsFraction, error) {
+	if s == "" {
+		return ShotsFraction{Missing: true}, nil
+	}
+	saves, attempts, ok := strings.Cut(s, "/")
+	f := ShotsFraction{}
+	var errSaves, errAttempts error
+	f.Saves, errSaves = strconv.Atoi(strings.TrimSpace(saves))
+	f.Attempts, errAttempts = strconv.Atoi(strings.TrimSpace(attempts))
+	if !ok || errSaves != nil || errAttempts != nil {
+		return ShotsFraction{}, fmt.Errorf("invalid shots fraction %q (expected saves/shots)", s)
+	}
+	return f, nil
+}
+
+// Validate reports negative counts and more saves than shots.
+func (f ShotsFraction) Validate() error {
+	if f.Saves < 0 || f.Attempts < 0 || f.Saves > f.Attempts {
+		return fmt.Errorf("invalid shots fraction %d/%d", f.Saves, f.Attempts)
+	}
+	return nil
+}
+
+// GoalsAgainst returns the shots that were not saved.
+func (f ShotsFraction) GoalsAgainst() int {
+	return f.Attempts - f.Saves
+}
+
+// Percentage returns the save percentage from 0 to 100, or 0 without
+// shots.
+func (f ShotsFraction) Percentage() float64 {
+	if f.Attempts == 0 {
+		return 0
+	}
+	return float64(f.Saves) / float64(f.Attempts) * 100
+}
+
+// String returns the fraction as "saves/shots", or "" when it is Missing.
+func (f ShotsFraction) String() string {
+	if f.Missing {
+		return ""
+	}
+	return strconv.Itoa(f.Saves) + "/" + strconv.Itoa(f.Attempts)
+}
+
+// MarshalJSON implements json.Marshaler, writing "saves/shots", or "" for
+// a Missing fraction.
+func (f ShotsFraction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It rejects only strings that
+// are not in "saves/shots" form: an impossible fraction, such as more saves
+// than shots, decodes as is so one bad row does not fail a whole boxscore;
+// check it with Validate.
+func (f *ShotsFraction) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid shots fraction %s", data)
+	}
+	parsed, err := parseShotsFraction(s)
+	if err != nil {
+		return err
+	}
+	*f = parsed
+	return nil
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestParseShotsFraction(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ShotsFraction
+		wantErr bool
+	}{
+		{"25/26", ShotsFraction{Saves: 25, Attempts: 26}, false},
+		{"0/0", ShotsFraction{}, false},
+		{"", ShotsFraction{Missing: true}, false},
+		{"26", ShotsFraction{}, true},
+		{"27/26", ShotsFraction{}, true},
+		{"-1/2", ShotsFraction{}, true},
+		{"a/b", ShotsFraction{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseShotsFraction(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseShotsFraction(%q) = %+v, %v; want %+v, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestShotsFraction(t *testing.T) {
+	f := ShotsFraction{Saves: 23, Attempts: 25}
+	if f.GoalsAgainst() != 2 || math.Abs(f.Percentage()-92) > 1e-9 || f.String() != "23/25" {
+		t.Errorf("fraction = %d against, %v%%, %s", f.GoalsAgainst(), f.Percentage(), f)
+	}
+	if (ShotsFraction{}).Percentage() != 0 {
+		t.Error("Percentage() without shots should be 0")
+	}
+
+	var g GoalieStats
+	data := []byte(`{"evenStrengthShotsAgainst":"20/22","powerPlayShotsAgainst":"3/5","shorthandedShotsAgainst":"0/0","saveShotsAgainst":"23/27"}`)
+	if err := json.Unmarshal(data, &g); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if g.SaveShotsAgainst != (ShotsFraction{Saves: 23, Attempts: 27}) || g.PowerPlayShotsAgainst.GoalsAgainst() != 2 {
+		t.Errorf("decoded %+v", g)
+	}
+	out, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var round map[string]any
+	json.Unmarshal(out, &round)
+	for key, want := range map[string]string{"evenStrengthShotsAgainst": "20/22", "shorthandedShotsAgainst": "0/0", "saveShotsAgainst": "23/27"} {
+		if round[key] != want {
+			t.Errorf("round trip %s = %v, want %q", key, round[key], want)
+		}
+	}
+	if err := json.Unmarshal([]byte(`{"saveShotsAgainst":"30/27"}`), &g); err != nil {
+		t.Errorf("Unmarshal(more saves than shots) error = %v, want it decoded as is", err)
+	} else if err := g.SaveShotsAgainst.Validate(); err == nil {
+		t.Error("Validate(30/27) error = nil")
+	}
+
+	g = GoalieStats{}
+	if err := json.Unmarshal([]byte(`{"saveShotsAgainst":"","powerPlayShotsAgainst":"0/0"}`), &g); err != nil {
+		t.Fatalf("Unmarshal(empty) error = %v", err)
+	}
+	if !g.SaveShotsAgainst.Missing || g.PowerPlayShotsAgainst.Missing {
+		t.Errorf("decoded %+v, want only saveShotsAgainst missing", g)
+	}
+	out, _ = json.Marshal(g)
+	round = nil
+	json.Unmarshal(out, &round)
+	if round["saveShotsAgainst"] != "" || round["powerPlayShotsAgainst"] != "0/0" {
+		t.Errorf("round trip = %s, want \"\" kept apart from \"0/0\"", out)
+	}
+	if err := json.Unmarshal([]byte(`{"saveShotsAgainst":27}`), &g); err == nil {
+		t.Error("Unmarshal(number) error = nil")
+	}
+}
@@ -0,0 +1,204 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Standing represents a team's standing entry with complete statistics.
+// Contains conference, division, team identification, and win/loss records.
+type Standing struct {
+	ConferenceAbbrev *string         `json:"conferenceAbbrev,omitempty"`
+	ConferenceName   *string         `json:"conferenceName,omitempty"`
+	DivisionAbbrev   string          `json:"divisionAbbrev"`
+	DivisionName     string          `json:"divisionName"`
+	TeamName         LocalizedString `json:"teamName"`
+	TeamCommonName   LocalizedString `json:"teamCommonName"`
+	TeamAbbrev       LocalizedString `json:"teamAbbrev"`
+	TeamLogo         string          `json:"teamLogo"`
+	Wins             int             `json:"wins"`
+	Losses           int             `json:"losses"`
+	OTLosses         int             `json:"otLosses"`
+	Points           int             `json:"points"`
+	L10Wins          int             `json:"l10Wins"`
+	L10Losses        int             `json:"l10Losses"`
+	L10OTLosses      int             `json:"l10OtLosses"`
+	StreakCode       string          `json:"streakCode,omitempty"`
+	StreakCount      int             `json:"streakCount,omitempty"`
+
+	// Tie-breakers, after points and games played.
+	RegulationWins       int `json:"regulationWins,omitempty"`
+	RegulationPlusOTWins int `json:"regulationPlusOtWins,omitempty"`
+	GoalDifferential     int `json:"goalDifferential,omitempty"`
+
+	// GoalFor and GoalAgainst leave out shootout goals, like
+	// GoalDifferential.
+	GoalFor     int `json:"goalFor,omitempty"`
+	GoalAgainst int `json:"goalAgainst,omitempty"`
+}
+
+const (
+	unknownConferenceAbbrev = "UNK"
+	unknownConferenceName   = "Unknown"
+)
+
+// conferenceAbbrev returns the conference abbreviation, or a default if not set.
+// Used internally to handle historical data before conferences existed.
+func (s *Standing) conferenceAbbrev() string {
+	if s.ConferenceAbbrev == nil {
+		return unknownConferenceAbbrev
+	}
+	return *s.ConferenceAbbrev
+}
+
+// conferenceName returns the conference name, or a default if not set.
+// Used internally to handle historical data before conferences existed.
+func (s *Standing) conferenceName() string {
+	if s.ConferenceName == nil {
+		return unknownConferenceName
+	}
+	return *s.ConferenceName
+}
+
+// ToTeam converts a Standing entry into a Team struct.
+// This is useful for extracting team metadata from standings data. The ID
+// and FranchiseID come from DefaultTeams, as for FranchiseID.
+func (s *Standing) ToTeam() Team {
+	team, _ := TeamByAbbrev(TeamAbbrev(s.TeamAbbrev.Default))
+	return Team{
+		ID:             team.ID,
+		FranchiseID:    team.FranchiseID,
+		FullName:       s.TeamName.Default,
+		TeamCommonName: LocalizedString{Default: s.TeamCommonName.Default},
+		TeamPlaceName:  LocalizedString{Default: s.TeamName.Default},
+		Tricode:        s.TeamAbbrev.Default,
+		TeamLogo:       s.TeamLogo,
+		Conference: Conference{
+			Abbrev: s.conferenceAbbrev(),
+			Name:   s.conferenceName(),
+		},
+		Division: Division{
+			Abbrev: s.DivisionAbbrev,
+			Name:   s.DivisionName,
+		},
+	}
+}
+
+// FranchiseID returns the ID of the team's franchise from DefaultTeams,
+// matching Franchise.ID, or 0 for a code the registry does not know.
+// Standings carry no team ID, so a code shared by clubs of different eras
+// resolves to the most recent club.
+func (s *Standing) FranchiseID() int64 {
+	team, _ := TeamByAbbrev(TeamAbbrev(s.TeamAbbrev.Default))
+	return team.FranchiseID
+}
+
+// GamesPlayed calculates the total number of games played.
+// Returns the sum of wins, losses, and overtime losses.
+func (s *Standing) GamesPlayed() int {
+	return s.Wins + s.Losses + s.OTLosses
+}
+
+// PointsPercentage returns points earned divided by points available.
+// Returns 0.0 if no games have been played.
+func (s *Standing) PointsPercentage() float64 {
+	gp := s.GamesPlayed()
+	if gp == 0 {
+		return 0.0
+	}
+	return float64(s.Points) / float64(2*gp)
+}
+
+// L10PointsPercentage returns the points percentage over the last ten games.
+// Returns 0.0 if the last-ten record is not populated.
+func (s *Standing) L10PointsPercentage() float64 {
+	gp := s.L10Wins + s.L10Losses + s.L10OTLosses
+	if gp == 0 {
+		return 0.0
+	}
+	return float64(2*s.L10Wins+s.L10OTLosses) / float64(2*gp)
+}
+
+// RegularSeasonGamesPerTeam is the length of a team's full regular season
+// since 1995-96, which PointsPace projects over.
+const RegularSeasonGamesPerTeam = 82
+
+// PointsPace returns the points the team would finish an 82-game season
+// with at its current points percentage. See ProjectedPoints.
+func (s *Standing) PointsPace() float64 {
+	return s.ProjectedPoints(RegularSeasonGamesPerTeam)
+}
+
+// ProjectedPoints returns the points the team would finish a season of
+// gamesInSeason games with at its current points percentage. Returns 0.0
+// if no games have been played.
+func (s *Standing) ProjectedPoints(gamesInSeason int) float64 {
+	return s.PointsPercentage() * float64(2*gamesInSeason)
+}
+
+// PointsPercentageString returns the points percentage as the league
+// prints it, to three decimals without the leading zero: ".625", or
+// "1.000" for a perfect record.
+func (s *Standing) PointsPercentageString() string {
+	return strings.TrimPrefix(strconv.FormatFloat(s.PointsPercentage(), 'f', 3, 64), "0")
+}
+
+// RecordString returns the record as wins, losses and overtime losses,
+// like "15-2-1".
+func (s *Standing) RecordString() string {
+	return fmt.Sprintf("%d-%d-%d", s.Wins, s.Losses, s.OTLosses)
+}
+
+// Validate checks the standing's totals against each other: Points
+// against two per win and one per overtime loss, and GoalDifferential
+// against GoalFor and GoalAgainst when the goals are reported. Standings
+// from before 2005-06 count ties, which Standing does not model, and may
+// fail the points check.
+func (s *Standing) Validate() error {
+	var errs []error
+	if want := 2*s.Wins + s.OTLosses; s.Points != want {
+		errs = append(errs, fmt.Errorf("%s: %d points, want %d for a %s record",
+			s.TeamAbbrev.Default, s.Points, want, s.RecordString()))
+	}
+	if s.GoalFor != 0 || s.GoalAgainst != 0 {
+		if want := s.GoalFor - s.GoalAgainst; s.GoalDifferential != want {
+			errs = append(errs, fmt.Errorf("%s: goal differential %d, want %d for %d goals for and %d against",
+				s.TeamAbbrev.Default, s.GoalDifferential, want, s.GoalFor, s.GoalAgainst))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// String implements fmt.Stringer for Standing.
+// Returns a formatted string like "BOS: 31 pts (15-2-1)".
+func (s Standing) String() string {
+	return fmt.Sprintf("%s: %d pts (%s)", s.TeamAbbrev.Default, s.Points, s.RecordString())
+}
+
+// StandingsResponse represents the API response for standings queries.
+// Contains a list of team standings.
+type StandingsResponse struct {
+	Standings []Standing `json:"standings"`
+}
+
+// SeasonInfo represents season metadata including standings date range.
+// Used in the seasons manifest to identify valid season periods.
+type SeasonInfo struct {
+	ID             Season `json:"id"`
+	StandingsStart Date   `json:"standingsStart"`
+	StandingsEnd   Date   `json:"standingsEnd"`
+}
+
+func (s SeasonInfo) Label() string {
+	startYear := s.ID.StartYear()
+	endYearShort := (startYear + 1) % 100
+	return fmt.Sprintf("%d-%02d", startYear, endYearShort)
+}
+
+// SeasonsResponse represents the API response for the seasons manifest.
+// Contains a list of all available seasons.
+type SeasonsResponse struct {
+	Seasons []SeasonInfo `json:"seasons"`
+}
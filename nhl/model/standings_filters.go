@@ -0,0 +1,127 @@
+package model
+
+import "slices"
+
+// Standings is a league table with helpers for the NHL playoff format: the
+// top three teams of each division qualify, plus two wild cards per
+// conference from the remaining teams. Convert a []Standing from the
+// standings methods with Standings(s).
+type Standings []Standing
+
+// playoffSpotsPerDivision and wildCardsPerConference describe the playoff
+// format in use since 2013-14.
+const (
+	playoffSpotsPerDivision = 3
+	wildCardsPerConference  = 2
+)
+
+// Sorted returns a copy ranked by the NHL tie-breakers available in the
+// standings: points, then fewer games played, regulation wins, regulation
+// plus overtime wins, wins and goal differential. Head-to-head points are
+// not in the standings and are not considered.
+func (s Standings) Sorted() Standings {
+	sorted := slices.Clone(s)
+	slices.SortStableFunc(sorted, compareStandings)
+	return sorted
+}
+
+// compareStandings orders a before b when a ranks higher.
+func compareStandings(a, b Standing) int {
+	keys := [][2]int{
+		{b.Points, a.Points},
+		{a.GamesPlayed(), b.GamesPlayed()},
+		{b.RegulationWins, a.RegulationWins},
+		{b.RegulationPlusOTWins, a.RegulationPlusOTWins},
+		{b.Wins, a.Wins},
+		{b.GoalDifferential, a.GoalDifferential},
+	}
+	for _, k := range keys {
+		if k[0] != k[1] {
+			if k[0] < k[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// ByDivision groups teams by division abbreviation ("A", "M", "C", "P"),
+// each group ranked as by Sorted.
+func (s Standings) ByDivision() map[string]Standings {
+	groups := make(map[string]Standings)
+	for _, st := range s.Sorted() {
+		groups[st.DivisionAbbrev] = append(groups[st.DivisionAbbrev], st)
+	}
+	return groups
+}
+
+// ByConference groups teams by conference abbreviation ("E", "W"), each
+// group ranked as by Sorted. Historical teams without a conference are
+// grouped under "UNK".
+func (s Standings) ByConference() map[string]Standings {
+	groups := make(map[string]Standings)
+	for _, st := range s.Sorted() {
+		conf := st.conferenceAbbrev()
+		groups[conf] = append(groups[conf], st)
+	}
+	return groups
+}
+
+// PlayoffPicture is the playoff seeding of one conference.
+type PlayoffPicture struct {
+	// Conference is the conference abbreviation.
+	Conference string
+	// DivisionLeaders holds the top three teams of each division in the
+	// conference, keyed by division abbreviation.
+	DivisionLeaders map[string]Standings
+	// WildCards holds the two wild-card teams, first wild card first.
+	WildCards Standings
+	// Outside holds the remaining teams in wild-card race order.
+	Outside Standings
+}
+
+// WildCardRace returns the conference's teams outside the top three of
+// their division, ranked. The first two hold the wild cards.
+func (s Standings) WildCardRace(conference string) Standings {
+	return s.PlayoffPicture(conference).race()
+}
+
+// PlayoffPicture seeds a conference: the top three of each division, the
+// two best remaining teams as wild cards, then everyone else.
+func (s Standings) PlayoffPicture(conference string) PlayoffPicture {
+	picture := PlayoffPicture{Conference: conference, DivisionLeaders: make(map[string]Standings)}
+	var race Standings
+	for division, teams := range s.ByConference()[conference].ByDivision() {
+		n := min(playoffSpotsPerDivision, len(teams))
+		picture.DivisionLeaders[division] = teams[:n:n]
+		race = append(race, teams[n:]...)
+	}
+	race = race.Sorted()
+	n := min(wildCardsPerConference, len(race))
+	picture.WildCards, picture.Outside = race[:n:n], race[n:]
+	return picture
+}
+
+// race returns the wild cards followed by the teams outside.
+func (p PlayoffPicture) race() Standings {
+	race := slices.Clone(p.WildCards)
+	return append(race, p.Outside...)
+}
+
+// InPlayoffPosition reports whether the team, by abbreviation, currently
+// holds a playoff spot in the picture.
+func (p PlayoffPicture) InPlayoffPosition(team TeamAbbrev) bool {
+	for _, leaders := range p.DivisionLeaders {
+		if leaders.find(team) {
+			return true
+		}
+	}
+	return p.WildCards.find(team)
+}
+
+func (s Standings) find(team TeamAbbrev) bool {
+	return slices.ContainsFunc(s, func(st Standing) bool {
+		return TeamAbbrev(st.TeamAbbrev.Default) == team
+	})
+}
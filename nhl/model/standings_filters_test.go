@@ -0,0 +1,85 @@
+package model
+
+import (
+	"slices"
+	"testing"
+)
+
+func testStanding(abbrev, conference, division string, points, wins, losses int) Standing {
+	return Standing{
+		ConferenceAbbrev: &conference,
+		DivisionAbbrev:   division,
+		TeamAbbrev:       LocalizedString{Default: abbrev},
+		Points:           points,
+		Wins:             wins,
+		Losses:           losses,
+	}
+}
+
+func abbrevs(s Standings) []string {
+	out := make([]string, len(s))
+	for i, st := range s {
+		out[i] = st.TeamAbbrev.Default
+	}
+	return out
+}
+
+func TestStandingsSorted(t *testing.T) {
+	fewerGames := testStanding("AAA", "E", "A", 50, 25, 20)
+	moreGames := testStanding("BBB", "E", "A", 50, 25, 22)
+	moreRegulationWins := testStanding("CCC", "E", "A", 50, 25, 20)
+	moreRegulationWins.RegulationWins = 22
+	leader := testStanding("DDD", "E", "A", 60, 30, 15)
+
+	got := abbrevs(Standings{moreGames, fewerGames, moreRegulationWins, leader}.Sorted())
+	if want := []string{"DDD", "CCC", "AAA", "BBB"}; !slices.Equal(got, want) {
+		t.Errorf("Sorted() = %v, want %v", got, want)
+	}
+}
+
+func TestStandingsPlayoffPicture(t *testing.T) {
+	standings := Standings{
+		testStanding("A1", "E", "A", 100, 48, 20),
+		testStanding("A2", "E", "A", 95, 45, 25),
+		testStanding("A3", "E", "A", 90, 42, 28),
+		testStanding("A4", "E", "A", 89, 41, 29),
+		testStanding("A5", "E", "A", 70, 30, 40),
+		testStanding("M1", "E", "M", 110, 52, 20),
+		testStanding("M2", "E", "M", 85, 40, 35),
+		testStanding("M3", "E", "M", 80, 38, 38),
+		testStanding("M4", "E", "M", 92, 40, 30),
+		testStanding("M5", "E", "M", 75, 35, 40),
+		testStanding("C1", "W", "C", 100, 48, 20),
+	}
+
+	if got := abbrevs(standings.ByDivision()["M"]); !slices.Equal(got, []string{"M1", "M4", "M2", "M3", "M5"}) {
+		t.Errorf("ByDivision()[M] = %v", got)
+	}
+	if got := len(standings.ByConference()["E"]); got != 10 {
+		t.Errorf("len(ByConference()[E]) = %d, want 10", got)
+	}
+
+	picture := standings.PlayoffPicture("E")
+	if got := abbrevs(picture.DivisionLeaders["A"]); !slices.Equal(got, []string{"A1", "A2", "A3"}) {
+		t.Errorf("Atlantic leaders = %v", got)
+	}
+	if got := abbrevs(picture.DivisionLeaders["M"]); !slices.Equal(got, []string{"M1", "M4", "M2"}) {
+		t.Errorf("Metropolitan leaders = %v", got)
+	}
+	if got := abbrevs(picture.WildCards); !slices.Equal(got, []string{"A4", "M3"}) {
+		t.Errorf("WildCards = %v, want [A4 M3]", got)
+	}
+	if got := abbrevs(picture.Outside); !slices.Equal(got, []string{"M5", "A5"}) {
+		t.Errorf("Outside = %v, want [M5 A5]", got)
+	}
+	if !picture.InPlayoffPosition("M2") || !picture.InPlayoffPosition("M3") || picture.InPlayoffPosition("A5") {
+		t.Error("InPlayoffPosition mismatch")
+	}
+
+	if got := abbrevs(standings.WildCardRace("E")); !slices.Equal(got, []string{"A4", "M3", "M5", "A5"}) {
+		t.Errorf("WildCardRace(E) = %v", got)
+	}
+	if got := standings.WildCardRace("W"); len(got) != 0 {
+		t.Errorf("WildCardRace(W) = %v, want empty", abbrevs(got))
+	}
+}
@@ -1,7 +1,9 @@
-package nhl
+package model
 
 import (
 	"encoding/json"
+	"math"
+	"strings"
 	"testing"
 )
 
@@ -73,6 +75,9 @@ func TestStandingToTeamConversion(t *testing.T) {
 	if team.Tricode != "VGK" {
 		t.Errorf("expected Tricode = VGK, got %s", team.Tricode)
 	}
+	if team.ID != 54 || team.FranchiseID != 38 || standing.FranchiseID() != 38 {
+		t.Errorf("expected ID 54 and franchise 38, got %d, %d, %d", team.ID, team.FranchiseID, standing.FranchiseID())
+	}
 	if team.TeamLogo != "https://assets.nhle.com/logos/nhl/svg/VGK_light.svg" {
 		t.Errorf("expected TeamLogo = https://assets.nhle.com/logos/nhl/svg/VGK_light.svg, got %s", team.TeamLogo)
 	}
@@ -235,6 +240,9 @@ func TestStandingToTeamWithoutConference(t *testing.T) {
 	if team.Tricode != "MTL" {
 		t.Errorf("expected Tricode = MTL, got %s", team.Tricode)
 	}
+	if (&Standing{TeamAbbrev: LocalizedString{Default: "XYZ"}}).FranchiseID() != 0 {
+		t.Error("expected no franchise for an unknown code")
+	}
 	if team.Conference.Abbrev != unknownConferenceAbbrev {
 		t.Errorf("expected Conference.Abbrev = %s, got %s", unknownConferenceAbbrev, team.Conference.Abbrev)
 	}
@@ -626,3 +634,131 @@ func TestStandingWithAllZeroStats(t *testing.T) {
 		t.Errorf("expected GamesPlayed = 0, got %d", standing.GamesPlayed())
 	}
 }
+
+func TestStandingPointsPercentage(t *testing.T) {
+	tests := []struct {
+		name     string
+		standing Standing
+		want     float64
+	}{
+		{"no games", Standing{}, 0.0},
+		{"perfect", Standing{Wins: 5, Points: 10}, 1.0},
+		{"mixed", Standing{Wins: 10, Losses: 8, OTLosses: 2, Points: 22}, 0.55},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.standing.PointsPercentage(); got != tt.want {
+				t.Errorf("PointsPercentage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStandingL10PointsPercentage(t *testing.T) {
+	tests := []struct {
+		name     string
+		standing Standing
+		want     float64
+	}{
+		{"not populated", Standing{Wins: 10, Points: 20}, 0.0},
+		{"seven and three", Standing{L10Wins: 7, L10Losses: 3}, 0.7},
+		{"with ot losses", Standing{L10Wins: 5, L10Losses: 3, L10OTLosses: 2}, 0.6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.standing.L10PointsPercentage(); got != tt.want {
+				t.Errorf("L10PointsPercentage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStandingPaceAndProjection(t *testing.T) {
+	tests := []struct {
+		name      string
+		standing  Standing
+		pace      float64
+		projected float64
+		pctg      string
+		record    string
+	}{
+		{"no games", Standing{}, 0, 0, ".000", "0-0-0"},
+		{"perfect", Standing{Wins: 5, Points: 10}, 164, 140, "1.000", "5-0-0"},
+		{"mixed", Standing{Wins: 10, Losses: 8, OTLosses: 2, Points: 22}, 90.2, 77, ".550", "10-8-2"},
+		{"rounded", Standing{Wins: 2, Losses: 1, Points: 4}, 109.33333333333333, 93.33333333333333, ".667", "2-1-0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.standing.PointsPace(); math.Abs(got-tt.pace) > 1e-9 {
+				t.Errorf("PointsPace() = %v, want %v", got, tt.pace)
+			}
+			if got := tt.standing.ProjectedPoints(70); math.Abs(got-tt.projected) > 1e-9 {
+				t.Errorf("ProjectedPoints(70) = %v, want %v", got, tt.projected)
+			}
+			if got := tt.standing.PointsPercentageString(); got != tt.pctg {
+				t.Errorf("PointsPercentageString() = %q, want %q", got, tt.pctg)
+			}
+			if got := tt.standing.RecordString(); got != tt.record {
+				t.Errorf("RecordString() = %q, want %q", got, tt.record)
+			}
+		})
+	}
+}
+
+func TestStandingValidate(t *testing.T) {
+	var standing Standing
+	payload := `{"teamAbbrev": {"default": "BOS"}, "wins": 47, "losses": 20, "otLosses": 15, "points": 109,
+		"goalDifferential": 49, "goalFor": 301, "goalAgainst": 252}`
+	if err := json.Unmarshal([]byte(payload), &standing); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if err := standing.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if standing.GoalFor != 301 || standing.GoalAgainst != 252 {
+		t.Errorf("GoalFor, GoalAgainst = %d, %d, want 301, 252", standing.GoalFor, standing.GoalAgainst)
+	}
+
+	// Goals not reported leave the differential unchecked.
+	noGoals := Standing{Wins: 2, OTLosses: 1, Points: 5, GoalDifferential: 3}
+	if err := noGoals.Validate(); err != nil {
+		t.Errorf("Validate() without goals error = %v", err)
+	}
+
+	bad := standing
+	bad.Points = 110
+	bad.GoalDifferential = 50
+	err := bad.Validate()
+	if err == nil {
+		t.Fatal("Validate() should fail on inconsistent totals")
+	}
+	for _, want := range []string{"110 points, want 109 for a 47-20-15 record", "goal differential 50, want 49"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate() error = %q, want it to mention %q", err, want)
+		}
+	}
+}
+
+func TestStandingFormFieldsDeserialization(t *testing.T) {
+	jsonData := `{
+		"teamAbbrev": {"default": "WPG"},
+		"wins": 30, "losses": 10, "otLosses": 3, "points": 63,
+		"l10Wins": 8, "l10Losses": 1, "l10OtLosses": 1,
+		"streakCode": "W", "streakCount": 4
+	}`
+
+	var standing Standing
+	if err := json.Unmarshal([]byte(jsonData), &standing); err != nil {
+		t.Fatalf("failed to unmarshal Standing: %v", err)
+	}
+
+	if standing.L10Wins != 8 || standing.L10Losses != 1 || standing.L10OTLosses != 1 {
+		t.Errorf("unexpected L10 record: %d-%d-%d", standing.L10Wins, standing.L10Losses, standing.L10OTLosses)
+	}
+	if standing.StreakCode != "W" || standing.StreakCount != 4 {
+		t.Errorf("unexpected streak: %s%d", standing.StreakCode, standing.StreakCount)
+	}
+}
@@ -0,0 +1,131 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// parseStartTime parses a startTimeUTC value such as
+// "2023-10-11T23:00:00Z".
+func parseStartTime(startTimeUTC string) (time.Time, error) {
+	if startTimeUTC == "" {
+		return time.Time{}, fmt.Errorf("no start time")
+	}
+	t, err := time.Parse(time.RFC3339, startTimeUTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid start time %q: %w", startTimeUTC, err)
+	}
+	return t.UTC(), nil
+}
+
+// parseUTCOffset parses an offset such as "-04:00" into seconds east of UTC.
+func parseUTCOffset(offset string) (int, error) {
+	t, err := time.Parse("-07:00", offset)
+	if err != nil {
+		return 0, fmt.Errorf("invalid UTC offset %q: %w", offset, err)
+	}
+	_, seconds := t.Zone()
+	return seconds, nil
+}
+
+// venueStartTime returns the start time in the venue's time zone: the IANA
+// zone when it is given and known to this system, and otherwise a fixed
+// zone at the venue's offset, which the API gives for the game's date so
+// it already accounts for daylight saving time.
+func venueStartTime(startTimeUTC, timezone, offset string) (time.Time, error) {
+	t, err := parseStartTime(startTimeUTC)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if timezone != "" {
+		if loc, err := time.LoadLocation(timezone); err == nil {
+			return t.In(loc), nil
+		}
+	}
+	if offset == "" {
+		return time.Time{}, fmt.Errorf("no venue time zone")
+	}
+	seconds, err := parseUTCOffset(offset)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.In(time.FixedZone(offset, seconds)), nil
+}
+
+// StartTime returns the scheduled start time in UTC.
+func (s ScheduleGame) StartTime() (time.Time, error) {
+	return parseStartTime(s.StartTimeUTC)
+}
+
+// StartTime returns the scheduled start time in UTC.
+func (g ScoreboardGame) StartTime() (time.Time, error) {
+	return parseStartTime(g.StartTimeUTC)
+}
+
+// LocalStartTime returns the start time at the venue's UTC offset.
+func (g ScoreboardGame) LocalStartTime() (time.Time, error) {
+	return venueStartTime(g.StartTimeUTC, "", g.VenueUTCOffset)
+}
+
+// StartTime returns the scheduled start time in UTC.
+func (b *Boxscore) StartTime() (time.Time, error) {
+	return parseStartTime(b.StartTimeUTC)
+}
+
+// LocalStartTime returns the start time at the venue's UTC offset.
+func (b *Boxscore) LocalStartTime() (time.Time, error) {
+	return venueStartTime(b.StartTimeUTC, "", b.VenueUTCOffset)
+}
+
+// StartTime returns the scheduled start time in UTC.
+func (p *PlayByPlay) StartTime() (time.Time, error) {
+	return parseStartTime(p.StartTimeUTC)
+}
+
+// LocalStartTime returns the start time at the venue's UTC offset.
+func (p *PlayByPlay) LocalStartTime() (time.Time, error) {
+	return venueStartTime(p.StartTimeUTC, "", p.VenueUTCOffset)
+}
+
+// StartTime returns the scheduled start time in UTC.
+func (g *GameMatchup) StartTime() (time.Time, error) {
+	return parseStartTime(g.StartTimeUTC)
+}
+
+// LocalStartTime returns the start time in the venue's time zone, falling
+// back to its UTC offset when the zone is unknown to this system.
+func (g *GameMatchup) LocalStartTime() (time.Time, error) {
+	return venueStartTime(g.StartTimeUTC, g.VenueTimezone, g.VenueUTCOffset)
+}
+
+// StartTime returns the scheduled start time in UTC.
+func (g *GameStory) StartTime() (time.Time, error) {
+	return parseStartTime(g.StartTimeUTC)
+}
+
+// LocalStartTime returns the start time in the venue's time zone, falling
+// back to its UTC offset when the zone is unknown to this system.
+func (g *GameStory) LocalStartTime() (time.Time, error) {
+	return venueStartTime(g.StartTimeUTC, g.VenueTimezone, g.VenueUTCOffset)
+}
+
+// StartTime returns the scheduled start time in UTC.
+func (g SeriesGame) StartTime() (time.Time, error) {
+	return parseStartTime(g.StartTimeUTC)
+}
+
+// LocalStartTime returns the start time at the venue's UTC offset.
+func (g SeriesGame) LocalStartTime() (time.Time, error) {
+	return venueStartTime(g.StartTimeUTC, "", g.VenueUTCOffset)
+}
+
+// StartTime returns the scheduled start time in UTC.
+func (g PlayoffSeriesGame) StartTime() (time.Time, error) {
+	return parseStartTime(g.StartTimeUTC)
+}
+
+// LocalStartTime returns the start time in the venue's time zone, falling
+// back to its UTC offset when the zone is unknown to this system.
+func (g PlayoffSeriesGame) LocalStartTime() (time.Time, error) {
+	return venueStartTime(g.StartTimeUTC, g.VenueTimezone, g.VenueUTCOffset)
+}
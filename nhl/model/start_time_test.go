@@ -0,0 +1,47 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStartTime(t *testing.T) {
+	want := time.Date(2023, 10, 11, 23, 0, 0, 0, time.UTC)
+	game := ScheduleGame{StartTimeUTC: "2023-10-11T23:00:00Z"}
+	if got, err := game.StartTime(); err != nil || !got.Equal(want) || got.Location() != time.UTC {
+		t.Errorf("StartTime() = %v, %v; want %v", got, err, want)
+	}
+	if _, err := (ScheduleGame{}).StartTime(); err == nil {
+		t.Error("StartTime() without a start time error = nil")
+	}
+	if _, err := (ScheduleGame{StartTimeUTC: "7pm"}).StartTime(); err == nil {
+		t.Error("StartTime(invalid) error = nil")
+	}
+
+	box := &Boxscore{StartTimeUTC: "2023-10-11T23:00:00Z", VenueUTCOffset: "-04:00"}
+	local, err := box.LocalStartTime()
+	if err != nil {
+		t.Fatalf("LocalStartTime() error = %v", err)
+	}
+	if !local.Equal(want) || local.Format("15:04 -07:00") != "19:00 -04:00" {
+		t.Errorf("LocalStartTime() = %v", local)
+	}
+	if _, err := (&Boxscore{StartTimeUTC: "2023-10-11T23:00:00Z"}).LocalStartTime(); err == nil {
+		t.Error("LocalStartTime() without an offset error = nil")
+	}
+	if _, err := (&PlayByPlay{StartTimeUTC: "2023-10-11T23:00:00Z", VenueUTCOffset: "EDT"}).LocalStartTime(); err == nil {
+		t.Error("LocalStartTime(invalid offset) error = nil")
+	}
+
+	// An unknown zone falls back to the offset.
+	story := &GameStory{StartTimeUTC: "2024-11-02T14:00:00Z", VenueTimezone: "Nowhere/Rink", VenueUTCOffset: "+01:00"}
+	if local, err := story.LocalStartTime(); err != nil || local.Format("15:04") != "15:00" {
+		t.Errorf("LocalStartTime() = %v, %v", local, err)
+	}
+	if _, err := time.LoadLocation("America/Toronto"); err == nil {
+		matchup := &GameMatchup{StartTimeUTC: "2023-10-11T23:00:00Z", VenueTimezone: "America/Toronto", VenueUTCOffset: "-04:00"}
+		if local, err := matchup.LocalStartTime(); err != nil || local.Location().String() != "America/Toronto" || local.Hour() != 19 {
+			t.Errorf("LocalStartTime() = %v, %v", local, err)
+		}
+	}
+}
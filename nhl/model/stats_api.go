@@ -0,0 +1,196 @@
+package model
+
+// SortDirection orders a stats report column.
+type SortDirection string
+
+const (
+	// SortAscending sorts a column from lowest to highest.
+	SortAscending SortDirection = "ASC"
+	// SortDescending sorts a column from highest to lowest.
+	SortDescending SortDirection = "DESC"
+)
+
+// ===== Report Rows =====
+
+// SkaterSummary is a row of the skater summary report. TimeOnIcePerGame is
+// in seconds; percentages are fractions (0.125 is 12.5%).
+type SkaterSummary struct {
+	PlayerID         PlayerID   `json:"playerId"`
+	SkaterFullName   string     `json:"skaterFullName"`
+	LastName         string     `json:"lastName"`
+	SeasonID         Season     `json:"seasonId"`
+	TeamAbbrevs      string     `json:"teamAbbrevs"`
+	PositionCode     Position   `json:"positionCode"`
+	ShootsCatches    Handedness `json:"shootsCatches"`
+	GamesPlayed      int        `json:"gamesPlayed"`
+	Goals            int        `json:"goals"`
+	Assists          int        `json:"assists"`
+	Points           int        `json:"points"`
+	PlusMinus        int        `json:"plusMinus"`
+	PenaltyMinutes   int        `json:"penaltyMinutes"`
+	PPGoals          int        `json:"ppGoals"`
+	PPPoints         int        `json:"ppPoints"`
+	SHGoals          int        `json:"shGoals"`
+	SHPoints         int        `json:"shPoints"`
+	EVGoals          int        `json:"evGoals"`
+	EVPoints         int        `json:"evPoints"`
+	GameWinningGoals int        `json:"gameWinningGoals"`
+	OTGoals          int        `json:"otGoals"`
+	Shots            int        `json:"shots"`
+	ShootingPct      *float64   `json:"shootingPct,omitempty"`
+	PointsPerGame    float64    `json:"pointsPerGame"`
+	TimeOnIcePerGame float64    `json:"timeOnIcePerGame"`
+	FaceoffWinPct    *float64   `json:"faceoffWinPct,omitempty"`
+}
+
+// SkaterRealtime is a row of the skater realtime report, covering events
+// tracked by the off-ice officials.
+type SkaterRealtime struct {
+	PlayerID          PlayerID `json:"playerId"`
+	SkaterFullName    string   `json:"skaterFullName"`
+	LastName          string   `json:"lastName"`
+	SeasonID          Season   `json:"seasonId"`
+	TeamAbbrevs       string   `json:"teamAbbrevs"`
+	PositionCode      Position `json:"positionCode"`
+	GamesPlayed       int      `json:"gamesPlayed"`
+	Hits              int      `json:"hits"`
+	HitsPer60         float64  `json:"hitsPer60"`
+	BlockedShots      int      `json:"blockedShots"`
+	BlockedShotsPer60 float64  `json:"blockedShotsPer60"`
+	Giveaways         int      `json:"giveaways"`
+	GiveawaysPer60    float64  `json:"giveawaysPer60"`
+	Takeaways         int      `json:"takeaways"`
+	TakeawaysPer60    float64  `json:"takeawaysPer60"`
+	MissedShots       int      `json:"missedShots"`
+	EmptyNetGoals     int      `json:"emptyNetGoals"`
+	FirstGoals        int      `json:"firstGoals"`
+	OTGoals           int      `json:"otGoals"`
+	TimeOnIcePerGame  float64  `json:"timeOnIcePerGame"`
+}
+
+// SkaterBio is a row of the skater bios report. Draft fields are nil for
+// undrafted players.
+type SkaterBio struct {
+	PlayerID               PlayerID   `json:"playerId"`
+	SkaterFullName         string     `json:"skaterFullName"`
+	LastName               string     `json:"lastName"`
+	PositionCode           Position   `json:"positionCode"`
+	ShootsCatches          Handedness `json:"shootsCatches"`
+	Height                 int        `json:"height"`
+	Weight                 int        `json:"weight"`
+	BirthDate              string     `json:"birthDate"`
+	BirthCity              string     `json:"birthCity"`
+	BirthStateProvinceCode string     `json:"birthStateProvinceCode,omitempty"`
+	BirthCountryCode       string     `json:"birthCountryCode"`
+	NationalityCode        string     `json:"nationalityCode"`
+	DraftYear              *int       `json:"draftYear,omitempty"`
+	DraftRound             *int       `json:"draftRound,omitempty"`
+	DraftOverall           *int       `json:"draftOverall,omitempty"`
+	CurrentTeamAbbrev      string     `json:"currentTeamAbbrev,omitempty"`
+	GamesPlayed            int        `json:"gamesPlayed"`
+	Goals                  int        `json:"goals"`
+	Assists                int        `json:"assists"`
+	Points                 int        `json:"points"`
+}
+
+// GoalieSummary is a row of the goalie summary report. TimeOnIce is in
+// seconds; SavePct is a fraction.
+type GoalieSummary struct {
+	PlayerID            PlayerID   `json:"playerId"`
+	GoalieFullName      string     `json:"goalieFullName"`
+	LastName            string     `json:"lastName"`
+	SeasonID            Season     `json:"seasonId"`
+	TeamAbbrevs         string     `json:"teamAbbrevs"`
+	ShootsCatches       Handedness `json:"shootsCatches"`
+	GamesPlayed         int        `json:"gamesPlayed"`
+	GamesStarted        int        `json:"gamesStarted"`
+	Wins                int        `json:"wins"`
+	Losses              int        `json:"losses"`
+	OTLosses            int        `json:"otLosses"`
+	Ties                *int       `json:"ties,omitempty"`
+	GoalsAgainst        int        `json:"goalsAgainst"`
+	GoalsAgainstAverage float64    `json:"goalsAgainstAverage"`
+	ShotsAgainst        int        `json:"shotsAgainst"`
+	Saves               int        `json:"saves"`
+	SavePct             *float64   `json:"savePct,omitempty"`
+	Shutouts            int        `json:"shutouts"`
+	TimeOnIce           int        `json:"timeOnIce"`
+	Goals               int        `json:"goals"`
+	Assists             int        `json:"assists"`
+	Points              int        `json:"points"`
+	PenaltyMinutes      int        `json:"penaltyMinutes"`
+}
+
+// GoalieBio is a row of the goalie bios report. Draft fields are nil for
+// undrafted goalies.
+type GoalieBio struct {
+	PlayerID               PlayerID   `json:"playerId"`
+	GoalieFullName         string     `json:"goalieFullName"`
+	LastName               string     `json:"lastName"`
+	ShootsCatches          Handedness `json:"shootsCatches"`
+	Height                 int        `json:"height"`
+	Weight                 int        `json:"weight"`
+	BirthDate              string     `json:"birthDate"`
+	BirthCity              string     `json:"birthCity"`
+	BirthStateProvinceCode string     `json:"birthStateProvinceCode,omitempty"`
+	BirthCountryCode       string     `json:"birthCountryCode"`
+	NationalityCode        string     `json:"nationalityCode"`
+	DraftYear              *int       `json:"draftYear,omitempty"`
+	DraftRound             *int       `json:"draftRound,omitempty"`
+	DraftOverall           *int       `json:"draftOverall,omitempty"`
+	CurrentTeamAbbrev      string     `json:"currentTeamAbbrev,omitempty"`
+	GamesPlayed            int        `json:"gamesPlayed"`
+	Wins                   int        `json:"wins"`
+	Losses                 int        `json:"losses"`
+	OTLosses               int        `json:"otLosses"`
+	Shutouts               int        `json:"shutouts"`
+}
+
+// TeamSummary is a row of the team summary report. Percentages are
+// fractions.
+type TeamSummary struct {
+	TeamID              TeamID   `json:"teamId"`
+	TeamFullName        string   `json:"teamFullName"`
+	SeasonID            Season   `json:"seasonId"`
+	GamesPlayed         int      `json:"gamesPlayed"`
+	Wins                int      `json:"wins"`
+	Losses              int      `json:"losses"`
+	OTLosses            int      `json:"otLosses"`
+	Ties                *int     `json:"ties,omitempty"`
+	Points              int      `json:"points"`
+	PointPct            float64  `json:"pointPct"`
+	RegulationAndOTWins int      `json:"regulationAndOtWins"`
+	WinsInRegulation    int      `json:"winsInRegulation"`
+	WinsInShootout      int      `json:"winsInShootout"`
+	GoalsFor            int      `json:"goalsFor"`
+	GoalsAgainst        int      `json:"goalsAgainst"`
+	GoalsForPerGame     float64  `json:"goalsForPerGame"`
+	GoalsAgainstPerGame float64  `json:"goalsAgainstPerGame"`
+	PowerPlayPct        *float64 `json:"powerPlayPct,omitempty"`
+	PenaltyKillPct      *float64 `json:"penaltyKillPct,omitempty"`
+	PowerPlayNetPct     *float64 `json:"powerPlayNetPct,omitempty"`
+	PenaltyKillNetPct   *float64 `json:"penaltyKillNetPct,omitempty"`
+	ShotsForPerGame     float64  `json:"shotsForPerGame"`
+	ShotsAgainstPerGame float64  `json:"shotsAgainstPerGame"`
+	FaceoffWinPct       *float64 `json:"faceoffWinPct,omitempty"`
+}
+
+// TeamRealtime is a row of the team realtime report.
+type TeamRealtime struct {
+	TeamID            TeamID   `json:"teamId"`
+	TeamFullName      string   `json:"teamFullName"`
+	SeasonID          Season   `json:"seasonId"`
+	GamesPlayed       int      `json:"gamesPlayed"`
+	Hits              int      `json:"hits"`
+	HitsPer60         float64  `json:"hitsPer60"`
+	BlockedShots      int      `json:"blockedShots"`
+	BlockedShotsPer60 float64  `json:"blockedShotsPer60"`
+	Giveaways         int      `json:"giveaways"`
+	GiveawaysPer60    float64  `json:"giveawaysPer60"`
+	Takeaways         int      `json:"takeaways"`
+	TakeawaysPer60    float64  `json:"takeawaysPer60"`
+	MissedShots       int      `json:"missedShots"`
+	EmptyNetGoals     int      `json:"emptyNetGoals"`
+	TimeOnIcePerGame  float64  `json:"timeOnIcePerGame"`
+	SATPct            *float64 `json:"satPct,omitempty"`
+}
@@ -0,0 +1,280 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TeamAbbrev is a team's three-letter code as used by the API, e.g. "MTL".
+//
+// nhl.Client methods that take a team keep a string parameter: callers
+// holding codes in string variables keep compiling, since Go has neither
+// overloads nor generic methods, and the client also serves defunct clubs
+// such as "ATL" that are not TeamAbbrev constants. They accept the code in
+// any case and reject unknown codes with nhl.ErrInvalidArgument. Pass a
+// constant with String, as in client.RosterCurrent(ctx, TeamMTL.String()).
+// Strings from user input can also be checked up front with
+// TeamAbbrevFromString or NormalizeTeam.
+type TeamAbbrev string
+
+// The active franchises.
+const (
+	TeamANA TeamAbbrev = "ANA"
+	TeamBOS TeamAbbrev = "BOS"
+	TeamBUF TeamAbbrev = "BUF"
+	TeamCGY TeamAbbrev = "CGY"
+	TeamCAR TeamAbbrev = "CAR"
+	TeamCHI TeamAbbrev = "CHI"
+	TeamCOL TeamAbbrev = "COL"
+	TeamCBJ TeamAbbrev = "CBJ"
+	TeamDAL TeamAbbrev = "DAL"
+	TeamDET TeamAbbrev = "DET"
+	TeamEDM TeamAbbrev = "EDM"
+	TeamFLA TeamAbbrev = "FLA"
+	TeamLAK TeamAbbrev = "LAK"
+	TeamMIN TeamAbbrev = "MIN"
+	TeamMTL TeamAbbrev = "MTL"
+	TeamNSH TeamAbbrev = "NSH"
+	TeamNJD TeamAbbrev = "NJD"
+	TeamNYI TeamAbbrev = "NYI"
+	TeamNYR TeamAbbrev = "NYR"
+	TeamOTT TeamAbbrev = "OTT"
+	TeamPHI TeamAbbrev = "PHI"
+	TeamPIT TeamAbbrev = "PIT"
+	TeamSJS TeamAbbrev = "SJS"
+	TeamSEA TeamAbbrev = "SEA"
+	TeamSTL TeamAbbrev = "STL"
+	TeamTBL TeamAbbrev = "TBL"
+	TeamTOR TeamAbbrev = "TOR"
+	TeamUTA TeamAbbrev = "UTA"
+	TeamVAN TeamAbbrev = "VAN"
+	TeamVGK TeamAbbrev = "VGK"
+	TeamWSH TeamAbbrev = "WSH"
+	TeamWPG TeamAbbrev = "WPG"
+)
+
+// String returns the code.
+func (t TeamAbbrev) String() string {
+	return string(t)
+}
+
+// IsValid reports whether t is the code of an active franchise or of a
+// recently relocated one (ARI).
+func (t TeamAbbrev) IsValid() bool {
+	_, ok := teamsByAbbrev[t]
+	return ok
+}
+
+// Validate reports a code that is not a known team, suggesting the code
+// NormalizeTeam would resolve it to, e.g. for "mtl" or "Canadiens".
+func (t TeamAbbrev) Validate() error {
+	if t.IsValid() {
+		return nil
+	}
+	if t == "" {
+		return fmt.Errorf("team abbreviation is empty")
+	}
+	if suggestion, err := NormalizeTeam(string(t)); err == nil {
+		return fmt.Errorf("unknown team abbreviation %q (did you mean %s?)", string(t), suggestion)
+	}
+	return fmt.Errorf("unknown team abbreviation %q", string(t))
+}
+
+// Name returns the team's full name, e.g. "Montréal Canadiens", or "" for
+// an unknown code.
+func (t TeamAbbrev) Name() string {
+	team, ok := teamsByAbbrev[t]
+	if !ok {
+		return ""
+	}
+	return team.place + " " + team.name
+}
+
+// Conference returns the team's current conference, or the zero value for
+// an unknown code. Relocated teams report their last conference.
+func (t TeamAbbrev) Conference() Conference {
+	team, ok := teamsByAbbrev[t]
+	if !ok {
+		return Conference{}
+	}
+	if team.division == divisionAtlantic || team.division == divisionMetropolitan {
+		return Conference{Abbrev: "E", Name: "Eastern"}
+	}
+	return Conference{Abbrev: "W", Name: "Western"}
+}
+
+// Division returns the team's current division, or the zero value for an
+// unknown code. Relocated teams report their last division.
+func (t TeamAbbrev) Division() Division {
+	return teamsByAbbrev[t].division
+}
+
+// TeamAbbrevFromString parses a team code in any case, e.g. "mtl". Unlike
+// NormalizeTeam it accepts codes only, not names or nicknames.
+func TeamAbbrevFromString(s string) (TeamAbbrev, error) {
+	t := TeamAbbrev(strings.ToUpper(strings.TrimSpace(s)))
+	if !t.IsValid() {
+		return "", fmt.Errorf("invalid team abbreviation: %q", s)
+	}
+	return t, nil
+}
+
+// MustTeamAbbrevFromString parses a team code like TeamAbbrevFromString.
+// Panics if the string is not a known code.
+func MustTeamAbbrevFromString(s string) TeamAbbrev {
+	t, err := TeamAbbrevFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling for TeamAbbrev. An
+// empty string is accepted for teams not yet known, e.g. in a TBD playoff
+// matchup.
+func (t *TeamAbbrev) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*t = ""
+		return nil
+	}
+	parsed, err := TeamAbbrevFromString(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalJSON implements custom JSON marshaling for TeamAbbrev.
+func (t TeamAbbrev) MarshalJSON() ([]byte, error) {
+	if t != "" && !t.IsValid() {
+		return nil, fmt.Errorf("cannot marshal invalid team abbreviation: %q", string(t))
+	}
+	return json.Marshal(string(t))
+}
+
+var (
+	divisionAtlantic     = Division{Abbrev: "A", Name: "Atlantic"}
+	divisionMetropolitan = Division{Abbrev: "M", Name: "Metropolitan"}
+	divisionCentral      = Division{Abbrev: "C", Name: "Central"}
+	divisionPacific      = Division{Abbrev: "P", Name: "Pacific"}
+)
+
+// teamNames describes a team: its place, common name and division, plus
+// nicknames and legacy codes fans commonly type.
+type teamNames struct {
+	abbrev   TeamAbbrev
+	place    string
+	name     string
+	division Division
+	aliases  []string
+}
+
+// knownTeams lists the active clubs, followed by recently relocated ones
+// whose codes still appear in historical data.
+var knownTeams = []teamNames{
+	{TeamANA, "Anaheim", "Ducks", divisionPacific, []string{"Mighty Ducks"}},
+	{TeamBOS, "Boston", "Bruins", divisionAtlantic, []string{"B's", "Bs"}},
+	{TeamBUF, "Buffalo", "Sabres", divisionAtlantic, nil},
+	{TeamCGY, "Calgary", "Flames", divisionPacific, []string{"CAL"}},
+	{TeamCAR, "Carolina", "Hurricanes", divisionMetropolitan, []string{"Canes"}},
+	{TeamCHI, "Chicago", "Blackhawks", divisionCentral, []string{"Hawks"}},
+	{TeamCOL, "Colorado", "Avalanche", divisionCentral, []string{"Avs"}},
+	{TeamCBJ, "Columbus", "Blue Jackets", divisionMetropolitan, []string{"Jackets", "CLB"}},
+	{TeamDAL, "Dallas", "Stars", divisionCentral, nil},
+	{TeamDET, "Detroit", "Red Wings", divisionAtlantic, []string{"Wings"}},
+	{TeamEDM, "Edmonton", "Oilers", divisionPacific, []string{"Oil"}},
+	{TeamFLA, "Florida", "Panthers", divisionAtlantic, []string{"Cats"}},
+	{TeamLAK, "Los Angeles", "Kings", divisionPacific, []string{"LA"}},
+	{TeamMIN, "Minnesota", "Wild", divisionCentral, nil},
+	{TeamMTL, "Montréal", "Canadiens", divisionAtlantic, []string{"Habs", "MON", "Canadians"}},
+	{TeamNSH, "Nashville", "Predators", divisionCentral, []string{"Preds", "NAS"}},
+	{TeamNJD, "New Jersey", "Devils", divisionMetropolitan, []string{"NJ"}},
+	{TeamNYI, "New York", "Islanders", divisionMetropolitan, []string{"NY Islanders", "Isles"}},
+	{TeamNYR, "New York", "Rangers", divisionMetropolitan, []string{"NY Rangers", "Blueshirts"}},
+	{TeamOTT, "Ottawa", "Senators", divisionAtlantic, []string{"Sens"}},
+	{TeamPHI, "Philadelphia", "Flyers", divisionMetropolitan, []string{"Philly"}},
+	{TeamPIT, "Pittsburgh", "Penguins", divisionMetropolitan, []string{"Pens"}},
+	{TeamSJS, "San Jose", "Sharks", divisionPacific, []string{"SJ"}},
+	{TeamSEA, "Seattle", "Kraken", divisionPacific, nil},
+	{TeamSTL, "St. Louis", "Blues", divisionCentral, []string{"Saint Louis"}},
+	{TeamTBL, "Tampa Bay", "Lightning", divisionAtlantic, []string{"Tampa", "Bolts", "TB"}},
+	{TeamTOR, "Toronto", "Maple Leafs", divisionAtlantic, []string{"Leafs"}},
+	{TeamUTA, "Utah", "Mammoth", divisionCentral, []string{"Utah Hockey Club", "Utah HC", "UHC"}},
+	{TeamVAN, "Vancouver", "Canucks", divisionPacific, []string{"Nucks"}},
+	{TeamVGK, "Vegas", "Golden Knights", divisionPacific, []string{"Las Vegas", "Knights", "VEG"}},
+	{TeamWSH, "Washington", "Capitals", divisionMetropolitan, []string{"Caps", "WAS"}},
+	{TeamWPG, "Winnipeg", "Jets", divisionCentral, []string{"WIN"}},
+	{"ARI", "Arizona", "Coyotes", divisionCentral, []string{"Yotes"}},
+}
+
+// teamsByAbbrev indexes knownTeams by code.
+var teamsByAbbrev = func() map[TeamAbbrev]teamNames {
+	byAbbrev := make(map[TeamAbbrev]teamNames, len(knownTeams))
+	for _, team := range knownTeams {
+		byAbbrev[team.abbrev] = team
+	}
+	return byAbbrev
+}()
+
+// teamLookup maps a normalized name to the codes it may refer to. More
+// than one code means the name is ambiguous ("New York").
+var teamLookup = buildTeamLookup()
+
+func buildTeamLookup() map[string][]TeamAbbrev {
+	lookup := make(map[string][]TeamAbbrev)
+	add := func(key string, abbrev TeamAbbrev) {
+		key = normalizeTeamKey(key)
+		for _, existing := range lookup[key] {
+			if existing == abbrev {
+				return
+			}
+		}
+		lookup[key] = append(lookup[key], abbrev)
+	}
+	for _, team := range knownTeams {
+		add(string(team.abbrev), team.abbrev)
+		add(team.place, team.abbrev)
+		add(team.name, team.abbrev)
+		add(team.place+" "+team.name, team.abbrev)
+		for _, alias := range team.aliases {
+			add(alias, team.abbrev)
+		}
+	}
+	return lookup
+}
+
+// NormalizeTeam maps user input to a team code. It accepts codes in any
+// case ("mtl"), places ("Montreal"), common and full names ("Canadiens",
+// "Montréal Canadiens"), and common nicknames or legacy codes ("Habs",
+// "NJ", "Vegas"). Accents, punctuation and extra spaces are ignored.
+// Ambiguous input such as "New York" is an error that lists the
+// candidates.
+func NormalizeTeam(input string) (TeamAbbrev, error) {
+	candidates := teamLookup[normalizeTeamKey(input)]
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf("unknown team: %q", input)
+	case 1:
+		return candidates[0], nil
+	default:
+		codes := make([]string, len(candidates))
+		for i, c := range candidates {
+			codes[i] = string(c)
+		}
+		sort.Strings(codes)
+		return "", fmt.Errorf("ambiguous team %q: could be %s", input, strings.Join(codes, " or "))
+	}
+}
+
+// normalizeTeamKey lowercases s, folds accents and drops punctuation, so
+// "St. Louis", "st louis" and "ST-LOUIS" share a key.
+func normalizeTeamKey(s string) string {
+	return strings.Join(strings.Fields(normalizeName(s)), " ")
+}
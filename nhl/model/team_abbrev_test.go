@@ -0,0 +1,207 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeTeam(t *testing.T) {
+	tests := []struct {
+		input string
+		want  TeamAbbrev
+	}{
+		{"MTL", "MTL"},
+		{"mtl", "MTL"},
+		{"Habs", "MTL"},
+		{"Montreal", "MTL"},
+		{"Montréal Canadiens", "MTL"},
+		{"  montreal   CANADIENS ", "MTL"},
+		{"Leafs", "TOR"},
+		{"Toronto Maple Leafs", "TOR"},
+		{"Vegas", "VGK"},
+		{"Vegas Golden Knights", "VGK"},
+		{"NJ", "NJD"},
+		{"New Jersey Devils", "NJD"},
+		{"NY Rangers", "NYR"},
+		{"Islanders", "NYI"},
+		{"St. Louis", "STL"},
+		{"st louis blues", "STL"},
+		{"L.A.", "LAK"},
+		{"Los Angeles Kings", "LAK"},
+		{"Tampa", "TBL"},
+		{"B's", "BOS"},
+		{"Columbus Blue Jackets", "CBJ"},
+		{"Utah Hockey Club", "UTA"},
+		{"Coyotes", "ARI"},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeTeam(tt.input)
+		if err != nil {
+			t.Errorf("NormalizeTeam(%q) error = %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizeTeam(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeTeam_Errors(t *testing.T) {
+	_, err := NormalizeTeam("New York")
+	if err == nil || !strings.Contains(err.Error(), "NYI or NYR") {
+		t.Errorf("NormalizeTeam(New York) error = %v, want ambiguity listing NYI or NYR", err)
+	}
+
+	for _, input := range []string{"", "Nordiques", "XYZ"} {
+		if got, err := NormalizeTeam(input); err == nil {
+			t.Errorf("NormalizeTeam(%q) = %s, want error", input, got)
+		}
+	}
+}
+
+func TestNormalizeTeam_EveryCodeRoundTrips(t *testing.T) {
+	for _, team := range knownTeams {
+		got, err := NormalizeTeam(string(team.abbrev))
+		if err != nil || got != team.abbrev {
+			t.Errorf("NormalizeTeam(%s) = %s, %v", team.abbrev, got, err)
+		}
+		full := team.place + " " + team.name
+		if got, err := NormalizeTeam(full); err != nil || got != team.abbrev {
+			t.Errorf("NormalizeTeam(%q) = %s, %v", full, got, err)
+		}
+	}
+}
+
+func TestTeamAbbrev_String(t *testing.T) {
+	if got := TeamAbbrev("EDM").String(); got != "EDM" {
+		t.Errorf("String() = %q, want EDM", got)
+	}
+}
+
+func TestTeamAbbrevFromString(t *testing.T) {
+	tests := map[string]TeamAbbrev{
+		"MTL":   TeamMTL,
+		"mtl":   TeamMTL,
+		" vgk ": TeamVGK,
+		"ARI":   "ARI",
+	}
+	for input, want := range tests {
+		got, err := TeamAbbrevFromString(input)
+		if err != nil || got != want {
+			t.Errorf("TeamAbbrevFromString(%q) = %s, %v; want %s", input, got, err, want)
+		}
+	}
+	for _, input := range []string{"", "Habs", "QUE", "XYZ"} {
+		if got, err := TeamAbbrevFromString(input); err == nil {
+			t.Errorf("TeamAbbrevFromString(%q) = %s, want error", input, got)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustTeamAbbrevFromString should panic on an unknown code")
+		}
+	}()
+	MustTeamAbbrevFromString("XYZ")
+}
+
+func TestTeamAbbrev_Constants(t *testing.T) {
+	active := 0
+	for _, team := range knownTeams {
+		if team.abbrev != "ARI" {
+			active++
+		}
+		if !team.abbrev.IsValid() {
+			t.Errorf("%s should be valid", team.abbrev)
+		}
+	}
+	if active != 32 {
+		t.Errorf("active teams = %d, want 32", active)
+	}
+	if TeamAbbrev("XYZ").IsValid() {
+		t.Error("XYZ should not be valid")
+	}
+}
+
+func TestTeamAbbrev_ConferenceDivision(t *testing.T) {
+	tests := []struct {
+		team       TeamAbbrev
+		conference string
+		division   string
+	}{
+		{TeamMTL, "Eastern", "Atlantic"},
+		{TeamNYR, "Eastern", "Metropolitan"},
+		{TeamUTA, "Western", "Central"},
+		{TeamSEA, "Western", "Pacific"},
+		{"ARI", "Western", "Central"},
+		{"XYZ", "", ""},
+	}
+	for _, tt := range tests {
+		if got := tt.team.Conference().Name; got != tt.conference {
+			t.Errorf("%s.Conference() = %q, want %q", tt.team, got, tt.conference)
+		}
+		if got := tt.team.Division().Name; got != tt.division {
+			t.Errorf("%s.Division() = %q, want %q", tt.team, got, tt.division)
+		}
+	}
+
+	counts := make(map[string]int)
+	for _, team := range knownTeams {
+		if team.abbrev != "ARI" {
+			counts[team.abbrev.Division().Abbrev]++
+		}
+	}
+	for _, division := range []string{"A", "M", "C", "P"} {
+		if counts[division] != 8 {
+			t.Errorf("division %s has %d teams, want 8", division, counts[division])
+		}
+	}
+	if got := TeamMTL.Name(); got != "Montréal Canadiens" {
+		t.Errorf("Name() = %q", got)
+	}
+}
+
+func TestTeamAbbrev_JSON(t *testing.T) {
+	var decoded struct {
+		Team  TeamAbbrev `json:"team"`
+		Other TeamAbbrev `json:"other"`
+	}
+	if err := json.Unmarshal([]byte(`{"team":"tor","other":""}`), &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if decoded.Team != TeamTOR || decoded.Other != "" {
+		t.Errorf("decoded = %+v", decoded)
+	}
+	if err := json.Unmarshal([]byte(`{"team":"XYZ"}`), &decoded); err == nil {
+		t.Error("Unmarshal() should reject an unknown code")
+	}
+
+	data, err := json.Marshal(TeamEDM)
+	if err != nil || string(data) != `"EDM"` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+	if _, err := json.Marshal(TeamAbbrev("XYZ")); err == nil {
+		t.Error("Marshal() should reject an unknown code")
+	}
+}
+
+func TestTeamAbbrev_Validate(t *testing.T) {
+	if err := TeamMTL.Validate(); err != nil {
+		t.Errorf("MTL.Validate() error = %v", err)
+	}
+	tests := []struct {
+		team  TeamAbbrev
+		match string
+	}{
+		{"", "empty"},
+		{"mtl", "did you mean MTL?"},
+		{"XYZ", `unknown team abbreviation "XYZ"`},
+	}
+	for _, tt := range tests {
+		err := tt.team.Validate()
+		if err == nil || !strings.Contains(err.Error(), tt.match) {
+			t.Errorf("%q.Validate() error = %v, want containing %q", tt.team, err, tt.match)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package model
+
+// TeamComparison is the season-to-date comparison of a game's two teams
+// that the gamecenter shows before and during the game: special teams,
+// faceoffs and scoring rates, each with the team's league rank.
+type TeamComparison struct {
+	// ContextLabel names the span the stats cover, e.g. "season".
+	ContextLabel  string              `json:"contextLabel"`
+	ContextSeason Season              `json:"contextSeason"`
+	AwayTeam      TeamComparisonStats `json:"awayTeam"`
+	HomeTeam      TeamComparisonStats `json:"homeTeam"`
+}
+
+// TeamComparisonStats is one team's side of a TeamComparison. Percentages
+// are fractions; ranks are league ranks, 1 being the best.
+type TeamComparisonStats struct {
+	PPPctg                        float64 `json:"ppPctg"`
+	PKPctg                        float64 `json:"pkPctg"`
+	FaceoffWinningPctg            float64 `json:"faceoffWinningPctg"`
+	GoalsForPerGamePlayed         float64 `json:"goalsForPerGamePlayed"`
+	GoalsAgainstPerGamePlayed     float64 `json:"goalsAgainstPerGamePlayed"`
+	PPPctgRank                    int     `json:"ppPctgRank"`
+	PKPctgRank                    int     `json:"pkPctgRank"`
+	FaceoffWinningPctgRank        int     `json:"faceoffWinningPctgRank"`
+	GoalsForPerGamePlayedRank     int     `json:"goalsForPerGamePlayedRank"`
+	GoalsAgainstPerGamePlayedRank int     `json:"goalsAgainstPerGamePlayedRank"`
+}
+
+// ComparisonRow is one stat of a TeamComparison, for side-by-side display.
+type ComparisonRow struct {
+	// Stat is the JSON field of the stat, e.g. "ppPctg".
+	Stat     string
+	Away     float64
+	Home     float64
+	AwayRank int
+	HomeRank int
+	// LowerIsBetter is set for goals against.
+	LowerIsBetter bool
+}
+
+// Leader returns the team with the better value, "away" or "home", or ""
+// on a tie.
+func (r ComparisonRow) Leader() string {
+	switch {
+	case r.Away == r.Home:
+		return ""
+	case (r.Away > r.Home) != r.LowerIsBetter:
+		return "away"
+	default:
+		return "home"
+	}
+}
+
+// Rows returns the comparison as rows in the gamecenter's order: power
+// play, penalty kill, faceoffs, goals for and goals against per game.
+func (c *TeamComparison) Rows() []ComparisonRow {
+	a, h := c.AwayTeam, c.HomeTeam
+	return []ComparisonRow{
+		{Stat: "ppPctg", Away: a.PPPctg, Home: h.PPPctg, AwayRank: a.PPPctgRank, HomeRank: h.PPPctgRank},
+		{Stat: "pkPctg", Away: a.PKPctg, Home: h.PKPctg, AwayRank: a.PKPctgRank, HomeRank: h.PKPctgRank},
+		{Stat: "faceoffWinningPctg", Away: a.FaceoffWinningPctg, Home: h.FaceoffWinningPctg, AwayRank: a.FaceoffWinningPctgRank, HomeRank: h.FaceoffWinningPctgRank},
+		{Stat: "goalsForPerGamePlayed", Away: a.GoalsForPerGamePlayed, Home: h.GoalsForPerGamePlayed, AwayRank: a.GoalsForPerGamePlayedRank, HomeRank: h.GoalsForPerGamePlayedRank},
+		{Stat: "goalsAgainstPerGamePlayed", Away: a.GoalsAgainstPerGamePlayed, Home: h.GoalsAgainstPerGamePlayed, AwayRank: a.GoalsAgainstPerGamePlayedRank, HomeRank: h.GoalsAgainstPerGamePlayedRank, LowerIsBetter: true},
+	}
+}
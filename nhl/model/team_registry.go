@@ -0,0 +1,262 @@
+package model
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+)
+
+// TeamInfo is one club in a TeamRegistry: a team ID with the code, name
+// and franchise it had. A relocated franchise has one TeamInfo per city,
+// e.g. the Atlanta Thrashers and the Winnipeg Jets.
+type TeamInfo struct {
+	ID TeamID
+	// Abbrev is the code the club played under. It is a plain string, not
+	// a TeamAbbrev, because the codes of defunct clubs such as "ATL" are
+	// not valid TeamAbbrev values.
+	Abbrev      string
+	FullName    string
+	FranchiseID int64
+	// FirstSeason and LastSeason bound the seasons the club played;
+	// LastSeason is zero for an active club. Both are zero when unknown,
+	// for clubs only known from a refresh.
+	FirstSeason Season
+	LastSeason  Season
+	// Successor is the club that took over when this one moved, e.g. Utah
+	// for Arizona, whose players moved to a new franchise, or zero.
+	Successor TeamID
+}
+
+// IsActive reports whether the club still plays.
+func (t TeamInfo) IsActive() bool {
+	return t.LastSeason == (Season{}) && t.FirstSeason != (Season{})
+}
+
+// PlayedIn reports whether the club played in season.
+func (t TeamInfo) PlayedIn(season Season) bool {
+	if t.FirstSeason == (Season{}) || season.StartYear() < t.FirstSeason.StartYear() {
+		return false
+	}
+	return t.LastSeason == (Season{}) || season.StartYear() <= t.LastSeason.StartYear()
+}
+
+// TeamRegistry maps team IDs, codes and franchises to each other. Its
+// methods are safe for concurrent use, including with Refresh.
+type TeamRegistry struct {
+	mu         sync.RWMutex
+	teams      map[TeamID]TeamInfo
+	franchises map[int64]Franchise
+}
+
+// NewTeamRegistry returns a registry of teams. Franchise names default to
+// those of each franchise's most recent club.
+func NewTeamRegistry(teams []TeamInfo) *TeamRegistry {
+	r := &TeamRegistry{teams: make(map[TeamID]TeamInfo, len(teams)), franchises: make(map[int64]Franchise)}
+	for _, t := range teams {
+		r.teams[t.ID] = t
+	}
+	return r
+}
+
+// DefaultTeams is the registry behind TeamByID, TeamByAbbrev and
+// FranchiseForTeam. It is built in for the active clubs and the
+// relocations they came from; Refresh adds the clubs and franchise names
+// the API knows.
+var DefaultTeams = NewTeamRegistry(builtinTeams)
+
+// TeamByID looks up a team in DefaultTeams.
+func TeamByID(id TeamID) (TeamInfo, bool) {
+	return DefaultTeams.TeamByID(id)
+}
+
+// TeamByAbbrev looks up the most recent club with a code in DefaultTeams.
+func TeamByAbbrev(abbrev TeamAbbrev) (TeamInfo, bool) {
+	return DefaultTeams.TeamByAbbrev(abbrev)
+}
+
+// FranchiseForTeam looks up a team's franchise in DefaultTeams.
+func FranchiseForTeam(id TeamID) (Franchise, bool) {
+	return DefaultTeams.FranchiseForTeam(id)
+}
+
+// TeamByID returns the club with a team ID.
+func (r *TeamRegistry) TeamByID(id TeamID) (TeamInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.teams[id]
+	return t, ok
+}
+
+// TeamByAbbrev returns the most recent club with a code. Clubs of
+// different eras can share a code; see TeamByAbbrevInSeason.
+func (r *TeamRegistry) TeamByAbbrev(abbrev TeamAbbrev) (TeamInfo, bool) {
+	var found TeamInfo
+	ok := false
+	for _, t := range r.all() {
+		if t.Abbrev == string(abbrev) && (!ok || t.FirstSeason.StartYear() > found.FirstSeason.StartYear()) {
+			found, ok = t, true
+		}
+	}
+	return found, ok
+}
+
+// TeamByAbbrevInSeason returns the club that played under a code in a
+// season.
+func (r *TeamRegistry) TeamByAbbrevInSeason(abbrev TeamAbbrev, season Season) (TeamInfo, bool) {
+	for _, t := range r.all() {
+		if t.Abbrev == string(abbrev) && t.PlayedIn(season) {
+			return t, true
+		}
+	}
+	return TeamInfo{}, false
+}
+
+// CurrentTeam follows a club's successors to the club that plays today,
+// e.g. from the Atlanta Thrashers to the Winnipeg Jets. An active club is
+// its own current team.
+func (r *TeamRegistry) CurrentTeam(id TeamID) (TeamInfo, bool) {
+	t, ok := r.TeamByID(id)
+	seen := map[TeamID]bool{id: true}
+	for ok && t.Successor != 0 && !seen[t.Successor] {
+		seen[t.Successor] = true
+		t, ok = r.TeamByID(t.Successor)
+	}
+	return t, ok
+}
+
+// FranchiseForTeam returns the franchise a team belongs to.
+func (r *TeamRegistry) FranchiseForTeam(id TeamID) (Franchise, bool) {
+	t, ok := r.TeamByID(id)
+	if !ok || t.FranchiseID == 0 {
+		return Franchise{}, false
+	}
+	r.mu.RLock()
+	f, ok := r.franchises[t.FranchiseID]
+	r.mu.RUnlock()
+	if ok {
+		return f, true
+	}
+	history := r.FranchiseHistory(t.FranchiseID)
+	return Franchise{ID: t.FranchiseID, FullName: history[len(history)-1].FullName}, true
+}
+
+// FranchiseHistory returns the clubs of a franchise from the first to the
+// most recent, e.g. the Thrashers then the Jets.
+func (r *TeamRegistry) FranchiseHistory(franchiseID int64) []TeamInfo {
+	var history []TeamInfo
+	for _, t := range r.all() {
+		if t.FranchiseID == franchiseID {
+			history = append(history, t)
+		}
+	}
+	return history
+}
+
+// TeamSource is what TeamRegistry.Refresh reads the team and franchise
+// tables from; *nhl.Client implements it.
+type TeamSource interface {
+	AllTeams(ctx context.Context) ([]StatsTeam, error)
+	Franchises(ctx context.Context) ([]Franchise, error)
+}
+
+// Refresh adds the clubs of the stats API team table that the registry
+// lacks, fills in missing names and franchise IDs, and loads the
+// franchise names of Franchises. Seasons are not in the team table, so
+// clubs only known from a refresh have none.
+func (r *TeamRegistry) Refresh(ctx context.Context, src TeamSource) error {
+	teams, err := src.AllTeams(ctx)
+	if err != nil {
+		return err
+	}
+	franchises, err := src.Franchises(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, st := range teams {
+		if !st.IsNHL() {
+			continue
+		}
+		t, ok := r.teams[st.ID]
+		if !ok {
+			t = TeamInfo{ID: st.ID, Abbrev: st.Tricode}
+		}
+		t.FullName = cmp.Or(t.FullName, st.FullName)
+		if t.FranchiseID == 0 && st.FranchiseID != nil {
+			t.FranchiseID = *st.FranchiseID
+		}
+		r.teams[st.ID] = t
+	}
+	for _, f := range franchises {
+		r.franchises[f.ID] = f
+	}
+	return nil
+}
+
+// all returns every club, oldest first.
+func (r *TeamRegistry) all() []TeamInfo {
+	r.mu.RLock()
+	teams := make([]TeamInfo, 0, len(r.teams))
+	for _, t := range r.teams {
+		teams = append(teams, t)
+	}
+	r.mu.RUnlock()
+	slices.SortFunc(teams, func(a, b TeamInfo) int {
+		return cmp.Or(
+			cmp.Compare(a.FirstSeason.StartYear(), b.FirstSeason.StartYear()),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+	return teams
+}
+
+// builtinTeams lists the active clubs, with the season each started under
+// its current identity, and the clubs their franchises relocated from.
+var builtinTeams = []TeamInfo{
+	{1, "NJD", "New Jersey Devils", 23, NewSeason(1982), Season{}, 0},
+	{2, "NYI", "New York Islanders", 22, NewSeason(1972), Season{}, 0},
+	{3, "NYR", "New York Rangers", 10, NewSeason(1926), Season{}, 0},
+	{4, "PHI", "Philadelphia Flyers", 16, NewSeason(1967), Season{}, 0},
+	{5, "PIT", "Pittsburgh Penguins", 17, NewSeason(1967), Season{}, 0},
+	{6, "BOS", "Boston Bruins", 6, NewSeason(1924), Season{}, 0},
+	{7, "BUF", "Buffalo Sabres", 19, NewSeason(1970), Season{}, 0},
+	{8, "MTL", "Montréal Canadiens", 1, NewSeason(1917), Season{}, 0},
+	{9, "OTT", "Ottawa Senators", 30, NewSeason(1992), Season{}, 0},
+	{10, "TOR", "Toronto Maple Leafs", 5, NewSeason(1917), Season{}, 0},
+	{12, "CAR", "Carolina Hurricanes", 26, NewSeason(1997), Season{}, 0},
+	{13, "FLA", "Florida Panthers", 33, NewSeason(1993), Season{}, 0},
+	{14, "TBL", "Tampa Bay Lightning", 31, NewSeason(1992), Season{}, 0},
+	{15, "WSH", "Washington Capitals", 24, NewSeason(1974), Season{}, 0},
+	{16, "CHI", "Chicago Blackhawks", 11, NewSeason(1926), Season{}, 0},
+	{17, "DET", "Detroit Red Wings", 12, NewSeason(1926), Season{}, 0},
+	{18, "NSH", "Nashville Predators", 34, NewSeason(1998), Season{}, 0},
+	{19, "STL", "St. Louis Blues", 18, NewSeason(1967), Season{}, 0},
+	{20, "CGY", "Calgary Flames", 21, NewSeason(1980), Season{}, 0},
+	{21, "COL", "Colorado Avalanche", 27, NewSeason(1995), Season{}, 0},
+	{22, "EDM", "Edmonton Oilers", 25, NewSeason(1979), Season{}, 0},
+	{23, "VAN", "Vancouver Canucks", 20, NewSeason(1970), Season{}, 0},
+	{24, "ANA", "Anaheim Ducks", 32, NewSeason(1993), Season{}, 0},
+	{25, "DAL", "Dallas Stars", 15, NewSeason(1993), Season{}, 0},
+	{26, "LAK", "Los Angeles Kings", 14, NewSeason(1967), Season{}, 0},
+	{28, "SJS", "San Jose Sharks", 29, NewSeason(1991), Season{}, 0},
+	{29, "CBJ", "Columbus Blue Jackets", 36, NewSeason(2000), Season{}, 0},
+	{30, "MIN", "Minnesota Wild", 37, NewSeason(2000), Season{}, 0},
+	{52, "WPG", "Winnipeg Jets", 35, NewSeason(2011), Season{}, 0},
+	{54, "VGK", "Vegas Golden Knights", 38, NewSeason(2017), Season{}, 0},
+	{55, "SEA", "Seattle Kraken", 39, NewSeason(2021), Season{}, 0},
+	{59, "UTA", "Utah Mammoth", 40, NewSeason(2024), Season{}, 0},
+
+	{48, "KCS", "Kansas City Scouts", 23, NewSeason(1974), NewSeason(1975), 35},
+	{35, "CLR", "Colorado Rockies", 23, NewSeason(1976), NewSeason(1981), 1},
+	{47, "AFM", "Atlanta Flames", 21, NewSeason(1972), NewSeason(1979), 20},
+	{11, "ATL", "Atlanta Thrashers", 35, NewSeason(1999), NewSeason(2010), 52},
+	{27, "PHX", "Phoenix Coyotes", 28, NewSeason(1996), NewSeason(2013), 53},
+	{53, "ARI", "Arizona Coyotes", 28, NewSeason(2014), NewSeason(2023), 59},
+	{31, "MNS", "Minnesota North Stars", 15, NewSeason(1967), NewSeason(1992), 25},
+	{32, "QUE", "Quebec Nordiques", 27, NewSeason(1979), NewSeason(1994), 21},
+	{33, "WIN", "Winnipeg Jets (1979)", 28, NewSeason(1979), NewSeason(1995), 27},
+	{34, "HFD", "Hartford Whalers", 26, NewSeason(1979), NewSeason(1996), 12},
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestTeamRegistry_Builtin(t *testing.T) {
+	for _, known := range knownTeams {
+		team, ok := TeamByAbbrev(known.abbrev)
+		if !ok || team.FullName != known.abbrev.Name() {
+			t.Errorf("TeamByAbbrev(%s) = %+v, %v; want %s", known.abbrev, team, ok, known.abbrev.Name())
+		}
+	}
+
+	mtl, ok := TeamByID(8)
+	if !ok || mtl.Abbrev != "MTL" || !mtl.IsActive() || !mtl.PlayedIn(NewSeason(1950)) {
+		t.Errorf("TeamByID(8) = %+v, %v", mtl, ok)
+	}
+	if f, ok := FranchiseForTeam(8); !ok || f.ID != 1 || f.FullName != "Montréal Canadiens" {
+		t.Errorf("FranchiseForTeam(8) = %+v, %v", f, ok)
+	}
+	if _, ok := FranchiseForTeam(999); ok {
+		t.Error("FranchiseForTeam(999) found")
+	}
+
+	atl, _ := TeamByID(11)
+	if atl.IsActive() || !atl.PlayedIn(NewSeason(2010)) || atl.PlayedIn(NewSeason(2011)) {
+		t.Errorf("Thrashers = %+v", atl)
+	}
+	if f, _ := FranchiseForTeam(11); f.FullName != "Winnipeg Jets" {
+		t.Errorf("FranchiseForTeam(ATL) = %+v", f)
+	}
+	if cur, ok := DefaultTeams.CurrentTeam(11); !ok || cur.Abbrev != "WPG" {
+		t.Errorf("CurrentTeam(ATL) = %+v, %v", cur, ok)
+	}
+	if cur, ok := DefaultTeams.CurrentTeam(33); !ok || cur.Abbrev != "UTA" {
+		t.Errorf("CurrentTeam(WIN) = %+v, %v", cur, ok)
+	}
+
+	history := DefaultTeams.FranchiseHistory(28)
+	var codes []string
+	for _, team := range history {
+		codes = append(codes, team.Abbrev)
+	}
+	if len(codes) != 3 || codes[0] != "WIN" || codes[1] != "PHX" || codes[2] != "ARI" {
+		t.Errorf("FranchiseHistory(28) = %v", codes)
+	}
+
+	for _, tt := range []struct {
+		id      TeamID
+		current string
+		history []string
+	}{
+		{48, "NJD", []string{"KCS", "CLR", "NJD"}},
+		{47, "CGY", []string{"AFM", "CGY"}},
+	} {
+		cur, ok := DefaultTeams.CurrentTeam(tt.id)
+		if !ok || cur.Abbrev != tt.current {
+			t.Errorf("CurrentTeam(%d) = %+v, %v; want %s", tt.id, cur, ok, tt.current)
+		}
+		codes = codes[:0]
+		for _, team := range DefaultTeams.FranchiseHistory(cur.FranchiseID) {
+			codes = append(codes, team.Abbrev)
+		}
+		if !slices.Equal(codes, tt.history) {
+			t.Errorf("FranchiseHistory(%d) = %v, want %v", cur.FranchiseID, codes, tt.history)
+		}
+	}
+
+	// Defunct codes are not TeamAbbrev values but must still encode.
+	for _, id := range []TeamID{11, 27, 31, 32, 33, 34, 35, 47, 48} {
+		team, _ := TeamByID(id)
+		if _, err := json.Marshal(team); err != nil {
+			t.Errorf("json.Marshal(TeamByID(%d)) error = %v", id, err)
+		}
+	}
+}
+
+func TestTeamRegistry_AbbrevBySeason(t *testing.T) {
+	r := NewTeamRegistry([]TeamInfo{
+		{ID: 47, Abbrev: "ATL", FullName: "Atlanta Flames", FranchiseID: 21, FirstSeason: NewSeason(1972), LastSeason: NewSeason(1979), Successor: 20},
+		{ID: 11, Abbrev: "ATL", FullName: "Atlanta Thrashers", FranchiseID: 35, FirstSeason: NewSeason(1999), LastSeason: NewSeason(2010)},
+		{ID: 20, Abbrev: "CGY", FullName: "Calgary Flames", FranchiseID: 21, FirstSeason: NewSeason(1980)},
+	})
+	if team, _ := r.TeamByAbbrev("ATL"); team.ID != 11 {
+		t.Errorf("TeamByAbbrev(ATL) = %+v", team)
+	}
+	if team, _ := r.TeamByAbbrevInSeason("ATL", NewSeason(1975)); team.ID != 47 {
+		t.Errorf("TeamByAbbrevInSeason(ATL, 1975) = %+v", team)
+	}
+	if _, ok := r.TeamByAbbrevInSeason("ATL", NewSeason(1990)); ok {
+		t.Error("TeamByAbbrevInSeason(ATL, 1990) found")
+	}
+	if f, _ := r.FranchiseForTeam(47); f.FullName != "Calgary Flames" {
+		t.Errorf("FranchiseForTeam(47) = %+v", f)
+	}
+}
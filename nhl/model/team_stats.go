@@ -0,0 +1,110 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// TeamSeasonStats is the team summary report for a season: one row of
+// aggregates (goals for and against, special teams, faceoffs, ...) per
+// team.
+type TeamSeasonStats []TeamSummary
+
+// SortBy sorts the rows in place by a numeric or string field, named either
+// by its Go field name ("PowerPlayPct") or its JSON name ("powerPlayPct").
+// Rows missing the value (a nil percentage) sort last in either direction,
+// and ties keep their current order.
+func (s TeamSeasonStats) SortBy(field string, direction SortDirection) error {
+	index, ok := teamSummaryField(field)
+	if !ok {
+		return fmt.Errorf("unknown team summary field: %q", field)
+	}
+	if direction != SortAscending && direction != SortDescending {
+		return fmt.Errorf("invalid sort direction %q", direction)
+	}
+
+	slices.SortStableFunc(s, func(a, b TeamSummary) int {
+		av, aok := sortValue(reflect.ValueOf(a).Field(index))
+		bv, bok := sortValue(reflect.ValueOf(b).Field(index))
+		switch {
+		case !aok && !bok:
+			return 0
+		case !aok:
+			return 1
+		case !bok:
+			return -1
+		}
+		cmp := compareSortValues(av, bv)
+		if direction == SortDescending {
+			return -cmp
+		}
+		return cmp
+	})
+	return nil
+}
+
+// Find returns the row for a team.
+func (s TeamSeasonStats) Find(id TeamID) (TeamSummary, bool) {
+	for _, row := range s {
+		if row.TeamID == id {
+			return row, true
+		}
+	}
+	return TeamSummary{}, false
+}
+
+// teamSummaryField resolves a field name to its TeamSummary field index.
+func teamSummaryField(name string) (int, bool) {
+	t := reflect.TypeFor[TeamSummary]()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		jsonName, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if f.Name == name || jsonName == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// sortValue extracts a comparable value: float64 for numbers, string for
+// strings. It reports false for nil pointers.
+func sortValue(v reflect.Value) (any, bool) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+	if season, ok := v.Interface().(Season); ok {
+		return float64(season.ID()), true
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		return v.String(), true
+	default:
+		return nil, false
+	}
+}
+
+func compareSortValues(a, b any) int {
+	switch av := a.(type) {
+	case float64:
+		bv := b.(float64)
+		switch {
+		case av < bv:
+			return -1
+		case av > bv:
+			return 1
+		}
+		return 0
+	case string:
+		return strings.Compare(av, b.(string))
+	}
+	return 0
+}
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeOnIce is a time on ice or shift length, which the API writes as
+// "MM:SS" strings such as "18:15". It converts to and from time.Duration,
+// adds with +, and encodes back to JSON in the API's form. Minutes are not
+// capped at 59: a goalie's overtime game is "65:00".
+//
+// A time the feed left empty decodes to MissingTOI, which encodes back to
+// "". Check IsMissing before adding times with +; Duration, Seconds and
+// Minutes treat a missing time as zero.
+type TimeOnIce time.Duration
+
+// MissingTOI is the TimeOnIce of an empty "" time.
+const MissingTOI = TimeOnIce(math.MinInt64)
+
+// ParseTOI parses a time on ice in "MM:SS" form. An empty string is
+// MissingTOI.
+func ParseTOI(s string) (TimeOnIce, error) {
+	if s == "" {
+		return MissingTOI, nil
+	}
+	minutes, seconds, ok := strings.Cut(s, ":")
+	m, errM := strconv.Atoi(minutes)
+	sec, errS := strconv.Atoi(seconds)
+	if !ok || errM != nil || errS != nil || m < 0 || sec < 0 || sec > 59 {
+		return 0, fmt.Errorf("invalid time on ice %q (expected MM:SS)", s)
+	}
+	return TOIFromDuration(time.Duration(m)*time.Minute + time.Duration(sec)*time.Second), nil
+}
+
+// TOIFromDuration returns d as a TimeOnIce, truncated to whole seconds.
+func TOIFromDuration(d time.Duration) TimeOnIce {
+	return TimeOnIce(d.Truncate(time.Second))
+}
+
+// IsMissing reports whether the time was left empty.
+func (t TimeOnIce) IsMissing() bool {
+	return t == MissingTOI
+}
+
+// Duration returns the time on ice as a time.Duration, or zero when it is
+// missing.
+func (t TimeOnIce) Duration() time.Duration {
+	if t.IsMissing() {
+		return 0
+	}
+	return time.Duration(t)
+}
+
+// Seconds returns the time on ice in whole seconds.
+func (t TimeOnIce) Seconds() int {
+	return int(t.Duration() / time.Second)
+}
+
+// Minutes returns the time on ice in fractional minutes, as used for
+// per-60 rates.
+func (t TimeOnIce) Minutes() float64 {
+	return t.Duration().Minutes()
+}
+
+// String returns the time on ice as "MM:SS", or "" when it is missing.
+func (t TimeOnIce) String() string {
+	if t.IsMissing() {
+		return ""
+	}
+	sign, seconds := "", t.Seconds()
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	return fmt.Sprintf("%s%02d:%02d", sign, seconds/60, seconds%60)
+}
+
+// MarshalJSON implements json.Marshaler, writing "MM:SS", or "" for
+// MissingTOI.
+func (t TimeOnIce) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts "MM:SS" strings,
+// and numbers of seconds as some stats endpoints send.
+func (t *TimeOnIce) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var seconds float64
+		if err := json.Unmarshal(data, &seconds); err != nil {
+			return fmt.Errorf("invalid time on ice %s", data)
+		}
+		*t = TOIFromDuration(time.Duration(seconds * float64(time.Second)))
+		return nil
+	}
+	parsed, err := ParseTOI(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
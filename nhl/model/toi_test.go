@@ -0,0 +1,82 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseTOI(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"18:15", 18*time.Minute + 15*time.Second, false},
+		{"00:45", 45 * time.Second, false},
+		{"65:00", 65 * time.Minute, false},
+		{"", 0, false},
+		{"18", 0, true},
+		{"18:60", 0, true},
+		{"-1:00", 0, true},
+		{"aa:bb", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseTOI(tt.in)
+		if (err != nil) != tt.wantErr || got.Duration() != tt.want || got.IsMissing() != (tt.in == "") {
+			t.Errorf("ParseTOI(%q) = %v, %v; want %v, error %v", tt.in, got.Duration(), err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestTimeOnIce(t *testing.T) {
+	a, _ := ParseTOI("18:15")
+	b, _ := ParseTOI("02:50")
+	sum := a + b
+	if sum.String() != "21:05" || sum.Seconds() != 1265 {
+		t.Errorf("sum = %s (%d s), want 21:05", sum, sum.Seconds())
+	}
+	if got := TOIFromDuration(90*time.Minute + 1500*time.Millisecond); got.String() != "90:01" {
+		t.Errorf("TOIFromDuration() = %s, want 90:01", got)
+	}
+	if got := (b - a).String(); got != "-15:25" {
+		t.Errorf("negative String() = %s", got)
+	}
+	if got := TimeOnIce(90 * time.Second).Minutes(); got != 1.5 {
+		t.Errorf("Minutes() = %v, want 1.5", got)
+	}
+}
+
+func TestTimeOnIce_JSON(t *testing.T) {
+	var v struct {
+		TOI    TimeOnIce  `json:"toi"`
+		AvgTOI *TimeOnIce `json:"avgToi"`
+		Secs   TimeOnIce  `json:"secs"`
+	}
+	if err := json.Unmarshal([]byte(`{"toi":"18:05","avgToi":null,"secs":1234.6}`), &v); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if v.TOI.String() != "18:05" || v.AvgTOI != nil || v.Secs.String() != "20:34" {
+		t.Errorf("decoded %s, %v, %s", v.TOI, v.AvgTOI, v.Secs)
+	}
+	data, err := json.Marshal(v.TOI)
+	if err != nil || string(data) != `"18:05"` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+	if err := json.Unmarshal([]byte(`{"toi":"","secs":"00:00"}`), &v); err != nil {
+		t.Fatalf("Unmarshal(empty) error = %v", err)
+	}
+	if !v.TOI.IsMissing() || v.Secs.IsMissing() || v.TOI.Seconds() != 0 {
+		t.Errorf("decoded %v, %v; want only toi missing", v.TOI, v.Secs)
+	}
+	data, _ = json.Marshal(v)
+	if string(data) != `{"toi":"","avgToi":null,"secs":"00:00"}` {
+		t.Errorf("round trip = %s, want \"\" kept apart from \"00:00\"", data)
+	}
+	if err := json.Unmarshal([]byte(`"18:5x"`), &v.TOI); err == nil {
+		t.Error("Unmarshal(invalid) error = nil")
+	}
+	if err := json.Unmarshal([]byte(`true`), &v.TOI); err == nil {
+		t.Error("Unmarshal(bool) error = nil")
+	}
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// The holiday roster freeze runs from 11:59 p.m. local time on December 19
+// through 12:01 a.m. on December 28; no player may be traded, waived or
+// loaned in between. As calendar days, the freeze covers December 20-27.
+const (
+	rosterFreezeFirstDay = 20
+	rosterFreezeLastDay  = 27
+)
+
+// IsRosterFreezePeriod reports whether date falls in the season's holiday
+// roster freeze, December 20-27 of the season's start year.
+func (d *SeasonDates) IsRosterFreezePeriod(date Date) bool {
+	first, last := d.rosterFreeze()
+	return !date.Before(first.Time) && !date.After(last.Time)
+}
+
+func (d *SeasonDates) rosterFreeze() (first, last Date) {
+	year := d.Season.StartYear()
+	return NewDate(year, time.December, rosterFreezeFirstDay), NewDate(year, time.December, rosterFreezeLastDay)
+}
+
+// DaysUntilTradeDeadline returns the number of days from date to the
+// trade deadline: 0 on deadline day and negative once it has passed. It
+// reports false when the deadline is not known.
+func (d *SeasonDates) DaysUntilTradeDeadline(date Date) (int, bool) {
+	if d.TradeDeadline == nil {
+		return 0, false
+	}
+	return int(d.TradeDeadline.Sub(date.Time).Hours() / 24), true
+}
+
+// SeasonEventKind is the kind of a SeasonEvent.
+type SeasonEventKind int
+
+const (
+	// SeasonEventRosterFreezeStart is the first day of the holiday roster
+	// freeze.
+	SeasonEventRosterFreezeStart SeasonEventKind = iota + 1
+	// SeasonEventRosterFreezeEnd is the first day after the holiday roster
+	// freeze.
+	SeasonEventRosterFreezeEnd
+	// SeasonEventTradeDeadlineDay is the day of the trade deadline.
+	SeasonEventTradeDeadlineDay
+)
+
+// String returns the kind name.
+func (k SeasonEventKind) String() string {
+	switch k {
+	case SeasonEventRosterFreezeStart:
+		return "roster-freeze-start"
+	case SeasonEventRosterFreezeEnd:
+		return "roster-freeze-end"
+	case SeasonEventTradeDeadlineDay:
+		return "trade-deadline-day"
+	default:
+		return fmt.Sprintf("SeasonEventKind(%d)", int(k))
+	}
+}
+
+// SeasonEvent is a transaction-calendar milestone of a season.
+type SeasonEvent struct {
+	Kind SeasonEventKind
+	Date Date
+}
+
+// Events returns the season's transaction milestones in date order. The
+// trade deadline is included only when known.
+func (d *SeasonDates) Events() []SeasonEvent {
+	first, last := d.rosterFreeze()
+	events := []SeasonEvent{
+		{Kind: SeasonEventRosterFreezeStart, Date: first},
+		{Kind: SeasonEventRosterFreezeEnd, Date: DateFromTime(last.AddDate(0, 0, 1))},
+	}
+	if d.TradeDeadline != nil {
+		events = append(events, SeasonEvent{Kind: SeasonEventTradeDeadlineDay, Date: *d.TradeDeadline})
+	}
+	slices.SortStableFunc(events, func(a, b SeasonEvent) int { return a.Date.Compare(b.Date.Time) })
+	return events
+}
+
+// WatchEvents delivers each upcoming season event at the start of its day
+// in loc (time.Local when nil). Events of the current day are delivered at
+// once; past ones are skipped. The channel is closed after the last event
+// or when ctx is canceled.
+func (d *SeasonDates) WatchEvents(ctx context.Context, loc *time.Location) <-chan SeasonEvent {
+	return d.watchEvents(ctx, loc, time.Now)
+}
+
+func (d *SeasonDates) watchEvents(ctx context.Context, loc *time.Location, now func() time.Time) <-chan SeasonEvent {
+	if loc == nil {
+		loc = time.Local
+	}
+	events := make(chan SeasonEvent)
+	go func() {
+		defer close(events)
+		for _, event := range d.Events() {
+			start := time.Date(event.Date.Year(), event.Date.Month(), event.Date.Day(), 0, 0, 0, 0, loc)
+			if !now().Before(start.AddDate(0, 0, 1)) {
+				continue
+			}
+			if wait := start.Sub(now()); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return
+				case <-timer.C:
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case events <- event:
+			}
+		}
+	}()
+	return events
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func testSeasonDates() *SeasonDates {
+	deadline := NewDateYMD(2024, 3, 8)
+	return &SeasonDates{Season: NewSeason(2023), TradeDeadline: &deadline}
+}
+
+func TestIsRosterFreezePeriod(t *testing.T) {
+	dates := testSeasonDates()
+	tests := map[string]bool{
+		"2023-12-19": false,
+		"2023-12-20": true,
+		"2023-12-25": true,
+		"2023-12-27": true,
+		"2023-12-28": false,
+		"2024-12-25": false,
+	}
+	for day, want := range tests {
+		if got := dates.IsRosterFreezePeriod(MustParseDate(day)); got != want {
+			t.Errorf("IsRosterFreezePeriod(%s) = %v, want %v", day, got, want)
+		}
+	}
+}
+
+func TestDaysUntilTradeDeadline(t *testing.T) {
+	dates := testSeasonDates()
+	tests := map[string]int{
+		"2024-03-01": 7,
+		"2024-03-08": 0,
+		"2024-03-10": -2,
+		"2023-10-10": 150,
+	}
+	for day, want := range tests {
+		got, ok := dates.DaysUntilTradeDeadline(MustParseDate(day))
+		if !ok || got != want {
+			t.Errorf("DaysUntilTradeDeadline(%s) = %d, %v; want %d", day, got, ok, want)
+		}
+	}
+	if _, ok := (&SeasonDates{Season: NewSeason(2030)}).DaysUntilTradeDeadline(MustParseDate("2031-01-01")); ok {
+		t.Error("unknown deadline should report false")
+	}
+}
+
+func TestSeasonDatesEvents(t *testing.T) {
+	events := testSeasonDates().Events()
+	want := []SeasonEvent{
+		{SeasonEventRosterFreezeStart, MustParseDate("2023-12-20")},
+		{SeasonEventRosterFreezeEnd, MustParseDate("2023-12-28")},
+		{SeasonEventTradeDeadlineDay, MustParseDate("2024-03-08")},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("Events() = %v, want %v", events, want)
+	}
+	for i := range want {
+		if events[i].Kind != want[i].Kind || !events[i].Date.Equal(want[i].Date) {
+			t.Errorf("event %d = %v %s, want %v %s", i, events[i].Kind, events[i].Date, want[i].Kind, want[i].Date)
+		}
+	}
+	if got := SeasonEventTradeDeadlineDay.String(); got != "trade-deadline-day" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestSeasonDatesWatchEvents(t *testing.T) {
+	dates := testSeasonDates()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The clock sits just before midnight on the eve of the deadline, after
+	// the roster freeze: only the deadline event is due, 20ms from now.
+	offset := time.Date(2024, 3, 7, 23, 59, 59, 980_000_000, time.UTC).Sub(time.Now())
+	now := func() time.Time { return time.Now().Add(offset) }
+
+	var got []SeasonEvent
+	for event := range dates.watchEvents(ctx, time.UTC, now) {
+		got = append(got, event)
+	}
+	if len(got) != 1 || got[0].Kind != SeasonEventTradeDeadlineDay {
+		t.Errorf("events = %v, want the trade deadline only", got)
+	}
+	if ctx.Err() != nil {
+		t.Error("watch should close on its own after the last event")
+	}
+}
+
+func TestSeasonDatesWatchEventsCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	now := func() time.Time { return time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC) }
+	events := testSeasonDates().watchEvents(ctx, time.UTC, now)
+	cancel()
+	if _, ok := <-events; ok {
+		t.Error("channel should close without events after cancel")
+	}
+}
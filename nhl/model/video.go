@@ -0,0 +1,107 @@
+package model
+
+import (
+	"strconv"
+)
+
+// VideoPlayerURL is the league's embeddable video player. Highlight,
+// recap and condensed-game IDs play in it through the videoId query
+// parameter; see VideoURL.
+const VideoPlayerURL = "https://players.brightcove.net/6415718365001/EXtG1xJ7H_default/index.html"
+
+// VideoURL returns the playable URL of a league video ID, or "" for 0,
+// which the API uses for a video not published yet.
+func VideoURL(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return VideoPlayerURL + "?videoId=" + strconv.FormatInt(id, 10)
+}
+
+func videoPtrURL(id *int64) string {
+	if id == nil {
+		return ""
+	}
+	return VideoURL(*id)
+}
+
+// HighlightURL returns the playable URL of a goal's highlight, or "" when
+// there is none.
+func (d *PlayEventDetails) HighlightURL() string {
+	if d == nil {
+		return ""
+	}
+	return videoPtrURL(d.HighlightClip)
+}
+
+// DiscreteClipURL returns the playable URL of the goal-only cut of a
+// goal's highlight, or "" when there is none.
+func (d *PlayEventDetails) DiscreteClipURL() string {
+	if d == nil {
+		return ""
+	}
+	return videoPtrURL(d.DiscreteClip)
+}
+
+// HighlightURL returns the playable URL of the goal's highlight, or ""
+// when there is none.
+func (g *GoalSummary) HighlightURL() string {
+	return videoPtrURL(g.HighlightClip)
+}
+
+// DiscreteClipURL returns the playable URL of the goal-only cut of the
+// goal's highlight, or "" when there is none.
+func (g *GoalSummary) DiscreteClipURL() string {
+	return videoPtrURL(g.DiscreteClip)
+}
+
+// HighlightURL returns the playable URL of the highlight, or "" when it is
+// not published.
+func (c GoalClip) HighlightURL() string {
+	return VideoURL(c.ClipID)
+}
+
+// DiscreteClipURL returns the playable URL of the goal-only cut, or ""
+// when it is not published.
+func (c GoalClip) DiscreteClipURL() string {
+	return VideoURL(c.DiscreteClipID)
+}
+
+// VideoKind is the kind of a game video.
+type VideoKind string
+
+const (
+	// VideoRecap is the three-minute recap.
+	VideoRecap VideoKind = "recap"
+	// VideoCondensedGame is the condensed game.
+	VideoCondensedGame VideoKind = "condensed-game"
+)
+
+// VideoAsset is one published video of a game.
+type VideoAsset struct {
+	Kind     VideoKind
+	Language Language
+	ID       int64
+	URL      string
+}
+
+// Assets returns the published videos: the recap, then the condensed
+// game, each in English then French.
+func (v *GameVideo) Assets() []VideoAsset {
+	if v == nil {
+		return nil
+	}
+	var assets []VideoAsset
+	for _, a := range []VideoAsset{
+		{Kind: VideoRecap, Language: LanguageEnglish, ID: v.ThreeMinRecap},
+		{Kind: VideoRecap, Language: LanguageFrench, ID: v.ThreeMinRecapFr},
+		{Kind: VideoCondensedGame, Language: LanguageEnglish, ID: v.CondensedGame},
+		{Kind: VideoCondensedGame, Language: LanguageFrench, ID: v.CondensedGameFr},
+	} {
+		if a.ID != 0 {
+			a.URL = VideoURL(a.ID)
+			assets = append(assets, a)
+		}
+	}
+	return assets
+}
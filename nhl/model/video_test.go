@@ -0,0 +1,32 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestVideoURLs(t *testing.T) {
+	if got := VideoURL(6345678901112); got != VideoPlayerURL+"?videoId=6345678901112" {
+		t.Errorf("VideoURL() = %s", got)
+	}
+	if VideoURL(0) != "" {
+		t.Error("VideoURL(0) should be empty")
+	}
+
+	clip, discrete := int64(111), int64(222)
+	d := &PlayEventDetails{HighlightClip: &clip, DiscreteClip: &discrete}
+	if d.HighlightURL() != VideoURL(111) || d.DiscreteClipURL() != VideoURL(222) {
+		t.Errorf("details URLs = %s, %s", d.HighlightURL(), d.DiscreteClipURL())
+	}
+	var none *PlayEventDetails
+	if none.HighlightURL() != "" || (&PlayEventDetails{}).DiscreteClipURL() != "" {
+		t.Error("details without clips should have no URLs")
+	}
+
+	g := GoalSummary{HighlightClip: &clip}
+	if g.HighlightURL() != VideoURL(111) || g.DiscreteClipURL() != "" {
+		t.Errorf("goal summary URLs = %s, %s", g.HighlightURL(), g.DiscreteClipURL())
+	}
+	if c := (GoalClip{DiscreteClipID: 222}); c.HighlightURL() != "" || c.DiscreteClipURL() != VideoURL(222) {
+		t.Errorf("goal clip URLs = %s, %s", c.HighlightURL(), c.DiscreteClipURL())
+	}
+}
@@ -0,0 +1,944 @@
+// Code generated by internal/aliasgen; DO NOT EDIT.
+
+package nhl
+
+import (
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// Model types, aliased so that both package names refer to the same types.
+type (
+	Boxscore                               = model.Boxscore
+	PeriodScore                            = model.PeriodScore
+	TeamGameStat                           = model.TeamGameStat
+	TVBroadcast                            = model.TVBroadcast
+	SpecialEvent                           = model.SpecialEvent
+	PeriodDescriptor                       = model.PeriodDescriptor
+	BoxscoreTeam                           = model.BoxscoreTeam
+	GameClock                              = model.GameClock
+	PlayerByGameStats                      = model.PlayerByGameStats
+	TeamPlayerStats                        = model.TeamPlayerStats
+	TeamGameStats                          = model.TeamGameStats
+	PeriodStats                            = model.PeriodStats
+	SkaterStats                            = model.SkaterStats
+	GoalieStats                            = model.GoalieStats
+	StreamingOption                        = model.StreamingOption
+	StreamingOptions                       = model.StreamingOptions
+	CareerGame                             = model.CareerGame
+	ClubSkaterStats                        = model.ClubSkaterStats
+	ClubGoalieStats                        = model.ClubGoalieStats
+	ClubStats                              = model.ClubStats
+	SeasonGameTypes                        = model.SeasonGameTypes
+	StatDiscrepancy                        = model.StatDiscrepancy
+	LocalizedString                        = model.LocalizedString
+	Conference                             = model.Conference
+	Division                               = model.Division
+	Franchise                              = model.Franchise
+	StatsTeam                              = model.StatsTeam
+	Team                                   = model.Team
+	Roster                                 = model.Roster
+	RosterPlayer                           = model.RosterPlayer
+	FranchisesResponse                     = model.FranchisesResponse
+	StatsTeamsResponse                     = model.StatsTeamsResponse
+	Country                                = model.Country
+	Date                                   = model.Date
+	GameDate                               = model.GameDate
+	DateRange                              = model.DateRange
+	Season                                 = model.Season
+	DraftProspectCategory                  = model.DraftProspectCategory
+	DraftRankings                          = model.DraftRankings
+	DraftCategory                          = model.DraftCategory
+	DraftProspect                          = model.DraftProspect
+	DraftPicks                             = model.DraftPicks
+	DraftPick                              = model.DraftPick
+	DraftTracker                           = model.DraftTracker
+	DraftTrackerPick                       = model.DraftTrackerPick
+	EdgeMeasurement                        = model.EdgeMeasurement
+	EdgeMeasurementWithOverlay             = model.EdgeMeasurementWithOverlay
+	EdgePercentileStat                     = model.EdgePercentileStat
+	EdgePercentileStatWithOverlay          = model.EdgePercentileStatWithOverlay
+	EdgeCountLeagueAvg                     = model.EdgeCountLeagueAvg
+	EdgeCountPercentileStat                = model.EdgeCountPercentileStat
+	EdgeRankStat                           = model.EdgeRankStat
+	EdgeRankStatWithOverlay                = model.EdgeRankStatWithOverlay
+	EdgeOverlay                            = model.EdgeOverlay
+	EdgeOverlayPlayer                      = model.EdgeOverlayPlayer
+	EdgeOverlayTeam                        = model.EdgeOverlayTeam
+	EdgeSeasonAvailability                 = model.EdgeSeasonAvailability
+	EdgeTeamLogo                           = model.EdgeTeamLogo
+	EdgeTeamInfo                           = model.EdgeTeamInfo
+	EdgeSkaterDetail                       = model.EdgeSkaterDetail
+	EdgeSkaterPlayer                       = model.EdgeSkaterPlayer
+	EdgeSkaterSpeed                        = model.EdgeSkaterSpeed
+	EdgeSkaterSogSummary                   = model.EdgeSkaterSogSummary
+	EdgeSogAreaDetail                      = model.EdgeSogAreaDetail
+	EdgeSkaterZoneTimeSummary              = model.EdgeSkaterZoneTimeSummary
+	EdgeSkaterSpeedDetail                  = model.EdgeSkaterSpeedDetail
+	EdgeSpeedEntry                         = model.EdgeSpeedEntry
+	EdgeSkaterDistanceDetail               = model.EdgeSkaterDistanceDetail
+	EdgeDistanceEntry                      = model.EdgeDistanceEntry
+	EdgeSkaterShotSpeedDetail              = model.EdgeSkaterShotSpeedDetail
+	EdgeShotSpeedEntry                     = model.EdgeShotSpeedEntry
+	EdgeSkaterShotLocationDetail           = model.EdgeSkaterShotLocationDetail
+	EdgeShotLocationEntry                  = model.EdgeShotLocationEntry
+	EdgeSkaterZoneTimeDetail               = model.EdgeSkaterZoneTimeDetail
+	EdgeZoneTimeEntry                      = model.EdgeZoneTimeEntry
+	EdgeComparisonShotSpeedDetails         = model.EdgeComparisonShotSpeedDetails
+	EdgeComparisonSkatingSpeedDetails      = model.EdgeComparisonSkatingSpeedDetails
+	EdgeComparisonSkatingDistanceDetails   = model.EdgeComparisonSkatingDistanceDetails
+	EdgeComparisonZoneTimeDetails          = model.EdgeComparisonZoneTimeDetails
+	EdgeComparisonShotLocationDetail       = model.EdgeComparisonShotLocationDetail
+	EdgeComparisonShotLocationTotal        = model.EdgeComparisonShotLocationTotal
+	EdgeComparisonDistanceLast10Entry      = model.EdgeComparisonDistanceLast10Entry
+	EdgeComparisonZoneStarts               = model.EdgeComparisonZoneStarts
+	EdgeSkaterComparison                   = model.EdgeSkaterComparison
+	EdgeLeaderShotLocation                 = model.EdgeLeaderShotLocation
+	EdgeSkaterLeader                       = model.EdgeSkaterLeader
+	EdgeSkaterLanding                      = model.EdgeSkaterLanding
+	EdgeGoalieDetail                       = model.EdgeGoalieDetail
+	EdgeGoaliePlayer                       = model.EdgeGoaliePlayer
+	EdgeGoalieStatsSummary                 = model.EdgeGoalieStatsSummary
+	EdgeGoalieStatEntry                    = model.EdgeGoalieStatEntry
+	EdgeGoalieShotLocationSummary          = model.EdgeGoalieShotLocationSummary
+	EdgeGoalieShotLocationArea             = model.EdgeGoalieShotLocationArea
+	EdgeGoalie5v5Detail                    = model.EdgeGoalie5v5Detail
+	EdgeGoalie5v5Entry                     = model.EdgeGoalie5v5Entry
+	EdgeGoalieShotLocationDetail           = model.EdgeGoalieShotLocationDetail
+	EdgeGoalieShotLocationEntry            = model.EdgeGoalieShotLocationEntry
+	EdgeGoalieSavePctgDetail               = model.EdgeGoalieSavePctgDetail
+	EdgeGoalieSavePctgStatDetail           = model.EdgeGoalieSavePctgStatDetail
+	EdgeGoalieSavePctgEntry                = model.EdgeGoalieSavePctgEntry
+	EdgeGoalieComparisonShotSummary        = model.EdgeGoalieComparisonShotSummary
+	EdgeGoalieComparisonShotDetail         = model.EdgeGoalieComparisonShotDetail
+	EdgeGoalieComparisonSavePctgDetails    = model.EdgeGoalieComparisonSavePctgDetails
+	EdgeGoalieComparisonSavePctg5v5Details = model.EdgeGoalieComparisonSavePctg5v5Details
+	EdgeGoalieComparisonLast10Entry        = model.EdgeGoalieComparisonLast10Entry
+	EdgeGoalieComparison                   = model.EdgeGoalieComparison
+	EdgeGoalieLeader                       = model.EdgeGoalieLeader
+	EdgeGoalieLanding                      = model.EdgeGoalieLanding
+	EdgeTeamDetail                         = model.EdgeTeamDetail
+	EdgeTeamShotSpeed                      = model.EdgeTeamShotSpeed
+	EdgeTeamSkatingSpeed                   = model.EdgeTeamSkatingSpeed
+	EdgeTeamDistance                       = model.EdgeTeamDistance
+	EdgeTeamSogSummary                     = model.EdgeTeamSogSummary
+	EdgeTeamSogAreaDetail                  = model.EdgeTeamSogAreaDetail
+	EdgeTeamZoneTime                       = model.EdgeTeamZoneTime
+	EdgeTeamSpeedDetail                    = model.EdgeTeamSpeedDetail
+	EdgeTeamSpeedEntry                     = model.EdgeTeamSpeedEntry
+	EdgeTeamDistanceDetail                 = model.EdgeTeamDistanceDetail
+	EdgeTeamDistanceEntry                  = model.EdgeTeamDistanceEntry
+	EdgeTeamShotSpeedDetail                = model.EdgeTeamShotSpeedDetail
+	EdgeTeamShotSpeedEntry                 = model.EdgeTeamShotSpeedEntry
+	EdgeTeamShotLocationDetail             = model.EdgeTeamShotLocationDetail
+	EdgeTeamShotLocationEntry              = model.EdgeTeamShotLocationEntry
+	EdgeTeamZoneTimeDetails                = model.EdgeTeamZoneTimeDetails
+	EdgeTeamZoneTimeByStrength             = model.EdgeTeamZoneTimeByStrength
+	EdgeTeamShotDifferential               = model.EdgeTeamShotDifferential
+	EdgeTeamComparison                     = model.EdgeTeamComparison
+	EdgeTeamLeader                         = model.EdgeTeamLeader
+	EdgeTeamLanding                        = model.EdgeTeamLanding
+	Position                               = model.Position
+	Handedness                             = model.Handedness
+	GoalieDecision                         = model.GoalieDecision
+	PeriodType                             = model.PeriodType
+	HomeRoad                               = model.HomeRoad
+	ZoneCode                               = model.ZoneCode
+	DefendingSide                          = model.DefendingSide
+	GameScheduleState                      = model.GameScheduleState
+	PlayEventType                          = model.PlayEventType
+	GameState                              = model.GameState
+	Language                               = model.Language
+	GameSituation                          = model.GameSituation
+	PlayByPlay                             = model.PlayByPlay
+	GameOutcome                            = model.GameOutcome
+	PlayEvent                              = model.PlayEvent
+	PlayKey                                = model.PlayKey
+	PlayEventDetails                       = model.PlayEventDetails
+	RosterSpot                             = model.RosterSpot
+	GameMatchup                            = model.GameMatchup
+	MatchupTeam                            = model.MatchupTeam
+	GameSummary                            = model.GameSummary
+	PeriodScoring                          = model.PeriodScoring
+	GoalSummary                            = model.GoalSummary
+	AssistSummary                          = model.AssistSummary
+	ShootoutAttempt                        = model.ShootoutAttempt
+	ThreeStar                              = model.ThreeStar
+	PeriodPenalties                        = model.PeriodPenalties
+	PenaltySummary                         = model.PenaltySummary
+	PenaltyPlayer                          = model.PenaltyPlayer
+	ShiftChart                             = model.ShiftChart
+	ShiftEntry                             = model.ShiftEntry
+	SeasonSeriesMatchup                    = model.SeasonSeriesMatchup
+	GameRightRail                          = model.GameRightRail
+	GameVideo                              = model.GameVideo
+	GameReports                            = model.GameReports
+	SeriesGame                             = model.SeriesGame
+	SeriesTeam                             = model.SeriesTeam
+	SeriesWins                             = model.SeriesWins
+	SeriesGameInfo                         = model.SeriesGameInfo
+	TeamGameInfo                           = model.TeamGameInfo
+	ScratchedPlayer                        = model.ScratchedPlayer
+	GameStory                              = model.GameStory
+	StoryTeam                              = model.StoryTeam
+	GameLogAverages                        = model.GameLogAverages
+	GameType                               = model.GameType
+	GoalClip                               = model.GoalClip
+	GoalPlaylist                           = model.GoalPlaylist
+	GameID                                 = model.GameID
+	PlayerID                               = model.PlayerID
+	TeamID                                 = model.TeamID
+	SeasonDates                            = model.SeasonDates
+	Linescore                              = model.Linescore
+	LinescoreTotal                         = model.LinescoreTotal
+	ShootoutRound                          = model.ShootoutRound
+	PlayerLanding                          = model.PlayerLanding
+	DraftDetails                           = model.DraftDetails
+	FeaturedStats                          = model.FeaturedStats
+	CareerTotals                           = model.CareerTotals
+	PlayerStats                            = model.PlayerStats
+	SeasonTotal                            = model.SeasonTotal
+	Award                                  = model.Award
+	AwardSeason                            = model.AwardSeason
+	GameLog                                = model.GameLog
+	PlayerGameLog                          = model.PlayerGameLog
+	PlayerSearchResult                     = model.PlayerSearchResult
+	PlayerSpotlight                        = model.PlayerSpotlight
+	MilestoneKind                          = model.MilestoneKind
+	Milestone                              = model.Milestone
+	MilestonesResponse                     = model.MilestonesResponse
+	PlayerMatch                            = model.PlayerMatch
+	PlayoffBracket                         = model.PlayoffBracket
+	PlayoffBracketSeries                   = model.PlayoffBracketSeries
+	PlayoffBracketTeam                     = model.PlayoffBracketTeam
+	PlayoffRound                           = model.PlayoffRound
+	PlayoffSeries                          = model.PlayoffSeries
+	PlayoffSeriesTeam                      = model.PlayoffSeriesTeam
+	PlayoffSeriesGame                      = model.PlayoffSeriesGame
+	PlayoffGameTeam                        = model.PlayoffGameTeam
+	PlayoffSeriesStatus                    = model.PlayoffSeriesStatus
+	Prospects                              = model.Prospects
+	ProspectPlayer                         = model.ProspectPlayer
+	ScheduleGame                           = model.ScheduleGame
+	ScheduleTeam                           = model.ScheduleTeam
+	DailySchedule                          = model.DailySchedule
+	WeeklyScheduleResponse                 = model.WeeklyScheduleResponse
+	GameDay                                = model.GameDay
+	TeamScheduleResponse                   = model.TeamScheduleResponse
+	DailyScores                            = model.DailyScores
+	GameScore                              = model.GameScore
+	ScheduleChangeKind                     = model.ScheduleChangeKind
+	ScheduleChange                         = model.ScheduleChange
+	ScoreUpdateKind                        = model.ScoreUpdateKind
+	ScoreUpdate                            = model.ScoreUpdate
+	TeamScoreboard                         = model.TeamScoreboard
+	ScoreboardDate                         = model.ScoreboardDate
+	ScoreboardGame                         = model.ScoreboardGame
+	Phase                                  = model.Phase
+	ShotsFraction                          = model.ShotsFraction
+	Standing                               = model.Standing
+	StandingsResponse                      = model.StandingsResponse
+	SeasonInfo                             = model.SeasonInfo
+	SeasonsResponse                        = model.SeasonsResponse
+	Standings                              = model.Standings
+	PlayoffPicture                         = model.PlayoffPicture
+	SortDirection                          = model.SortDirection
+	SkaterSummary                          = model.SkaterSummary
+	SkaterRealtime                         = model.SkaterRealtime
+	SkaterBio                              = model.SkaterBio
+	GoalieSummary                          = model.GoalieSummary
+	GoalieBio                              = model.GoalieBio
+	TeamSummary                            = model.TeamSummary
+	TeamRealtime                           = model.TeamRealtime
+	TeamAbbrev                             = model.TeamAbbrev
+	TeamComparison                         = model.TeamComparison
+	TeamComparisonStats                    = model.TeamComparisonStats
+	ComparisonRow                          = model.ComparisonRow
+	TeamInfo                               = model.TeamInfo
+	TeamRegistry                           = model.TeamRegistry
+	TeamSource                             = model.TeamSource
+	TeamSeasonStats                        = model.TeamSeasonStats
+	TimeOnIce                              = model.TimeOnIce
+	SeasonEventKind                        = model.SeasonEventKind
+	SeasonEvent                            = model.SeasonEvent
+	VideoKind                              = model.VideoKind
+	VideoAsset                             = model.VideoAsset
+)
+
+// Constants of package model.
+const (
+	AssetsBaseURL                  = model.AssetsBaseURL
+	MarketNational                 = model.MarketNational
+	MarketHome                     = model.MarketHome
+	MarketAway                     = model.MarketAway
+	LeagueIDNHL                    = model.LeagueIDNHL
+	CountryCanada                  = model.CountryCanada
+	CountryUSA                     = model.CountryUSA
+	CountrySweden                  = model.CountrySweden
+	CountryFinland                 = model.CountryFinland
+	CountryRussia                  = model.CountryRussia
+	CountryCzechia                 = model.CountryCzechia
+	CountrySlovakia                = model.CountrySlovakia
+	CountrySwitzerland             = model.CountrySwitzerland
+	CountryGermany                 = model.CountryGermany
+	CountryLatvia                  = model.CountryLatvia
+	CountryDenmark                 = model.CountryDenmark
+	CountryNorway                  = model.CountryNorway
+	CountryAustria                 = model.CountryAustria
+	CountryBelarus                 = model.CountryBelarus
+	CountrySlovenia                = model.CountrySlovenia
+	CountryFrance                  = model.CountryFrance
+	CountryKazakhstan              = model.CountryKazakhstan
+	CountryUkraine                 = model.CountryUkraine
+	CountryUnitedKingdom           = model.CountryUnitedKingdom
+	DateLayout                     = model.DateLayout
+	MonthLayout                    = model.MonthLayout
+	DraftNorthAmericanSkater       = model.DraftNorthAmericanSkater
+	DraftInternationalSkater       = model.DraftInternationalSkater
+	DraftNorthAmericanGoalie       = model.DraftNorthAmericanGoalie
+	DraftInternationalGoalie       = model.DraftInternationalGoalie
+	PositionCenter                 = model.PositionCenter
+	PositionLeftWing               = model.PositionLeftWing
+	PositionRightWing              = model.PositionRightWing
+	PositionForward                = model.PositionForward
+	PositionDefense                = model.PositionDefense
+	PositionGoalie                 = model.PositionGoalie
+	HandednessLeft                 = model.HandednessLeft
+	HandednessRight                = model.HandednessRight
+	GoalieDecisionWin              = model.GoalieDecisionWin
+	GoalieDecisionLoss             = model.GoalieDecisionLoss
+	GoalieDecisionTie              = model.GoalieDecisionTie
+	GoalieDecisionOvertimeLoss     = model.GoalieDecisionOvertimeLoss
+	PeriodTypeRegulation           = model.PeriodTypeRegulation
+	PeriodTypeOvertime             = model.PeriodTypeOvertime
+	PeriodTypeShootout             = model.PeriodTypeShootout
+	HomeRoadHome                   = model.HomeRoadHome
+	HomeRoadRoad                   = model.HomeRoadRoad
+	ZoneCodeOffensive              = model.ZoneCodeOffensive
+	ZoneCodeDefensive              = model.ZoneCodeDefensive
+	ZoneCodeNeutral                = model.ZoneCodeNeutral
+	DefendingSideLeft              = model.DefendingSideLeft
+	DefendingSideRight             = model.DefendingSideRight
+	GameScheduleStateOK            = model.GameScheduleStateOK
+	GameScheduleStateDontPlay      = model.GameScheduleStateDontPlay
+	GameScheduleStatePostponed     = model.GameScheduleStatePostponed
+	GameScheduleStateSuspended     = model.GameScheduleStateSuspended
+	GameScheduleStateTBD           = model.GameScheduleStateTBD
+	GameScheduleStateCompleted     = model.GameScheduleStateCompleted
+	GameScheduleStateCancelled     = model.GameScheduleStateCancelled
+	PlayEventTypeGameStart         = model.PlayEventTypeGameStart
+	PlayEventTypePeriodStart       = model.PlayEventTypePeriodStart
+	PlayEventTypePeriodEnd         = model.PlayEventTypePeriodEnd
+	PlayEventTypeGameEnd           = model.PlayEventTypeGameEnd
+	PlayEventTypeFaceoff           = model.PlayEventTypeFaceoff
+	PlayEventTypeHit               = model.PlayEventTypeHit
+	PlayEventTypeGiveaway          = model.PlayEventTypeGiveaway
+	PlayEventTypeTakeaway          = model.PlayEventTypeTakeaway
+	PlayEventTypeShotOnGoal        = model.PlayEventTypeShotOnGoal
+	PlayEventTypeMissedShot        = model.PlayEventTypeMissedShot
+	PlayEventTypeBlockedShot       = model.PlayEventTypeBlockedShot
+	PlayEventTypeGoal              = model.PlayEventTypeGoal
+	PlayEventTypePenalty           = model.PlayEventTypePenalty
+	PlayEventTypeStoppage          = model.PlayEventTypeStoppage
+	PlayEventTypeDelayedPenalty    = model.PlayEventTypeDelayedPenalty
+	PlayEventTypeFailedShotAttempt = model.PlayEventTypeFailedShotAttempt
+	PlayEventTypeShootoutComplete  = model.PlayEventTypeShootoutComplete
+	PlayEventTypeUnknown           = model.PlayEventTypeUnknown
+	GameStateFuture                = model.GameStateFuture
+	GameStatePreGame               = model.GameStatePreGame
+	GameStateLive                  = model.GameStateLive
+	GameStateFinal                 = model.GameStateFinal
+	GameStateOff                   = model.GameStateOff
+	GameStatePostponed             = model.GameStatePostponed
+	GameStateSuspended             = model.GameStateSuspended
+	GameStateCritical              = model.GameStateCritical
+	LanguageEnglish                = model.LanguageEnglish
+	LanguageFrench                 = model.LanguageFrench
+	LanguageCzech                  = model.LanguageCzech
+	LanguageGerman                 = model.LanguageGerman
+	LanguageSpanish                = model.LanguageSpanish
+	LanguageFinnish                = model.LanguageFinnish
+	LanguageSlovak                 = model.LanguageSlovak
+	LanguageSwedish                = model.LanguageSwedish
+	GameTypePreseason              = model.GameTypePreseason
+	GameTypeRegularSeason          = model.GameTypeRegularSeason
+	GameTypePlayoffs               = model.GameTypePlayoffs
+	GameTypeAllStar                = model.GameTypeAllStar
+	GameTypeWorldCup               = model.GameTypeWorldCup
+	GameTypeWorldCup2004           = model.GameTypeWorldCup2004
+	GameTypeWorldCupPreTournament  = model.GameTypeWorldCupPreTournament
+	GameTypeOlympics               = model.GameTypeOlympics
+	GameTypeYoungStars             = model.GameTypeYoungStars
+	GameTypePWHLShowcase           = model.GameTypePWHLShowcase
+	GameTypeLockoutLost            = model.GameTypeLockoutLost
+	GameTypeCanadaCup              = model.GameTypeCanadaCup
+	GameTypeExhibitionOverseas     = model.GameTypeExhibitionOverseas
+	GameTypeWomensAllStar          = model.GameTypeWomensAllStar
+	GameType4Nations               = model.GameType4Nations
+	MilestoneSkaters               = model.MilestoneSkaters
+	MilestoneGoalies               = model.MilestoneGoalies
+	MinPlayerMatchScore            = model.MinPlayerMatchScore
+	ScheduleChangePostponed        = model.ScheduleChangePostponed
+	ScheduleChangeCancelled        = model.ScheduleChangeCancelled
+	ScheduleChangeRescheduled      = model.ScheduleChangeRescheduled
+	ScheduleChangeRelocated        = model.ScheduleChangeRelocated
+	ScoreUpdateGameStart           = model.ScoreUpdateGameStart
+	ScoreUpdateGoal                = model.ScoreUpdateGoal
+	ScoreUpdateGoalRemoved         = model.ScoreUpdateGoalRemoved
+	ScoreUpdatePeriodChange        = model.ScoreUpdatePeriodChange
+	ScoreUpdateGameEnd             = model.ScoreUpdateGameEnd
+	PhaseOffseason                 = model.PhaseOffseason
+	PhasePreseason                 = model.PhasePreseason
+	PhaseRegularSeason             = model.PhaseRegularSeason
+	PhaseBreak                     = model.PhaseBreak
+	PhasePlayoffs                  = model.PhasePlayoffs
+	RegularSeasonGamesPerTeam      = model.RegularSeasonGamesPerTeam
+	SortAscending                  = model.SortAscending
+	SortDescending                 = model.SortDescending
+	TeamANA                        = model.TeamANA
+	TeamBOS                        = model.TeamBOS
+	TeamBUF                        = model.TeamBUF
+	TeamCGY                        = model.TeamCGY
+	TeamCAR                        = model.TeamCAR
+	TeamCHI                        = model.TeamCHI
+	TeamCOL                        = model.TeamCOL
+	TeamCBJ                        = model.TeamCBJ
+	TeamDAL                        = model.TeamDAL
+	TeamDET                        = model.TeamDET
+	TeamEDM                        = model.TeamEDM
+	TeamFLA                        = model.TeamFLA
+	TeamLAK                        = model.TeamLAK
+	TeamMIN                        = model.TeamMIN
+	TeamMTL                        = model.TeamMTL
+	TeamNSH                        = model.TeamNSH
+	TeamNJD                        = model.TeamNJD
+	TeamNYI                        = model.TeamNYI
+	TeamNYR                        = model.TeamNYR
+	TeamOTT                        = model.TeamOTT
+	TeamPHI                        = model.TeamPHI
+	TeamPIT                        = model.TeamPIT
+	TeamSJS                        = model.TeamSJS
+	TeamSEA                        = model.TeamSEA
+	TeamSTL                        = model.TeamSTL
+	TeamTBL                        = model.TeamTBL
+	TeamTOR                        = model.TeamTOR
+	TeamUTA                        = model.TeamUTA
+	TeamVAN                        = model.TeamVAN
+	TeamVGK                        = model.TeamVGK
+	TeamWSH                        = model.TeamWSH
+	TeamWPG                        = model.TeamWPG
+	MissingTOI                     = model.MissingTOI
+	SeasonEventRosterFreezeStart   = model.SeasonEventRosterFreezeStart
+	SeasonEventRosterFreezeEnd     = model.SeasonEventRosterFreezeEnd
+	SeasonEventTradeDeadlineDay    = model.SeasonEventTradeDeadlineDay
+	VideoPlayerURL                 = model.VideoPlayerURL
+	VideoRecap                     = model.VideoRecap
+	VideoCondensedGame             = model.VideoCondensedGame
+)
+
+// Variables of package model. They share their values with it.
+var (
+	DefaultTeams = model.DefaultTeams
+)
+
+// HeadshotURL calls model.HeadshotURL.
+func HeadshotURL(teamAbbrev TeamAbbrev, season Season, playerID PlayerID) string {
+	return model.HeadshotURL(teamAbbrev, season, playerID)
+}
+
+// HeroImageURL calls model.HeroImageURL.
+func HeroImageURL(playerID PlayerID) string {
+	return model.HeroImageURL(playerID)
+}
+
+// FromTeamPlayerStats calls model.FromTeamPlayerStats.
+func FromTeamPlayerStats(stats *TeamPlayerStats) TeamGameStats {
+	return model.FromTeamPlayerStats(stats)
+}
+
+// BroadcastsIn calls model.BroadcastsIn.
+func BroadcastsIn(broadcasts []TVBroadcast, countryCode string) []TVBroadcast {
+	return model.BroadcastsIn(broadcasts, countryCode)
+}
+
+// ValidateClubStats calls model.ValidateClubStats.
+func ValidateClubStats(clubStats *ClubStats, logs map[PlayerID]*PlayerGameLog) ([]StatDiscrepancy, error) {
+	return model.ValidateClubStats(clubStats, logs)
+}
+
+// CountryFromString calls model.CountryFromString.
+func CountryFromString(s string) (Country, error) {
+	return model.CountryFromString(s)
+}
+
+// CountryFromStringLenient calls model.CountryFromStringLenient.
+func CountryFromStringLenient(s string) (Country, error) {
+	return model.CountryFromStringLenient(s)
+}
+
+// NewDate calls model.NewDate.
+func NewDate(year int, month time.Month, day int) Date {
+	return model.NewDate(year, month, day)
+}
+
+// NewDateYMD calls model.NewDateYMD.
+func NewDateYMD(year, month, day int) Date {
+	return model.NewDateYMD(year, month, day)
+}
+
+// DateFromTime calls model.DateFromTime.
+func DateFromTime(t time.Time) Date {
+	return model.DateFromTime(t)
+}
+
+// ParseDate calls model.ParseDate.
+func ParseDate(s string) (Date, error) {
+	return model.ParseDate(s)
+}
+
+// MustParseDate calls model.MustParseDate.
+func MustParseDate(s string) Date {
+	return model.MustParseDate(s)
+}
+
+// Now calls model.Now.
+func Now() GameDate {
+	return model.Now()
+}
+
+// FromDate calls model.FromDate.
+func FromDate(t time.Time) GameDate {
+	return model.FromDate(t)
+}
+
+// FromYMD calls model.FromYMD.
+func FromYMD(year, month, day int) GameDate {
+	return model.FromYMD(year, month, day)
+}
+
+// Today calls model.Today.
+func Today() GameDate {
+	return model.Today()
+}
+
+// ParseAPIMonth calls model.ParseAPIMonth.
+func ParseAPIMonth(s string) (GameDate, error) {
+	return model.ParseAPIMonth(s)
+}
+
+// ParseGameDate calls model.ParseGameDate.
+//
+// Deprecated: Use ParseDate, which validates the same way, and FromDate;
+// callers that accept "now" should map it to Now themselves.
+func ParseGameDate(s string) (GameDate, error) {
+	return model.ParseGameDate(s)
+}
+
+// NewDateRange calls model.NewDateRange.
+func NewDateRange(start, end GameDate) (DateRange, error) {
+	return model.NewDateRange(start, end)
+}
+
+// NewSeason calls model.NewSeason.
+func NewSeason(startYear int) Season {
+	return model.NewSeason(startYear)
+}
+
+// FromYears calls model.FromYears.
+func FromYears(startYear, endYear int) (Season, error) {
+	return model.FromYears(startYear, endYear)
+}
+
+// ParseAPISeason calls model.ParseAPISeason.
+func ParseAPISeason(s string) (Season, error) {
+	return model.ParseAPISeason(s)
+}
+
+// Parse calls model.Parse.
+func Parse(s string) (Season, error) {
+	return model.Parse(s)
+}
+
+// SeasonOf calls model.SeasonOf.
+func SeasonOf(date GameDate) Season {
+	return model.SeasonOf(date)
+}
+
+// CurrentSeason calls model.CurrentSeason.
+func CurrentSeason() Season {
+	return model.CurrentSeason()
+}
+
+// SeasonsBetween calls model.SeasonsBetween.
+func SeasonsBetween(a, b Season) []Season {
+	return model.SeasonsBetween(a, b)
+}
+
+// Current calls model.Current.
+//
+// Deprecated: Use CurrentSeason, which keeps the season that just ended
+// current through the summer, as the API does.
+func Current() Season {
+	return model.Current()
+}
+
+// SeasonFromInt calls model.SeasonFromInt.
+func SeasonFromInt(i int) (Season, error) {
+	return model.SeasonFromInt(i)
+}
+
+// SeasonFromInt64 calls model.SeasonFromInt64.
+func SeasonFromInt64(i int64) (Season, error) {
+	return model.SeasonFromInt64(i)
+}
+
+// DraftEligible calls model.DraftEligible.
+func DraftEligible(birthDate string, draftYear int) bool {
+	return model.DraftEligible(birthDate, draftYear)
+}
+
+// FirstDraftYear calls model.FirstDraftYear.
+func FirstDraftYear(birthDate string) (int, bool) {
+	return model.FirstDraftYear(birthDate)
+}
+
+// PositionFromString calls model.PositionFromString.
+func PositionFromString(s string) (Position, error) {
+	return model.PositionFromString(s)
+}
+
+// MustPositionFromString calls model.MustPositionFromString.
+func MustPositionFromString(s string) Position {
+	return model.MustPositionFromString(s)
+}
+
+// HandednessFromString calls model.HandednessFromString.
+func HandednessFromString(s string) (Handedness, error) {
+	return model.HandednessFromString(s)
+}
+
+// MustHandednessFromString calls model.MustHandednessFromString.
+func MustHandednessFromString(s string) Handedness {
+	return model.MustHandednessFromString(s)
+}
+
+// GoalieDecisionFromString calls model.GoalieDecisionFromString.
+func GoalieDecisionFromString(s string) (GoalieDecision, error) {
+	return model.GoalieDecisionFromString(s)
+}
+
+// MustGoalieDecisionFromString calls model.MustGoalieDecisionFromString.
+func MustGoalieDecisionFromString(s string) GoalieDecision {
+	return model.MustGoalieDecisionFromString(s)
+}
+
+// PeriodTypeFromString calls model.PeriodTypeFromString.
+func PeriodTypeFromString(s string) (PeriodType, error) {
+	return model.PeriodTypeFromString(s)
+}
+
+// MustPeriodTypeFromString calls model.MustPeriodTypeFromString.
+func MustPeriodTypeFromString(s string) PeriodType {
+	return model.MustPeriodTypeFromString(s)
+}
+
+// HomeRoadFromString calls model.HomeRoadFromString.
+func HomeRoadFromString(s string) (HomeRoad, error) {
+	return model.HomeRoadFromString(s)
+}
+
+// MustHomeRoadFromString calls model.MustHomeRoadFromString.
+func MustHomeRoadFromString(s string) HomeRoad {
+	return model.MustHomeRoadFromString(s)
+}
+
+// ZoneCodeFromString calls model.ZoneCodeFromString.
+func ZoneCodeFromString(s string) (ZoneCode, error) {
+	return model.ZoneCodeFromString(s)
+}
+
+// MustZoneCodeFromString calls model.MustZoneCodeFromString.
+func MustZoneCodeFromString(s string) ZoneCode {
+	return model.MustZoneCodeFromString(s)
+}
+
+// DefendingSideFromString calls model.DefendingSideFromString.
+func DefendingSideFromString(s string) (DefendingSide, error) {
+	return model.DefendingSideFromString(s)
+}
+
+// MustDefendingSideFromString calls model.MustDefendingSideFromString.
+func MustDefendingSideFromString(s string) DefendingSide {
+	return model.MustDefendingSideFromString(s)
+}
+
+// GameScheduleStateFromString calls model.GameScheduleStateFromString.
+func GameScheduleStateFromString(s string) (GameScheduleState, error) {
+	return model.GameScheduleStateFromString(s)
+}
+
+// MustGameScheduleStateFromString calls model.MustGameScheduleStateFromString.
+func MustGameScheduleStateFromString(s string) GameScheduleState {
+	return model.MustGameScheduleStateFromString(s)
+}
+
+// PlayEventTypeFromString calls model.PlayEventTypeFromString.
+func PlayEventTypeFromString(s string) (PlayEventType, error) {
+	return model.PlayEventTypeFromString(s)
+}
+
+// MustPlayEventTypeFromString calls model.MustPlayEventTypeFromString.
+func MustPlayEventTypeFromString(s string) PlayEventType {
+	return model.MustPlayEventTypeFromString(s)
+}
+
+// GameStateFromString calls model.GameStateFromString.
+func GameStateFromString(s string) (GameState, error) {
+	return model.GameStateFromString(s)
+}
+
+// MustGameStateFromString calls model.MustGameStateFromString.
+func MustGameStateFromString(s string) GameState {
+	return model.MustGameStateFromString(s)
+}
+
+// LanguageFromString calls model.LanguageFromString.
+func LanguageFromString(s string) (Language, error) {
+	return model.LanguageFromString(s)
+}
+
+// MustLanguageFromString calls model.MustLanguageFromString.
+func MustLanguageFromString(s string) Language {
+	return model.MustLanguageFromString(s)
+}
+
+// FixtureBoxscore calls model.FixtureBoxscore.
+func FixtureBoxscore() *Boxscore {
+	return model.FixtureBoxscore()
+}
+
+// FixturePlayByPlay calls model.FixturePlayByPlay.
+func FixturePlayByPlay() *PlayByPlay {
+	return model.FixturePlayByPlay()
+}
+
+// FixtureGameStory calls model.FixtureGameStory.
+func FixtureGameStory() *GameStory {
+	return model.FixtureGameStory()
+}
+
+// FixtureShiftChart calls model.FixtureShiftChart.
+func FixtureShiftChart() *ShiftChart {
+	return model.FixtureShiftChart()
+}
+
+// FixtureSeasonSeriesMatchup calls model.FixtureSeasonSeriesMatchup.
+func FixtureSeasonSeriesMatchup() *SeasonSeriesMatchup {
+	return model.FixtureSeasonSeriesMatchup()
+}
+
+// GameSituationFromCode calls model.GameSituationFromCode.
+func GameSituationFromCode(code string) *GameSituation {
+	return model.GameSituationFromCode(code)
+}
+
+// GameIDFromParts calls model.GameIDFromParts.
+func GameIDFromParts(season Season, gameType GameType, gameNumber int) (GameID, error) {
+	return model.GameIDFromParts(season, gameType, gameNumber)
+}
+
+// GameIDsForSeason calls model.GameIDsForSeason.
+func GameIDsForSeason(season Season, gameType GameType) ([]GameID, error) {
+	return model.GameIDsForSeason(season, gameType)
+}
+
+// SortGameLogs calls model.SortGameLogs.
+func SortGameLogs(logs []GameLog) []GameLog {
+	return model.SortGameLogs(logs)
+}
+
+// LastGames calls model.LastGames.
+func LastGames(logs []GameLog, n int) []GameLog {
+	return model.LastGames(logs, n)
+}
+
+// SumGameLogs calls model.SumGameLogs.
+func SumGameLogs(logs []GameLog) PlayerStats {
+	return model.SumGameLogs(logs)
+}
+
+// PerGameAverages calls model.PerGameAverages.
+func PerGameAverages(logs []GameLog) GameLogAverages {
+	return model.PerGameAverages(logs)
+}
+
+// RollingWindow calls model.RollingWindow.
+func RollingWindow(logs []GameLog, n int) []PlayerStats {
+	return model.RollingWindow(logs, n)
+}
+
+// GameTypeFromInt calls model.GameTypeFromInt.
+func GameTypeFromInt(i int) (GameType, error) {
+	return model.GameTypeFromInt(i)
+}
+
+// MustGameTypeFromInt calls model.MustGameTypeFromInt.
+func MustGameTypeFromInt(i int) GameType {
+	return model.MustGameTypeFromInt(i)
+}
+
+// GameTypeFromString calls model.GameTypeFromString.
+func GameTypeFromString(s string) (GameType, error) {
+	return model.GameTypeFromString(s)
+}
+
+// MustGameTypeFromString calls model.MustGameTypeFromString.
+func MustGameTypeFromString(s string) GameType {
+	return model.MustGameTypeFromString(s)
+}
+
+// NewGameID calls model.NewGameID.
+func NewGameID(id int64) GameID {
+	return model.NewGameID(id)
+}
+
+// GameIDFromInt calls model.GameIDFromInt.
+func GameIDFromInt(i int) GameID {
+	return model.GameIDFromInt(i)
+}
+
+// GameIDFromString calls model.GameIDFromString.
+func GameIDFromString(s string) (GameID, error) {
+	return model.GameIDFromString(s)
+}
+
+// MustGameIDFromString calls model.MustGameIDFromString.
+func MustGameIDFromString(s string) GameID {
+	return model.MustGameIDFromString(s)
+}
+
+// NewPlayerID calls model.NewPlayerID.
+func NewPlayerID(id int64) PlayerID {
+	return model.NewPlayerID(id)
+}
+
+// PlayerIDFromInt calls model.PlayerIDFromInt.
+func PlayerIDFromInt(i int) PlayerID {
+	return model.PlayerIDFromInt(i)
+}
+
+// PlayerIDFromString calls model.PlayerIDFromString.
+func PlayerIDFromString(s string) (PlayerID, error) {
+	return model.PlayerIDFromString(s)
+}
+
+// MustPlayerIDFromString calls model.MustPlayerIDFromString.
+func MustPlayerIDFromString(s string) PlayerID {
+	return model.MustPlayerIDFromString(s)
+}
+
+// NewTeamID calls model.NewTeamID.
+func NewTeamID(id int64) TeamID {
+	return model.NewTeamID(id)
+}
+
+// TeamIDFromInt calls model.TeamIDFromInt.
+func TeamIDFromInt(i int) TeamID {
+	return model.TeamIDFromInt(i)
+}
+
+// TeamIDFromString calls model.TeamIDFromString.
+func TeamIDFromString(s string) (TeamID, error) {
+	return model.TeamIDFromString(s)
+}
+
+// MustTeamIDFromString calls model.MustTeamIDFromString.
+func MustTeamIDFromString(s string) TeamID {
+	return model.MustTeamIDFromString(s)
+}
+
+// SeasonDatesFromSchedule calls model.SeasonDatesFromSchedule.
+func SeasonDatesFromSchedule(season Season, games []ScheduleGame) *SeasonDates {
+	return model.SeasonDatesFromSchedule(season, games)
+}
+
+// LinescoreFromLanding calls model.LinescoreFromLanding.
+func LinescoreFromLanding(m *GameMatchup) *Linescore {
+	return model.LinescoreFromLanding(m)
+}
+
+// MatchPlayerName calls model.MatchPlayerName.
+func MatchPlayerName(query string, candidates []PlayerSearchResult) []PlayerMatch {
+	return model.MatchPlayerName(query, candidates)
+}
+
+// DiffSchedules calls model.DiffSchedules.
+func DiffSchedules(previous, current []ScheduleGame) []ScheduleChange {
+	return model.DiffSchedules(previous, current)
+}
+
+// DiffScores calls model.DiffScores.
+func DiffScores(previous, current *DailyScores) []ScoreUpdate {
+	return model.DiffScores(previous, current)
+}
+
+// SeasonPhase calls model.SeasonPhase.
+func SeasonPhase(date GameDate, seasonDates *SeasonDates) Phase {
+	return model.SeasonPhase(date, seasonDates)
+}
+
+// ParseShotsFraction calls model.ParseShotsFraction.
+func ParseShotsFraction(s string) (ShotsFraction, error) {
+	return model.ParseShotsFraction(s)
+}
+
+// TeamAbbrevFromString calls model.TeamAbbrevFromString.
+func TeamAbbrevFromString(s string) (TeamAbbrev, error) {
+	return model.TeamAbbrevFromString(s)
+}
+
+// MustTeamAbbrevFromString calls model.MustTeamAbbrevFromString.
+func MustTeamAbbrevFromString(s string) TeamAbbrev {
+	return model.MustTeamAbbrevFromString(s)
+}
+
+// NormalizeTeam calls model.NormalizeTeam.
+func NormalizeTeam(input string) (TeamAbbrev, error) {
+	return model.NormalizeTeam(input)
+}
+
+// NewTeamRegistry calls model.NewTeamRegistry.
+func NewTeamRegistry(teams []TeamInfo) *TeamRegistry {
+	return model.NewTeamRegistry(teams)
+}
+
+// TeamByID calls model.TeamByID.
+func TeamByID(id TeamID) (TeamInfo, bool) {
+	return model.TeamByID(id)
+}
+
+// TeamByAbbrev calls model.TeamByAbbrev.
+func TeamByAbbrev(abbrev TeamAbbrev) (TeamInfo, bool) {
+	return model.TeamByAbbrev(abbrev)
+}
+
+// FranchiseForTeam calls model.FranchiseForTeam.
+func FranchiseForTeam(id TeamID) (Franchise, bool) {
+	return model.FranchiseForTeam(id)
+}
+
+// ParseTOI calls model.ParseTOI.
+func ParseTOI(s string) (TimeOnIce, error) {
+	return model.ParseTOI(s)
+}
+
+// TOIFromDuration calls model.TOIFromDuration.
+func TOIFromDuration(d time.Duration) TimeOnIce {
+	return model.TOIFromDuration(d)
+}
+
+// VideoURL calls model.VideoURL.
+func VideoURL(id int64) string {
+	return model.VideoURL(id)
+}
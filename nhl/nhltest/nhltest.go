@@ -0,0 +1,93 @@
+// Package nhltest provides a small static NHL dataset for offline tests.
+//
+// StaticClient returns a client that answers from data embedded in this
+// package instead of the network: one completed game (boxscore,
+// play-by-play and shift chart), the schedule week that contains it, and
+// the league standings on that day. Every response has the shape of the
+// real API and decodes into the library's models, but the numbers are
+// representative rather than an official record.
+//
+//	client := nhltest.StaticClient()
+//	box, err := client.Boxscore(ctx, nhltest.GameID)
+//
+// Requests for anything outside the dataset fail with a not-found error.
+//
+// RandomSchedule and RandomPBP generate larger synthetic data from a seed,
+// for load and fuzz testing code that consumes the library's models.
+package nhltest
+
+import (
+	"embed"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/sperano/nhl-api-go/nhl"
+)
+
+// GameID is the game in the dataset, Toronto at Montréal.
+const GameID nhl.GameID = 2023020650
+
+// Season is the season of the dataset.
+var Season = nhl.NewSeason(2023)
+
+// Date is the day of the game, the first day of the schedule week and the
+// date of the standings snapshot.
+var Date = nhl.FromYMD(2024, 1, 13)
+
+//go:embed data/*.json
+var data embed.FS
+
+// routes maps request paths to embedded files.
+var routes = map[string]string{
+	"/gamecenter/" + GameID.String() + "/boxscore":     "data/boxscore.json",
+	"/gamecenter/" + GameID.String() + "/play-by-play": "data/play-by-play.json",
+	"/en/shiftcharts":                "data/shiftcharts.json",
+	"/schedule/" + Date.APIString():  "data/schedule.json",
+	"/standings/" + Date.APIString(): "data/standings.json",
+}
+
+// Handler serves the dataset under the same paths the client requests when
+// built with nhl.NewClientWithBaseURL. Query parameters are ignored.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, ok := routes[r.URL.Path]
+		if !ok || r.Method != http.MethodGet {
+			http.NotFound(w, r)
+			return
+		}
+		body, err := data.ReadFile(file)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	})
+}
+
+var (
+	serverOnce sync.Once
+	server     *httptest.Server
+)
+
+// StaticClient returns a client backed by the dataset. The clients share
+// one local server, started on first use and kept for the life of the
+// test binary.
+func StaticClient() *nhl.Client {
+	serverOnce.Do(func() {
+		server = httptest.NewServer(Handler())
+	})
+	return nhl.NewClientWithBaseURL(server.URL)
+}
+
+// Fixture returns the raw JSON of one dataset file: "boxscore",
+// "play-by-play", "shiftcharts", "schedule" or "standings". It panics on
+// an unknown name.
+func Fixture(name string) []byte {
+	body, err := data.ReadFile("data/" + name + ".json")
+	if err != nil {
+		panic("nhltest: unknown fixture " + name)
+	}
+	return body
+}
@@ -0,0 +1,112 @@
+package nhltest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl"
+)
+
+func TestStaticClient(t *testing.T) {
+	client := StaticClient()
+	ctx := context.Background()
+
+	box, err := client.Boxscore(ctx, GameID)
+	if err != nil {
+		t.Fatalf("Boxscore() error = %v", err)
+	}
+	if box.AwayTeam.Abbrev != "TOR" || box.HomeTeam.Abbrev != "MTL" || box.HomeTeam.Score != 3 {
+		t.Errorf("boxscore = %s %d @ %s %d", box.AwayTeam.Abbrev, box.AwayTeam.Score, box.HomeTeam.Abbrev, box.HomeTeam.Score)
+	}
+	if box.Linescore == nil || box.Linescore.Totals.Home != box.HomeTeam.Score {
+		t.Errorf("linescore = %+v", box.Linescore)
+	}
+	if _, _, ok := box.OfficialTeamGameStats(); !ok {
+		t.Error("boxscore should carry team game stats")
+	}
+
+	pbp, err := client.PlayByPlay(ctx, GameID)
+	if err != nil {
+		t.Fatalf("PlayByPlay() error = %v", err)
+	}
+	goals := pbp.Goals()
+	if len(goals) != box.AwayTeam.Score+box.HomeTeam.Score {
+		t.Errorf("play-by-play has %d goals, boxscore %d", len(goals), box.AwayTeam.Score+box.HomeTeam.Score)
+	}
+	last := goals[len(goals)-1].Details
+	if *last.AwayScore != box.AwayTeam.Score || *last.HomeScore != box.HomeTeam.Score {
+		t.Errorf("last goal score = %d-%d", *last.AwayScore, *last.HomeScore)
+	}
+	for _, g := range goals {
+		if pbp.GetPlayer(*g.Details.ScoringPlayerID) == nil {
+			t.Errorf("scorer %d missing from roster spots", *g.Details.ScoringPlayerID)
+		}
+	}
+
+	shifts, err := client.ShiftChart(ctx, GameID)
+	if err != nil {
+		t.Fatalf("ShiftChart() error = %v", err)
+	}
+	if len(shifts.Data) == 0 || shifts.Data[0].GameID != GameID {
+		t.Errorf("shift chart = %d shifts", len(shifts.Data))
+	}
+
+	day, err := client.DailySchedule(ctx, Date)
+	if err != nil {
+		t.Fatalf("DailySchedule() error = %v", err)
+	}
+	found := false
+	for _, g := range day.Games {
+		found = found || g.ID == GameID
+	}
+	if !found {
+		t.Errorf("schedule for %s does not include %s", Date, GameID)
+	}
+
+	standings, err := client.LeagueStandingsForDate(ctx, Date)
+	if err != nil {
+		t.Fatalf("LeagueStandingsForDate() error = %v", err)
+	}
+	if len(standings) != 32 {
+		t.Fatalf("standings has %d teams, want 32", len(standings))
+	}
+	for _, conf := range []string{"E", "W"} {
+		picture := nhl.Standings(standings).PlayoffPicture(conf)
+		if len(picture.DivisionLeaders) != 2 || len(picture.WildCards) != 2 {
+			t.Errorf("%s playoff picture = %+v", conf, picture)
+		}
+	}
+}
+
+func TestStaticClient_NotFound(t *testing.T) {
+	_, err := StaticClient().Boxscore(context.Background(), nhl.GameID(2023020001))
+	if !errors.Is(err, nhl.ErrNotFound) {
+		t.Errorf("Boxscore(unknown) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/standings/"+Date.APIString(), nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("POST status = %d, want 404", rec.Code)
+	}
+}
+
+func TestFixture(t *testing.T) {
+	for _, name := range []string{"boxscore", "play-by-play", "shiftcharts", "schedule", "standings"} {
+		if !json.Valid(Fixture(name)) {
+			t.Errorf("fixture %s is not valid JSON", name)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Fixture(unknown) should panic")
+		}
+	}()
+	Fixture("landing")
+}
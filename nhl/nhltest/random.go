@@ -0,0 +1,354 @@
+package nhltest
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/sperano/nhl-api-go/nhl"
+)
+
+// randomTeams are the teams the generators draw from, with their API IDs.
+var randomTeams = []struct {
+	abbrev nhl.TeamAbbrev
+	id     nhl.TeamID
+}{
+	{nhl.TeamANA, 24}, {nhl.TeamBOS, 6}, {nhl.TeamBUF, 7}, {nhl.TeamCGY, 20},
+	{nhl.TeamCAR, 12}, {nhl.TeamCHI, 16}, {nhl.TeamCOL, 21}, {nhl.TeamCBJ, 29},
+	{nhl.TeamDAL, 25}, {nhl.TeamDET, 17}, {nhl.TeamEDM, 22}, {nhl.TeamFLA, 13},
+	{nhl.TeamLAK, 26}, {nhl.TeamMIN, 30}, {nhl.TeamMTL, 8}, {nhl.TeamNSH, 18},
+	{nhl.TeamNJD, 1}, {nhl.TeamNYI, 2}, {nhl.TeamNYR, 3}, {nhl.TeamOTT, 9},
+	{nhl.TeamPHI, 4}, {nhl.TeamPIT, 5}, {nhl.TeamSJS, 28}, {nhl.TeamSEA, 55},
+	{nhl.TeamSTL, 19}, {nhl.TeamTBL, 14}, {nhl.TeamTOR, 10}, {nhl.TeamUTA, 59},
+	{nhl.TeamVAN, 23}, {nhl.TeamVGK, 54}, {nhl.TeamWSH, 15}, {nhl.TeamWPG, 52},
+}
+
+// randomStartTimes are common puck-drop times, in UTC on the day after the
+// game date for evening games in North America.
+var randomStartTimes = []string{"T00:00:00Z", "T00:30:00Z", "T01:00:00Z", "T02:00:00Z", "T03:00:00Z"}
+
+// newRand returns a generator whose output depends only on seed.
+func newRand(seed int64) *rand.Rand {
+	return rand.New(rand.NewPCG(uint64(seed), 0x6e686c))
+}
+
+func logo(abbrev nhl.TeamAbbrev) string {
+	return "https://assets.nhle.com/logos/nhl/svg/" + string(abbrev) + "_light.svg"
+}
+
+// RandomSchedule returns days of completed regular-season games starting
+// on Date, for load and fuzz testing. Each team plays at most once a day,
+// so gamesPerDay is capped at 16. Game IDs are numbered from 1 in Season;
+// scores never tie. The same seed always yields the same schedule.
+//
+// RandomSchedule panics if the schedule would need more than 9999 game
+// numbers.
+func RandomSchedule(seed int64, days, gamesPerDay int) []nhl.GameDay {
+	rng := newRand(seed)
+	gamesPerDay = min(max(gamesPerDay, 0), len(randomTeams)/2)
+	days = max(days, 0)
+	if days*gamesPerDay > 9999 {
+		panic(fmt.Sprintf("nhltest: %d days of %d games exceed the 9999 game numbers of a season", days, gamesPerDay))
+	}
+
+	schedule := make([]nhl.GameDay, 0, days)
+	number := 0
+	for d := range days {
+		date := Date.AddDays(d).APIString()
+		start := Date.AddDays(d + 1).APIString()
+		day := nhl.GameDay{Date: date, Games: make([]nhl.ScheduleGame, 0, gamesPerDay)}
+		order := rng.Perm(len(randomTeams))
+		for g := range gamesPerDay {
+			number++
+			away, home := randomTeams[order[2*g]], randomTeams[order[2*g+1]]
+			awayScore, homeScore := rng.IntN(7), rng.IntN(7)
+			if awayScore == homeScore {
+				// Decided in overtime or the shootout.
+				if rng.IntN(2) == 0 {
+					awayScore++
+				} else {
+					homeScore++
+				}
+			}
+			gameDate := date
+			day.Games = append(day.Games, nhl.ScheduleGame{
+				ID:           nhl.GameID(Season.StartYear()*1000000 + 20000 + number),
+				GameType:     nhl.GameTypeRegularSeason,
+				GameDate:     &gameDate,
+				StartTimeUTC: start + randomStartTimes[rng.IntN(len(randomStartTimes))],
+				AwayTeam:     nhl.ScheduleTeam{ID: away.id, Abbrev: string(away.abbrev), Logo: logo(away.abbrev), Score: &awayScore},
+				HomeTeam:     nhl.ScheduleTeam{ID: home.id, Abbrev: string(home.abbrev), Logo: logo(home.abbrev), Score: &homeScore},
+				GameState:    nhl.GameStateOff,
+			})
+		}
+		schedule = append(schedule, day)
+	}
+	return schedule
+}
+
+// randomPlayTypes weights the play types RandomPBP draws, roughly in the
+// proportions of a real game.
+var randomPlayTypes = []struct {
+	kind   nhl.PlayEventType
+	code   int
+	weight int
+}{
+	{nhl.PlayEventTypeFaceoff, 502, 20},
+	{nhl.PlayEventTypeHit, 503, 18},
+	{nhl.PlayEventTypeGiveaway, 504, 6},
+	{nhl.PlayEventTypeGoal, 505, 2},
+	{nhl.PlayEventTypeShotOnGoal, 506, 20},
+	{nhl.PlayEventTypeMissedShot, 507, 10},
+	{nhl.PlayEventTypeBlockedShot, 508, 12},
+	{nhl.PlayEventTypePenalty, 509, 3},
+	{nhl.PlayEventTypeStoppage, 516, 6},
+	{nhl.PlayEventTypeTakeaway, 525, 3},
+}
+
+var (
+	randomFirstNames = []string{"Alex", "Connor", "Mikko", "Nick", "Elias", "Jack", "Mathew", "Sidney", "Quinn", "Juraj"}
+	randomLastNames  = []string{"Tremblay", "Larsson", "Novak", "Smith", "Virtanen", "Kowalski", "Roy", "Hughes", "Lindholm", "Slafkovsky"}
+	randomShotTypes  = []string{"wrist", "snap", "slap", "backhand", "tip-in", "deflected"}
+	randomPenalties  = []string{"tripping", "hooking", "slashing", "holding", "interference", "roughing"}
+)
+
+// pbpTeam is one side of a generated game.
+type pbpTeam struct {
+	id      nhl.TeamID
+	skaters []nhl.PlayerID
+	goalie  nhl.PlayerID
+	score   int
+	sog     int
+	// boxUntil is the elapsed game second each current penalty ends at.
+	boxUntil []int
+}
+
+// RandomPBP returns the play-by-play of a completed regular-season game,
+// GameID on Date, made of events plays spread evenly over three periods,
+// for load and fuzz testing. Plays carry realistic details: players from
+// a generated roster, coordinates, running scores and shots on goal, and
+// situation codes that follow two-minute penalties. A game tied after
+// regulation gets one more play, an overtime goal. With events of 0 or
+// less the game is scheduled and has no plays. The same seed always yields
+// the same game.
+func RandomPBP(seed int64, events int) *nhl.PlayByPlay {
+	rng := newRand(seed)
+	order := rng.Perm(len(randomTeams))
+	awayInfo, homeInfo := randomTeams[order[0]], randomTeams[order[1]]
+	pbp := &nhl.PlayByPlay{
+		ID:                GameID,
+		Season:            Season,
+		GameType:          nhl.GameTypeRegularSeason,
+		GameDate:          Date.APIString(),
+		StartTimeUTC:      Date.AddDays(1).APIString() + "T00:00:00Z",
+		GameState:         nhl.GameStateFuture,
+		GameScheduleState: nhl.GameScheduleStateOK,
+		AwayTeam:          nhl.BoxscoreTeam{ID: awayInfo.id, Abbrev: string(awayInfo.abbrev), Logo: logo(awayInfo.abbrev)},
+		HomeTeam:          nhl.BoxscoreTeam{ID: homeInfo.id, Abbrev: string(homeInfo.abbrev), Logo: logo(homeInfo.abbrev)},
+		MaxPeriods:        5,
+		Plays:             []nhl.PlayEvent{},
+	}
+	away := randomRoster(rng, pbp, awayInfo.id, 1)
+	home := randomRoster(rng, pbp, homeInfo.id, 2)
+	if events <= 0 {
+		return pbp
+	}
+
+	totalWeight := 0
+	for _, t := range randomPlayTypes {
+		totalWeight += t.weight
+	}
+	perPeriod := (events + 2) / 3
+	for i := range events {
+		period := i/perPeriod + 1
+		slot := i % perPeriod
+		elapsed := slot * 1200 / perPeriod
+		if slot > 0 {
+			elapsed += rng.IntN(max(1200/perPeriod, 1))
+		}
+		elapsed = min(elapsed, 1199)
+
+		kind, code := nhl.PlayEventTypeFaceoff, 502
+		if slot > 0 {
+			pick := rng.IntN(totalWeight)
+			for _, t := range randomPlayTypes {
+				if pick < t.weight {
+					kind, code = t.kind, t.code
+					break
+				}
+				pick -= t.weight
+			}
+		}
+		play := randomPlay(rng, pbp, away, home, kind, code, period, elapsed, slot == 0)
+		pbp.Plays = append(pbp.Plays, play)
+	}
+
+	last := nhl.PeriodDescriptor{Number: 3, PeriodType: nhl.PeriodTypeRegulation, MaxRegulationPeriods: 3}
+	if away.score == home.score {
+		// Sudden-death overtime.
+		play := randomPlay(rng, pbp, away, home, nhl.PlayEventTypeGoal, 505, 4, 60+rng.IntN(240), false)
+		pbp.Plays = append(pbp.Plays, play)
+		last = nhl.PeriodDescriptor{Number: 4, PeriodType: nhl.PeriodTypeOvertime, MaxRegulationPeriods: 3}
+	}
+	pbp.GameState = nhl.GameStateOff
+	pbp.PeriodDescriptor = last
+	pbp.DisplayPeriod = last.Number
+	pbp.GameOutcome = &nhl.GameOutcome{LastPeriodType: last.PeriodType}
+	pbp.AwayTeam.Score, pbp.AwayTeam.SOG = away.score, away.sog
+	pbp.HomeTeam.Score, pbp.HomeTeam.SOG = home.score, home.sog
+	return pbp
+}
+
+// randomRoster adds a dressed lineup of 18 skaters and 2 goalies for a
+// team to pbp and returns the team's side.
+func randomRoster(rng *rand.Rand, pbp *nhl.PlayByPlay, teamID nhl.TeamID, side int) *pbpTeam {
+	team := &pbpTeam{id: teamID}
+	positions := []nhl.Position{
+		nhl.PositionCenter, nhl.PositionCenter, nhl.PositionCenter, nhl.PositionCenter,
+		nhl.PositionLeftWing, nhl.PositionLeftWing, nhl.PositionLeftWing, nhl.PositionLeftWing,
+		nhl.PositionRightWing, nhl.PositionRightWing, nhl.PositionRightWing, nhl.PositionRightWing,
+		nhl.PositionDefense, nhl.PositionDefense, nhl.PositionDefense,
+		nhl.PositionDefense, nhl.PositionDefense, nhl.PositionDefense,
+		nhl.PositionGoalie, nhl.PositionGoalie,
+	}
+	sweaters := rng.Perm(98)
+	for i, pos := range positions {
+		id := nhl.PlayerID(8470000 + side*1000 + i)
+		pbp.RosterSpots = append(pbp.RosterSpots, nhl.RosterSpot{
+			TeamID:        teamID,
+			PlayerID:      id,
+			FirstName:     nhl.LocalizedString{Default: randomFirstNames[rng.IntN(len(randomFirstNames))]},
+			LastName:      nhl.LocalizedString{Default: randomLastNames[rng.IntN(len(randomLastNames))]},
+			SweaterNumber: sweaters[i] + 1,
+			Position:      pos,
+		})
+		switch {
+		case pos != nhl.PositionGoalie:
+			team.skaters = append(team.skaters, id)
+		case team.goalie == 0:
+			team.goalie = id
+		}
+	}
+	return team
+}
+
+// randomPlay builds one play of type kind at elapsed seconds into period
+// and updates the score, shots and penalties of both sides.
+func randomPlay(rng *rand.Rand, pbp *nhl.PlayByPlay, away, home *pbpTeam, kind nhl.PlayEventType, code, period, elapsed int, centerIce bool) nhl.PlayEvent {
+	clock := (period-1)*1200 + elapsed
+	for _, t := range []*pbpTeam{away, home} {
+		active := t.boxUntil[:0]
+		for _, until := range t.boxUntil {
+			if until > clock {
+				active = append(active, until)
+			}
+		}
+		t.boxUntil = active
+	}
+
+	owner, other := away, home
+	if rng.IntN(2) == 1 {
+		owner, other = home, away
+	}
+	periodType := nhl.PeriodTypeRegulation
+	if period > 3 {
+		periodType = nhl.PeriodTypeOvertime
+	}
+	length := 1200
+	if period > 3 {
+		length = 300
+	}
+	play := nhl.PlayEvent{
+		EventID:               int64(len(pbp.Plays) + 101),
+		PeriodDescriptor:      nhl.PeriodDescriptor{Number: period, PeriodType: periodType, MaxRegulationPeriods: 3},
+		TimeInPeriod:          fmt.Sprintf("%02d:%02d", elapsed/60, elapsed%60),
+		TimeRemaining:         fmt.Sprintf("%02d:%02d", (length-elapsed)/60, (length-elapsed)%60),
+		SituationCode:         situationCode(away, home),
+		HomeTeamDefendingSide: nhl.DefendingSideLeft,
+		TypeCode:              code,
+		TypeDescKey:           kind,
+		SortOrder:             (len(pbp.Plays) + 1) * 10,
+	}
+	if period%2 == 0 {
+		play.HomeTeamDefendingSide = nhl.DefendingSideRight
+	}
+	if kind == nhl.PlayEventTypeStoppage {
+		reason := "icing"
+		play.Details = &nhl.PlayEventDetails{Reason: &reason}
+		return play
+	}
+
+	ownerID := owner.id
+	x, y := rng.IntN(199)-99, rng.IntN(85)-42
+	zone := [...]nhl.ZoneCode{nhl.ZoneCodeOffensive, nhl.ZoneCodeNeutral, nhl.ZoneCodeDefensive}[rng.IntN(3)]
+	if centerIce {
+		x, y, zone = 0, 0, nhl.ZoneCodeNeutral
+	}
+	d := &nhl.PlayEventDetails{EventOwnerTeamID: &ownerID, XCoord: &x, YCoord: &y, ZoneCode: &zone}
+	player := func(t *pbpTeam) *nhl.PlayerID {
+		id := t.skaters[rng.IntN(len(t.skaters))]
+		return &id
+	}
+	shot := func() {
+		zone = nhl.ZoneCodeOffensive
+		goalie := other.goalie
+		shotType := randomShotTypes[rng.IntN(len(randomShotTypes))]
+		d.ShootingPlayerID, d.GoalieInNetID, d.ShotType = player(owner), &goalie, &shotType
+	}
+
+	switch kind {
+	case nhl.PlayEventTypeFaceoff:
+		d.WinningPlayerID, d.LosingPlayerID = player(owner), player(other)
+	case nhl.PlayEventTypeHit:
+		d.HittingPlayerID, d.HitteePlayerID = player(owner), player(other)
+	case nhl.PlayEventTypeGiveaway, nhl.PlayEventTypeTakeaway:
+		d.PlayerID = player(owner)
+	case nhl.PlayEventTypeMissedShot:
+		shot()
+		reason := "wide-of-net"
+		d.Reason = &reason
+	case nhl.PlayEventTypeBlockedShot:
+		shot()
+		d.GoalieInNetID = nil
+		d.BlockingPlayerID = player(other)
+	case nhl.PlayEventTypeShotOnGoal, nhl.PlayEventTypeGoal:
+		shot()
+		owner.sog++
+		awaySOG, homeSOG := away.sog, home.sog
+		d.AwaySOG, d.HomeSOG = &awaySOG, &homeSOG
+		if kind == nhl.PlayEventTypeGoal {
+			owner.score++
+			d.ScoringPlayerID, d.ShootingPlayerID = d.ShootingPlayerID, nil
+			for _, assist := range []**nhl.PlayerID{&d.Assist1PlayerID, &d.Assist2PlayerID} {
+				if rng.IntN(10) < 8 {
+					if a := player(owner); *a != *d.ScoringPlayerID {
+						*assist = a
+					}
+				}
+			}
+			if d.Assist1PlayerID == nil {
+				d.Assist1PlayerID, d.Assist2PlayerID = d.Assist2PlayerID, nil
+			}
+			awayScore, homeScore := away.score, home.score
+			d.AwayScore, d.HomeScore = &awayScore, &homeScore
+			// A power-play goal ends the shortest penalty against the scorer.
+			if len(other.boxUntil) > 0 && len(other.boxUntil) > len(owner.boxUntil) {
+				other.boxUntil = other.boxUntil[1:]
+			}
+		}
+	case nhl.PlayEventTypePenalty:
+		// The penalized team is the play's owner, as in the API.
+		typeCode, desc, minutes := "MIN", randomPenalties[rng.IntN(len(randomPenalties))], 2
+		d.CommittedByPlayerID, d.DrawnByPlayerID = player(owner), player(other)
+		d.TypeCode, d.DescKey, d.Duration = &typeCode, &desc, &minutes
+		owner.boxUntil = append(owner.boxUntil, clock+minutes*60)
+	}
+	play.Details = d
+	return play
+}
+
+// situationCode encodes the goalies and skaters on the ice as the API
+// does: away goalie, away skaters, home skaters, home goalie.
+func situationCode(away, home *pbpTeam) string {
+	skaters := func(t *pbpTeam) int { return max(5-len(t.boxUntil), 3) }
+	return fmt.Sprintf("1%d%d1", skaters(away), skaters(home))
+}
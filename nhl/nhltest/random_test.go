@@ -0,0 +1,117 @@
+package nhltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl"
+)
+
+func TestRandomSchedule(t *testing.T) {
+	days := RandomSchedule(42, 5, 20)
+	if !reflect.DeepEqual(days, RandomSchedule(42, 5, 20)) {
+		t.Error("RandomSchedule() differs for the same seed")
+	}
+	if reflect.DeepEqual(days, RandomSchedule(43, 5, 20)) {
+		t.Error("RandomSchedule() is the same for different seeds")
+	}
+	if len(days) != 5 {
+		t.Fatalf("len(days) = %d, want 5", len(days))
+	}
+	ids := make(map[nhl.GameID]bool)
+	for i, day := range days {
+		if want := Date.AddDays(i).APIString(); day.Date != want {
+			t.Errorf("day %d date = %s, want %s", i, day.Date, want)
+		}
+		if len(day.Games) != 16 {
+			t.Errorf("day %s has %d games, want 16", day.Date, len(day.Games))
+		}
+		playing := make(map[string]bool)
+		for _, g := range day.Games {
+			season, _ := g.ID.Season()
+			if ids[g.ID] || !g.ID.IsValid() || season != Season {
+				t.Errorf("game ID %d is repeated or invalid", g.ID)
+			}
+			ids[g.ID] = true
+			for _, team := range []nhl.ScheduleTeam{g.AwayTeam, g.HomeTeam} {
+				if playing[team.Abbrev] {
+					t.Errorf("%s plays twice on %s", team.Abbrev, day.Date)
+				}
+				playing[team.Abbrev] = true
+			}
+			if *g.AwayTeam.Score == *g.HomeTeam.Score {
+				t.Errorf("game %d ends tied", g.ID)
+			}
+		}
+	}
+	if len(RandomSchedule(1, -1, 3)) != 0 || len(RandomSchedule(1, 2, -1)[0].Games) != 0 {
+		t.Error("RandomSchedule() with negative sizes should have no games")
+	}
+}
+
+func TestRandomPBP(t *testing.T) {
+	pbp := RandomPBP(7, 300)
+	if !reflect.DeepEqual(pbp, RandomPBP(7, 300)) {
+		t.Error("RandomPBP() differs for the same seed")
+	}
+	if len(pbp.Plays) < 300 || len(pbp.RosterSpots) != 40 {
+		t.Fatalf("got %d plays and %d roster spots", len(pbp.Plays), len(pbp.RosterSpots))
+	}
+
+	away, home := 0, 0
+	last := -1
+	for i, p := range pbp.Plays {
+		if i > 0 && p.SortOrder <= pbp.Plays[i-1].SortOrder {
+			t.Errorf("play %d is out of order", p.EventID)
+		}
+		elapsed := (p.PeriodDescriptor.Number-1)*1200 + seconds(t, p.TimeInPeriod)
+		if elapsed < last {
+			t.Errorf("play %d at %s of period %d goes back in time", p.EventID, p.TimeInPeriod, p.PeriodDescriptor.Number)
+		}
+		last = elapsed
+		if p.TypeDescKey != nhl.PlayEventTypeGoal {
+			continue
+		}
+		if *p.Details.EventOwnerTeamID == pbp.AwayTeam.ID {
+			away++
+		} else {
+			home++
+		}
+		if *p.Details.AwayScore != away || *p.Details.HomeScore != home {
+			t.Errorf("goal %d score = %d-%d, want %d-%d", p.EventID, *p.Details.AwayScore, *p.Details.HomeScore, away, home)
+		}
+	}
+	if pbp.AwayTeam.Score != away || pbp.HomeTeam.Score != home || away == home {
+		t.Errorf("final score = %d-%d, goals %d-%d", pbp.AwayTeam.Score, pbp.HomeTeam.Score, away, home)
+	}
+	if !pbp.GameState.IsFinal() || pbp.GameOutcome == nil {
+		t.Errorf("game state = %v, outcome = %v", pbp.GameState, pbp.GameOutcome)
+	}
+
+	data, err := json.Marshal(pbp)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var decoded nhl.PlayByPlay
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if len(decoded.Plays) != len(pbp.Plays) || decoded.HomeTeam.Score != home {
+		t.Error("play-by-play does not survive a JSON round trip")
+	}
+
+	if empty := RandomPBP(7, 0); len(empty.Plays) != 0 || empty.GameState != nhl.GameStateFuture {
+		t.Errorf("RandomPBP(0) = %d plays, state %v", len(empty.Plays), empty.GameState)
+	}
+}
+
+func seconds(t *testing.T, clock string) int {
+	t.Helper()
+	var m, s int
+	if _, err := fmt.Sscanf(clock, "%d:%d", &m, &s); err != nil {
+		t.Fatalf("bad clock %q", clock)
+	}
+	return m*60 + s
+}
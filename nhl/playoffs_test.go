@@ -0,0 +1,128 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+const bracketJSON = `{
+	"bracketLogo": "https://assets.nhle.com/logos/playoffs/png/scp-20232024-horizontal-banner-en.png",
+	"bracketLogoFr": "https://assets.nhle.com/logos/playoffs/png/scp-20232024-horizontal-banner-fr.png",
+	"series": [
+		{
+			"seriesUrl": "/schedule/playoff-series/2024/series-o/stanleycupfinal",
+			"seriesTitle": "Stanley Cup Final",
+			"seriesAbbrev": "SCF",
+			"seriesLetter": "O",
+			"playoffRound": 4,
+			"topSeedRank": 1,
+			"topSeedRankAbbrev": "D1",
+			"topSeedWins": 4,
+			"bottomSeedRank": 1,
+			"bottomSeedRankAbbrev": "D1",
+			"bottomSeedWins": 3,
+			"winningTeamId": 13,
+			"losingTeamId": 22,
+			"topSeedTeam": {"id": 13, "abbrev": "FLA", "name": {"default": "Florida Panthers"}, "commonName": {"default": "Panthers"}},
+			"bottomSeedTeam": {"id": 22, "abbrev": "EDM", "name": {"default": "Edmonton Oilers"}, "commonName": {"default": "Oilers"}},
+			"seriesLogo": "https://assets.nhle.com/logos/playoffs/png/scf-20232024.png"
+		},
+		{
+			"seriesUrl": "/schedule/playoff-series/2024/series-b/tampabaylightning-vs-floridapanthers",
+			"seriesTitle": "1st Round",
+			"seriesAbbrev": "R1",
+			"seriesLetter": "B",
+			"playoffRound": 1,
+			"topSeedRank": 2,
+			"topSeedWins": 2,
+			"bottomSeedRank": 3,
+			"bottomSeedWins": 1,
+			"conferenceAbbrev": "E",
+			"conferenceName": "Eastern"
+		},
+		{
+			"seriesTitle": "1st Round",
+			"seriesAbbrev": "R1",
+			"seriesLetter": "A",
+			"playoffRound": 1,
+			"topSeedWins": 4,
+			"bottomSeedWins": 1,
+			"winningTeamId": 13,
+			"losingTeamId": 14,
+			"topSeedTeam": {"id": 13, "abbrev": "FLA"},
+			"bottomSeedTeam": {"id": 14, "abbrev": "TBL"},
+			"conferenceAbbrev": "E",
+			"conferenceName": "Eastern"
+		}
+	]
+}`
+
+const playoffSeriesJSON = `{
+	"round": 1,
+	"roundAbbrev": "R1",
+	"roundLabel": "1st-round",
+	"seriesLetter": "A",
+	"neededToWin": 4,
+	"length": 7,
+	"topSeedTeam": {"id": 13, "name": {"default": "Florida Panthers"}, "abbrev": "FLA",
+		"conference": {"abbrev": "E", "name": "Eastern"}, "record": "52-24-6", "seriesWins": 4, "divisionAbbrev": "A", "seed": 1},
+	"bottomSeedTeam": {"id": 14, "name": {"default": "Tampa Bay Lightning"}, "abbrev": "TBL",
+		"record": "45-29-8", "seriesWins": 1, "seed": 4},
+	"games": [
+		{"id": 2023030111, "season": 20232024, "gameType": 3, "gameNumber": 1, "ifNecessary": false,
+		 "venue": {"default": "Amerant Bank Arena"}, "startTimeUTC": "2024-04-21T16:30:00Z",
+		 "gameState": "OFF", "gameScheduleState": "OK",
+		 "awayTeam": {"id": 14, "abbrev": "TBL", "score": 2}, "homeTeam": {"id": 13, "abbrev": "FLA", "score": 3},
+		 "periodDescriptor": {"number": 3, "periodType": "REG", "maxRegulationPeriods": 3},
+		 "seriesStatus": {"round": 1, "seriesAbbrev": "R1", "seriesLetter": "A", "neededToWin": 4,
+			"topSeedTeamAbbrev": "FLA", "topSeedWins": 1, "bottomSeedTeamAbbrev": "TBL", "bottomSeedWins": 0, "gameNumberOfSeries": 1},
+		 "gameOutcome": {"lastPeriodType": "REG"}},
+		{"id": 2023030116, "season": 20232024, "gameType": 3, "gameNumber": 6, "ifNecessary": true,
+		 "gameState": "FUT", "gameScheduleState": "OK",
+		 "awayTeam": {"id": 13, "abbrev": "FLA"}, "homeTeam": {"id": 14, "abbrev": "TBL"}}
+	]
+}`
+
+func TestPlayoffEndpoints(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/playoff-bracket/2024":
+			w.Write([]byte(bracketJSON))
+		case "/schedule/playoff-series/20232024/a":
+			w.Write([]byte(playoffSeriesJSON))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	ctx := context.Background()
+
+	bracket, err := client.PlayoffBracket(ctx, NewSeason(2023))
+	if err != nil {
+		t.Fatalf("PlayoffBracket() error = %v", err)
+	}
+	if len(bracket.Series) != 3 {
+		t.Errorf("got %d series, want 3", len(bracket.Series))
+	}
+
+	series, err := client.PlayoffSeries(ctx, NewSeason(2023), "A")
+	if err != nil {
+		t.Fatalf("PlayoffSeries() error = %v", err)
+	}
+	if series.Winner().Abbrev != "FLA" {
+		t.Errorf("Winner() = %s, want FLA", series.Winner().Abbrev)
+	}
+
+	want := []string{"/playoff-bracket/2024", "/schedule/playoff-series/20232024/a"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("requested %v, want %v", paths, want)
+	}
+}
@@ -0,0 +1,77 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const prospectsJSON = `{
+	"forwards": [
+		{"id": 8484958, "firstName": {"default": "Ivan"}, "lastName": {"default": "Demidov"}, "sweaterNumber": 93,
+		 "positionCode": "R", "shootsCatches": "L", "heightInInches": 71, "weightInPounds": 192,
+		 "birthDate": "2006-12-10", "birthCity": {"default": "Sevastopol"}, "birthCountry": "RUS"},
+		{"id": 8483515, "firstName": {"default": "Filip"}, "lastName": {"default": "Mesar"},
+		 "positionCode": "C", "shootsCatches": "L", "birthDate": "2004-01-03", "birthCountry": "SVK"}
+	],
+	"defensemen": [
+		{"id": 8484307, "firstName": {"default": "David"}, "lastName": {"default": "Reinbacher"},
+		 "positionCode": "D", "shootsCatches": "R", "birthDate": "2004-10-25", "birthCountry": "AUT"}
+	],
+	"goalies": [
+		{"id": 8482442, "firstName": {"default": "Jakub"}, "lastName": {"default": "Dobes"}, "sweaterNumber": 75,
+		 "positionCode": "G", "shootsCatches": "L", "birthDate": "2001-05-27", "birthCountry": "CZE"}
+	]
+}`
+
+func TestTeamProspects(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(prospectsJSON))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	prospects, err := client.TeamProspects(context.Background(), "MTL")
+	if err != nil {
+		t.Fatalf("TeamProspects() error = %v", err)
+	}
+	if gotPath != "/prospects/MTL" {
+		t.Errorf("path = %q, want /prospects/MTL", gotPath)
+	}
+	if prospects.PlayerCount() != 4 {
+		t.Fatalf("PlayerCount() = %d, want 4", prospects.PlayerCount())
+	}
+
+	demidov := prospects.Forwards[0]
+	if demidov.FullName() != "Ivan Demidov" || demidov.Position != PositionRightWing {
+		t.Errorf("first forward = %q at %v", demidov.FullName(), demidov.Position)
+	}
+	if demidov.SweaterNumber == nil || *demidov.SweaterNumber != 93 {
+		t.Errorf("SweaterNumber = %v, want 93", demidov.SweaterNumber)
+	}
+	if prospects.Forwards[1].SweaterNumber != nil {
+		t.Error("a prospect without a number should have a nil SweaterNumber")
+	}
+
+	var ids []PlayerID
+	for p := range prospects.All() {
+		ids = append(ids, p.ID)
+	}
+	if len(ids) != 4 || ids[2] != 8484307 || ids[3] != 8482442 {
+		t.Errorf("All() = %v", ids)
+	}
+}
+
+func TestTeamProspects_Error(t *testing.T) {
+	server := httptest.NewServer(makeErrorResponse(http.StatusNotFound))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	if _, err := client.TeamProspects(context.Background(), "XXX"); err == nil {
+		t.Error("TeamProspects() should error on HTTP error")
+	}
+}
@@ -0,0 +1,157 @@
+package nhl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// fieldTree is a set of dotted field paths. A node with keep set keeps its
+// whole value; otherwise only the listed children are kept.
+type fieldTree struct {
+	keep     bool
+	children map[string]*fieldTree
+}
+
+// newFieldTree parses dotted paths such as "awayTeam.score". Empty paths
+// and empty path segments are errors.
+func newFieldTree(fields []string) (*fieldTree, error) {
+	root := &fieldTree{}
+	for _, field := range fields {
+		node := root
+		for _, key := range strings.Split(field, ".") {
+			if key == "" {
+				return nil, fmt.Errorf("invalid field path %q", field)
+			}
+			if node.children == nil {
+				node.children = make(map[string]*fieldTree)
+			}
+			child := node.children[key]
+			if child == nil {
+				child = &fieldTree{}
+				node.children[key] = child
+			}
+			node = child
+		}
+		node.keep = true
+	}
+	return root, nil
+}
+
+// prune returns the parts of v selected by t, and false when nothing is
+// selected. Arrays are traversed element by element, so "plays.eventId"
+// keeps the event ID of every play.
+func (t *fieldTree) prune(v any) (any, bool) {
+	if t.keep {
+		return v, true
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any)
+		for key, child := range t.children {
+			value, ok := v[key]
+			if !ok {
+				continue
+			}
+			if pruned, ok := child.prune(value); ok {
+				out[key] = pruned
+			}
+		}
+		return out, len(out) > 0
+	case []any:
+		out := make([]any, 0, len(v))
+		for _, elem := range v {
+			if pruned, ok := t.prune(elem); ok {
+				out = append(out, pruned)
+			}
+		}
+		return out, true
+	default:
+		return nil, false
+	}
+}
+
+// PruneJSON reduces a JSON document to the given dotted field paths, such
+// as "id" or "awayTeam.score", for relaying payloads to bandwidth-sensitive
+// consumers. A path that names an object or array keeps all of it, and
+// paths through an array apply to each element. Fields missing from the
+// document are ignored, and objects left with no selected field are
+// dropped. Numbers are kept exactly and keys come out sorted. Without
+// fields the document is returned unchanged.
+func PruneJSON(data []byte, fields ...string) ([]byte, error) {
+	if len(fields) == 0 {
+		return data, nil
+	}
+	tree, err := newFieldTree(fields)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("pruning JSON: %w", err)
+	}
+	pruned, ok := tree.prune(doc)
+	if !ok {
+		if _, isObject := doc.(map[string]any); isObject {
+			pruned = map[string]any{}
+		} else {
+			pruned = nil
+		}
+	}
+	return json.Marshal(pruned)
+}
+
+// MarshalFields encodes v as JSON reduced to the given dotted field paths,
+// using the JSON names of v's fields. See PruneJSON.
+func MarshalFields(v any, fields ...string) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return PruneJSON(data, fields...)
+}
+
+// PruneFields returns a middleware that reduces every successful JSON
+// response to the given dotted field paths, see PruneJSON. The client
+// then decodes the pruned payload, so fields left out keep their zero
+// value, and middleware added before this one, such as RecordTo, sees the
+// pruned payload. Error responses and non-JSON bodies pass through
+// unchanged; an invalid path fails every request. See WithConfigFields.
+func PruneFields(fields ...string) Middleware {
+	_, treeErr := newFieldTree(fields)
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if treeErr != nil {
+				return nil, treeErr
+			}
+			resp, err := next(req)
+			if err != nil || resp.StatusCode < 200 || resp.StatusCode > 299 {
+				return resp, err
+			}
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("pruning %s: reading response: %w", req.URL, err)
+			}
+			if pruned, err := PruneJSON(body, fields...); err == nil {
+				body = pruned
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			resp.ContentLength = int64(len(body))
+			return resp, nil
+		}
+	}
+}
+
+// WithConfigFields reduces every successful response to the given dotted
+// field paths, such as "id" or "awayTeam.score", before the client decodes
+// it. Combine with WithConfigRecordTo, added first, to capture pruned
+// payloads for relaying. See PruneFields.
+func WithConfigFields(fields ...string) ConfigOption {
+	return WithConfigMiddleware(PruneFields(fields...))
+}
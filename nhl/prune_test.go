@@ -0,0 +1,112 @@
+package nhl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPruneJSON(t *testing.T) {
+	doc := []byte(`{
+		"id": 2023020001,
+		"gameState": "LIVE",
+		"awayTeam": {"abbrev": "TOR", "score": 2},
+		"homeTeam": {"abbrev": "MTL", "score": 3},
+		"plays": [{"eventId": 1, "typeDescKey": "faceoff"}, {"eventId": 2}, 7],
+		"clock": {"timeRemaining": "05:00"},
+		"venue": {"default": "Centre Bell"}
+	}`)
+	tests := []struct {
+		name   string
+		fields []string
+		want   string
+	}{
+		{"scores", []string{"id", "awayTeam.score", "homeTeam.score"}, `{"awayTeam":{"score":2},"homeTeam":{"score":3},"id":2023020001}`},
+		{"through arrays", []string{"plays.eventId"}, `{"plays":[{"eventId":1},{"eventId":2}]}`},
+		{"whole subtree wins", []string{"clock.timeRemaining", "clock"}, `{"clock":{"timeRemaining":"05:00"}}`},
+		{"missing fields", []string{"awayTeam.logo", "nope"}, `{}`},
+		{"into a scalar", []string{"gameState.code"}, `{}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PruneJSON(doc, tt.fields...)
+			if err != nil {
+				t.Fatalf("PruneJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("PruneJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if got, err := PruneJSON(doc); err != nil || string(got) != string(doc) {
+		t.Errorf("PruneJSON(no fields) = %s, %v", got, err)
+	}
+	if got, _ := PruneJSON([]byte(`{"n":12345678901234567890}`), "n"); string(got) != `{"n":12345678901234567890}` {
+		t.Errorf("PruneJSON() lost precision: %s", got)
+	}
+	if _, err := PruneJSON(doc, "awayTeam..score"); err == nil {
+		t.Error("PruneJSON(empty segment) error = nil")
+	}
+	if _, err := PruneJSON([]byte("{"), "id"); err == nil {
+		t.Error("PruneJSON(invalid JSON) error = nil")
+	}
+
+	got, err := MarshalFields(Team{ID: 8, Tricode: "MTL", TeamPlaceName: LocalizedString{Default: "Montréal"}}, "id", "teamPlaceName.default")
+	if err != nil || string(got) != `{"id":8,"teamPlaceName":{"default":"Montréal"}}` {
+		t.Errorf("MarshalFields() = %s, %v", got, err)
+	}
+}
+
+func TestWithConfigFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "2023020002") {
+			makeErrorResponse(http.StatusNotFound)(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":2023020001,"gameDate":"2023-10-10","awayTeam":{"abbrev":"TOR","score":2},"homeTeam":{"abbrev":"MTL","score":3}}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := NewClientWithConfig(NewClientConfig(
+		WithConfigRecordTo(dir),
+		WithConfigFields("id", "awayTeam.score", "homeTeam.score"),
+	))
+	client.baseURLOverride = server.URL
+
+	box, err := client.Boxscore(context.Background(), 2023020001)
+	if err != nil {
+		t.Fatalf("Boxscore() error = %v", err)
+	}
+	if box.ID != 2023020001 || box.AwayTeam.Score != 2 || box.HomeTeam.Score != 3 {
+		t.Errorf("Boxscore() = %+v", box)
+	}
+	if box.GameDate != "" || box.AwayTeam.Abbrev != "" {
+		t.Errorf("Boxscore() kept pruned fields: date %q, abbrev %q", box.GameDate, box.AwayTeam.Abbrev)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("recordings = %v, want 1", files)
+	}
+	data, _ := os.ReadFile(files[0])
+	if strings.Contains(string(data), "gameDate") {
+		t.Errorf("recording kept pruned fields: %s", data)
+	}
+
+	if _, err := client.Boxscore(context.Background(), 2023020002); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Boxscore(missing) error = %v, want not found", err)
+	}
+
+	bad := NewClientWithConfig(NewClientConfig(WithConfigFields(".")))
+	bad.baseURLOverride = server.URL
+	if _, err := bad.Boxscore(context.Background(), 2023020001); err == nil {
+		t.Error("Boxscore() with an invalid field path error = nil")
+	}
+}
@@ -0,0 +1,148 @@
+package nhl
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// recording is one response captured by RecordTo, stored as indented JSON
+// so fixtures can be read and edited by hand. Body holds the payload as
+// JSON when it is valid JSON; any other payload is kept in Text when it is
+// UTF-8, and in Binary, base64-encoded, when it is not, as for images or
+// compressed data.
+type recording struct {
+	URL    string          `json:"url"`
+	Status int             `json:"status"`
+	Header http.Header     `json:"header,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	Text   string          `json:"text,omitempty"`
+	Binary []byte          `json:"binary,omitempty"`
+}
+
+// recordedHeaders are the response headers worth keeping in a recording.
+var recordedHeaders = []string{"Content-Type", "Retry-After", RequestIDHeader}
+
+// recordingPath returns the file a response for rawURL is stored in: a
+// readable form of the URL's path followed by a hash of the whole URL.
+func recordingPath(dir, rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	name := rawURL
+	if i := strings.Index(name, "://"); i >= 0 {
+		name = name[i+3:]
+	}
+	if i := strings.IndexAny(name, "?#"); i >= 0 {
+		name = name[:i]
+	}
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+	if len(name) > 100 {
+		name = name[:100]
+	}
+	return filepath.Join(dir, name+"-"+hex.EncodeToString(sum[:4])+".json")
+}
+
+// RecordTo returns a middleware that saves every response, successful or
+// not, to dir, keyed by request URL, for ReplayFrom to serve later. A
+// recording is overwritten when its URL is requested again. Requests that
+// fail without a response are not recorded. See WithConfigRecordTo.
+func RecordTo(dir string) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			if err != nil {
+				return nil, err
+			}
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("recording %s: reading response: %w", req.URL, err)
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+
+			rec := recording{URL: req.URL.String(), Status: resp.StatusCode}
+			for _, name := range recordedHeaders {
+				if v := resp.Header.Get(name); v != "" {
+					if rec.Header == nil {
+						rec.Header = make(http.Header)
+					}
+					rec.Header.Set(name, v)
+				}
+			}
+			switch {
+			case json.Valid(body):
+				rec.Body = body
+			case utf8.Valid(body):
+				rec.Text = string(body)
+			default:
+				rec.Binary = body
+			}
+			data, err := json.MarshalIndent(rec, "", "  ")
+			if err != nil {
+				return nil, fmt.Errorf("recording %s: %w", req.URL, err)
+			}
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return nil, fmt.Errorf("recording %s: %w", req.URL, err)
+			}
+			if err := os.WriteFile(recordingPath(dir, rec.URL), append(data, '\n'), 0o644); err != nil {
+				return nil, fmt.Errorf("recording %s: %w", req.URL, err)
+			}
+			return resp, nil
+		}
+	}
+}
+
+// ReplayFrom returns a middleware that answers every request from the
+// recordings RecordTo saved in dir, without touching the network. A request
+// with no recording fails, so a test cannot silently go online. See
+// WithConfigReplayFrom.
+func ReplayFrom(dir string) Middleware {
+	return func(RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			data, err := os.ReadFile(recordingPath(dir, req.URL.String()))
+			if err != nil {
+				return nil, fmt.Errorf("no recording for %s: %w", req.URL, err)
+			}
+			var rec recording
+			if err := json.Unmarshal(data, &rec); err != nil {
+				return nil, fmt.Errorf("reading recording for %s: %w", req.URL, err)
+			}
+			body := []byte(rec.Text)
+			switch {
+			case len(rec.Body) > 0:
+				body = rec.Body
+			case len(rec.Binary) > 0:
+				body = rec.Binary
+			}
+			header := rec.Header
+			if header == nil {
+				header = make(http.Header)
+			}
+			return &http.Response{
+				Status:        fmt.Sprintf("%d %s", rec.Status, http.StatusText(rec.Status)),
+				StatusCode:    rec.Status,
+				Proto:         "HTTP/1.1",
+				ProtoMajor:    1,
+				ProtoMinor:    1,
+				Header:        header,
+				Body:          io.NopCloser(bytes.NewReader(body)),
+				ContentLength: int64(len(body)),
+				Request:       req,
+			}, nil
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package nhl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordReplay(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gamecenter/2023020001/boxscore":
+			makeJSONResponse(http.StatusOK, map[string]any{
+				"id":        2023020001,
+				"gameState": "OFF",
+				"awayTeam":  map[string]any{"abbrev": "TOR", "score": 2},
+				"homeTeam":  map[string]any{"abbrev": "MTL", "score": 3},
+			})(w, r)
+		case "/en/shiftcharts":
+			makeJSONResponse(http.StatusOK, map[string]any{"data": []any{}, "total": 0})(w, r)
+		case "/gamecenter/2023020002/boxscore":
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("<html>not found</html>"))
+		}
+	}))
+
+	recorder := NewClientWithConfig(NewClientConfig(WithConfigRecordTo(dir)))
+	recorder.baseURLOverride = server.URL
+	ctx := context.Background()
+	want, err := recorder.Boxscore(ctx, GameID(2023020001))
+	if err != nil {
+		t.Fatalf("recording Boxscore() error = %v", err)
+	}
+	if _, err := recorder.ShiftChart(ctx, GameID(2023020001)); err != nil {
+		t.Fatalf("recording ShiftChart() error = %v", err)
+	}
+	if _, err := recorder.Boxscore(ctx, GameID(2023020002)); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("recording Boxscore(missing) error = %v", err)
+	}
+	server.Close()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 3 {
+		t.Fatalf("recorded %d files, want 3", len(files))
+	}
+
+	replayer := NewClientWithConfig(NewClientConfig(WithConfigReplayFrom(dir)))
+	replayer.baseURLOverride = server.URL
+	got, err := replayer.Boxscore(ctx, GameID(2023020001))
+	if err != nil {
+		t.Fatalf("replayed Boxscore() error = %v", err)
+	}
+	if got.HomeTeam.Abbrev != want.HomeTeam.Abbrev || got.HomeTeam.Score != 3 || got.GameState != GameStateOff {
+		t.Errorf("replayed boxscore = %+v", got)
+	}
+	if _, err := replayer.ShiftChart(ctx, GameID(2023020001)); err != nil {
+		t.Errorf("replayed ShiftChart() error = %v", err)
+	}
+
+	_, err = replayer.Boxscore(ctx, GameID(2023020002))
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound || string(apiErr.Body) != "<html>not found</html>" {
+		t.Errorf("replayed Boxscore(missing) error = %v", err)
+	}
+
+	_, err = replayer.PlayByPlay(ctx, GameID(2023020001))
+	var transportErr *TransportError
+	if !errors.As(err, &transportErr) || !strings.Contains(err.Error(), "no recording") {
+		t.Errorf("PlayByPlay() without a recording error = %v", err)
+	}
+}
+
+func TestRecordReplayBinary(t *testing.T) {
+	dir := t.TempDir()
+	png := []byte("\x89PNG\r\n\x1a\n\x00\xff\xfe")
+	origin := func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"image/png"}},
+			Body:       io.NopCloser(bytes.NewReader(png)),
+		}, nil
+	}
+	req := httptest.NewRequest(http.MethodGet, "https://assets.nhle.com/mugs/nhl/20232024/TOR/8479318.png", nil)
+	if _, err := RecordTo(dir)(origin)(req); err != nil {
+		t.Fatalf("recording error = %v", err)
+	}
+
+	resp, err := ReplayFrom(dir)(nil)(req)
+	if err != nil {
+		t.Fatalf("replay error = %v", err)
+	}
+	got, _ := io.ReadAll(resp.Body)
+	if !bytes.Equal(got, png) || resp.Header.Get("Content-Type") != "image/png" {
+		t.Errorf("replayed body = %q (%s), want %q", got, resp.Header.Get("Content-Type"), png)
+	}
+}
+
+func TestRecordingPath(t *testing.T) {
+	a := recordingPath("fixtures", "https://api-web.nhle.com/v1/gamecenter/2023020001/boxscore")
+	if filepath.Dir(a) != "fixtures" || !strings.HasPrefix(filepath.Base(a), "api-web.nhle.com_v1_gamecenter_2023020001_boxscore-") {
+		t.Errorf("recordingPath() = %q", a)
+	}
+	b := recordingPath("fixtures", "https://api.nhle.com/stats/rest/en/shiftcharts?cayenneExp=gameId%3D1")
+	c := recordingPath("fixtures", "https://api.nhle.com/stats/rest/en/shiftcharts?cayenneExp=gameId%3D2")
+	if b == c {
+		t.Errorf("URLs differing by query share %q", b)
+	}
+	long := recordingPath("fixtures", "https://example.com/"+strings.Repeat("x", 300))
+	if len(filepath.Base(long)) > 120 {
+		t.Errorf("recordingPath() length = %d", len(filepath.Base(long)))
+	}
+}
+
+func TestReplayFromCorruptRecording(t *testing.T) {
+	dir := t.TempDir()
+	url := "http://nhl.invalid/gamecenter/2023020001/boxscore"
+	if err := os.WriteFile(recordingPath(dir, url), []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	client := NewClientWithConfig(NewClientConfig(WithConfigReplayFrom(dir)))
+	client.baseURLOverride = "http://nhl.invalid"
+	if _, err := client.Boxscore(context.Background(), GameID(2023020001)); err == nil || !strings.Contains(err.Error(), "reading recording") {
+		t.Errorf("Boxscore() error = %v", err)
+	}
+}
@@ -0,0 +1,262 @@
+// Package render formats NHL API models as Markdown for chat bots and
+// forums such as Discord and Reddit.
+package render
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// Column names a boxscore table column.
+type Column string
+
+// Columns shared by the skater and goalie tables.
+const (
+	ColumnNumber   Column = "number"
+	ColumnName     Column = "name"
+	ColumnPosition Column = "position"
+	ColumnTOI      Column = "toi"
+	ColumnPIM      Column = "pim"
+)
+
+// Skater columns.
+const (
+	ColumnGoals          Column = "goals"
+	ColumnAssists        Column = "assists"
+	ColumnPoints         Column = "points"
+	ColumnPlusMinus      Column = "plusMinus"
+	ColumnShots          Column = "sog"
+	ColumnHits           Column = "hits"
+	ColumnBlockedShots   Column = "blockedShots"
+	ColumnPowerPlayGoals Column = "powerPlayGoals"
+	ColumnFaceoffPct     Column = "faceoffPct"
+	ColumnShifts         Column = "shifts"
+	ColumnGiveaways      Column = "giveaways"
+	ColumnTakeaways      Column = "takeaways"
+)
+
+// Goalie columns.
+const (
+	ColumnDecision     Column = "decision"
+	ColumnShotsAgainst Column = "shotsAgainst"
+	ColumnSaves        Column = "saves"
+	ColumnGoalsAgainst Column = "goalsAgainst"
+	ColumnSavePct      Column = "savePct"
+)
+
+// DefaultSkaterColumns and DefaultGoalieColumns are the columns
+// BoxscoreTables renders unless told otherwise.
+var (
+	DefaultSkaterColumns = []Column{ColumnNumber, ColumnName, ColumnPosition, ColumnGoals, ColumnAssists, ColumnPoints, ColumnPlusMinus, ColumnShots, ColumnHits, ColumnBlockedShots, ColumnPIM, ColumnTOI}
+	DefaultGoalieColumns = []Column{ColumnNumber, ColumnName, ColumnDecision, ColumnShotsAgainst, ColumnSaves, ColumnGoalsAgainst, ColumnSavePct, ColumnTOI}
+)
+
+// cell is one table value with the key it sorts by.
+type cell struct {
+	text string
+	key  float64
+}
+
+// column describes how a column renders. skater and goalie are nil for
+// columns the table does not have; text columns sort ascending and are
+// left-aligned.
+type column struct {
+	header string
+	text   bool
+	skater func(model.SkaterStats) cell
+	goalie func(model.GoalieStats) cell
+}
+
+func number(n int) cell {
+	return cell{strconv.Itoa(n), float64(n)}
+}
+
+var columns = map[Column]column{
+	ColumnNumber: {"#", false,
+		func(s model.SkaterStats) cell { return number(s.SweaterNumber) },
+		func(g model.GoalieStats) cell { return number(g.SweaterNumber) }},
+	ColumnName: {"Player", true,
+		func(s model.SkaterStats) cell { return cell{text: s.Name.String()} },
+		func(g model.GoalieStats) cell { return cell{text: g.Name.String()} }},
+	ColumnPosition: {"Pos", true,
+		func(s model.SkaterStats) cell { return cell{text: string(s.Position)} },
+		func(g model.GoalieStats) cell { return cell{text: string(g.Position)} }},
+	ColumnTOI: {"TOI", false,
+		func(s model.SkaterStats) cell { return toi(s.TOI) },
+		func(g model.GoalieStats) cell { return toi(g.TOI) }},
+	ColumnPIM: {"PIM", false,
+		func(s model.SkaterStats) cell { return number(s.PIM) },
+		func(g model.GoalieStats) cell {
+			if g.PIM == nil {
+				return number(0)
+			}
+			return number(*g.PIM)
+		}},
+	ColumnGoals:          skaterColumn("G", func(s model.SkaterStats) int { return s.Goals }),
+	ColumnAssists:        skaterColumn("A", func(s model.SkaterStats) int { return s.Assists }),
+	ColumnPoints:         skaterColumn("P", func(s model.SkaterStats) int { return s.Points }),
+	ColumnShots:          skaterColumn("SOG", func(s model.SkaterStats) int { return s.SOG }),
+	ColumnHits:           skaterColumn("HIT", func(s model.SkaterStats) int { return s.Hits }),
+	ColumnBlockedShots:   skaterColumn("BLK", func(s model.SkaterStats) int { return s.BlockedShots }),
+	ColumnPowerPlayGoals: skaterColumn("PPG", func(s model.SkaterStats) int { return s.PowerPlayGoals }),
+	ColumnShifts:         skaterColumn("SHF", func(s model.SkaterStats) int { return s.Shifts }),
+	ColumnGiveaways:      skaterColumn("GV", func(s model.SkaterStats) int { return s.Giveaways }),
+	ColumnTakeaways:      skaterColumn("TK", func(s model.SkaterStats) int { return s.Takeaways }),
+	ColumnPlusMinus: {header: "+/-", skater: func(s model.SkaterStats) cell {
+		c := number(s.PlusMinus)
+		if s.PlusMinus > 0 {
+			c.text = "+" + c.text
+		}
+		return c
+	}},
+	ColumnFaceoffPct: {header: "FO%", skater: func(s model.SkaterStats) cell {
+		return cell{fmt.Sprintf("%.1f", s.FaceoffWinningPctg*100), s.FaceoffWinningPctg}
+	}},
+	ColumnDecision: {header: "Dec", text: true, goalie: func(g model.GoalieStats) cell {
+		if g.Decision == nil {
+			return cell{}
+		}
+		return cell{text: string(*g.Decision)}
+	}},
+	ColumnShotsAgainst: goalieColumn("SA", func(g model.GoalieStats) int { return g.ShotsAgainst }),
+	ColumnSaves:        goalieColumn("SV", func(g model.GoalieStats) int { return g.Saves }),
+	ColumnGoalsAgainst: goalieColumn("GA", func(g model.GoalieStats) int { return g.GoalsAgainst }),
+	ColumnSavePct: {header: "SV%", goalie: func(g model.GoalieStats) cell {
+		if g.SavePctg == nil {
+			return cell{"-", -1}
+		}
+		return cell{strings.TrimPrefix(fmt.Sprintf("%.3f", *g.SavePctg), "0"), *g.SavePctg}
+	}},
+}
+
+func skaterColumn(header string, value func(model.SkaterStats) int) column {
+	return column{header: header, skater: func(s model.SkaterStats) cell { return number(value(s)) }}
+}
+
+func goalieColumn(header string, value func(model.GoalieStats) int) column {
+	return column{header: header, goalie: func(g model.GoalieStats) cell { return number(value(g)) }}
+}
+
+// toi renders a time on ice and sorts it by seconds.
+func toi(t model.TimeOnIce) cell {
+	return cell{t.String(), float64(t.Seconds())}
+}
+
+// Option configures BoxscoreTables.
+type Option func(*options)
+
+type options struct {
+	skaterColumns []Column
+	goalieColumns []Column
+	sortBy        Column
+}
+
+// WithSkaterColumns sets the skater table's columns, in order.
+func WithSkaterColumns(columns ...Column) Option {
+	return func(o *options) { o.skaterColumns = columns }
+}
+
+// WithGoalieColumns sets the goalie table's columns, in order.
+func WithGoalieColumns(columns ...Column) Option {
+	return func(o *options) { o.goalieColumns = columns }
+}
+
+// WithSortBy orders the rows of each table that has the column: numbers
+// from highest to lowest, except sweater numbers, and text alphabetically.
+// Ties keep the boxscore's order.
+func WithSortBy(column Column) Option {
+	return func(o *options) { o.sortBy = column }
+}
+
+// BoxscoreTables renders the skater and goalie stats of a boxscore as
+// Markdown tables, away team first, each under a heading such as
+// "### TOR skaters". Without options the tables use DefaultSkaterColumns
+// and DefaultGoalieColumns in the boxscore's order. Columns that do not
+// apply to a table, such as ColumnSaves for skaters, are left out of it,
+// and a team without players of a kind gets no table for them.
+func BoxscoreTables(b *model.Boxscore, opts ...Option) string {
+	o := options{skaterColumns: DefaultSkaterColumns, goalieColumns: DefaultGoalieColumns}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var sb strings.Builder
+	teams := []struct {
+		abbrev string
+		stats  model.TeamPlayerStats
+	}{
+		{b.AwayTeam.Abbrev, b.PlayerByGameStats.AwayTeam},
+		{b.HomeTeam.Abbrev, b.PlayerByGameStats.HomeTeam},
+	}
+	for _, team := range teams {
+		skaters := slices.Concat(team.stats.Forwards, team.stats.Defense)
+		writeTable(&sb, team.abbrev+" skaters", o.skaterColumns, o.sortBy, skaters, func(c column) func(model.SkaterStats) cell { return c.skater })
+		writeTable(&sb, team.abbrev+" goalies", o.goalieColumns, o.sortBy, team.stats.Goalies, func(c column) func(model.GoalieStats) cell { return c.goalie })
+	}
+	return sb.String()
+}
+
+// writeTable renders rows under a heading, using the columns that have a
+// renderer for the row type.
+func writeTable[T any](sb *strings.Builder, heading string, selected []Column, sortBy Column, rows []T, render func(column) func(T) cell) {
+	if len(rows) == 0 {
+		return
+	}
+	var cols []column
+	for _, name := range selected {
+		if c, ok := columns[name]; ok && render(c) != nil {
+			cols = append(cols, c)
+		}
+	}
+	if len(cols) == 0 {
+		return
+	}
+	if c, ok := columns[sortBy]; ok && render(c) != nil {
+		value := render(c)
+		rows = slices.Clone(rows)
+		slices.SortStableFunc(rows, func(a, b T) int {
+			x, y := value(a), value(b)
+			switch {
+			case c.text:
+				return cmp.Compare(x.text, y.text)
+			case sortBy == ColumnNumber:
+				return cmp.Compare(x.key, y.key)
+			default:
+				return cmp.Compare(y.key, x.key)
+			}
+		})
+	}
+
+	if sb.Len() > 0 {
+		sb.WriteString("\n")
+	}
+	fmt.Fprintf(sb, "### %s\n\n|", heading)
+	for _, c := range cols {
+		fmt.Fprintf(sb, " %s |", c.header)
+	}
+	sb.WriteString("\n|")
+	for _, c := range cols {
+		if c.text {
+			sb.WriteString(" :-- |")
+		} else {
+			sb.WriteString(" --: |")
+		}
+	}
+	sb.WriteString("\n")
+	for _, row := range rows {
+		sb.WriteString("|")
+		for _, c := range cols {
+			fmt.Fprintf(sb, " %s |", escape(render(c)(row).text))
+		}
+		sb.WriteString("\n")
+	}
+}
+
+// escape keeps a value from breaking the table.
+func escape(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
@@ -0,0 +1,98 @@
+package render
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func testBoxscore() *model.Boxscore {
+	win := model.GoalieDecisionWin
+	pct := 0.9375
+	skater := func(number int, name string, pos model.Position, goals, assists, plusMinus int, clock string) model.SkaterStats {
+		toi, _ := model.ParseTOI(clock)
+		return model.SkaterStats{
+			SweaterNumber: number, Name: model.LocalizedString{Default: name}, Position: pos,
+			Goals: goals, Assists: assists, Points: goals + assists, PlusMinus: plusMinus, TOI: toi,
+		}
+	}
+	return &model.Boxscore{
+		AwayTeam: model.BoxscoreTeam{Abbrev: "TOR"},
+		HomeTeam: model.BoxscoreTeam{Abbrev: "MTL"},
+		PlayerByGameStats: model.PlayerByGameStats{
+			AwayTeam: model.TeamPlayerStats{
+				Forwards: []model.SkaterStats{
+					skater(16, "M. Marner", model.PositionRightWing, 0, 2, 1, "19:02"),
+					skater(34, "A. Matthews", model.PositionCenter, 2, 0, -1, "21:30"),
+				},
+				Defense: []model.SkaterStats{skater(44, "M. Rielly", model.PositionDefense, 0, 1, 0, "24:05")},
+				Goalies: []model.GoalieStats{{
+					SweaterNumber: 60, Name: model.LocalizedString{Default: "J. Woll"}, Position: model.PositionGoalie,
+					Decision: &win, ShotsAgainst: 32, Saves: 30, GoalsAgainst: 2, SavePctg: &pct, TOI: model.TimeOnIce(time.Hour),
+				}},
+			},
+			HomeTeam: model.TeamPlayerStats{
+				Forwards: []model.SkaterStats{skater(14, "N. Suzuki | C", model.PositionCenter, 1, 1, 0, "20:11")},
+			},
+		},
+	}
+}
+
+func TestBoxscoreTables(t *testing.T) {
+	got := BoxscoreTables(testBoxscore())
+	want := `### TOR skaters
+
+| # | Player | Pos | G | A | P | +/- | SOG | HIT | BLK | PIM | TOI |
+| --: | :-- | :-- | --: | --: | --: | --: | --: | --: | --: | --: | --: |
+| 16 | M. Marner | RW | 0 | 2 | 2 | +1 | 0 | 0 | 0 | 0 | 19:02 |
+| 34 | A. Matthews | C | 2 | 0 | 2 | -1 | 0 | 0 | 0 | 0 | 21:30 |
+| 44 | M. Rielly | D | 0 | 1 | 1 | 0 | 0 | 0 | 0 | 0 | 24:05 |
+
+### TOR goalies
+
+| # | Player | Dec | SA | SV | GA | SV% | TOI |
+| --: | :-- | :-- | --: | --: | --: | --: | --: |
+| 60 | J. Woll | W | 32 | 30 | 2 | .938 | 60:00 |
+
+### MTL skaters
+
+| # | Player | Pos | G | A | P | +/- | SOG | HIT | BLK | PIM | TOI |
+| --: | :-- | :-- | --: | --: | --: | --: | --: | --: | --: | --: | --: |
+| 14 | N. Suzuki \| C | C | 1 | 1 | 2 | 0 | 0 | 0 | 0 | 0 | 20:11 |
+`
+	if got != want {
+		t.Errorf("BoxscoreTables() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestBoxscoreTables_Options(t *testing.T) {
+	got := BoxscoreTables(testBoxscore(),
+		WithSkaterColumns(ColumnName, ColumnTOI, ColumnSaves),
+		WithGoalieColumns(ColumnName, ColumnSavePct),
+		WithSortBy(ColumnTOI),
+	)
+	want := `### TOR skaters
+
+| Player | TOI |
+| :-- | --: |
+| M. Rielly | 24:05 |
+| A. Matthews | 21:30 |
+| M. Marner | 19:02 |
+`
+	if !strings.HasPrefix(got, want) {
+		t.Errorf("BoxscoreTables() =\n%s\nwant prefix\n%s", got, want)
+	}
+	if !strings.Contains(got, "| Player | SV% |\n| :-- | --: |\n| J. Woll | .938 |\n") {
+		t.Errorf("goalie table = %s", got)
+	}
+
+	byName := BoxscoreTables(testBoxscore(), WithSkaterColumns(ColumnName), WithSortBy(ColumnName))
+	if !strings.Contains(byName, "| A. Matthews |\n| M. Marner |\n| M. Rielly |\n") {
+		t.Errorf("sorted by name =\n%s", byName)
+	}
+	if got := BoxscoreTables(&model.Boxscore{}); got != "" {
+		t.Errorf("BoxscoreTables(empty) = %q", got)
+	}
+}
@@ -0,0 +1,26 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+)
+
+// TeamScoreboard returns a team's scoreboard as of now: its recent and
+// upcoming games, with scores and live state, from the team's point of
+// view. Unlike DailyScores it spans several dates; see Live, Last and
+// Next.
+func (c *Client) TeamScoreboard(ctx context.Context, teamAbbr string) (*TeamScoreboard, error) {
+	code, err := teamCode(teamAbbr)
+	if err != nil {
+		return nil, err
+	}
+	var response TeamScoreboard
+	resource := fmt.Sprintf("scoreboard/%s/now", code)
+	if err := c.getJSON(ctx, EndpointAPIWebV1, resource, nil, &response); err != nil {
+		return nil, err
+	}
+	for i := range response.GamesByDate {
+		c.delayed.applyScoreboardGames(response.GamesByDate[i].Games)
+	}
+	return &response, nil
+}
@@ -0,0 +1,86 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const torScoreboard = `{
+	"focusedDate": "2024-01-10",
+	"focusedDateCount": 1,
+	"clubTimeZone": "America/Toronto",
+	"clubUTCOffset": "-05:00",
+	"clubScheduleLink": "/mapleleafs/schedule",
+	"gamesByDate": [
+		{"date": "2024-01-06", "games": [{"id": 2023020620, "season": 20232024, "gameType": 2, "gameDate": "2024-01-06",
+			"startTimeUTC": "2024-01-07T00:00:00Z", "venueUTCOffset": "-05:00", "gameState": "OFF", "gameScheduleState": "OK",
+			"awayTeam": {"id": 8, "abbrev": "MTL", "score": 2}, "homeTeam": {"id": 10, "abbrev": "TOR", "score": 3},
+			"periodDescriptor": {"number": 3, "periodType": "REG"}, "gameOutcome": {"lastPeriodType": "REG"}}]},
+		{"date": "2024-01-10", "games": [{"id": 2023020650, "season": 20232024, "gameType": 2, "gameDate": "2024-01-10",
+			"startTimeUTC": "2024-01-11T00:00:00Z", "venueUTCOffset": "-05:00", "gameState": "LIVE", "gameScheduleState": "OK",
+			"awayTeam": {"id": 10, "abbrev": "TOR", "score": 1}, "homeTeam": {"id": 6, "abbrev": "BOS", "score": 1},
+			"periodDescriptor": {"number": 2, "periodType": "REG"}}]},
+		{"date": "2024-01-13", "games": [{"id": 2023020680, "season": 20232024, "gameType": 2, "gameDate": "2024-01-13",
+			"startTimeUTC": "2024-01-14T00:00:00Z", "venueUTCOffset": "-05:00", "gameState": "FUT", "gameScheduleState": "OK",
+			"awayTeam": {"id": 7, "abbrev": "BUF"}, "homeTeam": {"id": 10, "abbrev": "TOR"}}]}
+	]
+}`
+
+func TestTeamScoreboard(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(torScoreboard))
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	sb, err := client.TeamScoreboard(context.Background(), "TOR")
+	if err != nil {
+		t.Fatalf("TeamScoreboard() error = %v", err)
+	}
+	if gotPath != "/scoreboard/TOR/now" {
+		t.Errorf("requested %s", gotPath)
+	}
+	if sb.FocusedDate != "2024-01-10" || sb.ClubTimeZone != "America/Toronto" || len(sb.Games()) != 3 {
+		t.Errorf("unexpected scoreboard: %+v", sb)
+	}
+
+	live, ok := sb.Live()
+	if !ok || live.ID != 2023020650 || live.PeriodDescriptor.Number != 2 {
+		t.Errorf("Live() = %v, %v", live, ok)
+	}
+	last, ok := sb.Last()
+	if !ok || last.String() != "MTL 2 @ TOR 3 [OFF]" || last.GameOutcome.LastPeriodType != PeriodTypeRegulation {
+		t.Errorf("Last() = %v, %v", last, ok)
+	}
+	next, ok := sb.Next()
+	if !ok || next.ID != 2023020680 || next.HomeTeam.Score != nil {
+		t.Errorf("Next() = %v, %v", next, ok)
+	}
+	if local, err := next.LocalStartTime(); err != nil || local.Hour() != 19 {
+		t.Errorf("LocalStartTime() = %v, %v", local, err)
+	}
+
+	empty := &TeamScoreboard{}
+	if _, ok := empty.Live(); ok {
+		t.Error("Live() found a game on an empty scoreboard")
+	}
+	if _, ok := empty.Last(); ok {
+		t.Error("Last() found a game on an empty scoreboard")
+	}
+	if _, ok := empty.Next(); ok {
+		t.Error("Next() found a game on an empty scoreboard")
+	}
+}
+
+func TestTeamScoreboard_Error(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	if _, err := NewClientWithBaseURL(server.URL).TeamScoreboard(context.Background(), "TOR"); err == nil {
+		t.Error("TeamScoreboard() error = nil")
+	}
+}
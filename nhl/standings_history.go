@@ -0,0 +1,78 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// StandingsSnapshot is the league standings as of one date.
+type StandingsSnapshot struct {
+	Date      Date
+	Standings Standings
+}
+
+// StandingsHistory iterates over the season's standings at regular
+// intervals, from the first to the last standings date of the seasons
+// manifest, for charting points pace and the like. Snapshots are every
+// whole number of days in every; zero, negative and sub-day values mean
+// daily. The last standings date is always included, and dates after today
+// are skipped, so a season in progress ends at the current standings.
+//
+// The manifest is read when iteration starts, from the client's cached copy
+// when there is one, then each snapshot is one request. A season missing
+// from the manifest, a manifest entry without a valid date range and a
+// failed request are yielded once as a zero snapshot with the error, after
+// which iteration stops.
+func (c *Client) StandingsHistory(ctx context.Context, season Season, every time.Duration) iter.Seq2[StandingsSnapshot, error] {
+	return func(yield func(StandingsSnapshot, error) bool) {
+		info, err := c.seasonInfo(ctx, season)
+		if err != nil {
+			yield(StandingsSnapshot{}, err)
+			return
+		}
+		dates, err := standingsDates(info, every, DateFromTime(time.Now()))
+		if err != nil {
+			yield(StandingsSnapshot{}, err)
+			return
+		}
+		for _, d := range dates {
+			standings, err := c.LeagueStandingsForDate(ctx, FromDate(d.Time))
+			if err != nil {
+				yield(StandingsSnapshot{}, err)
+				return
+			}
+			if !yield(StandingsSnapshot{Date: d, Standings: standings}, nil) {
+				return
+			}
+		}
+	}
+}
+
+// standingsDates lists the snapshot dates of a season's standings: every
+// step days from its first standings date, then its last, none after today.
+func standingsDates(info SeasonInfo, every time.Duration, today Date) ([]Date, error) {
+	start, end := info.StandingsStart, info.StandingsEnd
+	if start.IsZero() || end.IsZero() {
+		return nil, &DecodeError{Endpoint: EndpointAPIWebV1, Resource: "standings-season",
+			Err: fmt.Errorf("season %s has no standings dates", info.ID)}
+	}
+	if end.Before(start.Time) {
+		return nil, &DecodeError{Endpoint: EndpointAPIWebV1, Resource: "standings-season",
+			Err: fmt.Errorf("season %s standings end %s before they start %s", info.ID, end, start)}
+	}
+	if today.Before(end.Time) {
+		end = today
+	}
+	step := max(int(every/(24*time.Hour)), 1)
+
+	var dates []Date
+	for d := start; !d.After(end.Time); d = DateFromTime(d.AddDate(0, 0, step)) {
+		dates = append(dates, d)
+	}
+	if len(dates) > 0 && !dates[len(dates)-1].Equal(end) {
+		dates = append(dates, end)
+	}
+	return dates, nil
+}
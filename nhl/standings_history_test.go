@@ -0,0 +1,99 @@
+package nhl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStandingsDates(t *testing.T) {
+	info := SeasonInfo{ID: NewSeason(2022), StandingsStart: NewDateYMD(2022, 10, 7), StandingsEnd: NewDateYMD(2022, 10, 20)}
+	later := NewDateYMD(2023, 6, 1)
+
+	tests := []struct {
+		name  string
+		every time.Duration
+		today Date
+		want  []string
+	}{
+		{"daily", 0, later, []string{"2022-10-07", "2022-10-08", "2022-10-09", "2022-10-10", "2022-10-11", "2022-10-12", "2022-10-13", "2022-10-14", "2022-10-15", "2022-10-16", "2022-10-17", "2022-10-18", "2022-10-19", "2022-10-20"}},
+		{"weekly includes end", 7 * 24 * time.Hour, later, []string{"2022-10-07", "2022-10-14", "2022-10-20"}},
+		{"sub-day is daily", time.Hour, NewDateYMD(2022, 10, 9), []string{"2022-10-07", "2022-10-08", "2022-10-09"}},
+		{"stops at today", 4 * 24 * time.Hour, NewDateYMD(2022, 10, 12), []string{"2022-10-07", "2022-10-11", "2022-10-12"}},
+		{"not started", 24 * time.Hour, NewDateYMD(2022, 9, 1), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dates, err := standingsDates(info, tt.every, tt.today)
+			if err != nil {
+				t.Fatalf("standingsDates() error = %v", err)
+			}
+			var got []string
+			for _, d := range dates {
+				got = append(got, d.String())
+			}
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("standingsDates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStandingsDates_InvalidManifest(t *testing.T) {
+	today := NewDateYMD(2023, 6, 1)
+	var decodeErr *DecodeError
+	if _, err := standingsDates(SeasonInfo{ID: NewSeason(2022)}, 0, today); !errors.As(err, &decodeErr) {
+		t.Errorf("standingsDates() error = %v, want a DecodeError without standings dates", err)
+	}
+	backwards := SeasonInfo{ID: NewSeason(2022), StandingsStart: NewDateYMD(2023, 4, 13), StandingsEnd: NewDateYMD(2022, 10, 7)}
+	if _, err := standingsDates(backwards, 0, today); err == nil {
+		t.Error("standingsDates() should error when standings end before they start")
+	}
+}
+
+func TestClient_StandingsHistory(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/standings-season" {
+			json.NewEncoder(w).Encode(SeasonsResponse{Seasons: []SeasonInfo{
+				{ID: NewSeason(2022), StandingsStart: NewDateYMD(2022, 10, 7), StandingsEnd: NewDateYMD(2022, 10, 17)},
+			}})
+			return
+		}
+		date := strings.TrimPrefix(r.URL.Path, "/standings/")
+		requested = append(requested, date)
+		json.NewEncoder(w).Encode(StandingsResponse{Standings: []Standing{{Points: len(requested)}}})
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	var got []string
+	for snap, err := range client.StandingsHistory(context.Background(), NewSeason(2022), 5*24*time.Hour) {
+		if err != nil {
+			t.Fatalf("StandingsHistory() error = %v", err)
+		}
+		if len(snap.Standings) != 1 || snap.Standings[0].Points != len(got)+1 {
+			t.Errorf("snapshot %s standings = %+v", snap.Date, snap.Standings)
+		}
+		got = append(got, snap.Date.String())
+	}
+	want := "2022-10-07 2022-10-12 2022-10-17"
+	if strings.Join(got, " ") != want {
+		t.Errorf("snapshot dates = %v, want %s", got, want)
+	}
+	if strings.Join(requested, " ") != want {
+		t.Errorf("requested dates = %v, want %s", requested, want)
+	}
+
+	for _, err := range client.StandingsHistory(context.Background(), NewSeason(1999), 0) {
+		if err == nil {
+			t.Error("StandingsHistory() should error for a season missing from the manifest")
+		}
+	}
+}
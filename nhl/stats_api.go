@@ -0,0 +1,448 @@
+package nhl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// statsPageSize is the number of rows requested per page from the stats
+// REST API. The API caps page sizes at 100.
+const statsPageSize = 100
+
+// statsOperators are the comparison operators accepted in cayenneExp
+// expressions.
+var statsOperators = map[string]bool{
+	"=": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true,
+	"like": true, "likeIgnoreCase": true,
+}
+
+// StatsAPI is the entry point for the report endpoints of the NHL stats
+// REST API (api.nhle.com/stats/rest). Obtain one from Client.Stats.
+type StatsAPI struct {
+	client *Client
+}
+
+// Skaters starts a query against the skater reports.
+func (s *StatsAPI) Skaters() *SkaterStatsQuery {
+	return &SkaterStatsQuery{q: newStatsQuery(s.client, "skater")}
+}
+
+// Goalies starts a query against the goalie reports.
+func (s *StatsAPI) Goalies() *GoalieStatsQuery {
+	return &GoalieStatsQuery{q: newStatsQuery(s.client, "goalie")}
+}
+
+// Teams starts a query against the team reports.
+func (s *StatsAPI) Teams() *TeamStatsQuery {
+	return &TeamStatsQuery{q: newStatsQuery(s.client, "team")}
+}
+
+// statsSort is one entry of the sort parameter.
+type statsSort struct {
+	Property  string        `json:"property"`
+	Direction SortDirection `json:"direction"`
+}
+
+// statsRowIDs maps each stats entity to the property identifying its rows.
+// Queries without a sort are ordered by it, then by season, so that paging
+// neither repeats nor skips rows.
+var statsRowIDs = map[string]string{
+	"skater": "playerId",
+	"goalie": "playerId",
+	"team":   "teamId",
+}
+
+// statsQuery holds the state shared by the typed query builders. Builder
+// errors are recorded in errs and returned together by validate.
+type statsQuery struct {
+	client     *Client
+	entity     string
+	seasonFrom Season
+	seasonTo   Season
+	gameType   GameType
+	filters    []string
+	sorts      []statsSort
+	limit      int
+	errs       []error
+}
+
+func newStatsQuery(client *Client, entity string) *statsQuery {
+	current := CurrentSeason()
+	return &statsQuery{
+		client:     client,
+		entity:     entity,
+		seasonFrom: current,
+		seasonTo:   current,
+		gameType:   GameTypeRegularSeason,
+	}
+}
+
+func (q *statsQuery) setSeasons(from, to Season) {
+	if to.StartYear() < from.StartYear() {
+		q.fail(fmt.Errorf("invalid season range: %s to %s", from, to))
+		return
+	}
+	q.seasonFrom, q.seasonTo = from, to
+}
+
+func (q *statsQuery) addFilter(property, operator string, value any) {
+	if property == "" {
+		q.fail(fmt.Errorf("stats filter: empty property"))
+		return
+	}
+	if !statsOperators[operator] {
+		q.fail(fmt.Errorf("stats filter on %s: unsupported operator %q", property, operator))
+		return
+	}
+	literal, err := cayenneLiteral(value)
+	if err != nil {
+		q.fail(fmt.Errorf("stats filter on %s: %w", property, err))
+		return
+	}
+	q.filters = append(q.filters, fmt.Sprintf("%s%s%s", property, spacedOperator(operator), literal))
+}
+
+func (q *statsQuery) addSort(property string, direction SortDirection) {
+	if property == "" {
+		q.fail(fmt.Errorf("stats sort: empty property"))
+		return
+	}
+	if direction != SortAscending && direction != SortDescending {
+		q.fail(fmt.Errorf("stats sort on %s: invalid direction %q", property, direction))
+		return
+	}
+	q.sorts = append(q.sorts, statsSort{Property: property, Direction: direction})
+}
+
+func (q *statsQuery) setLimit(n int) {
+	if n < 0 {
+		q.fail(fmt.Errorf("stats limit must not be negative: %d", n))
+		return
+	}
+	q.limit = n
+}
+
+func (q *statsQuery) fail(err error) {
+	q.errs = append(q.errs, err)
+}
+
+// validate returns every builder error along with invalid seasons or game
+// type, joined and wrapping ErrInvalidArgument, or nil when the query can
+// run.
+func (q *statsQuery) validate() error {
+	errs := slices.Clone(q.errs)
+	if err := q.seasonFrom.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if q.seasonTo != q.seasonFrom {
+		if err := q.seasonTo.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !q.gameType.IsValid() {
+		errs = append(errs, fmt.Errorf("invalid game type: %d", int(q.gameType)))
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %w", ErrInvalidArgument, errors.Join(errs...))
+}
+
+// cayenneExp builds the filter expression sent with every page.
+func (q *statsQuery) cayenneExp() string {
+	clauses := []string{
+		"seasonId>=" + q.seasonFrom.APIString(),
+		"seasonId<=" + q.seasonTo.APIString(),
+		fmt.Sprintf("gameTypeId=%d", q.gameType.Int()),
+	}
+	clauses = append(clauses, q.filters...)
+	return strings.Join(clauses, " and ")
+}
+
+// spacedOperator pads word operators so they don't merge with operands.
+func spacedOperator(operator string) string {
+	if operator == "like" || operator == "likeIgnoreCase" {
+		return " " + operator + " "
+	}
+	return operator
+}
+
+// cayenneLiteral formats a filter value as a cayenneExp literal.
+func cayenneLiteral(value any) (string, error) {
+	if season, ok := value.(Season); ok {
+		return season.APIString(), nil
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return "\"" + strings.ReplaceAll(v.String(), "\"", "\\\"") + "\"", nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+// statsPage is one page of a stats report.
+type statsPage[T any] struct {
+	Data  []T `json:"data"`
+	Total int `json:"total"`
+}
+
+// runStatsReport pages through a report until the query limit or the end of
+// the data is reached.
+func runStatsReport[T any](ctx context.Context, q *statsQuery, report string) ([]T, error) {
+	if err := q.validate(); err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"cayenneExp":  q.cayenneExp(),
+		"isAggregate": "false",
+		"isGame":      "false",
+	}
+	sorts := q.sorts
+	if len(sorts) == 0 {
+		sorts = []statsSort{
+			{Property: statsRowIDs[q.entity], Direction: SortAscending},
+			{Property: "seasonId", Direction: SortAscending},
+		}
+	}
+	sort, err := json.Marshal(sorts)
+	if err != nil {
+		return nil, NewJSONError(err)
+	}
+	params["sort"] = string(sort)
+
+	resource := fmt.Sprintf("%s/%s/%s", pathCode(q.client.languageFor(ctx)), q.entity, report)
+	rows := []T{}
+	for {
+		pageSize := statsPageSize
+		if q.limit > 0 && q.limit-len(rows) < pageSize {
+			pageSize = q.limit - len(rows)
+		}
+		params["start"] = strconv.Itoa(len(rows))
+		params["limit"] = strconv.Itoa(pageSize)
+
+		var page statsPage[T]
+		if err := q.client.getJSON(ctx, EndpointAPIStats, resource, params, &page); err != nil {
+			return nil, err
+		}
+		rows = append(rows, page.Data...)
+
+		if len(page.Data) < pageSize || len(rows) >= page.Total {
+			return rows, nil
+		}
+		if q.limit > 0 && len(rows) >= q.limit {
+			return rows, nil
+		}
+	}
+}
+
+// SkaterStatsQuery builds a query against the skater reports. Without a
+// call to Season or Seasons it covers the current regular season.
+type SkaterStatsQuery struct {
+	q *statsQuery
+}
+
+// Validate returns every problem with the query, joined, without making a
+// request. Running an invalid query returns the same error.
+func (s *SkaterStatsQuery) Validate() error {
+	return s.q.validate()
+}
+
+// Season restricts the query to a single season.
+func (s *SkaterStatsQuery) Season(season Season) *SkaterStatsQuery {
+	s.q.setSeasons(season, season)
+	return s
+}
+
+// Seasons restricts the query to an inclusive range of seasons. Rows are
+// returned per player per season.
+func (s *SkaterStatsQuery) Seasons(from, to Season) *SkaterStatsQuery {
+	s.q.setSeasons(from, to)
+	return s
+}
+
+// GameType restricts the query to a game type. The default is the regular
+// season.
+func (s *SkaterStatsQuery) GameType(gameType GameType) *SkaterStatsQuery {
+	s.q.gameType = gameType
+	return s
+}
+
+// Filter adds a cayenneExp condition such as Filter("goals", ">=", 20).
+// Supported operators are =, !=, <, <=, >, >=, like and likeIgnoreCase.
+func (s *SkaterStatsQuery) Filter(property, operator string, value any) *SkaterStatsQuery {
+	s.q.addFilter(property, operator, value)
+	return s
+}
+
+// Sort adds a sort column. Sorts apply in the order they are added;
+// without one, rows are ordered by ID and season.
+func (s *SkaterStatsQuery) Sort(property string, direction SortDirection) *SkaterStatsQuery {
+	s.q.addSort(property, direction)
+	return s
+}
+
+// Limit caps the number of rows returned. Zero, the default, returns every
+// matching row.
+func (s *SkaterStatsQuery) Limit(n int) *SkaterStatsQuery {
+	s.q.setLimit(n)
+	return s
+}
+
+// Summary runs the query against the skater summary report.
+func (s *SkaterStatsQuery) Summary(ctx context.Context) ([]SkaterSummary, error) {
+	return runStatsReport[SkaterSummary](ctx, s.q, "summary")
+}
+
+// Realtime runs the query against the skater realtime report.
+func (s *SkaterStatsQuery) Realtime(ctx context.Context) ([]SkaterRealtime, error) {
+	return runStatsReport[SkaterRealtime](ctx, s.q, "realtime")
+}
+
+// Bios runs the query against the skater bios report.
+func (s *SkaterStatsQuery) Bios(ctx context.Context) ([]SkaterBio, error) {
+	return runStatsReport[SkaterBio](ctx, s.q, "bios")
+}
+
+// GoalieStatsQuery builds a query against the goalie reports. Without a
+// call to Season or Seasons it covers the current regular season. The API
+// has no realtime report for goalies.
+type GoalieStatsQuery struct {
+	q *statsQuery
+}
+
+// Validate returns every problem with the query, joined, without making a
+// request. Running an invalid query returns the same error.
+func (g *GoalieStatsQuery) Validate() error {
+	return g.q.validate()
+}
+
+// Season restricts the query to a single season.
+func (g *GoalieStatsQuery) Season(season Season) *GoalieStatsQuery {
+	g.q.setSeasons(season, season)
+	return g
+}
+
+// Seasons restricts the query to an inclusive range of seasons. Rows are
+// returned per goalie per season.
+func (g *GoalieStatsQuery) Seasons(from, to Season) *GoalieStatsQuery {
+	g.q.setSeasons(from, to)
+	return g
+}
+
+// GameType restricts the query to a game type. The default is the regular
+// season.
+func (g *GoalieStatsQuery) GameType(gameType GameType) *GoalieStatsQuery {
+	g.q.gameType = gameType
+	return g
+}
+
+// Filter adds a cayenneExp condition such as Filter("gamesPlayed", ">=", 25).
+// Supported operators are =, !=, <, <=, >, >=, like and likeIgnoreCase.
+func (g *GoalieStatsQuery) Filter(property, operator string, value any) *GoalieStatsQuery {
+	g.q.addFilter(property, operator, value)
+	return g
+}
+
+// Sort adds a sort column. Sorts apply in the order they are added;
+// without one, rows are ordered by ID and season.
+func (g *GoalieStatsQuery) Sort(property string, direction SortDirection) *GoalieStatsQuery {
+	g.q.addSort(property, direction)
+	return g
+}
+
+// Limit caps the number of rows returned. Zero, the default, returns every
+// matching row.
+func (g *GoalieStatsQuery) Limit(n int) *GoalieStatsQuery {
+	g.q.setLimit(n)
+	return g
+}
+
+// Summary runs the query against the goalie summary report.
+func (g *GoalieStatsQuery) Summary(ctx context.Context) ([]GoalieSummary, error) {
+	return runStatsReport[GoalieSummary](ctx, g.q, "summary")
+}
+
+// Bios runs the query against the goalie bios report.
+func (g *GoalieStatsQuery) Bios(ctx context.Context) ([]GoalieBio, error) {
+	return runStatsReport[GoalieBio](ctx, g.q, "bios")
+}
+
+// TeamStatsQuery builds a query against the team reports. Without a call to
+// Season or Seasons it covers the current regular season. The API has no
+// bios report for teams.
+type TeamStatsQuery struct {
+	q *statsQuery
+}
+
+// Validate returns every problem with the query, joined, without making a
+// request. Running an invalid query returns the same error.
+func (t *TeamStatsQuery) Validate() error {
+	return t.q.validate()
+}
+
+// Season restricts the query to a single season.
+func (t *TeamStatsQuery) Season(season Season) *TeamStatsQuery {
+	t.q.setSeasons(season, season)
+	return t
+}
+
+// Seasons restricts the query to an inclusive range of seasons. Rows are
+// returned per team per season.
+func (t *TeamStatsQuery) Seasons(from, to Season) *TeamStatsQuery {
+	t.q.setSeasons(from, to)
+	return t
+}
+
+// GameType restricts the query to a game type. The default is the regular
+// season.
+func (t *TeamStatsQuery) GameType(gameType GameType) *TeamStatsQuery {
+	t.q.gameType = gameType
+	return t
+}
+
+// Filter adds a cayenneExp condition such as Filter("wins", ">", 40).
+// Supported operators are =, !=, <, <=, >, >=, like and likeIgnoreCase.
+func (t *TeamStatsQuery) Filter(property, operator string, value any) *TeamStatsQuery {
+	t.q.addFilter(property, operator, value)
+	return t
+}
+
+// Sort adds a sort column. Sorts apply in the order they are added;
+// without one, rows are ordered by ID and season.
+func (t *TeamStatsQuery) Sort(property string, direction SortDirection) *TeamStatsQuery {
+	t.q.addSort(property, direction)
+	return t
+}
+
+// Limit caps the number of rows returned. Zero, the default, returns every
+// matching row.
+func (t *TeamStatsQuery) Limit(n int) *TeamStatsQuery {
+	t.q.setLimit(n)
+	return t
+}
+
+// Summary runs the query against the team summary report.
+func (t *TeamStatsQuery) Summary(ctx context.Context) ([]TeamSummary, error) {
+	return runStatsReport[TeamSummary](ctx, t.q, "summary")
+}
+
+// Realtime runs the query against the team realtime report.
+func (t *TeamStatsQuery) Realtime(ctx context.Context) ([]TeamRealtime, error) {
+	return runStatsReport[TeamRealtime](ctx, t.q, "realtime")
+}
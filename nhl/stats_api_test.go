@@ -0,0 +1,274 @@
+package nhl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// statsServer serves a report of total rows, honouring start and limit, and
+// records the query of every request.
+type statsServer struct {
+	mu       sync.Mutex
+	total    int
+	requests []*http.Request
+}
+
+func (s *statsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r)
+	s.mu.Unlock()
+
+	start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	var data []map[string]any
+	for i := start; i < start+limit && i < s.total; i++ {
+		data = append(data, map[string]any{
+			"playerId":       8470000 + i,
+			"skaterFullName": fmt.Sprintf("Skater %d", i),
+			"seasonId":       20232024,
+			"positionCode":   "C",
+			"shootsCatches":  "L",
+			"goals":          i,
+			"shootingPct":    0.125,
+		})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{"data": data, "total": s.total})
+}
+
+func TestStatsSkatersSummaryPaging(t *testing.T) {
+	fake := &statsServer{total: 250}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	rows, err := client.Stats().Skaters().
+		Season(NewSeason(2023)).
+		Filter("goals", ">=", 20).
+		Filter("skaterFullName", "likeIgnoreCase", "%mc%").
+		Sort("points", SortDescending).
+		Sort("goals", SortDescending).
+		Summary(context.Background())
+	if err != nil {
+		t.Fatalf("Summary() error = %v", err)
+	}
+	if len(rows) != 250 {
+		t.Fatalf("len(rows) = %d, want 250", len(rows))
+	}
+	if len(fake.requests) != 3 {
+		t.Fatalf("requests = %d, want 3", len(fake.requests))
+	}
+	if rows[249].PlayerID != 8470249 || rows[0].PositionCode != PositionCenter {
+		t.Errorf("unexpected rows: first %+v, last %+v", rows[0], rows[249])
+	}
+	if rows[0].ShootingPct == nil || *rows[0].ShootingPct != 0.125 {
+		t.Errorf("ShootingPct = %v, want 0.125", rows[0].ShootingPct)
+	}
+
+	req := fake.requests[0]
+	if req.URL.Path != "/en/skater/summary" {
+		t.Errorf("path = %q, want /en/skater/summary", req.URL.Path)
+	}
+	wantExp := `seasonId>=20232024 and seasonId<=20232024 and gameTypeId=2 and goals>=20 and skaterFullName likeIgnoreCase "%mc%"`
+	if got := req.URL.Query().Get("cayenneExp"); got != wantExp {
+		t.Errorf("cayenneExp = %q, want %q", got, wantExp)
+	}
+	wantSort := `[{"property":"points","direction":"DESC"},{"property":"goals","direction":"DESC"}]`
+	if got := req.URL.Query().Get("sort"); got != wantSort {
+		t.Errorf("sort = %q, want %q", got, wantSort)
+	}
+	if got := fake.requests[2].URL.Query().Get("start"); got != "200" {
+		t.Errorf("third page start = %q, want 200", got)
+	}
+}
+
+func TestStatsLimit(t *testing.T) {
+	fake := &statsServer{total: 250}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	rows, err := client.Stats().Skaters().Limit(130).Realtime(context.Background())
+	if err != nil {
+		t.Fatalf("Realtime() error = %v", err)
+	}
+	if len(rows) != 130 {
+		t.Fatalf("len(rows) = %d, want 130", len(rows))
+	}
+	if got := fake.requests[1].URL.Query().Get("limit"); got != "30" {
+		t.Errorf("second page limit = %q, want 30", got)
+	}
+	if fake.requests[0].URL.Path != "/en/skater/realtime" {
+		t.Errorf("path = %q", fake.requests[0].URL.Path)
+	}
+}
+
+func TestStatsEmptyReport(t *testing.T) {
+	server := httptest.NewServer(&statsServer{})
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	rows, err := client.Stats().Teams().Summary(context.Background())
+	if err != nil {
+		t.Fatalf("Summary() error = %v", err)
+	}
+	if rows == nil || len(rows) != 0 {
+		t.Errorf("rows = %v, want empty non-nil slice", rows)
+	}
+}
+
+func TestStatsReportPaths(t *testing.T) {
+	fake := &statsServer{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	ctx := WithLanguage(context.Background(), LanguageFrench)
+	stats := client.Stats()
+	calls := []func() error{
+		func() error { _, err := stats.Skaters().Bios(ctx); return err },
+		func() error { _, err := stats.Goalies().Summary(ctx); return err },
+		func() error { _, err := stats.Goalies().Bios(ctx); return err },
+		func() error { _, err := stats.Teams().Summary(ctx); return err },
+		func() error { _, err := stats.Teams().Realtime(ctx); return err },
+	}
+	for _, call := range calls {
+		if err := call(); err != nil {
+			t.Fatalf("report error = %v", err)
+		}
+	}
+
+	want := []string{"/fr/skater/bios", "/fr/goalie/summary", "/fr/goalie/bios", "/fr/team/summary", "/fr/team/realtime"}
+	for i, path := range want {
+		if got := fake.requests[i].URL.Path; got != path {
+			t.Errorf("request %d path = %q, want %q", i, got, path)
+		}
+	}
+}
+
+func TestStatsSeasonsAndGameType(t *testing.T) {
+	fake := &statsServer{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	_, err := client.Stats().Goalies().
+		Seasons(NewSeason(2020), NewSeason(2023)).
+		GameType(GameTypePlayoffs).
+		Filter("gamesPlayed", ">", 10).
+		Filter("seasonId", "!=", NewSeason(2021)).
+		Summary(context.Background())
+	if err != nil {
+		t.Fatalf("Summary() error = %v", err)
+	}
+	want := "seasonId>=20202021 and seasonId<=20232024 and gameTypeId=3 and gamesPlayed>10 and seasonId!=20212022"
+	if got := fake.requests[0].URL.Query().Get("cayenneExp"); got != want {
+		t.Errorf("cayenneExp = %q, want %q", got, want)
+	}
+	wantSort := `[{"property":"playerId","direction":"ASC"},{"property":"seasonId","direction":"ASC"}]`
+	if got := fake.requests[0].URL.Query().Get("sort"); got != wantSort {
+		t.Errorf("sort = %q, want the default %q", got, wantSort)
+	}
+}
+
+func TestStatsBuilderErrors(t *testing.T) {
+	client := NewClientWithBaseURL("http://127.0.0.1:0")
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		run   func() error
+		match string
+	}{
+		{"bad operator", func() error {
+			_, err := client.Stats().Skaters().Filter("goals", "=>", 1).Summary(ctx)
+			return err
+		}, "unsupported operator"},
+		{"empty property", func() error {
+			_, err := client.Stats().Teams().Filter("", "=", 1).Summary(ctx)
+			return err
+		}, "empty property"},
+		{"bad value", func() error {
+			_, err := client.Stats().Goalies().Filter("wins", "=", []int{1}).Summary(ctx)
+			return err
+		}, "unsupported value type"},
+		{"empty sort property", func() error {
+			_, err := client.Stats().Goalies().Sort("", SortDescending).Summary(ctx)
+			return err
+		}, "empty property"},
+		{"bad direction", func() error {
+			_, err := client.Stats().Skaters().Sort("points", "down").Summary(ctx)
+			return err
+		}, "invalid direction"},
+		{"negative limit", func() error {
+			_, err := client.Stats().Teams().Limit(-1).Realtime(ctx)
+			return err
+		}, "must not be negative"},
+		{"reversed seasons", func() error {
+			_, err := client.Stats().Skaters().Seasons(NewSeason(2023), NewSeason(2020)).Bios(ctx)
+			return err
+		}, "invalid season range"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.run()
+			if !errors.Is(err, ErrInvalidArgument) || !strings.Contains(err.Error(), tt.match) {
+				t.Errorf("error = %v, want ErrInvalidArgument containing %q", err, tt.match)
+			}
+		})
+	}
+}
+
+func TestStatsQueryValidate(t *testing.T) {
+	stats := NewClientWithBaseURL("http://127.0.0.1:0").Stats()
+	if err := stats.Teams().Season(NewSeason(2023)).Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	err := stats.Skaters().
+		Season(NewSeason(1900)).
+		GameType(GameType(5)).
+		Filter("goals", "=>", 1).
+		Limit(-5).
+		Validate()
+	if err == nil {
+		t.Fatal("Validate() error = nil")
+	}
+	for _, want := range []string{"unsupported operator", "must not be negative", "before the first NHL season", "invalid game type"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate() error = %v, want containing %q", err, want)
+		}
+	}
+	if err := stats.Goalies().Seasons(NewSeason(2020), NewSeason(20000)).Validate(); err == nil {
+		t.Error("Validate(out-of-range season) error = nil")
+	}
+}
+
+func TestCayenneLiteral(t *testing.T) {
+	tests := []struct {
+		value any
+		want  string
+	}{
+		{"O\"Reilly", `"O\"Reilly"`},
+		{true, "true"},
+		{int64(-3), "-3"},
+		{uint8(7), "7"},
+		{0.5, "0.5"},
+		{NewTeamID(8), "8"},
+		{NewSeason(2023), "20232024"},
+	}
+	for _, tt := range tests {
+		got, err := cayenneLiteral(tt.value)
+		if err != nil || got != tt.want {
+			t.Errorf("cayenneLiteral(%v) = %q, %v; want %q", tt.value, got, err, tt.want)
+		}
+	}
+}
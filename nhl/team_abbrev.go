@@ -0,0 +1,21 @@
+package nhl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// teamCode checks the team code passed to a Client method and returns it
+// upper-cased. Besides the codes TeamAbbrev accepts, it accepts those of
+// the defunct clubs in DefaultTeams, such as "ATL", whose rosters and
+// schedules the API still serves.
+func teamCode(teamAbbr string) (string, error) {
+	code := TeamAbbrev(strings.ToUpper(strings.TrimSpace(teamAbbr)))
+	if code.IsValid() {
+		return code.String(), nil
+	}
+	if _, ok := DefaultTeams.TeamByAbbrev(code); ok {
+		return code.String(), nil
+	}
+	return "", fmt.Errorf("%w: %w", ErrInvalidArgument, TeamAbbrev(teamAbbr).Validate())
+}
@@ -0,0 +1,42 @@
+package nhl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestTeamMethodsAcceptStrings(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"forwards":[],"defensemen":[],"goalies":[]}`))
+	}))
+	defer server.Close()
+	client := NewClientWithBaseURL(server.URL)
+	ctx := context.Background()
+
+	team := "mtl"
+	if _, err := client.RosterCurrent(ctx, team); err != nil {
+		t.Fatalf("RosterCurrent(%q) error = %v", team, err)
+	}
+	if _, err := client.RosterSeason(ctx, "ATL", NewSeason(2005)); err != nil {
+		t.Fatalf("RosterSeason(ATL) error = %v", err)
+	}
+	want := []string{"/roster/MTL/current", "/roster/ATL/20052006"}
+	if !slices.Equal(paths, want) {
+		t.Errorf("requested %v, want %v", paths, want)
+	}
+
+	for _, bad := range []string{"", "XYZ"} {
+		if _, err := client.RosterCurrent(ctx, bad); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("RosterCurrent(%q) error = %v, want ErrInvalidArgument", bad, err)
+		}
+	}
+	if len(paths) != len(want) {
+		t.Errorf("invalid codes made requests: %v", paths[len(want):])
+	}
+}
@@ -0,0 +1,14 @@
+package nhl
+
+import "context"
+
+// TeamComparison returns the season stats comparison of a game's teams,
+// from the right-rail payload, or nil when the game has none, as for some
+// preseason and international games.
+func (c *Client) TeamComparison(ctx context.Context, gameID GameID) (*TeamComparison, error) {
+	rail, err := c.GameRightRail(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	return rail.TeamSeasonStats, nil
+}
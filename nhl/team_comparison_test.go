@@ -0,0 +1,56 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTeamComparison(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/gamecenter/2024020500/right-rail" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"teamSeasonStats":{
+			"contextLabel":"season","contextSeason":20242025,
+			"awayTeam":{"ppPctg":0.25,"pkPctg":0.79,"faceoffWinningPctg":0.51,"goalsForPerGamePlayed":3.4,"goalsAgainstPerGamePlayed":2.6,
+				"ppPctgRank":5,"pkPctgRank":20,"faceoffWinningPctgRank":12,"goalsForPerGamePlayedRank":3,"goalsAgainstPerGamePlayedRank":4},
+			"homeTeam":{"ppPctg":0.18,"pkPctg":0.83,"faceoffWinningPctg":0.51,"goalsForPerGamePlayed":2.9,"goalsAgainstPerGamePlayed":3.1,
+				"ppPctgRank":24,"pkPctgRank":8,"faceoffWinningPctgRank":12,"goalsForPerGamePlayedRank":18,"goalsAgainstPerGamePlayedRank":22}
+		}}`)
+	}))
+	defer server.Close()
+
+	cmp, err := NewClientWithBaseURL(server.URL).TeamComparison(context.Background(), 2024020500)
+	if err != nil {
+		t.Fatalf("TeamComparison() error = %v", err)
+	}
+	if cmp.ContextSeason != NewSeason(2024) || cmp.HomeTeam.PKPctgRank != 8 {
+		t.Errorf("TeamComparison() = %+v", cmp)
+	}
+
+	var leaders []string
+	for _, row := range cmp.Rows() {
+		leaders = append(leaders, row.Stat+"="+row.Leader())
+	}
+	want := "[ppPctg=away pkPctg=home faceoffWinningPctg= goalsForPerGamePlayed=away goalsAgainstPerGamePlayed=away]"
+	if fmt.Sprint(leaders) != want {
+		t.Errorf("leaders = %v, want %v", leaders, want)
+	}
+}
+
+func TestTeamComparison_Missing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	cmp, err := NewClientWithBaseURL(server.URL).TeamComparison(context.Background(), 2024010001)
+	if err != nil || cmp != nil {
+		t.Errorf("TeamComparison() = %v, %v, want nil, nil", cmp, err)
+	}
+}
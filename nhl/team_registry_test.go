@@ -0,0 +1,49 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTeamRegistry_Refresh(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/en/team":
+			w.Write([]byte(`{"data": [
+				{"id": 10, "franchiseId": 5, "fullName": "Toronto Maple Leafs", "leagueId": 133, "triCode": "TOR"},
+				{"id": 36, "franchiseId": 9, "fullName": "Philadelphia Quakers", "leagueId": 133, "triCode": "QUA"},
+				{"id": 70, "franchiseId": null, "fullName": "To be determined", "leagueId": 0, "triCode": "TBD"}
+			]}`))
+		case "/en/franchise":
+			w.Write([]byte(`{"data": [{"id": 5, "fullName": "Toronto Maple Leafs", "teamCommonName": "Maple Leafs", "teamPlaceName": "Toronto"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	r := NewTeamRegistry([]TeamInfo{{ID: 10, Abbrev: "TOR", FirstSeason: NewSeason(1917)}})
+	if err := r.Refresh(context.Background(), NewClientWithBaseURL(server.URL)); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if tor, _ := r.TeamByID(10); tor.FullName != "Toronto Maple Leafs" || tor.FranchiseID != 5 || tor.FirstSeason != NewSeason(1917) {
+		t.Errorf("refreshed TOR = %+v", tor)
+	}
+	if f, _ := r.FranchiseForTeam(10); f.TeamPlaceName != "Toronto" {
+		t.Errorf("FranchiseForTeam(10) = %+v", f)
+	}
+	if qua, ok := r.TeamByAbbrev("QUA"); !ok || qua.ID != 36 || qua.FranchiseID != 9 || qua.IsActive() {
+		t.Errorf("added team = %+v, %v", qua, ok)
+	}
+	if _, ok := r.TeamByID(70); ok {
+		t.Error("Refresh() added a non-NHL team")
+	}
+
+	failing := httptest.NewServer(http.NotFoundHandler())
+	defer failing.Close()
+	if err := r.Refresh(context.Background(), NewClientWithBaseURL(failing.URL)); err == nil {
+		t.Error("Refresh() error = nil for a failing API")
+	}
+}
@@ -0,0 +1,15 @@
+package nhl
+
+import (
+	"context"
+)
+
+// TeamSeasonStats returns every team's summary aggregates for a season and
+// game type, from the stats REST API team summary report.
+func (c *Client) TeamSeasonStats(ctx context.Context, season Season, gameType GameType) (TeamSeasonStats, error) {
+	rows, err := c.Stats().Teams().Season(season).GameType(gameType).Summary(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return TeamSeasonStats(rows), nil
+}
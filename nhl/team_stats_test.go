@@ -0,0 +1,118 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const teamSummaryJSON = `{
+	"data": [
+		{"teamId": 8, "teamFullName": "Montréal Canadiens", "seasonId": 20232024, "gamesPlayed": 82,
+		 "wins": 30, "losses": 36, "otLosses": 16, "points": 76, "pointPct": 0.46341,
+		 "goalsFor": 236, "goalsAgainst": 289, "powerPlayPct": 0.19, "faceoffWinPct": 0.478},
+		{"teamId": 10, "teamFullName": "Toronto Maple Leafs", "seasonId": 20232024, "gamesPlayed": 82,
+		 "wins": 46, "losses": 26, "otLosses": 10, "points": 102, "pointPct": 0.62195,
+		 "goalsFor": 303, "goalsAgainst": 263, "powerPlayPct": 0.238, "faceoffWinPct": 0.515},
+		{"teamId": 6, "teamFullName": "Boston Bruins", "seasonId": 20232024, "gamesPlayed": 82,
+		 "wins": 47, "losses": 20, "otLosses": 15, "points": 109, "pointPct": 0.66463,
+		 "goalsFor": 267, "goalsAgainst": 224, "powerPlayPct": null, "faceoffWinPct": 0.52}
+	],
+	"total": 3
+}`
+
+func teamSummaryClient(t *testing.T) (*Client, *http.Request) {
+	t.Helper()
+	var got http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = *r
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(teamSummaryJSON))
+	}))
+	t.Cleanup(server.Close)
+	return NewClientWithBaseURL(server.URL), &got
+}
+
+func TestTeamSeasonStats(t *testing.T) {
+	client, req := teamSummaryClient(t)
+
+	stats, err := client.TeamSeasonStats(context.Background(), NewSeason(2023), GameTypePlayoffs)
+	if err != nil {
+		t.Fatalf("TeamSeasonStats() error = %v", err)
+	}
+	if len(stats) != 3 {
+		t.Fatalf("len(stats) = %d, want 3", len(stats))
+	}
+	if req.URL.Path != "/en/team/summary" {
+		t.Errorf("path = %q, want /en/team/summary", req.URL.Path)
+	}
+	wantExp := "seasonId>=20232024 and seasonId<=20232024 and gameTypeId=3"
+	if got := req.URL.Query().Get("cayenneExp"); got != wantExp {
+		t.Errorf("cayenneExp = %q, want %q", got, wantExp)
+	}
+
+	mtl, ok := stats.Find(NewTeamID(8))
+	if !ok || mtl.GoalsFor != 236 || mtl.GoalsAgainst != 289 {
+		t.Errorf("Find(8) = %+v, %v", mtl, ok)
+	}
+	if _, ok := stats.Find(NewTeamID(99)); ok {
+		t.Error("Find(99) should report false")
+	}
+}
+
+func TestTeamSeasonStats_SortBy(t *testing.T) {
+	client, _ := teamSummaryClient(t)
+	stats, err := client.TeamSeasonStats(context.Background(), NewSeason(2023), GameTypeRegularSeason)
+	if err != nil {
+		t.Fatalf("TeamSeasonStats() error = %v", err)
+	}
+
+	order := func() []TeamID {
+		ids := make([]TeamID, len(stats))
+		for i, row := range stats {
+			ids[i] = row.TeamID
+		}
+		return ids
+	}
+	tests := []struct {
+		field     string
+		direction SortDirection
+		want      []TeamID
+	}{
+		{"goalsFor", SortDescending, []TeamID{10, 6, 8}},
+		{"GoalsAgainst", SortAscending, []TeamID{6, 10, 8}},
+		{"powerPlayPct", SortDescending, []TeamID{10, 8, 6}},
+		{"powerPlayPct", SortAscending, []TeamID{8, 10, 6}},
+		{"teamFullName", SortAscending, []TeamID{6, 8, 10}},
+	}
+	for _, tt := range tests {
+		if err := stats.SortBy(tt.field, tt.direction); err != nil {
+			t.Fatalf("SortBy(%q) error = %v", tt.field, err)
+		}
+		got := order()
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("SortBy(%q, %s) = %v, want %v", tt.field, tt.direction, got, tt.want)
+				break
+			}
+		}
+	}
+
+	if err := stats.SortBy("hatTricks", SortDescending); err == nil {
+		t.Error("SortBy() should reject unknown fields")
+	}
+	if err := stats.SortBy("wins", "sideways"); err == nil {
+		t.Error("SortBy() should reject invalid directions")
+	}
+}
+
+func TestTeamSeasonStats_Error(t *testing.T) {
+	server := httptest.NewServer(makeErrorResponse(http.StatusInternalServerError))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	if _, err := client.TeamSeasonStats(context.Background(), NewSeason(2023), GameTypeRegularSeason); err == nil {
+		t.Error("TeamSeasonStats() should return the API error")
+	}
+}
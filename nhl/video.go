@@ -0,0 +1,16 @@
+package nhl
+
+import (
+	"context"
+)
+
+// GameVideos returns a game's published recap and condensed-game videos,
+// from the right-rail payload, as GameVideo.Assets orders them. The list
+// is empty until the league publishes them, typically after the game.
+func (c *Client) GameVideos(ctx context.Context, gameID GameID) ([]VideoAsset, error) {
+	rail, err := c.GameRightRail(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	return rail.GameVideo.Assets(), nil
+}
@@ -0,0 +1,50 @@
+package nhl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGameVideos(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gamecenter/2023020204/right-rail":
+			w.Write([]byte(`{"gameVideo": {"threeMinRecap": 101, "condensedGame": 201, "condensedGameFr": 202}}`))
+		case "/gamecenter/2023020900/right-rail":
+			w.Write([]byte(`{}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithBaseURL(server.URL)
+	ctx := context.Background()
+	videos, err := client.GameVideos(ctx, 2023020204)
+	if err != nil {
+		t.Fatalf("GameVideos() error = %v", err)
+	}
+	want := []VideoAsset{
+		{Kind: VideoRecap, Language: LanguageEnglish, ID: 101, URL: VideoURL(101)},
+		{Kind: VideoCondensedGame, Language: LanguageEnglish, ID: 201, URL: VideoURL(201)},
+		{Kind: VideoCondensedGame, Language: LanguageFrench, ID: 202, URL: VideoURL(202)},
+	}
+	if len(videos) != len(want) {
+		t.Fatalf("GameVideos() = %+v", videos)
+	}
+	for i := range want {
+		if videos[i] != want[i] {
+			t.Errorf("video %d = %+v, want %+v", i, videos[i], want[i])
+		}
+	}
+
+	videos, err = client.GameVideos(ctx, 2023020900)
+	if err != nil || len(videos) != 0 {
+		t.Errorf("GameVideos(future game) = %v, %v", videos, err)
+	}
+	if _, err := client.GameVideos(ctx, 2023020901); err == nil {
+		t.Error("GameVideos(missing game) error = nil")
+	}
+}
@@ -0,0 +1,245 @@
+package nhl
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// DefaultWatchInterval is the polling interval used by WatchGame when no
+// WithWatchInterval option is given.
+const DefaultWatchInterval = 10 * time.Second
+
+// DefaultWatchMaxAttempts is how many times in a row WatchGame offers a play
+// to a failing WithOnEvent handler or checkpoint before giving up, when no
+// WithWatchMaxAttempts option is given.
+const DefaultWatchMaxAttempts = 5
+
+// WatchOption configures WatchGame.
+type WatchOption func(*watchConfig)
+
+type watchConfig struct {
+	interval    time.Duration
+	maxAttempts int
+	onEvent     func(context.Context, PlayEvent) error
+	checkpoint  WatchCheckpoint
+}
+
+// WithWatchInterval sets how often WatchGame polls the play-by-play
+// endpoint. Non-positive values are ignored.
+func WithWatchInterval(interval time.Duration) WatchOption {
+	return func(c *watchConfig) {
+		if interval > 0 {
+			c.interval = interval
+		}
+	}
+}
+
+// WithWatchMaxAttempts sets how many times in a row WatchGame offers a play
+// whose handler or checkpoint keeps failing before it gives up. Non-positive
+// values are ignored.
+func WithWatchMaxAttempts(n int) WatchOption {
+	return func(c *watchConfig) {
+		if n > 0 {
+			c.maxAttempts = n
+		}
+	}
+}
+
+// WithOnEvent sets a handler that WatchGame calls for each new play before
+// delivering it on the events channel. A play counts as delivered only once
+// fn returns nil: on error, the error is reported on the errors channel and
+// the play and those after it are offered again on the next poll, in order,
+// even after the game is final. After DefaultWatchMaxAttempts failures in a
+// row (see WithWatchMaxAttempts) the watch ends with the last error.
+// Delivery is therefore at least once; with WithWatchCheckpoint it survives
+// restarts.
+func WithOnEvent(fn func(ctx context.Context, play PlayEvent) error) WatchOption {
+	return func(c *watchConfig) {
+		c.onEvent = fn
+	}
+}
+
+// WithWatchCheckpoint makes WatchGame record each delivered play in cp and
+// skip the plays cp already holds for the game, so a watch restarted after
+// a crash resumes where the previous one stopped. A play is recorded once
+// its WithOnEvent handler has succeeded and it has been received from the
+// events channel, so a crash in between delivers it again: handlers should
+// be idempotent, e.g. keyed by EventID. Failing to load the
+// checkpoint ends the watch with an error rather than replaying the game.
+func WithWatchCheckpoint(cp WatchCheckpoint) WatchOption {
+	return func(c *watchConfig) {
+		c.checkpoint = cp
+	}
+}
+
+// WatchCheckpoint persists the plays WatchGame has delivered.
+// Implementations must be safe for concurrent use; package checkpoint has
+// in-memory and file-backed ones.
+type WatchCheckpoint interface {
+	// Delivered returns the plays of the game recorded so far.
+	Delivered(gameID GameID) ([]PlayKey, error)
+	// MarkDelivered records a play of the game. Marking a play twice is
+	// not an error.
+	MarkDelivered(gameID GameID, key PlayKey) error
+}
+
+// WatchGame polls the play-by-play endpoint for a game and delivers each new
+// PlayEvent on the returned events channel, in sort order. Events already
+// delivered, identified by EventID and SortOrder, are not sent again.
+//
+// Polling errors do not stop the watch; they are sent on the errors channel,
+// which is buffered and never blocks the poller, so an error is dropped if
+// the previous one has not been received yet. Both channels are closed when
+// ctx is canceled or once the game is final and its remaining events have
+// been delivered.
+//
+// In delayed-data mode (WithConfigDataDelay) events are delivered only once
+// the delay has passed, and the watch continues until every event of a
+// finished game has been released.
+//
+// WithOnEvent and WithWatchCheckpoint turn the watch into an at-least-once
+// pipeline: plays are acknowledged by the handler, sent on the events
+// channel, then recorded in the checkpoint, and a watch restarted with the
+// same checkpoint replays only the plays that were not recorded. A play the
+// handler or checkpoint keeps rejecting ends the watch with its error once
+// the attempts run out; that error replaces any unread one on the errors
+// channel.
+func (c *Client) WatchGame(ctx context.Context, gameID GameID, opts ...WatchOption) (<-chan PlayEvent, <-chan error) {
+	cfg := watchConfig{interval: DefaultWatchInterval, maxAttempts: DefaultWatchMaxAttempts}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	events := make(chan PlayEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		seen := make(map[PlayKey]bool)
+		if cfg.checkpoint != nil {
+			keys, err := cfg.checkpoint.Delivered(gameID)
+			if err != nil {
+				errs <- fmt.Errorf("loading watch checkpoint of game %d: %w", gameID, err)
+				return
+			}
+			for _, key := range keys {
+				seen[key] = true
+			}
+		}
+		report := func(err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		}
+		// failing is the play whose delivery last failed and attempts the
+		// number of failures in a row.
+		var failing PlayKey
+		attempts := 0
+
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+
+			pbp, err := c.PlayByPlay(ctx, gameID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				report(err)
+				timer.Reset(cfg.interval)
+				continue
+			}
+
+			pending := false
+			for _, play := range newPlays(pbp.Plays, seen) {
+				err := cfg.handle(ctx, gameID, play)
+				if err == nil {
+					select {
+					case events <- play:
+					case <-ctx.Done():
+						return
+					}
+					err = cfg.record(gameID, play)
+				}
+				if err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					if play.Key() != failing {
+						failing, attempts = play.Key(), 0
+					}
+					attempts++
+					if attempts >= cfg.maxAttempts {
+						select {
+						case <-errs:
+						default:
+						}
+						errs <- fmt.Errorf("watch of game %d gave up after %d attempts: %w", gameID, attempts, err)
+						return
+					}
+					report(err)
+					pending = true
+					break
+				}
+				seen[play.Key()] = true
+				attempts = 0
+			}
+
+			if pbp.GameState.IsFinal() && !pending {
+				return
+			}
+			timer.Reset(cfg.interval)
+		}
+	}()
+
+	return events, errs
+}
+
+// handle hands play to the OnEvent handler.
+func (cfg *watchConfig) handle(ctx context.Context, gameID GameID, play PlayEvent) error {
+	if cfg.onEvent != nil {
+		if err := cfg.onEvent(ctx, play); err != nil {
+			return fmt.Errorf("handling play %d of game %d: %w", play.EventID, gameID, err)
+		}
+	}
+	return nil
+}
+
+// record marks play as delivered in the checkpoint.
+func (cfg *watchConfig) record(gameID GameID, play PlayEvent) error {
+	if cfg.checkpoint != nil {
+		if err := cfg.checkpoint.MarkDelivered(gameID, play.Key()); err != nil {
+			return fmt.Errorf("recording play %d of game %d: %w", play.EventID, gameID, err)
+		}
+	}
+	return nil
+}
+
+// newPlays returns the plays not in seen, once each and ordered by
+// SortOrder. The caller records them in seen as they are delivered.
+func newPlays(plays []PlayEvent, seen map[PlayKey]bool) []PlayEvent {
+	var fresh []PlayEvent
+	batch := make(map[PlayKey]bool)
+	for _, play := range plays {
+		key := play.Key()
+		if seen[key] || batch[key] {
+			continue
+		}
+		batch[key] = true
+		fresh = append(fresh, play)
+	}
+	slices.SortStableFunc(fresh, func(a, b PlayEvent) int { return cmp.Compare(a.SortOrder, b.SortOrder) })
+	return fresh
+}
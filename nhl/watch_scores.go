@@ -0,0 +1,81 @@
+package nhl
+
+import (
+	"context"
+	"time"
+)
+
+// WatchDailyScores polls DailyScores for date every interval (or
+// DefaultWatchInterval when interval is not positive) and sends the
+// DiffScores changes between successive polls. The first poll only sets
+// the baseline.
+//
+// As with WatchGame, polling errors are sent on the buffered errors
+// channel without stopping the watch, and both channels are closed when
+// ctx is canceled or once every game of the day is final and its updates
+// have been delivered. A day without games closes after the first poll.
+func (c *Client) WatchDailyScores(ctx context.Context, date GameDate, interval time.Duration) (<-chan ScoreUpdate, <-chan error) {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	updates := make(chan ScoreUpdate)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(updates)
+		defer close(errs)
+
+		var previous *DailyScores
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+
+			current, err := c.DailyScores(ctx, date)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				select {
+				case errs <- err:
+				default:
+				}
+				timer.Reset(interval)
+				continue
+			}
+
+			for _, update := range DiffScores(previous, current) {
+				select {
+				case updates <- update:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if allFinal(current.Games) {
+				return
+			}
+			previous = current
+			timer.Reset(interval)
+		}
+	}()
+
+	return updates, errs
+}
+
+// allFinal reports whether every game is final, which includes a day
+// without games.
+func allFinal(games []GameScore) bool {
+	for _, g := range games {
+		if !g.GameState.IsFinal() {
+			return false
+		}
+	}
+	return true
+}
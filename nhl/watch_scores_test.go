@@ -0,0 +1,119 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// scoreGame is one game of a daily scores payload: state, period and the
+// away and home scores. A period of 0 omits the period descriptor and a
+// negative score omits the score.
+type scoreGame struct {
+	id         int64
+	state      string
+	period     int
+	away, home int
+}
+
+func scoresJSON(games ...scoreGame) string {
+	parts := make([]string, len(games))
+	for i, g := range games {
+		team := func(id int, abbrev string, score int) string {
+			if score < 0 {
+				return fmt.Sprintf(`{"id":%d,"abbrev":%q}`, id, abbrev)
+			}
+			return fmt.Sprintf(`{"id":%d,"abbrev":%q,"score":%d}`, id, abbrev, score)
+		}
+		period := ""
+		if g.period > 0 {
+			periodType := "REG"
+			if g.period == 4 {
+				periodType = "OT"
+			}
+			period = fmt.Sprintf(`,"periodDescriptor":{"number":%d,"periodType":%q}`, g.period, periodType)
+		}
+		parts[i] = fmt.Sprintf(`{"id":%d,"gameType":2,"gameState":%q,"awayTeam":%s,"homeTeam":%s%s}`,
+			g.id, g.state, team(8, "MTL", g.away), team(10, "TOR", g.home), period)
+	}
+	return fmt.Sprintf(`{"currentDate":"2024-01-15","games":[%s]}`, strings.Join(parts, ","))
+}
+
+func collectUpdates(t *testing.T, updates <-chan ScoreUpdate) []string {
+	t.Helper()
+	var got []string
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case u, ok := <-updates:
+			if !ok {
+				return got
+			}
+			got = append(got, fmt.Sprintf("%s %s", u.Kind, u.Team))
+		case <-timeout:
+			t.Fatal("timed out waiting for updates channel to close")
+		}
+	}
+}
+
+func TestWatchDailyScores(t *testing.T) {
+	server, calls := sequenceServer(t,
+		scoresJSON(scoreGame{1, "FUT", 0, -1, -1}),
+		scoresJSON(scoreGame{1, "LIVE", 1, 0, 0}),
+		scoresJSON(scoreGame{1, "LIVE", 1, 1, 0}),
+		"",
+		scoresJSON(scoreGame{1, "LIVE", 2, 1, 2}),
+		scoresJSON(scoreGame{1, "CRIT", 4, 2, 2}),
+		scoresJSON(scoreGame{1, "OFF", 4, 3, 2}),
+	)
+	client := NewClientWithBaseURL(server.URL)
+
+	updates, errs := client.WatchDailyScores(context.Background(), FromYMD(2024, 1, 15), time.Millisecond)
+	got := collectUpdates(t, updates)
+
+	want := []string{
+		"game-start ",
+		"goal MTL",
+		"goal TOR", "goal TOR", "period-change ",
+		"goal MTL", "period-change ",
+		"goal MTL", "game-end ",
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("updates =\n%v\nwant\n%v", got, want)
+	}
+	if err := <-errs; err == nil {
+		t.Error("the failed poll should be reported on the errors channel")
+	}
+	if n := atomic.LoadInt32(calls); n != 7 {
+		t.Errorf("polls = %d, want 7", n)
+	}
+}
+
+func TestWatchDailyScores_NoGames(t *testing.T) {
+	server, calls := sequenceServer(t, `{"currentDate":"2024-07-15","games":[]}`)
+	client := NewClientWithBaseURL(server.URL)
+
+	updates, _ := client.WatchDailyScores(context.Background(), FromYMD(2024, 7, 15), time.Millisecond)
+	if got := collectUpdates(t, updates); len(got) != 0 {
+		t.Errorf("updates = %v, want none", got)
+	}
+	if n := atomic.LoadInt32(calls); n != 1 {
+		t.Errorf("polls = %d, want 1", n)
+	}
+}
+
+func TestWatchDailyScores_Cancel(t *testing.T) {
+	server, _ := sequenceServer(t, scoresJSON(scoreGame{1, "LIVE", 1, 0, 0}))
+	client := NewClientWithBaseURL(server.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, errs := client.WatchDailyScores(ctx, FromYMD(2024, 1, 15), time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	collectUpdates(t, updates)
+	for range errs {
+	}
+}
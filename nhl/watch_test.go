@@ -0,0 +1,290 @@
+package nhl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// pbpJSON builds a minimal play-by-play payload. Each play is an
+// eventID/sortOrder pair.
+func pbpJSON(state string, plays ...[2]int) string {
+	parts := make([]string, len(plays))
+	for i, p := range plays {
+		parts[i] = fmt.Sprintf(`{"eventId":%d,"sortOrder":%d,"typeDescKey":"faceoff","periodDescriptor":{"number":1,"periodType":"REG"}}`, p[0], p[1])
+	}
+	return fmt.Sprintf(`{"id":2023020001,"season":20232024,"gameType":2,"gameState":%q,"gameScheduleState":"OK","plays":[%s]}`,
+		state, strings.Join(parts, ","))
+}
+
+// sequenceServer serves the given responses in order, repeating the last.
+// An empty response string produces a 500.
+func sequenceServer(t *testing.T, responses ...string) (*httptest.Server, *int32) {
+	t.Helper()
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&calls, 1)) - 1
+		if n >= len(responses) {
+			n = len(responses) - 1
+		}
+		if responses[n] == "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, responses[n])
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func collectEvents(t *testing.T, events <-chan PlayEvent) []PlayEvent {
+	t.Helper()
+	var got []PlayEvent
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return got
+			}
+			got = append(got, ev)
+		case <-timeout:
+			t.Fatal("timed out waiting for events channel to close")
+		}
+	}
+}
+
+func TestWatchGame_DeliversNewEventsUntilFinal(t *testing.T) {
+	server, calls := sequenceServer(t,
+		pbpJSON("LIVE", [2]int{2, 20}, [2]int{1, 10}),
+		pbpJSON("LIVE", [2]int{1, 10}, [2]int{2, 20}),
+		pbpJSON("LIVE", [2]int{1, 10}, [2]int{2, 20}, [2]int{3, 30}),
+		pbpJSON("OFF", [2]int{1, 10}, [2]int{2, 20}, [2]int{3, 30}, [2]int{4, 40}),
+	)
+	client := NewClientWithBaseURL(server.URL)
+
+	events, _ := client.WatchGame(context.Background(), GameID(2023020001), WithWatchInterval(time.Millisecond))
+	got := collectEvents(t, events)
+
+	var ids []int64
+	for _, ev := range got {
+		ids = append(ids, ev.EventID)
+	}
+	if fmt.Sprint(ids) != "[1 2 3 4]" {
+		t.Errorf("event IDs = %v, want [1 2 3 4]", ids)
+	}
+	if n := atomic.LoadInt32(calls); n != 4 {
+		t.Errorf("polled %d times, want 4", n)
+	}
+}
+
+func TestWatchGame_ResequencedEventIsRedelivered(t *testing.T) {
+	server, _ := sequenceServer(t,
+		pbpJSON("LIVE", [2]int{1, 10}),
+		pbpJSON("FINAL", [2]int{1, 15}),
+	)
+	client := NewClientWithBaseURL(server.URL)
+
+	events, _ := client.WatchGame(context.Background(), GameID(2023020001), WithWatchInterval(time.Millisecond))
+	got := collectEvents(t, events)
+	if len(got) != 2 || got[1].SortOrder != 15 {
+		t.Errorf("got %d events, want original and re-sequenced event", len(got))
+	}
+}
+
+func TestWatchGame_ErrorsDoNotStopWatch(t *testing.T) {
+	server, _ := sequenceServer(t,
+		"",
+		pbpJSON("OFF", [2]int{1, 10}),
+	)
+	client := NewClientWithBaseURL(server.URL)
+
+	events, errs := client.WatchGame(context.Background(), GameID(2023020001), WithWatchInterval(time.Millisecond))
+	got := collectEvents(t, events)
+	if len(got) != 1 {
+		t.Errorf("got %d events, want 1", len(got))
+	}
+
+	err, ok := <-errs
+	if !ok || err == nil {
+		t.Fatal("expected polling error on errors channel")
+	}
+	if _, ok := <-errs; ok {
+		t.Error("errors channel not closed")
+	}
+}
+
+func TestWatchGame_StopsOnCancel(t *testing.T) {
+	server, _ := sequenceServer(t, pbpJSON("LIVE", [2]int{1, 10}))
+	client := NewClientWithBaseURL(server.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, _ := client.WatchGame(ctx, GameID(2023020001), WithWatchInterval(time.Millisecond))
+
+	if ev := <-events; ev.EventID != 1 {
+		t.Fatalf("first event ID = %d, want 1", ev.EventID)
+	}
+	cancel()
+	if got := collectEvents(t, events); len(got) != 0 {
+		t.Errorf("got %d events after cancel, want 0", len(got))
+	}
+}
+
+func TestWithWatchInterval(t *testing.T) {
+	cfg := watchConfig{interval: DefaultWatchInterval}
+	WithWatchInterval(0)(&cfg)
+	if cfg.interval != DefaultWatchInterval {
+		t.Errorf("interval = %v, want default after non-positive option", cfg.interval)
+	}
+	WithWatchInterval(time.Second)(&cfg)
+	if cfg.interval != time.Second {
+		t.Errorf("interval = %v, want 1s", cfg.interval)
+	}
+}
+
+// memCheckpoint is a minimal WatchCheckpoint; package checkpoint imports
+// nhl, so its implementations cannot be used here.
+type memCheckpoint struct {
+	mu   sync.Mutex
+	keys []PlayKey
+	err  error
+}
+
+func (m *memCheckpoint) Delivered(GameID) ([]PlayKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]PlayKey(nil), m.keys...), m.err
+}
+
+func (m *memCheckpoint) MarkDelivered(_ GameID, key PlayKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.keys = append(m.keys, key)
+	return nil
+}
+
+func TestWatchGame_OnEventRetriesFailedPlays(t *testing.T) {
+	server, calls := sequenceServer(t,
+		pbpJSON("LIVE", [2]int{1, 10}, [2]int{2, 20}, [2]int{3, 30}),
+		pbpJSON("OFF", [2]int{1, 10}, [2]int{2, 20}, [2]int{3, 30}),
+	)
+	client := NewClientWithBaseURL(server.URL)
+
+	var handled []int64
+	failures := 2
+	onEvent := func(_ context.Context, play PlayEvent) error {
+		if play.EventID == 2 && failures > 0 {
+			failures--
+			return fmt.Errorf("sink unavailable")
+		}
+		handled = append(handled, play.EventID)
+		return nil
+	}
+	events, errs := client.WatchGame(context.Background(), GameID(2023020001),
+		WithWatchInterval(time.Millisecond), WithOnEvent(onEvent))
+	got := collectEvents(t, events)
+
+	var ids []int64
+	for _, ev := range got {
+		ids = append(ids, ev.EventID)
+	}
+	if fmt.Sprint(ids) != "[1 2 3]" || fmt.Sprint(handled) != "[1 2 3]" {
+		t.Errorf("delivered %v, handled %v, want [1 2 3] each", ids, handled)
+	}
+	if n := atomic.LoadInt32(calls); n != 3 {
+		t.Errorf("polled %d times, want a retry past the final snapshot", n)
+	}
+	if err := <-errs; err == nil || !strings.Contains(err.Error(), "sink unavailable") {
+		t.Errorf("error = %v, want handler error", err)
+	}
+}
+
+func TestWatchGame_GivesUpOnFailingHandler(t *testing.T) {
+	server, calls := sequenceServer(t, pbpJSON("OFF", [2]int{1, 10}, [2]int{2, 20}))
+	client := NewClientWithBaseURL(server.URL)
+
+	onEvent := func(_ context.Context, play PlayEvent) error {
+		if play.EventID == 2 {
+			return fmt.Errorf("sink unavailable")
+		}
+		return nil
+	}
+	events, errs := client.WatchGame(context.Background(), GameID(2023020001),
+		WithWatchInterval(time.Millisecond), WithOnEvent(onEvent), WithWatchMaxAttempts(3))
+	if got := collectEvents(t, events); len(got) != 1 || got[0].EventID != 1 {
+		t.Errorf("delivered %v, want only play 1", got)
+	}
+	if n := atomic.LoadInt32(calls); n != 3 {
+		t.Errorf("polled %d times, want 3", n)
+	}
+	err := <-errs
+	if err == nil || !strings.Contains(err.Error(), "gave up after 3 attempts") || !strings.Contains(err.Error(), "sink unavailable") {
+		t.Errorf("error = %v, want the final handler error", err)
+	}
+}
+
+func TestWatchGame_RecordsAfterSend(t *testing.T) {
+	server, _ := sequenceServer(t, pbpJSON("LIVE", [2]int{1, 10}))
+	client := NewClientWithBaseURL(server.URL)
+	cp := &memCheckpoint{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	onEvent := func(context.Context, PlayEvent) error {
+		cancel()
+		return nil
+	}
+	events, _ := client.WatchGame(ctx, GameID(2023020001), WithOnEvent(onEvent), WithWatchCheckpoint(cp))
+	// Give the watcher time to block on the send before draining.
+	time.Sleep(20 * time.Millisecond)
+	for range events {
+	}
+	if keys, _ := cp.Delivered(0); len(keys) != 0 {
+		t.Errorf("checkpoint = %v, want the unreceived play left unrecorded", keys)
+	}
+}
+
+func TestWatchGame_ResumesFromCheckpoint(t *testing.T) {
+	server, _ := sequenceServer(t, pbpJSON("OFF", [2]int{1, 10}, [2]int{2, 20}, [2]int{3, 30}))
+	client := NewClientWithBaseURL(server.URL)
+	cp := &memCheckpoint{keys: []PlayKey{{EventID: 1, SortOrder: 10}, {EventID: 2, SortOrder: 20}}}
+
+	var handled []int64
+	onEvent := func(_ context.Context, play PlayEvent) error {
+		handled = append(handled, play.EventID)
+		return nil
+	}
+	events, _ := client.WatchGame(context.Background(), GameID(2023020001),
+		WithWatchInterval(time.Millisecond), WithOnEvent(onEvent), WithWatchCheckpoint(cp))
+	got := collectEvents(t, events)
+
+	if len(got) != 1 || got[0].EventID != 3 || fmt.Sprint(handled) != "[3]" {
+		t.Errorf("delivered %d events, handled %v, want only play 3", len(got), handled)
+	}
+	if keys, _ := cp.Delivered(0); len(keys) != 3 || keys[2] != (PlayKey{EventID: 3, SortOrder: 30}) {
+		t.Errorf("checkpoint = %v, want play 3 recorded", keys)
+	}
+}
+
+func TestWatchGame_CheckpointLoadError(t *testing.T) {
+	server, calls := sequenceServer(t, pbpJSON("OFF", [2]int{1, 10}))
+	client := NewClientWithBaseURL(server.URL)
+	cp := &memCheckpoint{err: fmt.Errorf("disk on fire")}
+
+	events, errs := client.WatchGame(context.Background(), GameID(2023020001), WithWatchCheckpoint(cp))
+	if got := collectEvents(t, events); len(got) != 0 {
+		t.Errorf("got %d events, want none", len(got))
+	}
+	if err := <-errs; err == nil {
+		t.Error("expected checkpoint error")
+	}
+	if n := atomic.LoadInt32(calls); n != 0 {
+		t.Errorf("polled %d times, want 0", n)
+	}
+}
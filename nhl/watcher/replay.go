@@ -0,0 +1,123 @@
+// Package watcher provides tools for consuming a game's play-by-play as a
+// stream of events.
+//
+// Replay streams a completed game with its original pacing so that live
+// UIs built on Client.WatchGame can be exercised at any time of year; the
+// two share the same channel-based shape.
+//
+// GameTracker compares successive polls of a live game and reports period
+// starts, goals, penalties and the final whistle through callbacks,
+// including goals amended or withdrawn between polls.
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// Replay emits the plays of pbp on the returned events channel in sort
+// order, spaced as they were on the game clock. speed scales the pacing:
+// 1 replays in game-clock time, 60 plays one game minute per second, and
+// math.Inf(1) emits everything without waiting.
+//
+// Pacing follows the game clock, which stops during stoppages and
+// intermissions, so plays that happened at the same clock time (a penalty
+// and the ensuing faceoff, say) are emitted back to back, and a new period
+// starts right after the previous one ended.
+//
+// A non-positive speed or a play with an unreadable time is reported on
+// the errors channel, which is buffered, and ends the replay. Both channels
+// are closed when every play has been delivered, on error, or when ctx is
+// canceled.
+func Replay(ctx context.Context, pbp *model.PlayByPlay, speed float64) (<-chan model.PlayEvent, <-chan error) {
+	events := make(chan model.PlayEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		if !(speed > 0) {
+			errs <- fmt.Errorf("watcher: replay speed must be positive, got %v", speed)
+			return
+		}
+
+		plays := append([]model.PlayEvent(nil), pbp.Plays...)
+		sort.SliceStable(plays, func(i, j int) bool { return plays[i].SortOrder < plays[j].SortOrder })
+
+		var prev *model.PlayEvent
+		for i := range plays {
+			play := &plays[i]
+			gap, err := clockGap(prev, play)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if !sleep(ctx, time.Duration(float64(gap)/speed)) {
+				return
+			}
+
+			select {
+			case events <- *play:
+			case <-ctx.Done():
+				return
+			}
+			prev = play
+		}
+	}()
+
+	return events, errs
+}
+
+// sleep waits for d, returning false if ctx is canceled first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// clockGap returns the game-clock time that elapsed between prev and play.
+// Within a period that is the difference of their elapsed times; across a
+// period boundary it is the time elapsed in play's period.
+func clockGap(prev, play *model.PlayEvent) (time.Duration, error) {
+	at, err := parseClock(play.TimeInPeriod)
+	if err != nil {
+		return 0, fmt.Errorf("watcher: play %d: %w", play.EventID, err)
+	}
+	if prev == nil || prev.PeriodDescriptor.Number != play.PeriodDescriptor.Number {
+		if prev == nil {
+			return 0, nil
+		}
+		return at, nil
+	}
+	before, err := parseClock(prev.TimeInPeriod)
+	if err != nil {
+		return 0, fmt.Errorf("watcher: play %d: %w", prev.EventID, err)
+	}
+	if at < before {
+		return 0, nil
+	}
+	return at - before, nil
+}
+
+// parseClock parses an elapsed period time in MM:SS form. An empty time is
+// treated as the start of the period.
+func parseClock(s string) (time.Duration, error) {
+	t, err := model.ParseTOI(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time in period %q", s)
+	}
+	return t.Duration(), nil
+}
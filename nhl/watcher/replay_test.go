@@ -0,0 +1,155 @@
+package watcher
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func play(eventID int64, sortOrder, period int, timeInPeriod string) model.PlayEvent {
+	return model.PlayEvent{
+		EventID:          eventID,
+		SortOrder:        sortOrder,
+		TimeInPeriod:     timeInPeriod,
+		PeriodDescriptor: model.PeriodDescriptor{Number: period, PeriodType: model.PeriodTypeRegulation},
+	}
+}
+
+func collect(t *testing.T, events <-chan model.PlayEvent, errs <-chan error) ([]model.PlayEvent, error) {
+	t.Helper()
+	var got []model.PlayEvent
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return got, <-errs
+			}
+			got = append(got, ev)
+		case <-timeout:
+			t.Fatal("timed out waiting for the replay to finish")
+		}
+	}
+}
+
+func TestReplayOrder(t *testing.T) {
+	pbp := &model.PlayByPlay{Plays: []model.PlayEvent{
+		play(3, 30, 1, "05:00"),
+		play(1, 10, 1, "00:00"),
+		play(4, 40, 2, "00:00"),
+		play(2, 20, 1, "01:30"),
+	}}
+
+	events, errs := Replay(context.Background(), pbp, math.Inf(1))
+	got, err := collect(t, events, errs)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	var ids []int64
+	for _, ev := range got {
+		ids = append(ids, ev.EventID)
+	}
+	if len(ids) != 4 || ids[0] != 1 || ids[1] != 2 || ids[2] != 3 || ids[3] != 4 {
+		t.Errorf("events = %v, want [1 2 3 4]", ids)
+	}
+	if pbp.Plays[0].EventID != 3 {
+		t.Error("Replay should not reorder the caller's plays")
+	}
+}
+
+func TestReplayPacing(t *testing.T) {
+	// Six game seconds at 60x is 100ms; the period change costs only the
+	// three seconds elapsed in period 2, another 50ms.
+	pbp := &model.PlayByPlay{Plays: []model.PlayEvent{
+		play(1, 10, 1, "19:54"),
+		play(2, 20, 1, "20:00"),
+		play(3, 30, 2, "00:03"),
+	}}
+
+	start := time.Now()
+	events, errs := Replay(context.Background(), pbp, 60)
+	var at []time.Duration
+	for range events {
+		at = append(at, time.Since(start))
+	}
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+	if len(at) != 3 {
+		t.Fatalf("got %d events, want 3", len(at))
+	}
+	if gap := at[1] - at[0]; gap < 90*time.Millisecond {
+		t.Errorf("gap within period = %v, want about 100ms", gap)
+	}
+	if gap := at[2] - at[1]; gap < 45*time.Millisecond || gap > 500*time.Millisecond {
+		t.Errorf("gap across periods = %v, want about 50ms", gap)
+	}
+}
+
+func TestReplayCanceled(t *testing.T) {
+	pbp := &model.PlayByPlay{Plays: []model.PlayEvent{
+		play(1, 10, 1, "00:00"),
+		play(2, 20, 1, "10:00"),
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errs := Replay(ctx, pbp, 1)
+	if ev := <-events; ev.EventID != 1 {
+		t.Fatalf("first event = %d, want 1", ev.EventID)
+	}
+	cancel()
+
+	got, err := collect(t, events, errs)
+	if len(got) != 0 || err != nil {
+		t.Errorf("after cancel got %d events and error %v, want none", len(got), err)
+	}
+}
+
+func TestReplayErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		plays []model.PlayEvent
+		speed float64
+		want  string
+	}{
+		{"zero speed", nil, 0, "speed must be positive"},
+		{"NaN speed", nil, math.NaN(), "speed must be positive"},
+		{"bad clock", []model.PlayEvent{play(1, 10, 1, "00:00"), play(7, 20, 1, "5m")}, math.Inf(1), "play 7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, errs := Replay(context.Background(), &model.PlayByPlay{Plays: tt.plays}, tt.speed)
+			_, err := collect(t, events, errs)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("err = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"00:00", 0, false},
+		{"12:34", 12*time.Minute + 34*time.Second, false},
+		{"20:00", 20 * time.Minute, false},
+		{"1234", 0, true},
+		{"12:60", 0, true},
+		{"-1:00", 0, true},
+		{"ab:cd", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseClock(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseClock(%q) = %v, %v; want %v, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,383 @@
+package watcher
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+// EventKind is the kind of change reported by a GameTracker.
+type EventKind int
+
+const (
+	// PeriodStarted reports a new period, including overtime and the
+	// shootout.
+	PeriodStarted EventKind = iota + 1
+	// GoalScored reports a goal seen for the first time.
+	GoalScored
+	// GoalAmended reports a goal whose scorer, assists, team or score
+	// changed since the previous snapshot.
+	GoalAmended
+	// GoalRemoved reports a goal that disappeared or a score that went
+	// down, typically a goal overturned on review.
+	GoalRemoved
+	// PenaltyCalled reports a penalty seen for the first time.
+	PenaltyCalled
+	// PenaltyRemoved reports a penalty that disappeared from the feed.
+	PenaltyRemoved
+	// GameFinal reports a game that became final. It is reported once.
+	GameFinal
+)
+
+// String returns the kind name.
+func (k EventKind) String() string {
+	switch k {
+	case PeriodStarted:
+		return "period-started"
+	case GoalScored:
+		return "goal-scored"
+	case GoalAmended:
+		return "goal-amended"
+	case GoalRemoved:
+		return "goal-removed"
+	case PenaltyCalled:
+		return "penalty-called"
+	case PenaltyRemoved:
+		return "penalty-removed"
+	case GameFinal:
+		return "game-final"
+	default:
+		return fmt.Sprintf("EventKind(%d)", int(k))
+	}
+}
+
+// Event is one change to a game between two snapshots.
+type Event struct {
+	Kind   EventKind
+	GameID model.GameID
+	// Period is the period of the event; for GameFinal, the last period.
+	Period     int
+	PeriodType model.PeriodType
+	// Team is the abbreviation of the team concerned by a goal or penalty,
+	// or "" when unknown.
+	Team string
+	// Play is the play behind a goal or penalty event when tracking
+	// play-by-play; for removals it is the play as last seen. It is nil
+	// for other events and when tracking boxscores.
+	Play *model.PlayEvent
+	// AwayScore and HomeScore are the score after the event, as far as the
+	// snapshot tells.
+	AwayScore int
+	HomeScore int
+}
+
+// GameTracker turns successive snapshots of one game into events.
+//
+// The play-by-play feed is not append-only: plays arrive out of order,
+// and goals and penalties are corrected or withdrawn between polls. The
+// tracker keys goals and penalties by event ID and compares each snapshot
+// with the previous one, so a corrected goal is reported as GoalAmended
+// and a withdrawn one as GoalRemoved rather than being missed or counted
+// twice. Highlight clips and other details that fill in later do not
+// count as amendments.
+//
+// Feed a tracker either play-by-play or boxscore snapshots, not both:
+// boxscores carry no plays, so goals are read from the score and reported
+// without a Play, and penalties are not reported.
+//
+// A GameTracker is safe for concurrent use. Handlers run synchronously on
+// the goroutine that calls Update, after the tracker state is updated, so
+// they may call back into the tracker.
+type GameTracker struct {
+	gameID model.GameID
+
+	mu        sync.Mutex
+	handlers  map[EventKind][]func(Event)
+	period    int
+	goals     map[int64]model.PlayEvent
+	penalties map[int64]model.PlayEvent
+	away      int
+	home      int
+	final     bool
+}
+
+// NewGameTracker returns a tracker for the given game.
+func NewGameTracker(gameID model.GameID) *GameTracker {
+	return &GameTracker{
+		gameID:    gameID,
+		handlers:  make(map[EventKind][]func(Event)),
+		goals:     make(map[int64]model.PlayEvent),
+		penalties: make(map[int64]model.PlayEvent),
+	}
+}
+
+// On registers fn to be called for every event of the given kind.
+// Handlers of a kind run in registration order.
+func (t *GameTracker) On(kind EventKind, fn func(Event)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.handlers[kind] = append(t.handlers[kind], fn)
+}
+
+// UpdatePlayByPlay compares pbp with the previous snapshot, calls the
+// handlers for each change and returns the changes. Plays are considered
+// in sort order, so within an update events come in game order, followed
+// by removals and then GameFinal. A snapshot of another game is an error.
+func (t *GameTracker) UpdatePlayByPlay(pbp *model.PlayByPlay) ([]Event, error) {
+	if pbp == nil {
+		return nil, fmt.Errorf("watcher: nil play-by-play")
+	}
+	if pbp.ID != t.gameID {
+		return nil, fmt.Errorf("watcher: play-by-play of game %d sent to the tracker of game %d", pbp.ID, t.gameID)
+	}
+
+	t.mu.Lock()
+	var events []Event
+	add := func(kind EventKind, play *model.PlayEvent) {
+		ev := Event{
+			Kind:       kind,
+			GameID:     t.gameID,
+			Period:     play.PeriodDescriptor.Number,
+			PeriodType: play.PeriodDescriptor.PeriodType,
+			Play:       play,
+			AwayScore:  t.away,
+			HomeScore:  t.home,
+		}
+		if d := play.Details; d != nil {
+			if d.EventOwnerTeamID != nil {
+				ev.Team = teamAbbrev(pbp, *d.EventOwnerTeamID)
+			}
+			if d.AwayScore != nil && d.HomeScore != nil && kind != GoalRemoved {
+				ev.AwayScore, ev.HomeScore = *d.AwayScore, *d.HomeScore
+			}
+		}
+		events = append(events, ev)
+	}
+
+	plays := append([]model.PlayEvent(nil), pbp.Plays...)
+	sort.SliceStable(plays, func(i, j int) bool { return plays[i].SortOrder < plays[j].SortOrder })
+	goals := make(map[int64]model.PlayEvent)
+	penalties := make(map[int64]model.PlayEvent)
+	for i := range plays {
+		play := &plays[i]
+		for p := t.period + 1; p <= play.PeriodDescriptor.Number; p++ {
+			events = append(events, t.periodEvent(p, play.PeriodDescriptor))
+		}
+		t.period = max(t.period, play.PeriodDescriptor.Number)
+
+		switch play.TypeDescKey {
+		case model.PlayEventTypeGoal:
+			goals[play.EventID] = *play
+			if prev, ok := t.goals[play.EventID]; !ok {
+				add(GoalScored, play)
+			} else if goalKey(prev) != goalKey(*play) {
+				add(GoalAmended, play)
+			}
+		case model.PlayEventTypePenalty:
+			penalties[play.EventID] = *play
+			if prev, ok := t.penalties[play.EventID]; !ok {
+				add(PenaltyCalled, play)
+			} else if penaltyKey(prev) != penaltyKey(*play) {
+				// A corrected penalty is withdrawn and called again.
+				add(PenaltyRemoved, &prev)
+				add(PenaltyCalled, play)
+			}
+		}
+	}
+	if pbp.GameState.HasStarted() {
+		for p := t.period + 1; p <= pbp.PeriodDescriptor.Number; p++ {
+			events = append(events, t.periodEvent(p, pbp.PeriodDescriptor))
+		}
+		t.period = max(t.period, pbp.PeriodDescriptor.Number)
+	}
+
+	t.away, t.home = pbp.AwayTeam.Score, pbp.HomeTeam.Score
+	for _, id := range removed(t.goals, goals) {
+		prev := t.goals[id]
+		add(GoalRemoved, &prev)
+	}
+	for _, id := range removed(t.penalties, penalties) {
+		prev := t.penalties[id]
+		add(PenaltyRemoved, &prev)
+	}
+	t.goals, t.penalties = goals, penalties
+
+	if pbp.GameState.IsFinal() && !t.final {
+		t.final = true
+		events = append(events, t.finalEvent(pbp.PeriodDescriptor))
+	}
+	handlers := t.handlersFor(events)
+	t.mu.Unlock()
+
+	dispatch(events, handlers)
+	return events, nil
+}
+
+// UpdateBoxscore compares box with the previous snapshot, calls the
+// handlers for each change and returns the changes, in the order period
+// start, goals, removals, final. Goals are read from the score: two goals
+// by a team between snapshots yield two GoalScored events without a Play.
+// A snapshot of another game is an error.
+func (t *GameTracker) UpdateBoxscore(box *model.Boxscore) ([]Event, error) {
+	if box == nil {
+		return nil, fmt.Errorf("watcher: nil boxscore")
+	}
+	if box.ID != t.gameID {
+		return nil, fmt.Errorf("watcher: boxscore of game %d sent to the tracker of game %d", box.ID, t.gameID)
+	}
+
+	t.mu.Lock()
+	var events []Event
+	if box.GameState.HasStarted() {
+		for p := t.period + 1; p <= box.PeriodDescriptor.Number; p++ {
+			events = append(events, t.periodEvent(p, box.PeriodDescriptor))
+		}
+		t.period = max(t.period, box.PeriodDescriptor.Number)
+	}
+
+	var removals []Event
+	score := func(prev *int, cur int, team string) {
+		for *prev != cur {
+			kind := GoalScored
+			if cur > *prev {
+				*prev++
+			} else {
+				*prev--
+				kind = GoalRemoved
+			}
+			ev := Event{
+				Kind:       kind,
+				GameID:     t.gameID,
+				Period:     box.PeriodDescriptor.Number,
+				PeriodType: box.PeriodDescriptor.PeriodType,
+				Team:       team,
+				AwayScore:  box.AwayTeam.Score,
+				HomeScore:  box.HomeTeam.Score,
+			}
+			if kind == GoalRemoved {
+				removals = append(removals, ev)
+			} else {
+				events = append(events, ev)
+			}
+		}
+	}
+	score(&t.away, box.AwayTeam.Score, box.AwayTeam.Abbrev)
+	score(&t.home, box.HomeTeam.Score, box.HomeTeam.Abbrev)
+	events = append(events, removals...)
+
+	if box.GameState.IsFinal() && !t.final {
+		t.final = true
+		events = append(events, t.finalEvent(box.PeriodDescriptor))
+	}
+	handlers := t.handlersFor(events)
+	t.mu.Unlock()
+
+	dispatch(events, handlers)
+	return events, nil
+}
+
+// periodEvent returns a PeriodStarted event for period number p, taking
+// the period type from desc when it describes p.
+func (t *GameTracker) periodEvent(p int, desc model.PeriodDescriptor) Event {
+	ev := Event{Kind: PeriodStarted, GameID: t.gameID, Period: p, AwayScore: t.away, HomeScore: t.home}
+	if desc.Number == p {
+		ev.PeriodType = desc.PeriodType
+	} else {
+		ev.PeriodType = model.PeriodTypeRegulation
+	}
+	return ev
+}
+
+func (t *GameTracker) finalEvent(desc model.PeriodDescriptor) Event {
+	return Event{
+		Kind:       GameFinal,
+		GameID:     t.gameID,
+		Period:     desc.Number,
+		PeriodType: desc.PeriodType,
+		AwayScore:  t.away,
+		HomeScore:  t.home,
+	}
+}
+
+// handlersFor snapshots the handlers of each event so they can run after
+// the lock is released.
+func (t *GameTracker) handlersFor(events []Event) [][]func(Event) {
+	handlers := make([][]func(Event), len(events))
+	for i, ev := range events {
+		handlers[i] = t.handlers[ev.Kind]
+	}
+	return handlers
+}
+
+func dispatch(events []Event, handlers [][]func(Event)) {
+	for i, ev := range events {
+		for _, fn := range handlers[i] {
+			fn(ev)
+		}
+	}
+}
+
+// removed returns, in sort order, the event IDs in before and not in after.
+func removed(before, after map[int64]model.PlayEvent) []int64 {
+	var ids []int64
+	for id := range before {
+		if _, ok := after[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return before[ids[i]].SortOrder < before[ids[j]].SortOrder })
+	return ids
+}
+
+// playKey holds the fields of a goal or penalty whose change is a
+// correction. Pointers are dereferenced so keys compare by value.
+type playKey struct {
+	period     int
+	time       string
+	team       model.TeamID
+	players    [3]model.PlayerID
+	away, home int
+	code       string
+	duration   int
+}
+
+func goalKey(play model.PlayEvent) playKey {
+	k := playKey{period: play.PeriodDescriptor.Number, time: play.TimeInPeriod}
+	if d := play.Details; d != nil {
+		k.team = deref(d.EventOwnerTeamID)
+		k.players = [3]model.PlayerID{deref(d.ScoringPlayerID), deref(d.Assist1PlayerID), deref(d.Assist2PlayerID)}
+		k.away, k.home = deref(d.AwayScore), deref(d.HomeScore)
+	}
+	return k
+}
+
+func penaltyKey(play model.PlayEvent) playKey {
+	k := playKey{period: play.PeriodDescriptor.Number, time: play.TimeInPeriod}
+	if d := play.Details; d != nil {
+		k.team = deref(d.EventOwnerTeamID)
+		k.players = [3]model.PlayerID{deref(d.CommittedByPlayerID), deref(d.DrawnByPlayerID)}
+		k.code = deref(d.TypeCode) + "/" + deref(d.DescKey)
+		k.duration = deref(d.Duration)
+	}
+	return k
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
+func teamAbbrev(pbp *model.PlayByPlay, id model.TeamID) string {
+	switch id {
+	case pbp.AwayTeam.ID:
+		return pbp.AwayTeam.Abbrev
+	case pbp.HomeTeam.ID:
+		return pbp.HomeTeam.Abbrev
+	}
+	return ""
+}
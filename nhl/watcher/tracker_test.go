@@ -0,0 +1,170 @@
+package watcher
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl/model"
+)
+
+func trackedGoal(eventID int64, sortOrder, period int, team model.TeamID, scorer model.PlayerID, away, home int) model.PlayEvent {
+	p := play(eventID, sortOrder, period, "05:00")
+	p.TypeDescKey = model.PlayEventTypeGoal
+	p.Details = &model.PlayEventDetails{EventOwnerTeamID: &team, ScoringPlayerID: &scorer, AwayScore: &away, HomeScore: &home}
+	return p
+}
+
+func trackedPenalty(eventID int64, sortOrder, period int, team model.TeamID, duration int) model.PlayEvent {
+	p := play(eventID, sortOrder, period, "08:00")
+	p.TypeDescKey = model.PlayEventTypePenalty
+	p.Details = &model.PlayEventDetails{EventOwnerTeamID: &team, Duration: &duration}
+	return p
+}
+
+func trackedGame(state model.GameState, period, away, home int, plays ...model.PlayEvent) *model.PlayByPlay {
+	return &model.PlayByPlay{
+		ID:               2023020001,
+		GameState:        state,
+		PeriodDescriptor: model.PeriodDescriptor{Number: period, PeriodType: model.PeriodTypeRegulation},
+		AwayTeam:         model.BoxscoreTeam{ID: 10, Abbrev: "TOR", Score: away},
+		HomeTeam:         model.BoxscoreTeam{ID: 8, Abbrev: "MTL", Score: home},
+		Plays:            plays,
+	}
+}
+
+func kinds(events []Event) string {
+	var names []string
+	for _, ev := range events {
+		name := ev.Kind.String()
+		if ev.Team != "" {
+			name += " " + ev.Team
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func TestGameTrackerPlayByPlay(t *testing.T) {
+	tracker := NewGameTracker(2023020001)
+	var goals []model.PlayerID
+	tracker.On(GoalScored, func(ev Event) { goals = append(goals, *ev.Play.Details.ScoringPlayerID) })
+	var finals int
+	tracker.On(GameFinal, func(Event) { finals++ })
+
+	update := func(pbp *model.PlayByPlay, want string) []Event {
+		t.Helper()
+		events, err := tracker.UpdatePlayByPlay(pbp)
+		if err != nil {
+			t.Fatalf("UpdatePlayByPlay() error = %v", err)
+		}
+		if got := kinds(events); got != want {
+			t.Errorf("events = %q, want %q", got, want)
+		}
+		return events
+	}
+
+	// Plays arrive out of order.
+	update(trackedGame(model.GameStateLive, 1, 0, 1,
+		trackedPenalty(3, 30, 1, 10, 2),
+		trackedGoal(2, 20, 1, 8, 100, 0, 1),
+	), "period-started, goal-scored MTL, penalty-called TOR")
+
+	// A repeated snapshot is quiet; a clip filling in is not an amendment.
+	clip := trackedGoal(2, 20, 1, 8, 100, 0, 1)
+	clipID := int64(42)
+	clip.Details.HighlightClip = &clipID
+	update(trackedGame(model.GameStateLive, 1, 0, 1, trackedPenalty(3, 30, 1, 10, 2), clip), "")
+
+	// The scorer is corrected, the penalty upgraded, and period 2 starts.
+	events := update(trackedGame(model.GameStateLive, 2, 0, 1,
+		trackedGoal(2, 20, 1, 8, 101, 0, 1),
+		trackedPenalty(3, 30, 1, 10, 4),
+		trackedGoal(4, 40, 2, 10, 200, 1, 1),
+	), "goal-amended MTL, penalty-removed TOR, penalty-called TOR, period-started, goal-scored TOR")
+	if events[0].HomeScore != 1 || *events[0].Play.Details.ScoringPlayerID != 101 {
+		t.Errorf("amended goal = %+v", events[0])
+	}
+
+	// The TOR goal is overturned and the game ends.
+	events = update(trackedGame(model.GameStateOff, 3, 0, 1,
+		trackedGoal(2, 20, 1, 8, 101, 0, 1),
+		trackedPenalty(3, 30, 1, 10, 4),
+	), "period-started, goal-removed TOR, game-final")
+	if removal := events[1]; removal.Play.EventID != 4 || removal.AwayScore != 0 || removal.HomeScore != 1 {
+		t.Errorf("removal = %+v", removal)
+	}
+	update(trackedGame(model.GameStateOff, 3, 0, 1, trackedGoal(2, 20, 1, 8, 101, 0, 1), trackedPenalty(3, 30, 1, 10, 4)), "")
+
+	if len(goals) != 2 || goals[0] != 100 || goals[1] != 200 {
+		t.Errorf("GoalScored handler saw %v, want [100 200]", goals)
+	}
+	if finals != 1 {
+		t.Errorf("GameFinal handler ran %d times, want 1", finals)
+	}
+
+	other := trackedGame(model.GameStateLive, 1, 0, 0)
+	other.ID = 2023020002
+	if _, err := tracker.UpdatePlayByPlay(other); err == nil {
+		t.Error("UpdatePlayByPlay(other game) error = nil")
+	}
+	if _, err := tracker.UpdatePlayByPlay(nil); err == nil {
+		t.Error("UpdatePlayByPlay(nil) error = nil")
+	}
+}
+
+func TestGameTrackerBoxscore(t *testing.T) {
+	box := func(state model.GameState, period int, periodType model.PeriodType, away, home int) *model.Boxscore {
+		return &model.Boxscore{
+			ID:               2023020001,
+			GameState:        state,
+			PeriodDescriptor: model.PeriodDescriptor{Number: period, PeriodType: periodType},
+			AwayTeam:         model.BoxscoreTeam{Abbrev: "TOR", Score: away},
+			HomeTeam:         model.BoxscoreTeam{Abbrev: "MTL", Score: home},
+		}
+	}
+	tracker := NewGameTracker(2023020001)
+	var periods []model.PeriodType
+	tracker.On(PeriodStarted, func(ev Event) { periods = append(periods, ev.PeriodType) })
+
+	steps := []struct {
+		box  *model.Boxscore
+		want string
+	}{
+		{box(model.GameStateFuture, 0, "", 0, 0), ""},
+		{box(model.GameStateLive, 2, model.PeriodTypeRegulation, 2, 0), "period-started, period-started, goal-scored TOR, goal-scored TOR"},
+		{box(model.GameStateLive, 2, model.PeriodTypeRegulation, 1, 1), "goal-scored MTL, goal-removed TOR"},
+		{box(model.GameStateFinal, 4, model.PeriodTypeOvertime, 1, 2), "period-started, period-started, goal-scored MTL, game-final"},
+		{box(model.GameStateOff, 4, model.PeriodTypeOvertime, 1, 2), ""},
+	}
+	for i, step := range steps {
+		events, err := tracker.UpdateBoxscore(step.box)
+		if err != nil {
+			t.Fatalf("step %d: UpdateBoxscore() error = %v", i, err)
+		}
+		if got := kinds(events); got != step.want {
+			t.Errorf("step %d: events = %q, want %q", i, got, step.want)
+		}
+	}
+	want := []model.PeriodType{model.PeriodTypeRegulation, model.PeriodTypeRegulation, model.PeriodTypeRegulation, model.PeriodTypeOvertime}
+	if len(periods) != len(want) {
+		t.Fatalf("periods = %v, want %v", periods, want)
+	}
+	for i := range want {
+		if periods[i] != want[i] {
+			t.Errorf("periods = %v, want %v", periods, want)
+			break
+		}
+	}
+	if _, err := tracker.UpdateBoxscore(nil); err == nil {
+		t.Error("UpdateBoxscore(nil) error = nil")
+	}
+}
+
+func TestEventKindString(t *testing.T) {
+	if got := GoalAmended.String(); got != "goal-amended" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := EventKind(99).String(); got != "EventKind(99)" {
+		t.Errorf("String() = %q", got)
+	}
+}
@@ -0,0 +1,11 @@
+// Package nhlarrow writes the flat rows of package export, such as
+// export.PlayRow and export.ShiftRow, as Apache Arrow records and Parquet
+// files, so multi-season extractions load straight into DuckDB, pandas or
+// Spark without a separate ETL step.
+//
+// It lives in its own module so that the main client stays free of the
+// Arrow dependency. Columns follow the rows' JSON tags and field order, as
+// in export.RowWriter; pointer fields, such as the play details that only
+// some events have, become nullable columns, and other fields required
+// ones.
+package nhlarrow
@@ -0,0 +1,230 @@
+package nhlarrow
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+
+	"github.com/sperano/nhl-api-go/nhl"
+	"github.com/sperano/nhl-api-go/nhl/export"
+)
+
+// DefaultRowGroupRows is the number of rows a Writer gathers in a Parquet
+// row group before starting the next: about a season of shifts, or a few
+// hundred games of plays.
+const DefaultRowGroupRows = 250_000
+
+// column is one flattened field of a row type: its index path, through
+// embedded structs, and whether it is nullable.
+type column struct {
+	index    []int
+	nullable bool
+}
+
+// Schema returns the Arrow schema of a row type such as export.PlayRow.
+// T must be a struct of string, bool, integer and float fields, or pointers
+// to them, as for export.RowWriter; embedded structs contribute their
+// columns in place.
+func Schema[T any]() (*arrow.Schema, error) {
+	schema, _, err := schemaOf(reflect.TypeFor[T]())
+	return schema, err
+}
+
+func schemaOf(t reflect.Type) (*arrow.Schema, []column, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("nhlarrow: row type %s is not a struct", t)
+	}
+	var fields []arrow.Field
+	var columns []column
+	var walk func(t reflect.Type, prefix []int) error
+	walk = func(t reflect.Type, prefix []int) error {
+		for i := range t.NumField() {
+			f := t.Field(i)
+			index := append(append([]int(nil), prefix...), i)
+			if f.Anonymous && f.Type.Kind() == reflect.Struct {
+				if err := walk(f.Type, index); err != nil {
+					return err
+				}
+				continue
+			}
+			typ, nullable := f.Type, false
+			if typ.Kind() == reflect.Pointer {
+				typ, nullable = typ.Elem(), true
+			}
+			var dt arrow.DataType
+			switch typ.Kind() {
+			case reflect.String:
+				dt = arrow.BinaryTypes.String
+			case reflect.Bool:
+				dt = arrow.FixedWidthTypes.Boolean
+			case reflect.Int, reflect.Int64:
+				dt = arrow.PrimitiveTypes.Int64
+			case reflect.Float64:
+				dt = arrow.PrimitiveTypes.Float64
+			default:
+				return fmt.Errorf("nhlarrow: row type %s: field %s is a %s", t, f.Name, f.Type)
+			}
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "" {
+				name = f.Name
+			}
+			fields = append(fields, arrow.Field{Name: name, Type: dt, Nullable: nullable})
+			columns = append(columns, column{index: index, nullable: nullable})
+		}
+		return nil
+	}
+	if err := walk(t, nil); err != nil {
+		return nil, nil, err
+	}
+	return arrow.NewSchema(fields, nil), columns, nil
+}
+
+// NewRecord builds an Arrow record of rows with T's schema. Release it
+// when done.
+func NewRecord[T any](mem memory.Allocator, rows []T) (arrow.RecordBatch, error) {
+	schema, columns, err := schemaOf(reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+	return newRecord(mem, schema, columns, rows), nil
+}
+
+func newRecord[T any](mem memory.Allocator, schema *arrow.Schema, columns []column, rows []T) arrow.RecordBatch {
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+	for _, row := range rows {
+		v := reflect.ValueOf(row)
+		for i, c := range columns {
+			appendValue(b.Field(i), v.FieldByIndex(c.index))
+		}
+	}
+	return b.NewRecordBatch()
+}
+
+func appendValue(b array.Builder, f reflect.Value) {
+	if f.Kind() == reflect.Pointer {
+		if f.IsNil() {
+			b.AppendNull()
+			return
+		}
+		f = f.Elem()
+	}
+	switch b := b.(type) {
+	case *array.StringBuilder:
+		b.Append(f.String())
+	case *array.BooleanBuilder:
+		b.Append(f.Bool())
+	case *array.Int64Builder:
+		b.Append(f.Int())
+	case *array.Float64Builder:
+		b.Append(f.Float())
+	}
+}
+
+// Writer streams rows of one type to a Parquet file, compressed with
+// Snappy. Rows from successive Write calls share row groups of up to
+// RowGroupRows rows, so writing one game at a time still makes a compact
+// file. Call Close to write the footer; the file is unreadable without it.
+type Writer[T any] struct {
+	fw      *pqarrow.FileWriter
+	mem     memory.Allocator
+	schema  *arrow.Schema
+	columns []column
+}
+
+// NewWriter starts a Parquet file of T rows on w, with row groups of
+// rowGroupRows rows, or DefaultRowGroupRows when it is not positive.
+func NewWriter[T any](w io.Writer, rowGroupRows int) (*Writer[T], error) {
+	schema, columns, err := schemaOf(reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+	if rowGroupRows <= 0 {
+		rowGroupRows = DefaultRowGroupRows
+	}
+	props := parquet.NewWriterProperties(
+		parquet.WithCompression(compress.Codecs.Snappy),
+		parquet.WithMaxRowGroupLength(int64(rowGroupRows)),
+	)
+	fw, err := pqarrow.NewFileWriter(schema, w, props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, fmt.Errorf("nhlarrow: %w", err)
+	}
+	return &Writer[T]{fw: fw, mem: memory.DefaultAllocator, schema: schema, columns: columns}, nil
+}
+
+// Write appends rows to the file.
+func (w *Writer[T]) Write(rows []T) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	rec := newRecord(w.mem, w.schema, w.columns, rows)
+	defer rec.Release()
+	if err := w.fw.WriteBuffered(rec); err != nil {
+		return fmt.Errorf("nhlarrow: write rows: %w", err)
+	}
+	return nil
+}
+
+// Close flushes the last row group and writes the file footer. It does
+// not close the underlying writer.
+func (w *Writer[T]) Close() error {
+	if err := w.fw.Close(); err != nil {
+		return fmt.Errorf("nhlarrow: close: %w", err)
+	}
+	return nil
+}
+
+// WriteParquet writes rows, such as the export.PlayRows of many games, as
+// a complete Parquet file on w.
+func WriteParquet[T any](rows []T, w io.Writer) error {
+	pw, err := NewWriter[T](w, 0)
+	if err != nil {
+		return err
+	}
+	if err := pw.Write(rows); err != nil {
+		pw.Close()
+		return err
+	}
+	return pw.Close()
+}
+
+// WritePlays writes the plays of games as a Parquet file of
+// export.PlayRow, in game order.
+func WritePlays(games []*nhl.PlayByPlay, w io.Writer) error {
+	pw, err := NewWriter[export.PlayRow](w, 0)
+	if err != nil {
+		return err
+	}
+	for _, pbp := range games {
+		if err := pw.Write(export.PlayRows(pbp)); err != nil {
+			pw.Close()
+			return err
+		}
+	}
+	return pw.Close()
+}
+
+// WriteShifts writes shift charts as a Parquet file of export.ShiftRow,
+// in chart order.
+func WriteShifts(charts []*nhl.ShiftChart, w io.Writer) error {
+	pw, err := NewWriter[export.ShiftRow](w, 0)
+	if err != nil {
+		return err
+	}
+	for _, chart := range charts {
+		if err := pw.Write(export.ShiftRows(chart)); err != nil {
+			pw.Close()
+			return err
+		}
+	}
+	return pw.Close()
+}
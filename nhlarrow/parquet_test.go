@@ -0,0 +1,202 @@
+package nhlarrow
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+
+	"github.com/sperano/nhl-api-go/nhl"
+	"github.com/sperano/nhl-api-go/nhl/export"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func testGame(id nhl.GameID) *nhl.PlayByPlay {
+	return &nhl.PlayByPlay{
+		ID:       id,
+		Season:   nhl.NewSeason(2023),
+		GameType: nhl.GameTypeRegularSeason,
+		GameDate: "2023-11-11",
+		AwayTeam: nhl.BoxscoreTeam{ID: 10, Abbrev: "TOR"},
+		HomeTeam: nhl.BoxscoreTeam{ID: 8, Abbrev: "MTL"},
+		Plays: []nhl.PlayEvent{
+			{EventID: 1, SortOrder: 8, TypeCode: 520, TypeDescKey: nhl.PlayEventTypePeriodStart,
+				PeriodDescriptor: nhl.PeriodDescriptor{Number: 1, PeriodType: nhl.PeriodTypeRegulation}},
+			{EventID: 54, SortOrder: 90, TypeCode: 505, TypeDescKey: nhl.PlayEventTypeGoal, TimeInPeriod: "04:12",
+				PeriodDescriptor: nhl.PeriodDescriptor{Number: 1, PeriodType: nhl.PeriodTypeRegulation},
+				Details: &nhl.PlayEventDetails{
+					EventOwnerTeamID: ptr(nhl.TeamID(8)),
+					XCoord:           ptr(-80),
+					ScoringPlayerID:  ptr(nhl.PlayerID(8480018)),
+					HomeScore:        ptr(1),
+					AwayScore:        ptr(0),
+				}},
+		},
+	}
+}
+
+func TestSchema(t *testing.T) {
+	schema, err := Schema[export.PlayRow]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name     string
+		typ      arrow.DataType
+		nullable bool
+	}{
+		{"game_id", arrow.PrimitiveTypes.Int64, false},
+		{"game_date", arrow.BinaryTypes.String, false},
+		{"event_id", arrow.PrimitiveTypes.Int64, false},
+		{"team", arrow.BinaryTypes.String, true},
+		{"x_coord", arrow.PrimitiveTypes.Int64, true},
+		{"scoring_player_id", arrow.PrimitiveTypes.Int64, true},
+	} {
+		fields, ok := schema.FieldsByName(tt.name)
+		if !ok {
+			t.Errorf("no %s column", tt.name)
+			continue
+		}
+		if f := fields[0]; !arrow.TypeEqual(f.Type, tt.typ) || f.Nullable != tt.nullable {
+			t.Errorf("%s = %s nullable %t, want %s nullable %t", tt.name, f.Type, f.Nullable, tt.typ, tt.nullable)
+		}
+	}
+	if schema.Field(0).Name != "game_id" {
+		t.Errorf("first column = %s, want game_id", schema.Field(0).Name)
+	}
+
+	if _, err := Schema[struct{ At []int }](); err == nil {
+		t.Error("Schema() of a slice field succeeded")
+	}
+	if _, err := Schema[int](); err == nil {
+		t.Error("Schema[int]() succeeded")
+	}
+}
+
+func readTable(t *testing.T, data []byte) arrow.Table {
+	t.Helper()
+	tbl, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(data), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(tbl.Release)
+	return tbl
+}
+
+func tableColumn[A arrow.Array](t *testing.T, tbl arrow.Table, name string) A {
+	t.Helper()
+	idx := tbl.Schema().FieldIndices(name)
+	if len(idx) == 0 {
+		t.Fatalf("no %s column", name)
+	}
+	chunks := tbl.Column(idx[0]).Data().Chunks()
+	if len(chunks) != 1 {
+		t.Fatalf("%s has %d chunks", name, len(chunks))
+	}
+	return chunks[0].(A)
+}
+
+func TestWriteParquet(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteParquet(export.PlayRows(testGame(2023020204)), &buf); err != nil {
+		t.Fatal(err)
+	}
+	tbl := readTable(t, buf.Bytes())
+	if tbl.NumRows() != 2 {
+		t.Fatalf("NumRows() = %d, want 2", tbl.NumRows())
+	}
+
+	team := tableColumn[*array.String](t, tbl, "team")
+	if !team.IsNull(0) || team.Value(1) != "MTL" {
+		t.Errorf("team = %v", team)
+	}
+	scorer := tableColumn[*array.Int64](t, tbl, "scoring_player_id")
+	if !scorer.IsNull(0) || scorer.Value(1) != 8480018 {
+		t.Errorf("scoring_player_id = %v", scorer)
+	}
+	if assist := tableColumn[*array.Int64](t, tbl, "assist1_player_id"); assist.NullN() != 2 {
+		t.Errorf("assist1_player_id = %v", assist)
+	}
+	season := tableColumn[*array.Int64](t, tbl, "season")
+	if season.NullN() != 0 || season.Value(0) != 20232024 {
+		t.Errorf("season = %v", season)
+	}
+}
+
+func TestWritePlays(t *testing.T) {
+	var buf bytes.Buffer
+	games := []*nhl.PlayByPlay{testGame(2023020204), testGame(2023020205), testGame(2023020206)}
+	if err := WritePlays(games, &buf); err != nil {
+		t.Fatal(err)
+	}
+	r, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if r.NumRowGroups() != 1 || r.NumRows() != 6 {
+		t.Errorf("row groups = %d, rows = %d, want 1 and 6", r.NumRowGroups(), r.NumRows())
+	}
+
+	tbl := readTable(t, buf.Bytes())
+	ids := tableColumn[*array.Int64](t, tbl, "game_id")
+	if ids.Value(0) != 2023020204 || ids.Value(5) != 2023020206 {
+		t.Errorf("game_id = %v", ids)
+	}
+}
+
+func TestWriteShifts(t *testing.T) {
+	chart := &nhl.ShiftChart{Data: []nhl.ShiftEntry{
+		{GameID: 2023020204, TeamAbbrev: "TOR", TeamID: 10, PlayerID: 8479318, Period: 1, ShiftNumber: 1,
+			StartTime: "00:00", EndTime: "00:45", Duration: nhl.TOIFromDuration(45e9)},
+		{GameID: 2023020204, TeamAbbrev: "TOR", TeamID: 10, Period: 1, TypeCode: 505,
+			EventDescription: ptr("EVG")},
+	}}
+	var buf bytes.Buffer
+	if err := WriteShifts([]*nhl.ShiftChart{chart, nil}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	tbl := readTable(t, buf.Bytes())
+	if tbl.NumRows() != 2 {
+		t.Fatalf("NumRows() = %d, want 2", tbl.NumRows())
+	}
+	if d := tableColumn[*array.Int64](t, tbl, "duration"); d.Value(0) != 45 {
+		t.Errorf("duration = %v", d)
+	}
+	if desc := tableColumn[*array.String](t, tbl, "event_description"); !desc.IsNull(0) || desc.Value(1) != "EVG" {
+		t.Errorf("event_description = %v", desc)
+	}
+}
+
+func TestWriterRowGroups(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter[export.PlayRow](&buf, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []nhl.GameID{2023020204, 2023020205, 2023020206} {
+		if err := w.Write(export.PlayRows(testGame(id))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Write(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	r, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if r.NumRows() != 6 || r.NumRowGroups() != 2 {
+		t.Errorf("rows = %d, row groups = %d, want 6 and 2", r.NumRows(), r.NumRowGroups())
+	}
+}
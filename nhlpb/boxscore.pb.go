@@ -0,0 +1,1450 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: nhl/v1/boxscore.proto
+
+package nhlpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Boxscore mirrors nhl.Boxscore.
+type Boxscore struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Season start year, e.g. 2023 for the 2023-2024 season.
+	SeasonStartYear   int64              `protobuf:"varint,2,opt,name=season_start_year,json=seasonStartYear,proto3" json:"season_start_year,omitempty"`
+	GameType          int64              `protobuf:"varint,3,opt,name=game_type,json=gameType,proto3" json:"game_type,omitempty"`
+	LimitedScoring    bool               `protobuf:"varint,4,opt,name=limited_scoring,json=limitedScoring,proto3" json:"limited_scoring,omitempty"`
+	GameDate          string             `protobuf:"bytes,5,opt,name=game_date,json=gameDate,proto3" json:"game_date,omitempty"`
+	Venue             *LocalizedString   `protobuf:"bytes,6,opt,name=venue,proto3" json:"venue,omitempty"`
+	VenueLocation     *LocalizedString   `protobuf:"bytes,7,opt,name=venue_location,json=venueLocation,proto3" json:"venue_location,omitempty"`
+	StartTimeUtc      string             `protobuf:"bytes,8,opt,name=start_time_utc,json=startTimeUtc,proto3" json:"start_time_utc,omitempty"`
+	EasternUtcOffset  string             `protobuf:"bytes,9,opt,name=eastern_utc_offset,json=easternUtcOffset,proto3" json:"eastern_utc_offset,omitempty"`
+	VenueUtcOffset    string             `protobuf:"bytes,10,opt,name=venue_utc_offset,json=venueUtcOffset,proto3" json:"venue_utc_offset,omitempty"`
+	TvBroadcasts      []*TVBroadcast     `protobuf:"bytes,11,rep,name=tv_broadcasts,json=tvBroadcasts,proto3" json:"tv_broadcasts,omitempty"`
+	GameState         string             `protobuf:"bytes,12,opt,name=game_state,json=gameState,proto3" json:"game_state,omitempty"`
+	GameScheduleState string             `protobuf:"bytes,13,opt,name=game_schedule_state,json=gameScheduleState,proto3" json:"game_schedule_state,omitempty"`
+	PeriodDescriptor  *PeriodDescriptor  `protobuf:"bytes,14,opt,name=period_descriptor,json=periodDescriptor,proto3" json:"period_descriptor,omitempty"`
+	SpecialEvent      *SpecialEvent      `protobuf:"bytes,15,opt,name=special_event,json=specialEvent,proto3" json:"special_event,omitempty"`
+	AwayTeam          *BoxscoreTeam      `protobuf:"bytes,16,opt,name=away_team,json=awayTeam,proto3" json:"away_team,omitempty"`
+	HomeTeam          *BoxscoreTeam      `protobuf:"bytes,17,opt,name=home_team,json=homeTeam,proto3" json:"home_team,omitempty"`
+	Clock             *GameClock         `protobuf:"bytes,18,opt,name=clock,proto3" json:"clock,omitempty"`
+	PlayerByGameStats *PlayerByGameStats `protobuf:"bytes,19,opt,name=player_by_game_stats,json=playerByGameStats,proto3" json:"player_by_game_stats,omitempty"`
+	Linescore         *Linescore         `protobuf:"bytes,20,opt,name=linescore,proto3" json:"linescore,omitempty"`
+	ShotsByPeriod     []*PeriodScore     `protobuf:"bytes,21,rep,name=shots_by_period,json=shotsByPeriod,proto3" json:"shots_by_period,omitempty"`
+	TeamGameStats     []*TeamGameStat    `protobuf:"bytes,22,rep,name=team_game_stats,json=teamGameStats,proto3" json:"team_game_stats,omitempty"`
+	// Paid attendance, when the feed reports it.
+	Attendance    *int64 `protobuf:"varint,23,opt,name=attendance,proto3,oneof" json:"attendance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Boxscore) Reset() {
+	*x = Boxscore{}
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Boxscore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Boxscore) ProtoMessage() {}
+
+func (x *Boxscore) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Boxscore.ProtoReflect.Descriptor instead.
+func (*Boxscore) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_boxscore_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Boxscore) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Boxscore) GetSeasonStartYear() int64 {
+	if x != nil {
+		return x.SeasonStartYear
+	}
+	return 0
+}
+
+func (x *Boxscore) GetGameType() int64 {
+	if x != nil {
+		return x.GameType
+	}
+	return 0
+}
+
+func (x *Boxscore) GetLimitedScoring() bool {
+	if x != nil {
+		return x.LimitedScoring
+	}
+	return false
+}
+
+func (x *Boxscore) GetGameDate() string {
+	if x != nil {
+		return x.GameDate
+	}
+	return ""
+}
+
+func (x *Boxscore) GetVenue() *LocalizedString {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
+}
+
+func (x *Boxscore) GetVenueLocation() *LocalizedString {
+	if x != nil {
+		return x.VenueLocation
+	}
+	return nil
+}
+
+func (x *Boxscore) GetStartTimeUtc() string {
+	if x != nil {
+		return x.StartTimeUtc
+	}
+	return ""
+}
+
+func (x *Boxscore) GetEasternUtcOffset() string {
+	if x != nil {
+		return x.EasternUtcOffset
+	}
+	return ""
+}
+
+func (x *Boxscore) GetVenueUtcOffset() string {
+	if x != nil {
+		return x.VenueUtcOffset
+	}
+	return ""
+}
+
+func (x *Boxscore) GetTvBroadcasts() []*TVBroadcast {
+	if x != nil {
+		return x.TvBroadcasts
+	}
+	return nil
+}
+
+func (x *Boxscore) GetGameState() string {
+	if x != nil {
+		return x.GameState
+	}
+	return ""
+}
+
+func (x *Boxscore) GetGameScheduleState() string {
+	if x != nil {
+		return x.GameScheduleState
+	}
+	return ""
+}
+
+func (x *Boxscore) GetPeriodDescriptor() *PeriodDescriptor {
+	if x != nil {
+		return x.PeriodDescriptor
+	}
+	return nil
+}
+
+func (x *Boxscore) GetSpecialEvent() *SpecialEvent {
+	if x != nil {
+		return x.SpecialEvent
+	}
+	return nil
+}
+
+func (x *Boxscore) GetAwayTeam() *BoxscoreTeam {
+	if x != nil {
+		return x.AwayTeam
+	}
+	return nil
+}
+
+func (x *Boxscore) GetHomeTeam() *BoxscoreTeam {
+	if x != nil {
+		return x.HomeTeam
+	}
+	return nil
+}
+
+func (x *Boxscore) GetClock() *GameClock {
+	if x != nil {
+		return x.Clock
+	}
+	return nil
+}
+
+func (x *Boxscore) GetPlayerByGameStats() *PlayerByGameStats {
+	if x != nil {
+		return x.PlayerByGameStats
+	}
+	return nil
+}
+
+func (x *Boxscore) GetLinescore() *Linescore {
+	if x != nil {
+		return x.Linescore
+	}
+	return nil
+}
+
+func (x *Boxscore) GetShotsByPeriod() []*PeriodScore {
+	if x != nil {
+		return x.ShotsByPeriod
+	}
+	return nil
+}
+
+func (x *Boxscore) GetTeamGameStats() []*TeamGameStat {
+	if x != nil {
+		return x.TeamGameStats
+	}
+	return nil
+}
+
+func (x *Boxscore) GetAttendance() int64 {
+	if x != nil && x.Attendance != nil {
+		return *x.Attendance
+	}
+	return 0
+}
+
+// Linescore mirrors nhl.Linescore.
+type Linescore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ByPeriod      []*PeriodScore         `protobuf:"bytes,1,rep,name=by_period,json=byPeriod,proto3" json:"by_period,omitempty"`
+	AwayTotal     int64                  `protobuf:"varint,2,opt,name=away_total,json=awayTotal,proto3" json:"away_total,omitempty"`
+	HomeTotal     int64                  `protobuf:"varint,3,opt,name=home_total,json=homeTotal,proto3" json:"home_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Linescore) Reset() {
+	*x = Linescore{}
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Linescore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Linescore) ProtoMessage() {}
+
+func (x *Linescore) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Linescore.ProtoReflect.Descriptor instead.
+func (*Linescore) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_boxscore_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Linescore) GetByPeriod() []*PeriodScore {
+	if x != nil {
+		return x.ByPeriod
+	}
+	return nil
+}
+
+func (x *Linescore) GetAwayTotal() int64 {
+	if x != nil {
+		return x.AwayTotal
+	}
+	return 0
+}
+
+func (x *Linescore) GetHomeTotal() int64 {
+	if x != nil {
+		return x.HomeTotal
+	}
+	return 0
+}
+
+// PeriodScore mirrors nhl.PeriodScore.
+type PeriodScore struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PeriodDescriptor *PeriodDescriptor      `protobuf:"bytes,1,opt,name=period_descriptor,json=periodDescriptor,proto3" json:"period_descriptor,omitempty"`
+	Away             int64                  `protobuf:"varint,2,opt,name=away,proto3" json:"away,omitempty"`
+	Home             int64                  `protobuf:"varint,3,opt,name=home,proto3" json:"home,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PeriodScore) Reset() {
+	*x = PeriodScore{}
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodScore) ProtoMessage() {}
+
+func (x *PeriodScore) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodScore.ProtoReflect.Descriptor instead.
+func (*PeriodScore) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_boxscore_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PeriodScore) GetPeriodDescriptor() *PeriodDescriptor {
+	if x != nil {
+		return x.PeriodDescriptor
+	}
+	return nil
+}
+
+func (x *PeriodScore) GetAway() int64 {
+	if x != nil {
+		return x.Away
+	}
+	return 0
+}
+
+func (x *PeriodScore) GetHome() int64 {
+	if x != nil {
+		return x.Home
+	}
+	return 0
+}
+
+// TeamGameStat mirrors nhl.TeamGameStat. Values hold the JSON sent by the
+// API, which is a number or a string depending on the category.
+type TeamGameStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	AwayValueJson []byte                 `protobuf:"bytes,2,opt,name=away_value_json,json=awayValueJson,proto3" json:"away_value_json,omitempty"`
+	HomeValueJson []byte                 `protobuf:"bytes,3,opt,name=home_value_json,json=homeValueJson,proto3" json:"home_value_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamGameStat) Reset() {
+	*x = TeamGameStat{}
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamGameStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamGameStat) ProtoMessage() {}
+
+func (x *TeamGameStat) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamGameStat.ProtoReflect.Descriptor instead.
+func (*TeamGameStat) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_boxscore_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TeamGameStat) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TeamGameStat) GetAwayValueJson() []byte {
+	if x != nil {
+		return x.AwayValueJson
+	}
+	return nil
+}
+
+func (x *TeamGameStat) GetHomeValueJson() []byte {
+	if x != nil {
+		return x.HomeValueJson
+	}
+	return nil
+}
+
+// TVBroadcast mirrors nhl.TVBroadcast.
+type TVBroadcast struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Market         string                 `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+	CountryCode    string                 `protobuf:"bytes,3,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Network        string                 `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	SequenceNumber int64                  `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TVBroadcast) Reset() {
+	*x = TVBroadcast{}
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TVBroadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TVBroadcast) ProtoMessage() {}
+
+func (x *TVBroadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TVBroadcast.ProtoReflect.Descriptor instead.
+func (*TVBroadcast) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_boxscore_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TVBroadcast) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TVBroadcast) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *TVBroadcast) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *TVBroadcast) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *TVBroadcast) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+// SpecialEvent mirrors nhl.SpecialEvent.
+type SpecialEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentId      int64                  `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name          *LocalizedString       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LightLogoUrl  *LocalizedString       `protobuf:"bytes,3,opt,name=light_logo_url,json=lightLogoUrl,proto3" json:"light_logo_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpecialEvent) Reset() {
+	*x = SpecialEvent{}
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecialEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecialEvent) ProtoMessage() {}
+
+func (x *SpecialEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecialEvent.ProtoReflect.Descriptor instead.
+func (*SpecialEvent) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_boxscore_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SpecialEvent) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *SpecialEvent) GetName() *LocalizedString {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *SpecialEvent) GetLightLogoUrl() *LocalizedString {
+	if x != nil {
+		return x.LightLogoUrl
+	}
+	return nil
+}
+
+// BoxscoreTeam mirrors nhl.BoxscoreTeam.
+type BoxscoreTeam struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Id                       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CommonName               *LocalizedString       `protobuf:"bytes,2,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	Abbrev                   string                 `protobuf:"bytes,3,opt,name=abbrev,proto3" json:"abbrev,omitempty"`
+	Score                    int64                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Sog                      int64                  `protobuf:"varint,5,opt,name=sog,proto3" json:"sog,omitempty"`
+	Logo                     string                 `protobuf:"bytes,6,opt,name=logo,proto3" json:"logo,omitempty"`
+	DarkLogo                 string                 `protobuf:"bytes,7,opt,name=dark_logo,json=darkLogo,proto3" json:"dark_logo,omitempty"`
+	PlaceName                *LocalizedString       `protobuf:"bytes,8,opt,name=place_name,json=placeName,proto3" json:"place_name,omitempty"`
+	PlaceNameWithPreposition *LocalizedString       `protobuf:"bytes,9,opt,name=place_name_with_preposition,json=placeNameWithPreposition,proto3" json:"place_name_with_preposition,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *BoxscoreTeam) Reset() {
+	*x = BoxscoreTeam{}
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoxscoreTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoxscoreTeam) ProtoMessage() {}
+
+func (x *BoxscoreTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoxscoreTeam.ProtoReflect.Descriptor instead.
+func (*BoxscoreTeam) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_boxscore_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BoxscoreTeam) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BoxscoreTeam) GetCommonName() *LocalizedString {
+	if x != nil {
+		return x.CommonName
+	}
+	return nil
+}
+
+func (x *BoxscoreTeam) GetAbbrev() string {
+	if x != nil {
+		return x.Abbrev
+	}
+	return ""
+}
+
+func (x *BoxscoreTeam) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *BoxscoreTeam) GetSog() int64 {
+	if x != nil {
+		return x.Sog
+	}
+	return 0
+}
+
+func (x *BoxscoreTeam) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *BoxscoreTeam) GetDarkLogo() string {
+	if x != nil {
+		return x.DarkLogo
+	}
+	return ""
+}
+
+func (x *BoxscoreTeam) GetPlaceName() *LocalizedString {
+	if x != nil {
+		return x.PlaceName
+	}
+	return nil
+}
+
+func (x *BoxscoreTeam) GetPlaceNameWithPreposition() *LocalizedString {
+	if x != nil {
+		return x.PlaceNameWithPreposition
+	}
+	return nil
+}
+
+// GameClock mirrors nhl.GameClock.
+type GameClock struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TimeRemaining    string                 `protobuf:"bytes,1,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`
+	SecondsRemaining int64                  `protobuf:"varint,2,opt,name=seconds_remaining,json=secondsRemaining,proto3" json:"seconds_remaining,omitempty"`
+	Running          bool                   `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	InIntermission   bool                   `protobuf:"varint,4,opt,name=in_intermission,json=inIntermission,proto3" json:"in_intermission,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GameClock) Reset() {
+	*x = GameClock{}
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameClock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameClock) ProtoMessage() {}
+
+func (x *GameClock) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameClock.ProtoReflect.Descriptor instead.
+func (*GameClock) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_boxscore_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GameClock) GetTimeRemaining() string {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return ""
+}
+
+func (x *GameClock) GetSecondsRemaining() int64 {
+	if x != nil {
+		return x.SecondsRemaining
+	}
+	return 0
+}
+
+func (x *GameClock) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *GameClock) GetInIntermission() bool {
+	if x != nil {
+		return x.InIntermission
+	}
+	return false
+}
+
+// PlayerByGameStats mirrors nhl.PlayerByGameStats.
+type PlayerByGameStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AwayTeam      *TeamPlayerStats       `protobuf:"bytes,1,opt,name=away_team,json=awayTeam,proto3" json:"away_team,omitempty"`
+	HomeTeam      *TeamPlayerStats       `protobuf:"bytes,2,opt,name=home_team,json=homeTeam,proto3" json:"home_team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerByGameStats) Reset() {
+	*x = PlayerByGameStats{}
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerByGameStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerByGameStats) ProtoMessage() {}
+
+func (x *PlayerByGameStats) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerByGameStats.ProtoReflect.Descriptor instead.
+func (*PlayerByGameStats) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_boxscore_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlayerByGameStats) GetAwayTeam() *TeamPlayerStats {
+	if x != nil {
+		return x.AwayTeam
+	}
+	return nil
+}
+
+func (x *PlayerByGameStats) GetHomeTeam() *TeamPlayerStats {
+	if x != nil {
+		return x.HomeTeam
+	}
+	return nil
+}
+
+// TeamPlayerStats mirrors nhl.TeamPlayerStats.
+type TeamPlayerStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Forwards      []*SkaterStats         `protobuf:"bytes,1,rep,name=forwards,proto3" json:"forwards,omitempty"`
+	Defense       []*SkaterStats         `protobuf:"bytes,2,rep,name=defense,proto3" json:"defense,omitempty"`
+	Goalies       []*GoalieStats         `protobuf:"bytes,3,rep,name=goalies,proto3" json:"goalies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamPlayerStats) Reset() {
+	*x = TeamPlayerStats{}
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamPlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamPlayerStats) ProtoMessage() {}
+
+func (x *TeamPlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamPlayerStats.ProtoReflect.Descriptor instead.
+func (*TeamPlayerStats) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_boxscore_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TeamPlayerStats) GetForwards() []*SkaterStats {
+	if x != nil {
+		return x.Forwards
+	}
+	return nil
+}
+
+func (x *TeamPlayerStats) GetDefense() []*SkaterStats {
+	if x != nil {
+		return x.Defense
+	}
+	return nil
+}
+
+func (x *TeamPlayerStats) GetGoalies() []*GoalieStats {
+	if x != nil {
+		return x.Goalies
+	}
+	return nil
+}
+
+// SkaterStats mirrors nhl.SkaterStats.
+type SkaterStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId           int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	SweaterNumber      int64                  `protobuf:"varint,2,opt,name=sweater_number,json=sweaterNumber,proto3" json:"sweater_number,omitempty"`
+	Name               *LocalizedString       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Position           string                 `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	Goals              int64                  `protobuf:"varint,5,opt,name=goals,proto3" json:"goals,omitempty"`
+	Assists            int64                  `protobuf:"varint,6,opt,name=assists,proto3" json:"assists,omitempty"`
+	Points             int64                  `protobuf:"varint,7,opt,name=points,proto3" json:"points,omitempty"`
+	PlusMinus          int64                  `protobuf:"varint,8,opt,name=plus_minus,json=plusMinus,proto3" json:"plus_minus,omitempty"`
+	Pim                int64                  `protobuf:"varint,9,opt,name=pim,proto3" json:"pim,omitempty"`
+	Hits               int64                  `protobuf:"varint,10,opt,name=hits,proto3" json:"hits,omitempty"`
+	PowerPlayGoals     int64                  `protobuf:"varint,11,opt,name=power_play_goals,json=powerPlayGoals,proto3" json:"power_play_goals,omitempty"`
+	Sog                int64                  `protobuf:"varint,12,opt,name=sog,proto3" json:"sog,omitempty"`
+	FaceoffWinningPctg float64                `protobuf:"fixed64,13,opt,name=faceoff_winning_pctg,json=faceoffWinningPctg,proto3" json:"faceoff_winning_pctg,omitempty"`
+	Toi                string                 `protobuf:"bytes,14,opt,name=toi,proto3" json:"toi,omitempty"`
+	BlockedShots       int64                  `protobuf:"varint,15,opt,name=blocked_shots,json=blockedShots,proto3" json:"blocked_shots,omitempty"`
+	Shifts             int64                  `protobuf:"varint,16,opt,name=shifts,proto3" json:"shifts,omitempty"`
+	Giveaways          int64                  `protobuf:"varint,17,opt,name=giveaways,proto3" json:"giveaways,omitempty"`
+	Takeaways          int64                  `protobuf:"varint,18,opt,name=takeaways,proto3" json:"takeaways,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SkaterStats) Reset() {
+	*x = SkaterStats{}
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkaterStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkaterStats) ProtoMessage() {}
+
+func (x *SkaterStats) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkaterStats.ProtoReflect.Descriptor instead.
+func (*SkaterStats) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_boxscore_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SkaterStats) GetPlayerId() int64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetSweaterNumber() int64 {
+	if x != nil {
+		return x.SweaterNumber
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetName() *LocalizedString {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *SkaterStats) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *SkaterStats) GetGoals() int64 {
+	if x != nil {
+		return x.Goals
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetAssists() int64 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetPlusMinus() int64 {
+	if x != nil {
+		return x.PlusMinus
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetPim() int64 {
+	if x != nil {
+		return x.Pim
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetPowerPlayGoals() int64 {
+	if x != nil {
+		return x.PowerPlayGoals
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetSog() int64 {
+	if x != nil {
+		return x.Sog
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetFaceoffWinningPctg() float64 {
+	if x != nil {
+		return x.FaceoffWinningPctg
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetToi() string {
+	if x != nil {
+		return x.Toi
+	}
+	return ""
+}
+
+func (x *SkaterStats) GetBlockedShots() int64 {
+	if x != nil {
+		return x.BlockedShots
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetShifts() int64 {
+	if x != nil {
+		return x.Shifts
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetGiveaways() int64 {
+	if x != nil {
+		return x.Giveaways
+	}
+	return 0
+}
+
+func (x *SkaterStats) GetTakeaways() int64 {
+	if x != nil {
+		return x.Takeaways
+	}
+	return 0
+}
+
+// GoalieStats mirrors nhl.GoalieStats.
+type GoalieStats struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId                 int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	SweaterNumber            int64                  `protobuf:"varint,2,opt,name=sweater_number,json=sweaterNumber,proto3" json:"sweater_number,omitempty"`
+	Name                     *LocalizedString       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Position                 string                 `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	EvenStrengthShotsAgainst string                 `protobuf:"bytes,5,opt,name=even_strength_shots_against,json=evenStrengthShotsAgainst,proto3" json:"even_strength_shots_against,omitempty"`
+	PowerPlayShotsAgainst    string                 `protobuf:"bytes,6,opt,name=power_play_shots_against,json=powerPlayShotsAgainst,proto3" json:"power_play_shots_against,omitempty"`
+	ShorthandedShotsAgainst  string                 `protobuf:"bytes,7,opt,name=shorthanded_shots_against,json=shorthandedShotsAgainst,proto3" json:"shorthanded_shots_against,omitempty"`
+	SaveShotsAgainst         string                 `protobuf:"bytes,8,opt,name=save_shots_against,json=saveShotsAgainst,proto3" json:"save_shots_against,omitempty"`
+	SavePctg                 *float64               `protobuf:"fixed64,9,opt,name=save_pctg,json=savePctg,proto3,oneof" json:"save_pctg,omitempty"`
+	EvenStrengthGoalsAgainst int64                  `protobuf:"varint,10,opt,name=even_strength_goals_against,json=evenStrengthGoalsAgainst,proto3" json:"even_strength_goals_against,omitempty"`
+	PowerPlayGoalsAgainst    int64                  `protobuf:"varint,11,opt,name=power_play_goals_against,json=powerPlayGoalsAgainst,proto3" json:"power_play_goals_against,omitempty"`
+	ShorthandedGoalsAgainst  int64                  `protobuf:"varint,12,opt,name=shorthanded_goals_against,json=shorthandedGoalsAgainst,proto3" json:"shorthanded_goals_against,omitempty"`
+	Pim                      *int64                 `protobuf:"varint,13,opt,name=pim,proto3,oneof" json:"pim,omitempty"`
+	GoalsAgainst             int64                  `protobuf:"varint,14,opt,name=goals_against,json=goalsAgainst,proto3" json:"goals_against,omitempty"`
+	Toi                      string                 `protobuf:"bytes,15,opt,name=toi,proto3" json:"toi,omitempty"`
+	Starter                  *bool                  `protobuf:"varint,16,opt,name=starter,proto3,oneof" json:"starter,omitempty"`
+	Decision                 *string                `protobuf:"bytes,17,opt,name=decision,proto3,oneof" json:"decision,omitempty"`
+	ShotsAgainst             int64                  `protobuf:"varint,18,opt,name=shots_against,json=shotsAgainst,proto3" json:"shots_against,omitempty"`
+	Saves                    int64                  `protobuf:"varint,19,opt,name=saves,proto3" json:"saves,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GoalieStats) Reset() {
+	*x = GoalieStats{}
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoalieStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalieStats) ProtoMessage() {}
+
+func (x *GoalieStats) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_boxscore_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalieStats.ProtoReflect.Descriptor instead.
+func (*GoalieStats) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_boxscore_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GoalieStats) GetPlayerId() int64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GoalieStats) GetSweaterNumber() int64 {
+	if x != nil {
+		return x.SweaterNumber
+	}
+	return 0
+}
+
+func (x *GoalieStats) GetName() *LocalizedString {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *GoalieStats) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *GoalieStats) GetEvenStrengthShotsAgainst() string {
+	if x != nil {
+		return x.EvenStrengthShotsAgainst
+	}
+	return ""
+}
+
+func (x *GoalieStats) GetPowerPlayShotsAgainst() string {
+	if x != nil {
+		return x.PowerPlayShotsAgainst
+	}
+	return ""
+}
+
+func (x *GoalieStats) GetShorthandedShotsAgainst() string {
+	if x != nil {
+		return x.ShorthandedShotsAgainst
+	}
+	return ""
+}
+
+func (x *GoalieStats) GetSaveShotsAgainst() string {
+	if x != nil {
+		return x.SaveShotsAgainst
+	}
+	return ""
+}
+
+func (x *GoalieStats) GetSavePctg() float64 {
+	if x != nil && x.SavePctg != nil {
+		return *x.SavePctg
+	}
+	return 0
+}
+
+func (x *GoalieStats) GetEvenStrengthGoalsAgainst() int64 {
+	if x != nil {
+		return x.EvenStrengthGoalsAgainst
+	}
+	return 0
+}
+
+func (x *GoalieStats) GetPowerPlayGoalsAgainst() int64 {
+	if x != nil {
+		return x.PowerPlayGoalsAgainst
+	}
+	return 0
+}
+
+func (x *GoalieStats) GetShorthandedGoalsAgainst() int64 {
+	if x != nil {
+		return x.ShorthandedGoalsAgainst
+	}
+	return 0
+}
+
+func (x *GoalieStats) GetPim() int64 {
+	if x != nil && x.Pim != nil {
+		return *x.Pim
+	}
+	return 0
+}
+
+func (x *GoalieStats) GetGoalsAgainst() int64 {
+	if x != nil {
+		return x.GoalsAgainst
+	}
+	return 0
+}
+
+func (x *GoalieStats) GetToi() string {
+	if x != nil {
+		return x.Toi
+	}
+	return ""
+}
+
+func (x *GoalieStats) GetStarter() bool {
+	if x != nil && x.Starter != nil {
+		return *x.Starter
+	}
+	return false
+}
+
+func (x *GoalieStats) GetDecision() string {
+	if x != nil && x.Decision != nil {
+		return *x.Decision
+	}
+	return ""
+}
+
+func (x *GoalieStats) GetShotsAgainst() int64 {
+	if x != nil {
+		return x.ShotsAgainst
+	}
+	return 0
+}
+
+func (x *GoalieStats) GetSaves() int64 {
+	if x != nil {
+		return x.Saves
+	}
+	return 0
+}
+
+var File_nhl_v1_boxscore_proto protoreflect.FileDescriptor
+
+const file_nhl_v1_boxscore_proto_rawDesc = "" +
+	"\n" +
+	"\x15nhl/v1/boxscore.proto\x12\x06nhl.v1\x1a\x13nhl/v1/common.proto\"\xdc\b\n" +
+	"\bBoxscore\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12*\n" +
+	"\x11season_start_year\x18\x02 \x01(\x03R\x0fseasonStartYear\x12\x1b\n" +
+	"\tgame_type\x18\x03 \x01(\x03R\bgameType\x12'\n" +
+	"\x0flimited_scoring\x18\x04 \x01(\bR\x0elimitedScoring\x12\x1b\n" +
+	"\tgame_date\x18\x05 \x01(\tR\bgameDate\x12-\n" +
+	"\x05venue\x18\x06 \x01(\v2\x17.nhl.v1.LocalizedStringR\x05venue\x12>\n" +
+	"\x0evenue_location\x18\a \x01(\v2\x17.nhl.v1.LocalizedStringR\rvenueLocation\x12$\n" +
+	"\x0estart_time_utc\x18\b \x01(\tR\fstartTimeUtc\x12,\n" +
+	"\x12eastern_utc_offset\x18\t \x01(\tR\x10easternUtcOffset\x12(\n" +
+	"\x10venue_utc_offset\x18\n" +
+	" \x01(\tR\x0evenueUtcOffset\x128\n" +
+	"\rtv_broadcasts\x18\v \x03(\v2\x13.nhl.v1.TVBroadcastR\ftvBroadcasts\x12\x1d\n" +
+	"\n" +
+	"game_state\x18\f \x01(\tR\tgameState\x12.\n" +
+	"\x13game_schedule_state\x18\r \x01(\tR\x11gameScheduleState\x12E\n" +
+	"\x11period_descriptor\x18\x0e \x01(\v2\x18.nhl.v1.PeriodDescriptorR\x10periodDescriptor\x129\n" +
+	"\rspecial_event\x18\x0f \x01(\v2\x14.nhl.v1.SpecialEventR\fspecialEvent\x121\n" +
+	"\taway_team\x18\x10 \x01(\v2\x14.nhl.v1.BoxscoreTeamR\bawayTeam\x121\n" +
+	"\thome_team\x18\x11 \x01(\v2\x14.nhl.v1.BoxscoreTeamR\bhomeTeam\x12'\n" +
+	"\x05clock\x18\x12 \x01(\v2\x11.nhl.v1.GameClockR\x05clock\x12J\n" +
+	"\x14player_by_game_stats\x18\x13 \x01(\v2\x19.nhl.v1.PlayerByGameStatsR\x11playerByGameStats\x12/\n" +
+	"\tlinescore\x18\x14 \x01(\v2\x11.nhl.v1.LinescoreR\tlinescore\x12;\n" +
+	"\x0fshots_by_period\x18\x15 \x03(\v2\x13.nhl.v1.PeriodScoreR\rshotsByPeriod\x12<\n" +
+	"\x0fteam_game_stats\x18\x16 \x03(\v2\x14.nhl.v1.TeamGameStatR\rteamGameStats\x12#\n" +
+	"\n" +
+	"attendance\x18\x17 \x01(\x03H\x00R\n" +
+	"attendance\x88\x01\x01B\r\n" +
+	"\v_attendance\"{\n" +
+	"\tLinescore\x120\n" +
+	"\tby_period\x18\x01 \x03(\v2\x13.nhl.v1.PeriodScoreR\bbyPeriod\x12\x1d\n" +
+	"\n" +
+	"away_total\x18\x02 \x01(\x03R\tawayTotal\x12\x1d\n" +
+	"\n" +
+	"home_total\x18\x03 \x01(\x03R\thomeTotal\"|\n" +
+	"\vPeriodScore\x12E\n" +
+	"\x11period_descriptor\x18\x01 \x01(\v2\x18.nhl.v1.PeriodDescriptorR\x10periodDescriptor\x12\x12\n" +
+	"\x04away\x18\x02 \x01(\x03R\x04away\x12\x12\n" +
+	"\x04home\x18\x03 \x01(\x03R\x04home\"z\n" +
+	"\fTeamGameStat\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12&\n" +
+	"\x0faway_value_json\x18\x02 \x01(\fR\rawayValueJson\x12&\n" +
+	"\x0fhome_value_json\x18\x03 \x01(\fR\rhomeValueJson\"\x9b\x01\n" +
+	"\vTVBroadcast\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06market\x18\x02 \x01(\tR\x06market\x12!\n" +
+	"\fcountry_code\x18\x03 \x01(\tR\vcountryCode\x12\x18\n" +
+	"\anetwork\x18\x04 \x01(\tR\anetwork\x12'\n" +
+	"\x0fsequence_number\x18\x05 \x01(\x03R\x0esequenceNumber\"\x97\x01\n" +
+	"\fSpecialEvent\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\x03R\bparentId\x12+\n" +
+	"\x04name\x18\x02 \x01(\v2\x17.nhl.v1.LocalizedStringR\x04name\x12=\n" +
+	"\x0elight_logo_url\x18\x03 \x01(\v2\x17.nhl.v1.LocalizedStringR\flightLogoUrl\"\xd9\x02\n" +
+	"\fBoxscoreTeam\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x128\n" +
+	"\vcommon_name\x18\x02 \x01(\v2\x17.nhl.v1.LocalizedStringR\n" +
+	"commonName\x12\x16\n" +
+	"\x06abbrev\x18\x03 \x01(\tR\x06abbrev\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x03R\x05score\x12\x10\n" +
+	"\x03sog\x18\x05 \x01(\x03R\x03sog\x12\x12\n" +
+	"\x04logo\x18\x06 \x01(\tR\x04logo\x12\x1b\n" +
+	"\tdark_logo\x18\a \x01(\tR\bdarkLogo\x126\n" +
+	"\n" +
+	"place_name\x18\b \x01(\v2\x17.nhl.v1.LocalizedStringR\tplaceName\x12V\n" +
+	"\x1bplace_name_with_preposition\x18\t \x01(\v2\x17.nhl.v1.LocalizedStringR\x18placeNameWithPreposition\"\xa2\x01\n" +
+	"\tGameClock\x12%\n" +
+	"\x0etime_remaining\x18\x01 \x01(\tR\rtimeRemaining\x12+\n" +
+	"\x11seconds_remaining\x18\x02 \x01(\x03R\x10secondsRemaining\x12\x18\n" +
+	"\arunning\x18\x03 \x01(\bR\arunning\x12'\n" +
+	"\x0fin_intermission\x18\x04 \x01(\bR\x0einIntermission\"\x7f\n" +
+	"\x11PlayerByGameStats\x124\n" +
+	"\taway_team\x18\x01 \x01(\v2\x17.nhl.v1.TeamPlayerStatsR\bawayTeam\x124\n" +
+	"\thome_team\x18\x02 \x01(\v2\x17.nhl.v1.TeamPlayerStatsR\bhomeTeam\"\xa0\x01\n" +
+	"\x0fTeamPlayerStats\x12/\n" +
+	"\bforwards\x18\x01 \x03(\v2\x13.nhl.v1.SkaterStatsR\bforwards\x12-\n" +
+	"\adefense\x18\x02 \x03(\v2\x13.nhl.v1.SkaterStatsR\adefense\x12-\n" +
+	"\agoalies\x18\x03 \x03(\v2\x13.nhl.v1.GoalieStatsR\agoalies\"\xa0\x04\n" +
+	"\vSkaterStats\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\x12%\n" +
+	"\x0esweater_number\x18\x02 \x01(\x03R\rsweaterNumber\x12+\n" +
+	"\x04name\x18\x03 \x01(\v2\x17.nhl.v1.LocalizedStringR\x04name\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\tR\bposition\x12\x14\n" +
+	"\x05goals\x18\x05 \x01(\x03R\x05goals\x12\x18\n" +
+	"\aassists\x18\x06 \x01(\x03R\aassists\x12\x16\n" +
+	"\x06points\x18\a \x01(\x03R\x06points\x12\x1d\n" +
+	"\n" +
+	"plus_minus\x18\b \x01(\x03R\tplusMinus\x12\x10\n" +
+	"\x03pim\x18\t \x01(\x03R\x03pim\x12\x12\n" +
+	"\x04hits\x18\n" +
+	" \x01(\x03R\x04hits\x12(\n" +
+	"\x10power_play_goals\x18\v \x01(\x03R\x0epowerPlayGoals\x12\x10\n" +
+	"\x03sog\x18\f \x01(\x03R\x03sog\x120\n" +
+	"\x14faceoff_winning_pctg\x18\r \x01(\x01R\x12faceoffWinningPctg\x12\x10\n" +
+	"\x03toi\x18\x0e \x01(\tR\x03toi\x12#\n" +
+	"\rblocked_shots\x18\x0f \x01(\x03R\fblockedShots\x12\x16\n" +
+	"\x06shifts\x18\x10 \x01(\x03R\x06shifts\x12\x1c\n" +
+	"\tgiveaways\x18\x11 \x01(\x03R\tgiveaways\x12\x1c\n" +
+	"\ttakeaways\x18\x12 \x01(\x03R\ttakeaways\"\xca\x06\n" +
+	"\vGoalieStats\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\x12%\n" +
+	"\x0esweater_number\x18\x02 \x01(\x03R\rsweaterNumber\x12+\n" +
+	"\x04name\x18\x03 \x01(\v2\x17.nhl.v1.LocalizedStringR\x04name\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\tR\bposition\x12=\n" +
+	"\x1beven_strength_shots_against\x18\x05 \x01(\tR\x18evenStrengthShotsAgainst\x127\n" +
+	"\x18power_play_shots_against\x18\x06 \x01(\tR\x15powerPlayShotsAgainst\x12:\n" +
+	"\x19shorthanded_shots_against\x18\a \x01(\tR\x17shorthandedShotsAgainst\x12,\n" +
+	"\x12save_shots_against\x18\b \x01(\tR\x10saveShotsAgainst\x12 \n" +
+	"\tsave_pctg\x18\t \x01(\x01H\x00R\bsavePctg\x88\x01\x01\x12=\n" +
+	"\x1beven_strength_goals_against\x18\n" +
+	" \x01(\x03R\x18evenStrengthGoalsAgainst\x127\n" +
+	"\x18power_play_goals_against\x18\v \x01(\x03R\x15powerPlayGoalsAgainst\x12:\n" +
+	"\x19shorthanded_goals_against\x18\f \x01(\x03R\x17shorthandedGoalsAgainst\x12\x15\n" +
+	"\x03pim\x18\r \x01(\x03H\x01R\x03pim\x88\x01\x01\x12#\n" +
+	"\rgoals_against\x18\x0e \x01(\x03R\fgoalsAgainst\x12\x10\n" +
+	"\x03toi\x18\x0f \x01(\tR\x03toi\x12\x1d\n" +
+	"\astarter\x18\x10 \x01(\bH\x02R\astarter\x88\x01\x01\x12\x1f\n" +
+	"\bdecision\x18\x11 \x01(\tH\x03R\bdecision\x88\x01\x01\x12#\n" +
+	"\rshots_against\x18\x12 \x01(\x03R\fshotsAgainst\x12\x14\n" +
+	"\x05saves\x18\x13 \x01(\x03R\x05savesB\f\n" +
+	"\n" +
+	"_save_pctgB\x06\n" +
+	"\x04_pimB\n" +
+	"\n" +
+	"\b_starterB\v\n" +
+	"\t_decisionB+Z)github.com/sperano/nhl-api-go/nhlpb;nhlpbb\x06proto3"
+
+var (
+	file_nhl_v1_boxscore_proto_rawDescOnce sync.Once
+	file_nhl_v1_boxscore_proto_rawDescData []byte
+)
+
+func file_nhl_v1_boxscore_proto_rawDescGZIP() []byte {
+	file_nhl_v1_boxscore_proto_rawDescOnce.Do(func() {
+		file_nhl_v1_boxscore_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nhl_v1_boxscore_proto_rawDesc), len(file_nhl_v1_boxscore_proto_rawDesc)))
+	})
+	return file_nhl_v1_boxscore_proto_rawDescData
+}
+
+var file_nhl_v1_boxscore_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_nhl_v1_boxscore_proto_goTypes = []any{
+	(*Boxscore)(nil),          // 0: nhl.v1.Boxscore
+	(*Linescore)(nil),         // 1: nhl.v1.Linescore
+	(*PeriodScore)(nil),       // 2: nhl.v1.PeriodScore
+	(*TeamGameStat)(nil),      // 3: nhl.v1.TeamGameStat
+	(*TVBroadcast)(nil),       // 4: nhl.v1.TVBroadcast
+	(*SpecialEvent)(nil),      // 5: nhl.v1.SpecialEvent
+	(*BoxscoreTeam)(nil),      // 6: nhl.v1.BoxscoreTeam
+	(*GameClock)(nil),         // 7: nhl.v1.GameClock
+	(*PlayerByGameStats)(nil), // 8: nhl.v1.PlayerByGameStats
+	(*TeamPlayerStats)(nil),   // 9: nhl.v1.TeamPlayerStats
+	(*SkaterStats)(nil),       // 10: nhl.v1.SkaterStats
+	(*GoalieStats)(nil),       // 11: nhl.v1.GoalieStats
+	(*LocalizedString)(nil),   // 12: nhl.v1.LocalizedString
+	(*PeriodDescriptor)(nil),  // 13: nhl.v1.PeriodDescriptor
+}
+var file_nhl_v1_boxscore_proto_depIdxs = []int32{
+	12, // 0: nhl.v1.Boxscore.venue:type_name -> nhl.v1.LocalizedString
+	12, // 1: nhl.v1.Boxscore.venue_location:type_name -> nhl.v1.LocalizedString
+	4,  // 2: nhl.v1.Boxscore.tv_broadcasts:type_name -> nhl.v1.TVBroadcast
+	13, // 3: nhl.v1.Boxscore.period_descriptor:type_name -> nhl.v1.PeriodDescriptor
+	5,  // 4: nhl.v1.Boxscore.special_event:type_name -> nhl.v1.SpecialEvent
+	6,  // 5: nhl.v1.Boxscore.away_team:type_name -> nhl.v1.BoxscoreTeam
+	6,  // 6: nhl.v1.Boxscore.home_team:type_name -> nhl.v1.BoxscoreTeam
+	7,  // 7: nhl.v1.Boxscore.clock:type_name -> nhl.v1.GameClock
+	8,  // 8: nhl.v1.Boxscore.player_by_game_stats:type_name -> nhl.v1.PlayerByGameStats
+	1,  // 9: nhl.v1.Boxscore.linescore:type_name -> nhl.v1.Linescore
+	2,  // 10: nhl.v1.Boxscore.shots_by_period:type_name -> nhl.v1.PeriodScore
+	3,  // 11: nhl.v1.Boxscore.team_game_stats:type_name -> nhl.v1.TeamGameStat
+	2,  // 12: nhl.v1.Linescore.by_period:type_name -> nhl.v1.PeriodScore
+	13, // 13: nhl.v1.PeriodScore.period_descriptor:type_name -> nhl.v1.PeriodDescriptor
+	12, // 14: nhl.v1.SpecialEvent.name:type_name -> nhl.v1.LocalizedString
+	12, // 15: nhl.v1.SpecialEvent.light_logo_url:type_name -> nhl.v1.LocalizedString
+	12, // 16: nhl.v1.BoxscoreTeam.common_name:type_name -> nhl.v1.LocalizedString
+	12, // 17: nhl.v1.BoxscoreTeam.place_name:type_name -> nhl.v1.LocalizedString
+	12, // 18: nhl.v1.BoxscoreTeam.place_name_with_preposition:type_name -> nhl.v1.LocalizedString
+	9,  // 19: nhl.v1.PlayerByGameStats.away_team:type_name -> nhl.v1.TeamPlayerStats
+	9,  // 20: nhl.v1.PlayerByGameStats.home_team:type_name -> nhl.v1.TeamPlayerStats
+	10, // 21: nhl.v1.TeamPlayerStats.forwards:type_name -> nhl.v1.SkaterStats
+	10, // 22: nhl.v1.TeamPlayerStats.defense:type_name -> nhl.v1.SkaterStats
+	11, // 23: nhl.v1.TeamPlayerStats.goalies:type_name -> nhl.v1.GoalieStats
+	12, // 24: nhl.v1.SkaterStats.name:type_name -> nhl.v1.LocalizedString
+	12, // 25: nhl.v1.GoalieStats.name:type_name -> nhl.v1.LocalizedString
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_nhl_v1_boxscore_proto_init() }
+func file_nhl_v1_boxscore_proto_init() {
+	if File_nhl_v1_boxscore_proto != nil {
+		return
+	}
+	file_nhl_v1_common_proto_init()
+	file_nhl_v1_boxscore_proto_msgTypes[0].OneofWrappers = []any{}
+	file_nhl_v1_boxscore_proto_msgTypes[11].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nhl_v1_boxscore_proto_rawDesc), len(file_nhl_v1_boxscore_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_nhl_v1_boxscore_proto_goTypes,
+		DependencyIndexes: file_nhl_v1_boxscore_proto_depIdxs,
+		MessageInfos:      file_nhl_v1_boxscore_proto_msgTypes,
+	}.Build()
+	File_nhl_v1_boxscore_proto = out.File
+	file_nhl_v1_boxscore_proto_goTypes = nil
+	file_nhl_v1_boxscore_proto_depIdxs = nil
+}
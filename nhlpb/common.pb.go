@@ -0,0 +1,254 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: nhl/v1/common.proto
+
+package nhlpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LocalizedString mirrors nhl.LocalizedString.
+type LocalizedString struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Default       string                 `protobuf:"bytes,1,opt,name=default,proto3" json:"default,omitempty"`
+	Fr            string                 `protobuf:"bytes,2,opt,name=fr,proto3" json:"fr,omitempty"`
+	Cs            string                 `protobuf:"bytes,3,opt,name=cs,proto3" json:"cs,omitempty"`
+	De            string                 `protobuf:"bytes,4,opt,name=de,proto3" json:"de,omitempty"`
+	Es            string                 `protobuf:"bytes,5,opt,name=es,proto3" json:"es,omitempty"`
+	Fi            string                 `protobuf:"bytes,6,opt,name=fi,proto3" json:"fi,omitempty"`
+	Sk            string                 `protobuf:"bytes,7,opt,name=sk,proto3" json:"sk,omitempty"`
+	Sv            string                 `protobuf:"bytes,8,opt,name=sv,proto3" json:"sv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalizedString) Reset() {
+	*x = LocalizedString{}
+	mi := &file_nhl_v1_common_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalizedString) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalizedString) ProtoMessage() {}
+
+func (x *LocalizedString) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_common_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalizedString.ProtoReflect.Descriptor instead.
+func (*LocalizedString) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LocalizedString) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
+func (x *LocalizedString) GetFr() string {
+	if x != nil {
+		return x.Fr
+	}
+	return ""
+}
+
+func (x *LocalizedString) GetCs() string {
+	if x != nil {
+		return x.Cs
+	}
+	return ""
+}
+
+func (x *LocalizedString) GetDe() string {
+	if x != nil {
+		return x.De
+	}
+	return ""
+}
+
+func (x *LocalizedString) GetEs() string {
+	if x != nil {
+		return x.Es
+	}
+	return ""
+}
+
+func (x *LocalizedString) GetFi() string {
+	if x != nil {
+		return x.Fi
+	}
+	return ""
+}
+
+func (x *LocalizedString) GetSk() string {
+	if x != nil {
+		return x.Sk
+	}
+	return ""
+}
+
+func (x *LocalizedString) GetSv() string {
+	if x != nil {
+		return x.Sv
+	}
+	return ""
+}
+
+// PeriodDescriptor mirrors nhl.PeriodDescriptor.
+type PeriodDescriptor struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Number int64                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// One of the nhl.PeriodType values ("REG", "OT", "SO"), or empty.
+	PeriodType           string `protobuf:"bytes,2,opt,name=period_type,json=periodType,proto3" json:"period_type,omitempty"`
+	MaxRegulationPeriods int64  `protobuf:"varint,3,opt,name=max_regulation_periods,json=maxRegulationPeriods,proto3" json:"max_regulation_periods,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PeriodDescriptor) Reset() {
+	*x = PeriodDescriptor{}
+	mi := &file_nhl_v1_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodDescriptor) ProtoMessage() {}
+
+func (x *PeriodDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodDescriptor.ProtoReflect.Descriptor instead.
+func (*PeriodDescriptor) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PeriodDescriptor) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *PeriodDescriptor) GetPeriodType() string {
+	if x != nil {
+		return x.PeriodType
+	}
+	return ""
+}
+
+func (x *PeriodDescriptor) GetMaxRegulationPeriods() int64 {
+	if x != nil {
+		return x.MaxRegulationPeriods
+	}
+	return 0
+}
+
+var File_nhl_v1_common_proto protoreflect.FileDescriptor
+
+const file_nhl_v1_common_proto_rawDesc = "" +
+	"\n" +
+	"\x13nhl/v1/common.proto\x12\x06nhl.v1\"\x9b\x01\n" +
+	"\x0fLocalizedString\x12\x18\n" +
+	"\adefault\x18\x01 \x01(\tR\adefault\x12\x0e\n" +
+	"\x02fr\x18\x02 \x01(\tR\x02fr\x12\x0e\n" +
+	"\x02cs\x18\x03 \x01(\tR\x02cs\x12\x0e\n" +
+	"\x02de\x18\x04 \x01(\tR\x02de\x12\x0e\n" +
+	"\x02es\x18\x05 \x01(\tR\x02es\x12\x0e\n" +
+	"\x02fi\x18\x06 \x01(\tR\x02fi\x12\x0e\n" +
+	"\x02sk\x18\a \x01(\tR\x02sk\x12\x0e\n" +
+	"\x02sv\x18\b \x01(\tR\x02sv\"\x81\x01\n" +
+	"\x10PeriodDescriptor\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x03R\x06number\x12\x1f\n" +
+	"\vperiod_type\x18\x02 \x01(\tR\n" +
+	"periodType\x124\n" +
+	"\x16max_regulation_periods\x18\x03 \x01(\x03R\x14maxRegulationPeriodsB+Z)github.com/sperano/nhl-api-go/nhlpb;nhlpbb\x06proto3"
+
+var (
+	file_nhl_v1_common_proto_rawDescOnce sync.Once
+	file_nhl_v1_common_proto_rawDescData []byte
+)
+
+func file_nhl_v1_common_proto_rawDescGZIP() []byte {
+	file_nhl_v1_common_proto_rawDescOnce.Do(func() {
+		file_nhl_v1_common_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nhl_v1_common_proto_rawDesc), len(file_nhl_v1_common_proto_rawDesc)))
+	})
+	return file_nhl_v1_common_proto_rawDescData
+}
+
+var file_nhl_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_nhl_v1_common_proto_goTypes = []any{
+	(*LocalizedString)(nil),  // 0: nhl.v1.LocalizedString
+	(*PeriodDescriptor)(nil), // 1: nhl.v1.PeriodDescriptor
+}
+var file_nhl_v1_common_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_nhl_v1_common_proto_init() }
+func file_nhl_v1_common_proto_init() {
+	if File_nhl_v1_common_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nhl_v1_common_proto_rawDesc), len(file_nhl_v1_common_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_nhl_v1_common_proto_goTypes,
+		DependencyIndexes: file_nhl_v1_common_proto_depIdxs,
+		MessageInfos:      file_nhl_v1_common_proto_msgTypes,
+	}.Build()
+	File_nhl_v1_common_proto = out.File
+	file_nhl_v1_common_proto_goTypes = nil
+	file_nhl_v1_common_proto_depIdxs = nil
+}
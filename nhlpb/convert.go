@@ -0,0 +1,757 @@
+package nhlpb
+
+import "github.com/sperano/nhl-api-go/nhl"
+
+// ===== Common =====
+
+// LocalizedStringFromNHL converts an nhl.LocalizedString to its message.
+func LocalizedStringFromNHL(s nhl.LocalizedString) *LocalizedString {
+	return &LocalizedString{
+		Default: s.Default,
+		Fr:      s.Fr,
+		Cs:      s.Cs,
+		De:      s.De,
+		Es:      s.Es,
+		Fi:      s.Fi,
+		Sk:      s.Sk,
+		Sv:      s.Sv,
+	}
+}
+
+// LocalizedStringToNHL converts a LocalizedString message to an
+// nhl.LocalizedString. A nil message yields the zero value.
+func LocalizedStringToNHL(m *LocalizedString) nhl.LocalizedString {
+	return nhl.LocalizedString{
+		Default: m.GetDefault(),
+		Fr:      m.GetFr(),
+		Cs:      m.GetCs(),
+		De:      m.GetDe(),
+		Es:      m.GetEs(),
+		Fi:      m.GetFi(),
+		Sk:      m.GetSk(),
+		Sv:      m.GetSv(),
+	}
+}
+
+// PeriodDescriptorFromNHL converts an nhl.PeriodDescriptor to its message.
+func PeriodDescriptorFromNHL(p nhl.PeriodDescriptor) *PeriodDescriptor {
+	return &PeriodDescriptor{
+		Number:               int64(p.Number),
+		PeriodType:           string(p.PeriodType),
+		MaxRegulationPeriods: int64(p.MaxRegulationPeriods),
+	}
+}
+
+// PeriodDescriptorToNHL converts a PeriodDescriptor message to an
+// nhl.PeriodDescriptor.
+func PeriodDescriptorToNHL(m *PeriodDescriptor) nhl.PeriodDescriptor {
+	return nhl.PeriodDescriptor{
+		Number:               int(m.GetNumber()),
+		PeriodType:           nhl.PeriodType(m.GetPeriodType()),
+		MaxRegulationPeriods: int(m.GetMaxRegulationPeriods()),
+	}
+}
+
+// ===== Boxscore =====
+
+// BoxscoreFromNHL converts an nhl.Boxscore to its message. It returns nil
+// for a nil boxscore.
+func BoxscoreFromNHL(b *nhl.Boxscore) *Boxscore {
+	if b == nil {
+		return nil
+	}
+	m := &Boxscore{
+		Id:                int64(b.ID),
+		SeasonStartYear:   int64(b.Season.StartYear()),
+		GameType:          int64(b.GameType),
+		LimitedScoring:    b.LimitedScoring,
+		GameDate:          b.GameDate,
+		Venue:             LocalizedStringFromNHL(b.Venue),
+		VenueLocation:     LocalizedStringFromNHL(b.VenueLocation),
+		StartTimeUtc:      b.StartTimeUTC,
+		EasternUtcOffset:  b.EasternUTCOffset,
+		VenueUtcOffset:    b.VenueUTCOffset,
+		TvBroadcasts:      make([]*TVBroadcast, len(b.TVBroadcasts)),
+		GameState:         string(b.GameState),
+		GameScheduleState: string(b.GameScheduleState),
+		PeriodDescriptor:  PeriodDescriptorFromNHL(b.PeriodDescriptor),
+		AwayTeam:          boxscoreTeamFromNHL(b.AwayTeam),
+		HomeTeam:          boxscoreTeamFromNHL(b.HomeTeam),
+		Clock: &GameClock{
+			TimeRemaining:    b.Clock.TimeRemaining,
+			SecondsRemaining: int64(b.Clock.SecondsRemaining),
+			Running:          b.Clock.Running,
+			InIntermission:   b.Clock.InIntermission,
+		},
+		PlayerByGameStats: &PlayerByGameStats{
+			AwayTeam: teamPlayerStatsFromNHL(b.PlayerByGameStats.AwayTeam),
+			HomeTeam: teamPlayerStatsFromNHL(b.PlayerByGameStats.HomeTeam),
+		},
+	}
+	for i, tv := range b.TVBroadcasts {
+		m.TvBroadcasts[i] = &TVBroadcast{
+			Id:             tv.ID,
+			Market:         tv.Market,
+			CountryCode:    tv.CountryCode,
+			Network:        tv.Network,
+			SequenceNumber: int64(tv.SequenceNumber),
+		}
+	}
+	m.SpecialEvent = specialEventFromNHL(b.SpecialEvent)
+	if b.Linescore != nil {
+		m.Linescore = &Linescore{
+			ByPeriod:  periodScoresFromNHL(b.Linescore.ByPeriod),
+			AwayTotal: int64(b.Linescore.Totals.Away),
+			HomeTotal: int64(b.Linescore.Totals.Home),
+		}
+	}
+	m.ShotsByPeriod = periodScoresFromNHL(b.ShotsByPeriod)
+	for _, stat := range b.TeamGameStats {
+		m.TeamGameStats = append(m.TeamGameStats, &TeamGameStat{
+			Category:      stat.Category,
+			AwayValueJson: stat.AwayValue,
+			HomeValueJson: stat.HomeValue,
+		})
+	}
+	m.Attendance = int64Ptr(b.Attendance)
+	return m
+}
+
+// BoxscoreToNHL converts a Boxscore message to an nhl.Boxscore. It returns
+// nil for a nil message.
+func BoxscoreToNHL(m *Boxscore) *nhl.Boxscore {
+	if m == nil {
+		return nil
+	}
+	b := &nhl.Boxscore{
+		ID:                nhl.GameID(m.GetId()),
+		Season:            nhl.NewSeason(int(m.GetSeasonStartYear())),
+		GameType:          nhl.GameType(m.GetGameType()),
+		LimitedScoring:    m.GetLimitedScoring(),
+		GameDate:          m.GetGameDate(),
+		Venue:             LocalizedStringToNHL(m.GetVenue()),
+		VenueLocation:     LocalizedStringToNHL(m.GetVenueLocation()),
+		StartTimeUTC:      m.GetStartTimeUtc(),
+		EasternUTCOffset:  m.GetEasternUtcOffset(),
+		VenueUTCOffset:    m.GetVenueUtcOffset(),
+		TVBroadcasts:      make([]nhl.TVBroadcast, len(m.GetTvBroadcasts())),
+		GameState:         nhl.GameState(m.GetGameState()),
+		GameScheduleState: nhl.GameScheduleState(m.GetGameScheduleState()),
+		PeriodDescriptor:  PeriodDescriptorToNHL(m.GetPeriodDescriptor()),
+		AwayTeam:          boxscoreTeamToNHL(m.GetAwayTeam()),
+		HomeTeam:          boxscoreTeamToNHL(m.GetHomeTeam()),
+		Clock: nhl.GameClock{
+			TimeRemaining:    m.GetClock().GetTimeRemaining(),
+			SecondsRemaining: int(m.GetClock().GetSecondsRemaining()),
+			Running:          m.GetClock().GetRunning(),
+			InIntermission:   m.GetClock().GetInIntermission(),
+		},
+		PlayerByGameStats: nhl.PlayerByGameStats{
+			AwayTeam: teamPlayerStatsToNHL(m.GetPlayerByGameStats().GetAwayTeam()),
+			HomeTeam: teamPlayerStatsToNHL(m.GetPlayerByGameStats().GetHomeTeam()),
+		},
+	}
+	for i, tv := range m.GetTvBroadcasts() {
+		b.TVBroadcasts[i] = nhl.TVBroadcast{
+			ID:             tv.GetId(),
+			Market:         tv.GetMarket(),
+			CountryCode:    tv.GetCountryCode(),
+			Network:        tv.GetNetwork(),
+			SequenceNumber: int(tv.GetSequenceNumber()),
+		}
+	}
+	b.SpecialEvent = specialEventToNHL(m.GetSpecialEvent())
+	if ls := m.GetLinescore(); ls != nil {
+		b.Linescore = &nhl.Linescore{
+			ByPeriod: periodScoresToNHL(ls.GetByPeriod()),
+			Totals:   nhl.LinescoreTotal{Away: int(ls.GetAwayTotal()), Home: int(ls.GetHomeTotal())},
+		}
+		if b.Linescore.ByPeriod == nil {
+			b.Linescore.ByPeriod = []nhl.PeriodScore{}
+		}
+	}
+	b.ShotsByPeriod = periodScoresToNHL(m.GetShotsByPeriod())
+	for _, stat := range m.GetTeamGameStats() {
+		b.TeamGameStats = append(b.TeamGameStats, nhl.TeamGameStat{
+			Category:  stat.GetCategory(),
+			AwayValue: stat.GetAwayValueJson(),
+			HomeValue: stat.GetHomeValueJson(),
+		})
+	}
+	b.Attendance = intPtr[int](m.Attendance)
+	return b
+}
+
+// periodScoresFromNHL converts a per-period table; an empty table stays
+// nil.
+func periodScoresFromNHL(scores []nhl.PeriodScore) []*PeriodScore {
+	if len(scores) == 0 {
+		return nil
+	}
+	out := make([]*PeriodScore, len(scores))
+	for i, p := range scores {
+		out[i] = &PeriodScore{
+			PeriodDescriptor: PeriodDescriptorFromNHL(p.PeriodDescriptor),
+			Away:             int64(p.Away),
+			Home:             int64(p.Home),
+		}
+	}
+	return out
+}
+
+func periodScoresToNHL(scores []*PeriodScore) []nhl.PeriodScore {
+	if len(scores) == 0 {
+		return nil
+	}
+	out := make([]nhl.PeriodScore, len(scores))
+	for i, p := range scores {
+		out[i] = nhl.PeriodScore{
+			PeriodDescriptor: PeriodDescriptorToNHL(p.GetPeriodDescriptor()),
+			Away:             int(p.GetAway()),
+			Home:             int(p.GetHome()),
+		}
+	}
+	return out
+}
+
+func boxscoreTeamFromNHL(t nhl.BoxscoreTeam) *BoxscoreTeam {
+	return &BoxscoreTeam{
+		Id:                       int64(t.ID),
+		CommonName:               LocalizedStringFromNHL(t.CommonName),
+		Abbrev:                   t.Abbrev,
+		Score:                    int64(t.Score),
+		Sog:                      int64(t.SOG),
+		Logo:                     t.Logo,
+		DarkLogo:                 t.DarkLogo,
+		PlaceName:                LocalizedStringFromNHL(t.PlaceName),
+		PlaceNameWithPreposition: LocalizedStringFromNHL(t.PlaceNameWithPreposition),
+	}
+}
+
+func boxscoreTeamToNHL(m *BoxscoreTeam) nhl.BoxscoreTeam {
+	return nhl.BoxscoreTeam{
+		ID:                       nhl.TeamID(m.GetId()),
+		CommonName:               LocalizedStringToNHL(m.GetCommonName()),
+		Abbrev:                   m.GetAbbrev(),
+		Score:                    int(m.GetScore()),
+		SOG:                      int(m.GetSog()),
+		Logo:                     m.GetLogo(),
+		DarkLogo:                 m.GetDarkLogo(),
+		PlaceName:                LocalizedStringToNHL(m.GetPlaceName()),
+		PlaceNameWithPreposition: LocalizedStringToNHL(m.GetPlaceNameWithPreposition()),
+	}
+}
+
+func teamPlayerStatsFromNHL(s nhl.TeamPlayerStats) *TeamPlayerStats {
+	m := &TeamPlayerStats{
+		Forwards: make([]*SkaterStats, len(s.Forwards)),
+		Defense:  make([]*SkaterStats, len(s.Defense)),
+		Goalies:  make([]*GoalieStats, len(s.Goalies)),
+	}
+	for i := range s.Forwards {
+		m.Forwards[i] = skaterStatsFromNHL(&s.Forwards[i])
+	}
+	for i := range s.Defense {
+		m.Defense[i] = skaterStatsFromNHL(&s.Defense[i])
+	}
+	for i := range s.Goalies {
+		m.Goalies[i] = goalieStatsFromNHL(&s.Goalies[i])
+	}
+	return m
+}
+
+func teamPlayerStatsToNHL(m *TeamPlayerStats) nhl.TeamPlayerStats {
+	s := nhl.TeamPlayerStats{
+		Forwards: make([]nhl.SkaterStats, len(m.GetForwards())),
+		Defense:  make([]nhl.SkaterStats, len(m.GetDefense())),
+		Goalies:  make([]nhl.GoalieStats, len(m.GetGoalies())),
+	}
+	for i, f := range m.GetForwards() {
+		s.Forwards[i] = skaterStatsToNHL(f)
+	}
+	for i, d := range m.GetDefense() {
+		s.Defense[i] = skaterStatsToNHL(d)
+	}
+	for i, g := range m.GetGoalies() {
+		s.Goalies[i] = goalieStatsToNHL(g)
+	}
+	return s
+}
+
+func skaterStatsFromNHL(s *nhl.SkaterStats) *SkaterStats {
+	return &SkaterStats{
+		PlayerId:           int64(s.PlayerID),
+		SweaterNumber:      int64(s.SweaterNumber),
+		Name:               LocalizedStringFromNHL(s.Name),
+		Position:           string(s.Position),
+		Goals:              int64(s.Goals),
+		Assists:            int64(s.Assists),
+		Points:             int64(s.Points),
+		PlusMinus:          int64(s.PlusMinus),
+		Pim:                int64(s.PIM),
+		Hits:               int64(s.Hits),
+		PowerPlayGoals:     int64(s.PowerPlayGoals),
+		Sog:                int64(s.SOG),
+		FaceoffWinningPctg: s.FaceoffWinningPctg,
+		Toi:                s.TOI.String(),
+		BlockedShots:       int64(s.BlockedShots),
+		Shifts:             int64(s.Shifts),
+		Giveaways:          int64(s.Giveaways),
+		Takeaways:          int64(s.Takeaways),
+	}
+}
+
+func skaterStatsToNHL(m *SkaterStats) nhl.SkaterStats {
+	return nhl.SkaterStats{
+		PlayerID:           nhl.PlayerID(m.GetPlayerId()),
+		SweaterNumber:      int(m.GetSweaterNumber()),
+		Name:               LocalizedStringToNHL(m.GetName()),
+		Position:           nhl.Position(m.GetPosition()),
+		Goals:              int(m.GetGoals()),
+		Assists:            int(m.GetAssists()),
+		Points:             int(m.GetPoints()),
+		PlusMinus:          int(m.GetPlusMinus()),
+		PIM:                int(m.GetPim()),
+		Hits:               int(m.GetHits()),
+		PowerPlayGoals:     int(m.GetPowerPlayGoals()),
+		SOG:                int(m.GetSog()),
+		FaceoffWinningPctg: m.GetFaceoffWinningPctg(),
+		TOI:                toiToNHL(m.GetToi()),
+		BlockedShots:       int(m.GetBlockedShots()),
+		Shifts:             int(m.GetShifts()),
+		Giveaways:          int(m.GetGiveaways()),
+		Takeaways:          int(m.GetTakeaways()),
+	}
+}
+
+func goalieStatsFromNHL(g *nhl.GoalieStats) *GoalieStats {
+	return &GoalieStats{
+		PlayerId:                 int64(g.PlayerID),
+		SweaterNumber:            int64(g.SweaterNumber),
+		Name:                     LocalizedStringFromNHL(g.Name),
+		Position:                 string(g.Position),
+		EvenStrengthShotsAgainst: g.EvenStrengthShotsAgainst.String(),
+		PowerPlayShotsAgainst:    g.PowerPlayShotsAgainst.String(),
+		ShorthandedShotsAgainst:  g.ShorthandedShotsAgainst.String(),
+		SaveShotsAgainst:         g.SaveShotsAgainst.String(),
+		SavePctg:                 copyPtr(g.SavePctg),
+		EvenStrengthGoalsAgainst: int64(g.EvenStrengthGoalsAgainst),
+		PowerPlayGoalsAgainst:    int64(g.PowerPlayGoalsAgainst),
+		ShorthandedGoalsAgainst:  int64(g.ShorthandedGoalsAgainst),
+		Pim:                      int64Ptr(g.PIM),
+		GoalsAgainst:             int64(g.GoalsAgainst),
+		Toi:                      g.TOI.String(),
+		Starter:                  copyPtr(g.Starter),
+		Decision:                 stringPtr(g.Decision),
+		ShotsAgainst:             int64(g.ShotsAgainst),
+		Saves:                    int64(g.Saves),
+	}
+}
+
+func goalieStatsToNHL(m *GoalieStats) nhl.GoalieStats {
+	return nhl.GoalieStats{
+		PlayerID:                 nhl.PlayerID(m.GetPlayerId()),
+		SweaterNumber:            int(m.GetSweaterNumber()),
+		Name:                     LocalizedStringToNHL(m.GetName()),
+		Position:                 nhl.Position(m.GetPosition()),
+		EvenStrengthShotsAgainst: shotsFractionToNHL(m.GetEvenStrengthShotsAgainst()),
+		PowerPlayShotsAgainst:    shotsFractionToNHL(m.GetPowerPlayShotsAgainst()),
+		ShorthandedShotsAgainst:  shotsFractionToNHL(m.GetShorthandedShotsAgainst()),
+		SaveShotsAgainst:         shotsFractionToNHL(m.GetSaveShotsAgainst()),
+		SavePctg:                 copyPtr(m.SavePctg),
+		EvenStrengthGoalsAgainst: int(m.GetEvenStrengthGoalsAgainst()),
+		PowerPlayGoalsAgainst:    int(m.GetPowerPlayGoalsAgainst()),
+		ShorthandedGoalsAgainst:  int(m.GetShorthandedGoalsAgainst()),
+		PIM:                      intPtr[int](m.Pim),
+		GoalsAgainst:             int(m.GetGoalsAgainst()),
+		TOI:                      toiToNHL(m.GetToi()),
+		Starter:                  copyPtr(m.Starter),
+		Decision:                 namedStringPtr[nhl.GoalieDecision](m.Decision),
+		ShotsAgainst:             int(m.GetShotsAgainst()),
+		Saves:                    int(m.GetSaves()),
+	}
+}
+
+// ===== PlayEvent =====
+
+// PlayEventFromNHL converts an nhl.PlayEvent to its message. It returns nil
+// for a nil event.
+func PlayEventFromNHL(e *nhl.PlayEvent) *PlayEvent {
+	if e == nil {
+		return nil
+	}
+	m := &PlayEvent{
+		EventId:               e.EventID,
+		PeriodDescriptor:      PeriodDescriptorFromNHL(e.PeriodDescriptor),
+		TimeInPeriod:          e.TimeInPeriod,
+		TimeRemaining:         e.TimeRemaining,
+		SituationCode:         e.SituationCode,
+		HomeTeamDefendingSide: string(e.HomeTeamDefendingSide),
+		TypeCode:              int64(e.TypeCode),
+		TypeDescKey:           string(e.TypeDescKey),
+		SortOrder:             int64(e.SortOrder),
+		PptReplayUrl:          copyPtr(e.PPTReplayURL),
+	}
+	if d := e.Details; d != nil {
+		m.Details = &PlayEventDetails{
+			XCoord:                  int64Ptr(d.XCoord),
+			YCoord:                  int64Ptr(d.YCoord),
+			ZoneCode:                stringPtr(d.ZoneCode),
+			EventOwnerTeamId:        int64Ptr(d.EventOwnerTeamID),
+			ShotType:                copyPtr(d.ShotType),
+			ShootingPlayerId:        int64Ptr(d.ShootingPlayerID),
+			GoalieInNetId:           int64Ptr(d.GoalieInNetID),
+			BlockingPlayerId:        int64Ptr(d.BlockingPlayerID),
+			ScoringPlayerId:         int64Ptr(d.ScoringPlayerID),
+			ScoringPlayerTotal:      int64Ptr(d.ScoringPlayerTotal),
+			Assist1PlayerId:         int64Ptr(d.Assist1PlayerID),
+			Assist1PlayerTotal:      int64Ptr(d.Assist1PlayerTotal),
+			Assist2PlayerId:         int64Ptr(d.Assist2PlayerID),
+			Assist2PlayerTotal:      int64Ptr(d.Assist2PlayerTotal),
+			AwayScore:               int64Ptr(d.AwayScore),
+			HomeScore:               int64Ptr(d.HomeScore),
+			HighlightClip:           copyPtr(d.HighlightClip),
+			HighlightClipSharingUrl: copyPtr(d.HighlightClipSharingURL),
+			DiscreteClip:            copyPtr(d.DiscreteClip),
+			TypeCode:                copyPtr(d.TypeCode),
+			DescKey:                 copyPtr(d.DescKey),
+			Duration:                int64Ptr(d.Duration),
+			CommittedByPlayerId:     int64Ptr(d.CommittedByPlayerID),
+			DrawnByPlayerId:         int64Ptr(d.DrawnByPlayerID),
+			HittingPlayerId:         int64Ptr(d.HittingPlayerID),
+			HitteePlayerId:          int64Ptr(d.HitteePlayerID),
+			WinningPlayerId:         int64Ptr(d.WinningPlayerID),
+			LosingPlayerId:          int64Ptr(d.LosingPlayerID),
+			PlayerId:                int64Ptr(d.PlayerID),
+			Reason:                  copyPtr(d.Reason),
+			AwaySog:                 int64Ptr(d.AwaySOG),
+			HomeSog:                 int64Ptr(d.HomeSOG),
+		}
+	}
+	return m
+}
+
+// PlayEventToNHL converts a PlayEvent message to an nhl.PlayEvent. It
+// returns nil for a nil message.
+func PlayEventToNHL(m *PlayEvent) *nhl.PlayEvent {
+	if m == nil {
+		return nil
+	}
+	e := &nhl.PlayEvent{
+		EventID:               m.GetEventId(),
+		PeriodDescriptor:      PeriodDescriptorToNHL(m.GetPeriodDescriptor()),
+		TimeInPeriod:          m.GetTimeInPeriod(),
+		TimeRemaining:         m.GetTimeRemaining(),
+		SituationCode:         m.GetSituationCode(),
+		HomeTeamDefendingSide: nhl.DefendingSide(m.GetHomeTeamDefendingSide()),
+		TypeCode:              int(m.GetTypeCode()),
+		TypeDescKey:           nhl.PlayEventType(m.GetTypeDescKey()),
+		SortOrder:             int(m.GetSortOrder()),
+		PPTReplayURL:          copyPtr(m.PptReplayUrl),
+	}
+	if d := m.GetDetails(); d != nil {
+		e.Details = &nhl.PlayEventDetails{
+			XCoord:                  intPtr[int](d.XCoord),
+			YCoord:                  intPtr[int](d.YCoord),
+			ZoneCode:                namedStringPtr[nhl.ZoneCode](d.ZoneCode),
+			EventOwnerTeamID:        intPtr[nhl.TeamID](d.EventOwnerTeamId),
+			ShotType:                copyPtr(d.ShotType),
+			ShootingPlayerID:        intPtr[nhl.PlayerID](d.ShootingPlayerId),
+			GoalieInNetID:           intPtr[nhl.PlayerID](d.GoalieInNetId),
+			BlockingPlayerID:        intPtr[nhl.PlayerID](d.BlockingPlayerId),
+			ScoringPlayerID:         intPtr[nhl.PlayerID](d.ScoringPlayerId),
+			ScoringPlayerTotal:      intPtr[int](d.ScoringPlayerTotal),
+			Assist1PlayerID:         intPtr[nhl.PlayerID](d.Assist1PlayerId),
+			Assist1PlayerTotal:      intPtr[int](d.Assist1PlayerTotal),
+			Assist2PlayerID:         intPtr[nhl.PlayerID](d.Assist2PlayerId),
+			Assist2PlayerTotal:      intPtr[int](d.Assist2PlayerTotal),
+			AwayScore:               intPtr[int](d.AwayScore),
+			HomeScore:               intPtr[int](d.HomeScore),
+			HighlightClip:           copyPtr(d.HighlightClip),
+			HighlightClipSharingURL: copyPtr(d.HighlightClipSharingUrl),
+			DiscreteClip:            copyPtr(d.DiscreteClip),
+			TypeCode:                copyPtr(d.TypeCode),
+			DescKey:                 copyPtr(d.DescKey),
+			Duration:                intPtr[int](d.Duration),
+			CommittedByPlayerID:     intPtr[nhl.PlayerID](d.CommittedByPlayerId),
+			DrawnByPlayerID:         intPtr[nhl.PlayerID](d.DrawnByPlayerId),
+			HittingPlayerID:         intPtr[nhl.PlayerID](d.HittingPlayerId),
+			HitteePlayerID:          intPtr[nhl.PlayerID](d.HitteePlayerId),
+			WinningPlayerID:         intPtr[nhl.PlayerID](d.WinningPlayerId),
+			LosingPlayerID:          intPtr[nhl.PlayerID](d.LosingPlayerId),
+			PlayerID:                intPtr[nhl.PlayerID](d.PlayerId),
+			Reason:                  copyPtr(d.Reason),
+			AwaySOG:                 intPtr[int](d.AwaySog),
+			HomeSOG:                 intPtr[int](d.HomeSog),
+		}
+	}
+	return e
+}
+
+// ===== Standing =====
+
+// StandingFromNHL converts an nhl.Standing to its message. It returns nil
+// for a nil standing.
+func StandingFromNHL(s *nhl.Standing) *Standing {
+	if s == nil {
+		return nil
+	}
+	return &Standing{
+		ConferenceAbbrev: copyPtr(s.ConferenceAbbrev),
+		ConferenceName:   copyPtr(s.ConferenceName),
+		DivisionAbbrev:   s.DivisionAbbrev,
+		DivisionName:     s.DivisionName,
+		TeamName:         LocalizedStringFromNHL(s.TeamName),
+		TeamCommonName:   LocalizedStringFromNHL(s.TeamCommonName),
+		TeamAbbrev:       LocalizedStringFromNHL(s.TeamAbbrev),
+		TeamLogo:         s.TeamLogo,
+		Wins:             int64(s.Wins),
+		Losses:           int64(s.Losses),
+		OtLosses:         int64(s.OTLosses),
+		Points:           int64(s.Points),
+		L10Wins:          int64(s.L10Wins),
+		L10Losses:        int64(s.L10Losses),
+		L10OtLosses:      int64(s.L10OTLosses),
+		StreakCode:       s.StreakCode,
+		StreakCount:      int64(s.StreakCount),
+
+		RegulationWins:       int64(s.RegulationWins),
+		RegulationPlusOtWins: int64(s.RegulationPlusOTWins),
+		GoalDifferential:     int64(s.GoalDifferential),
+
+		GoalFor:     int64(s.GoalFor),
+		GoalAgainst: int64(s.GoalAgainst),
+	}
+}
+
+// StandingToNHL converts a Standing message to an nhl.Standing. It returns
+// nil for a nil message.
+func StandingToNHL(m *Standing) *nhl.Standing {
+	if m == nil {
+		return nil
+	}
+	return &nhl.Standing{
+		ConferenceAbbrev: copyPtr(m.ConferenceAbbrev),
+		ConferenceName:   copyPtr(m.ConferenceName),
+		DivisionAbbrev:   m.GetDivisionAbbrev(),
+		DivisionName:     m.GetDivisionName(),
+		TeamName:         LocalizedStringToNHL(m.GetTeamName()),
+		TeamCommonName:   LocalizedStringToNHL(m.GetTeamCommonName()),
+		TeamAbbrev:       LocalizedStringToNHL(m.GetTeamAbbrev()),
+		TeamLogo:         m.GetTeamLogo(),
+		Wins:             int(m.GetWins()),
+		Losses:           int(m.GetLosses()),
+		OTLosses:         int(m.GetOtLosses()),
+		Points:           int(m.GetPoints()),
+		L10Wins:          int(m.GetL10Wins()),
+		L10Losses:        int(m.GetL10Losses()),
+		L10OTLosses:      int(m.GetL10OtLosses()),
+		StreakCode:       m.GetStreakCode(),
+		StreakCount:      int(m.GetStreakCount()),
+
+		RegulationWins:       int(m.GetRegulationWins()),
+		RegulationPlusOTWins: int(m.GetRegulationPlusOtWins()),
+		GoalDifferential:     int(m.GetGoalDifferential()),
+
+		GoalFor:     int(m.GetGoalFor()),
+		GoalAgainst: int(m.GetGoalAgainst()),
+	}
+}
+
+// ===== ScheduleGame =====
+
+// ScheduleGameFromNHL converts an nhl.ScheduleGame to its message. It
+// returns nil for a nil game.
+func ScheduleGameFromNHL(g *nhl.ScheduleGame) *ScheduleGame {
+	if g == nil {
+		return nil
+	}
+	m := &ScheduleGame{
+		Id:                int64(g.ID),
+		GameType:          int64(g.GameType),
+		GameDate:          copyPtr(g.GameDate),
+		StartTimeUtc:      g.StartTimeUTC,
+		AwayTeam:          scheduleTeamFromNHL(g.AwayTeam),
+		HomeTeam:          scheduleTeamFromNHL(g.HomeTeam),
+		GameState:         string(g.GameState),
+		TvBroadcasts:      tvBroadcastsFromNHL(g.TVBroadcasts),
+		SpecialEvent:      specialEventFromNHL(g.SpecialEvent),
+		GameScheduleState: stringPtr(g.GameScheduleState),
+	}
+	if g.Venue != nil {
+		m.Venue = LocalizedStringFromNHL(*g.Venue)
+	}
+	return m
+}
+
+// ScheduleGameToNHL converts a ScheduleGame message to an nhl.ScheduleGame.
+// It returns nil for a nil message.
+func ScheduleGameToNHL(m *ScheduleGame) *nhl.ScheduleGame {
+	if m == nil {
+		return nil
+	}
+	g := &nhl.ScheduleGame{
+		ID:                nhl.GameID(m.GetId()),
+		GameType:          nhl.GameType(m.GetGameType()),
+		GameDate:          copyPtr(m.GameDate),
+		StartTimeUTC:      m.GetStartTimeUtc(),
+		AwayTeam:          scheduleTeamToNHL(m.GetAwayTeam()),
+		HomeTeam:          scheduleTeamToNHL(m.GetHomeTeam()),
+		GameState:         nhl.GameState(m.GetGameState()),
+		TVBroadcasts:      tvBroadcastsToNHL(m.GetTvBroadcasts()),
+		SpecialEvent:      specialEventToNHL(m.GetSpecialEvent()),
+		GameScheduleState: namedStringPtr[nhl.GameScheduleState](m.GameScheduleState),
+	}
+	if m.GetVenue() != nil {
+		venue := LocalizedStringToNHL(m.GetVenue())
+		g.Venue = &venue
+	}
+	return g
+}
+
+// specialEventFromNHL converts a game's special event; nil stays nil.
+func specialEventFromNHL(se *nhl.SpecialEvent) *SpecialEvent {
+	if se == nil {
+		return nil
+	}
+	return &SpecialEvent{
+		ParentId:     se.ParentID,
+		Name:         LocalizedStringFromNHL(se.Name),
+		LightLogoUrl: LocalizedStringFromNHL(se.LightLogoURL),
+	}
+}
+
+func specialEventToNHL(se *SpecialEvent) *nhl.SpecialEvent {
+	if se == nil {
+		return nil
+	}
+	return &nhl.SpecialEvent{
+		ParentID:     se.GetParentId(),
+		Name:         LocalizedStringToNHL(se.GetName()),
+		LightLogoURL: LocalizedStringToNHL(se.GetLightLogoUrl()),
+	}
+}
+
+// tvBroadcastsFromNHL converts a game's broadcast list. An empty list
+// stays nil so omitted broadcasts round-trip.
+func tvBroadcastsFromNHL(broadcasts []nhl.TVBroadcast) []*TVBroadcast {
+	if len(broadcasts) == 0 {
+		return nil
+	}
+	out := make([]*TVBroadcast, len(broadcasts))
+	for i, tv := range broadcasts {
+		out[i] = &TVBroadcast{
+			Id:             tv.ID,
+			Market:         tv.Market,
+			CountryCode:    tv.CountryCode,
+			Network:        tv.Network,
+			SequenceNumber: int64(tv.SequenceNumber),
+		}
+	}
+	return out
+}
+
+func tvBroadcastsToNHL(broadcasts []*TVBroadcast) []nhl.TVBroadcast {
+	if len(broadcasts) == 0 {
+		return nil
+	}
+	out := make([]nhl.TVBroadcast, len(broadcasts))
+	for i, tv := range broadcasts {
+		out[i] = nhl.TVBroadcast{
+			ID:             tv.GetId(),
+			Market:         tv.GetMarket(),
+			CountryCode:    tv.GetCountryCode(),
+			Network:        tv.GetNetwork(),
+			SequenceNumber: int(tv.GetSequenceNumber()),
+		}
+	}
+	return out
+}
+
+func scheduleTeamFromNHL(t nhl.ScheduleTeam) *ScheduleTeam {
+	m := &ScheduleTeam{
+		Id:     int64(t.ID),
+		Abbrev: t.Abbrev,
+		Logo:   t.Logo,
+		Score:  int64Ptr(t.Score),
+	}
+	if t.PlaceName != nil {
+		m.PlaceName = LocalizedStringFromNHL(*t.PlaceName)
+	}
+	return m
+}
+
+func scheduleTeamToNHL(m *ScheduleTeam) nhl.ScheduleTeam {
+	t := nhl.ScheduleTeam{
+		ID:     nhl.TeamID(m.GetId()),
+		Abbrev: m.GetAbbrev(),
+		Logo:   m.GetLogo(),
+		Score:  intPtr[int](m.Score),
+	}
+	if m.GetPlaceName() != nil {
+		name := LocalizedStringToNHL(m.GetPlaceName())
+		t.PlaceName = &name
+	}
+	return t
+}
+
+// toiToNHL parses a stored "MM:SS" time on ice; a malformed value, which
+// conversion from the library never produces, becomes zero.
+func toiToNHL(s string) nhl.TimeOnIce {
+	t, _ := nhl.ParseTOI(s)
+	return t
+}
+
+// shotsFractionToNHL parses a stored "saves/shots" string; a malformed
+// value becomes zero.
+func shotsFractionToNHL(s string) nhl.ShotsFraction {
+	f, _ := nhl.ParseShotsFraction(s)
+	return f
+}
+
+// ===== Pointer helpers =====
+
+// copyPtr returns a pointer to a copy of *p, or nil.
+func copyPtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// int64Ptr widens an optional integer field to a proto optional int64.
+func int64Ptr[T ~int | ~int64](p *T) *int64 {
+	if p == nil {
+		return nil
+	}
+	v := int64(*p)
+	return &v
+}
+
+// intPtr narrows a proto optional int64 to an optional integer field.
+func intPtr[T ~int | ~int64](p *int64) *T {
+	if p == nil {
+		return nil
+	}
+	v := T(*p)
+	return &v
+}
+
+// stringPtr converts an optional enum field to a proto optional string.
+func stringPtr[T ~string](p *T) *string {
+	if p == nil {
+		return nil
+	}
+	v := string(*p)
+	return &v
+}
+
+// namedStringPtr converts a proto optional string to an optional enum field.
+func namedStringPtr[T ~string](p *string) *T {
+	if p == nil {
+		return nil
+	}
+	v := T(*p)
+	return &v
+}
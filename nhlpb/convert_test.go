@@ -0,0 +1,221 @@
+package nhlpb
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/sperano/nhl-api-go/nhl"
+	"google.golang.org/protobuf/proto"
+)
+
+// wireRoundTrip marshals a message to the protobuf wire format and back.
+func wireRoundTrip[M proto.Message](t *testing.T, m M, into M) M {
+	t.Helper()
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatalf("proto.Marshal() error = %v", err)
+	}
+	if err := proto.Unmarshal(data, into); err != nil {
+		t.Fatalf("proto.Unmarshal() error = %v", err)
+	}
+	return into
+}
+
+func decode[T any](t *testing.T, payload string) *T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal([]byte(payload), &v); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	return &v
+}
+
+const boxscoreJSON = `{
+	"id": 2023020204,
+	"season": 20232024,
+	"gameType": 2,
+	"limitedScoring": false,
+	"gameDate": "2023-11-08",
+	"venue": {"default": "Scotiabank Arena"},
+	"venueLocation": {"default": "Toronto", "fr": "Toronto", "cs": "Toronto", "de": "Toronto", "es": "Toronto", "fi": "Toronto", "sk": "Toronto", "sv": "Toronto"},
+	"startTimeUTC": "2023-11-09T00:00:00Z",
+	"easternUTCOffset": "-05:00",
+	"venueUTCOffset": "-05:00",
+	"tvBroadcasts": [{"id": 28, "market": "H", "countryCode": "CA", "network": "SN", "sequenceNumber": 1}],
+	"gameState": "OFF",
+	"gameScheduleState": "OK",
+	"periodDescriptor": {"number": 3, "periodType": "REG", "maxRegulationPeriods": 3},
+	"specialEvent": {"parentId": 7, "name": {"default": "Heritage Classic"}, "lightLogoUrl": {"default": "https://example.com/logo.svg"}},
+	"awayTeam": {"id": 8, "commonName": {"default": "Canadiens"}, "abbrev": "MTL", "score": 2, "sog": 28, "logo": "mtl.svg", "darkLogo": "mtl_dark.svg", "placeName": {"default": "Montreal", "fr": "Montréal", "sv": "Montréal"}, "placeNameWithPreposition": {"default": "Montréal", "fr": "de Montréal"}},
+	"homeTeam": {"id": 10, "commonName": {"default": "Maple Leafs"}, "abbrev": "TOR", "score": 3, "sog": 31},
+	"clock": {"timeRemaining": "00:00", "secondsRemaining": 0, "running": false, "inIntermission": false},
+	"linescore": {"byPeriod": [{"periodDescriptor": {"number": 1, "periodType": "REG", "maxRegulationPeriods": 3}, "away": 1, "home": 0}, {"periodDescriptor": {"number": 2, "periodType": "REG", "maxRegulationPeriods": 3}, "away": 1, "home": 3}], "totals": {"away": 2, "home": 3}},
+	"shotsByPeriod": [{"periodDescriptor": {"number": 1, "periodType": "REG", "maxRegulationPeriods": 3}, "away": 10, "home": 12}],
+	"teamGameStats": [{"category": "sog", "awayValue": 28, "homeValue": 31}, {"category": "powerPlay", "awayValue": "1/3", "homeValue": "0/2"}],
+	"attendance": 18789,
+	"playerByGameStats": {
+		"awayTeam": {
+			"forwards": [{"playerId": 8480018, "sweaterNumber": 14, "name": {"default": "N. Suzuki"}, "position": "C", "goals": 1, "assists": 1, "points": 2, "plusMinus": 1, "pim": 2, "hits": 1, "powerPlayGoals": 0, "sog": 4, "faceoffWinningPctg": 0.55, "toi": "21:03", "blockedShots": 1, "shifts": 24, "giveaways": 1, "takeaways": 2}],
+			"defense": [],
+			"goalies": [{"playerId": 8478470, "sweaterNumber": 35, "name": {"default": "S. Montembeault"}, "position": "G", "evenStrengthShotsAgainst": "25/27", "powerPlayShotsAgainst": "3/4", "shorthandedShotsAgainst": "0/0", "saveShotsAgainst": "28/31", "savePctg": 0.903, "evenStrengthGoalsAgainst": 2, "powerPlayGoalsAgainst": 1, "shorthandedGoalsAgainst": 0, "pim": 0, "goalsAgainst": 3, "toi": "59:12", "starter": true, "decision": "L", "shotsAgainst": 31, "saves": 28}]
+		},
+		"homeTeam": {
+			"forwards": [],
+			"defense": [{"playerId": 8476853, "sweaterNumber": 44, "name": {"default": "M. Rielly"}, "position": "D", "toi": "24:10"}],
+			"goalies": [{"playerId": 8479361, "sweaterNumber": 60, "name": {"default": "J. Woll"}, "position": "G", "toi": "60:00", "goalsAgainst": 2}]
+		}
+	}
+}`
+
+func TestBoxscoreRoundTrip(t *testing.T) {
+	original := decode[nhl.Boxscore](t, boxscoreJSON)
+
+	m := wireRoundTrip(t, BoxscoreFromNHL(original), &Boxscore{})
+	got := BoxscoreToNHL(m)
+
+	if !reflect.DeepEqual(got, original) {
+		t.Errorf("BoxscoreToNHL(BoxscoreFromNHL(b)) mismatch\n got: %+v\nwant: %+v", got, original)
+	}
+	if got.Season.StartYear() != 2023 {
+		t.Errorf("Season = %v, want 2023-2024", got.Season)
+	}
+	goalie := got.PlayerByGameStats.HomeTeam.Goalies[0]
+	if goalie.SavePctg != nil || goalie.PIM != nil || goalie.Starter != nil || goalie.Decision != nil {
+		t.Error("absent optional goalie fields should stay nil")
+	}
+}
+
+func TestPlayEventRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+	}{
+		{
+			name: "goal with details",
+			payload: `{"eventId": 151, "periodDescriptor": {"number": 2, "periodType": "REG", "maxRegulationPeriods": 3},
+				"timeInPeriod": "05:12", "timeRemaining": "14:48", "situationCode": "1551", "homeTeamDefendingSide": "left",
+				"typeCode": 505, "typeDescKey": "goal", "sortOrder": 240, "pptReplayUrl": "https://example.com/ppt.json",
+				"details": {"xCoord": -80, "yCoord": 3, "zoneCode": "O", "eventOwnerTeamId": 10, "shotType": "wrist",
+					"scoringPlayerId": 8479318, "scoringPlayerTotal": 7, "assist1PlayerId": 8478483, "assist1PlayerTotal": 10,
+					"goalieInNetId": 8478470, "awayScore": 1, "homeScore": 2, "highlightClip": 6341, "discreteClip": 6342,
+					"highlightClipSharingUrl": "https://nhl.com/video/1", "awaySOG": 12, "homeSOG": 15}}`,
+		},
+		{
+			name: "penalty",
+			payload: `{"eventId": 200, "periodDescriptor": {"number": 3, "periodType": "REG", "maxRegulationPeriods": 3},
+				"typeCode": 509, "typeDescKey": "penalty", "sortOrder": 300,
+				"details": {"typeCode": "MIN", "descKey": "tripping", "duration": 2, "committedByPlayerId": 8480018,
+					"drawnByPlayerId": 8479318, "eventOwnerTeamId": 8, "zoneCode": "D", "xCoord": 0, "yCoord": 0}}`,
+		},
+		{
+			name:    "no details",
+			payload: `{"eventId": 1, "periodDescriptor": {"number": 1, "periodType": "REG"}, "typeCode": 520, "typeDescKey": "period-start", "sortOrder": 8}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := decode[nhl.PlayEvent](t, tt.payload)
+			got := PlayEventToNHL(wireRoundTrip(t, PlayEventFromNHL(original), &PlayEvent{}))
+			if !reflect.DeepEqual(got, original) {
+				t.Errorf("PlayEvent round trip mismatch\n got: %+v\nwant: %+v", got, original)
+			}
+		})
+	}
+}
+
+func TestPlayEventRoundTrip_ZeroCoordinatesKeepPresence(t *testing.T) {
+	zero := 0
+	original := &nhl.PlayEvent{TypeDescKey: nhl.PlayEventTypeFaceoff, Details: &nhl.PlayEventDetails{XCoord: &zero, YCoord: &zero}}
+
+	got := PlayEventToNHL(wireRoundTrip(t, PlayEventFromNHL(original), &PlayEvent{}))
+	if got.Details.XCoord == nil || *got.Details.XCoord != 0 {
+		t.Error("zero XCoord lost presence across round trip")
+	}
+	if got.Details.ShotType != nil {
+		t.Error("unset ShotType became present")
+	}
+}
+
+func TestStandingRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+	}{
+		{
+			name: "modern",
+			payload: `{"conferenceAbbrev": "E", "conferenceName": "Eastern", "divisionAbbrev": "A", "divisionName": "Atlantic",
+				"teamName": {"default": "Boston Bruins", "fr": "Bruins de Boston"}, "teamCommonName": {"default": "Bruins"},
+				"teamAbbrev": {"default": "BOS"}, "teamLogo": "bos.svg", "wins": 47, "losses": 20, "otLosses": 15, "points": 109,
+				"l10Wins": 6, "l10Losses": 3, "l10OtLosses": 1, "streakCode": "W", "streakCount": 2,
+				"regulationWins": 40, "regulationPlusOtWins": 44, "goalDifferential": 49,
+				"goalFor": 301, "goalAgainst": 252}`,
+		},
+		{
+			name:    "historical without conference",
+			payload: `{"divisionAbbrev": "A", "divisionName": "Adams", "teamAbbrev": {"default": "QUE"}, "wins": 30}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := decode[nhl.Standing](t, tt.payload)
+			got := StandingToNHL(wireRoundTrip(t, StandingFromNHL(original), &Standing{}))
+			if !reflect.DeepEqual(got, original) {
+				t.Errorf("Standing round trip mismatch\n got: %+v\nwant: %+v", got, original)
+			}
+		})
+	}
+}
+
+func TestScheduleGameRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+	}{
+		{
+			name: "final",
+			payload: `{"id": 2023020204, "gameType": 2, "gameDate": "2023-11-08", "startTimeUTC": "2023-11-09T00:00:00Z",
+				"awayTeam": {"id": 8, "abbrev": "MTL", "placeName": {"default": "Montreal", "fr": "Montréal", "sv": "Montréal"}, "logo": "mtl.svg", "score": 0},
+				"homeTeam": {"id": 10, "abbrev": "TOR", "logo": "tor.svg", "score": 3}, "gameState": "OFF", "venue": {"default": "Scotiabank Arena"},
+				"tvBroadcasts": [{"id": 28, "market": "A", "countryCode": "CA", "network": "RDS", "sequenceNumber": 1}]}`,
+		},
+		{
+			name: "future",
+			payload: `{"id": 2023020900, "gameType": 2, "startTimeUTC": "2024-02-09T00:00:00Z",
+				"awayTeam": {"id": 8, "abbrev": "MTL"}, "homeTeam": {"id": 10, "abbrev": "TOR"}, "gameState": "FUT", "gameScheduleState": "TBD"}`,
+		},
+		{
+			name: "special event",
+			payload: `{"id": 2023020605, "gameType": 2, "gameDate": "2024-01-01", "startTimeUTC": "2024-01-01T22:00:00Z",
+				"awayTeam": {"id": 16, "abbrev": "CHI"}, "homeTeam": {"id": 55, "abbrev": "SEA"}, "gameState": "OFF",
+				"specialEvent": {"parentId": 5, "name": {"default": "NHL Winter Classic"}, "lightLogoUrl": {"default": "wc.svg"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := decode[nhl.ScheduleGame](t, tt.payload)
+			got := ScheduleGameToNHL(wireRoundTrip(t, ScheduleGameFromNHL(original), &ScheduleGame{}))
+			if !reflect.DeepEqual(got, original) {
+				t.Errorf("ScheduleGame round trip mismatch\n got: %+v\nwant: %+v", got, original)
+			}
+		})
+	}
+}
+
+func TestConvertersNil(t *testing.T) {
+	if BoxscoreFromNHL(nil) != nil || BoxscoreToNHL(nil) != nil {
+		t.Error("Boxscore converters should return nil for nil input")
+	}
+	if PlayEventFromNHL(nil) != nil || PlayEventToNHL(nil) != nil {
+		t.Error("PlayEvent converters should return nil for nil input")
+	}
+	if StandingFromNHL(nil) != nil || StandingToNHL(nil) != nil {
+		t.Error("Standing converters should return nil for nil input")
+	}
+	if ScheduleGameFromNHL(nil) != nil || ScheduleGameToNHL(nil) != nil {
+		t.Error("ScheduleGame converters should return nil for nil input")
+	}
+}
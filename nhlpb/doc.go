@@ -0,0 +1,16 @@
+// Package nhlpb provides protocol buffer definitions for the core NHL models
+// and lossless converters to and from the structs in package nhl, so that
+// services can forward NHL data over gRPC.
+//
+// It lives in its own module so that the main client stays free of the
+// protobuf dependency. The .proto sources are under proto/nhl/v1; the
+// generated *.pb.go files are produced with buf:
+//
+//	go generate ./...
+//
+// Each supported model has a pair of converters named after it, for
+// example BoxscoreFromNHL and BoxscoreToNHL. Converting a value to its
+// message and back yields a struct equal to the original.
+package nhlpb
+
+//go:generate buf generate
@@ -0,0 +1,10 @@
+module github.com/sperano/nhl-api-go/nhlpb
+
+go 1.26.0
+
+require (
+	github.com/sperano/nhl-api-go v0.0.0-00010101000000-000000000000
+	google.golang.org/protobuf v1.36.12
+)
+
+replace github.com/sperano/nhl-api-go => ../
@@ -0,0 +1,592 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: nhl/v1/play.proto
+
+package nhlpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PlayEvent mirrors nhl.PlayEvent.
+type PlayEvent struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	EventId               int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PeriodDescriptor      *PeriodDescriptor      `protobuf:"bytes,2,opt,name=period_descriptor,json=periodDescriptor,proto3" json:"period_descriptor,omitempty"`
+	TimeInPeriod          string                 `protobuf:"bytes,3,opt,name=time_in_period,json=timeInPeriod,proto3" json:"time_in_period,omitempty"`
+	TimeRemaining         string                 `protobuf:"bytes,4,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`
+	SituationCode         string                 `protobuf:"bytes,5,opt,name=situation_code,json=situationCode,proto3" json:"situation_code,omitempty"`
+	HomeTeamDefendingSide string                 `protobuf:"bytes,6,opt,name=home_team_defending_side,json=homeTeamDefendingSide,proto3" json:"home_team_defending_side,omitempty"`
+	TypeCode              int64                  `protobuf:"varint,7,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	TypeDescKey           string                 `protobuf:"bytes,8,opt,name=type_desc_key,json=typeDescKey,proto3" json:"type_desc_key,omitempty"`
+	SortOrder             int64                  `protobuf:"varint,9,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Details               *PlayEventDetails      `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
+	PptReplayUrl          *string                `protobuf:"bytes,11,opt,name=ppt_replay_url,json=pptReplayUrl,proto3,oneof" json:"ppt_replay_url,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PlayEvent) Reset() {
+	*x = PlayEvent{}
+	mi := &file_nhl_v1_play_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayEvent) ProtoMessage() {}
+
+func (x *PlayEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_play_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayEvent.ProtoReflect.Descriptor instead.
+func (*PlayEvent) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_play_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlayEvent) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *PlayEvent) GetPeriodDescriptor() *PeriodDescriptor {
+	if x != nil {
+		return x.PeriodDescriptor
+	}
+	return nil
+}
+
+func (x *PlayEvent) GetTimeInPeriod() string {
+	if x != nil {
+		return x.TimeInPeriod
+	}
+	return ""
+}
+
+func (x *PlayEvent) GetTimeRemaining() string {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return ""
+}
+
+func (x *PlayEvent) GetSituationCode() string {
+	if x != nil {
+		return x.SituationCode
+	}
+	return ""
+}
+
+func (x *PlayEvent) GetHomeTeamDefendingSide() string {
+	if x != nil {
+		return x.HomeTeamDefendingSide
+	}
+	return ""
+}
+
+func (x *PlayEvent) GetTypeCode() int64 {
+	if x != nil {
+		return x.TypeCode
+	}
+	return 0
+}
+
+func (x *PlayEvent) GetTypeDescKey() string {
+	if x != nil {
+		return x.TypeDescKey
+	}
+	return ""
+}
+
+func (x *PlayEvent) GetSortOrder() int64 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *PlayEvent) GetDetails() *PlayEventDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *PlayEvent) GetPptReplayUrl() string {
+	if x != nil && x.PptReplayUrl != nil {
+		return *x.PptReplayUrl
+	}
+	return ""
+}
+
+// PlayEventDetails mirrors nhl.PlayEventDetails. Every field is optional
+// because the API only sends the details relevant to the event type.
+type PlayEventDetails struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	XCoord                  *int64                 `protobuf:"varint,1,opt,name=x_coord,json=xCoord,proto3,oneof" json:"x_coord,omitempty"`
+	YCoord                  *int64                 `protobuf:"varint,2,opt,name=y_coord,json=yCoord,proto3,oneof" json:"y_coord,omitempty"`
+	ZoneCode                *string                `protobuf:"bytes,3,opt,name=zone_code,json=zoneCode,proto3,oneof" json:"zone_code,omitempty"`
+	EventOwnerTeamId        *int64                 `protobuf:"varint,4,opt,name=event_owner_team_id,json=eventOwnerTeamId,proto3,oneof" json:"event_owner_team_id,omitempty"`
+	ShotType                *string                `protobuf:"bytes,5,opt,name=shot_type,json=shotType,proto3,oneof" json:"shot_type,omitempty"`
+	ShootingPlayerId        *int64                 `protobuf:"varint,6,opt,name=shooting_player_id,json=shootingPlayerId,proto3,oneof" json:"shooting_player_id,omitempty"`
+	GoalieInNetId           *int64                 `protobuf:"varint,7,opt,name=goalie_in_net_id,json=goalieInNetId,proto3,oneof" json:"goalie_in_net_id,omitempty"`
+	BlockingPlayerId        *int64                 `protobuf:"varint,8,opt,name=blocking_player_id,json=blockingPlayerId,proto3,oneof" json:"blocking_player_id,omitempty"`
+	ScoringPlayerId         *int64                 `protobuf:"varint,9,opt,name=scoring_player_id,json=scoringPlayerId,proto3,oneof" json:"scoring_player_id,omitempty"`
+	ScoringPlayerTotal      *int64                 `protobuf:"varint,10,opt,name=scoring_player_total,json=scoringPlayerTotal,proto3,oneof" json:"scoring_player_total,omitempty"`
+	Assist1PlayerId         *int64                 `protobuf:"varint,11,opt,name=assist1_player_id,json=assist1PlayerId,proto3,oneof" json:"assist1_player_id,omitempty"`
+	Assist1PlayerTotal      *int64                 `protobuf:"varint,12,opt,name=assist1_player_total,json=assist1PlayerTotal,proto3,oneof" json:"assist1_player_total,omitempty"`
+	Assist2PlayerId         *int64                 `protobuf:"varint,13,opt,name=assist2_player_id,json=assist2PlayerId,proto3,oneof" json:"assist2_player_id,omitempty"`
+	Assist2PlayerTotal      *int64                 `protobuf:"varint,14,opt,name=assist2_player_total,json=assist2PlayerTotal,proto3,oneof" json:"assist2_player_total,omitempty"`
+	AwayScore               *int64                 `protobuf:"varint,15,opt,name=away_score,json=awayScore,proto3,oneof" json:"away_score,omitempty"`
+	HomeScore               *int64                 `protobuf:"varint,16,opt,name=home_score,json=homeScore,proto3,oneof" json:"home_score,omitempty"`
+	HighlightClip           *int64                 `protobuf:"varint,17,opt,name=highlight_clip,json=highlightClip,proto3,oneof" json:"highlight_clip,omitempty"`
+	HighlightClipSharingUrl *string                `protobuf:"bytes,18,opt,name=highlight_clip_sharing_url,json=highlightClipSharingUrl,proto3,oneof" json:"highlight_clip_sharing_url,omitempty"`
+	DiscreteClip            *int64                 `protobuf:"varint,19,opt,name=discrete_clip,json=discreteClip,proto3,oneof" json:"discrete_clip,omitempty"`
+	TypeCode                *string                `protobuf:"bytes,20,opt,name=type_code,json=typeCode,proto3,oneof" json:"type_code,omitempty"`
+	DescKey                 *string                `protobuf:"bytes,21,opt,name=desc_key,json=descKey,proto3,oneof" json:"desc_key,omitempty"`
+	Duration                *int64                 `protobuf:"varint,22,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	CommittedByPlayerId     *int64                 `protobuf:"varint,23,opt,name=committed_by_player_id,json=committedByPlayerId,proto3,oneof" json:"committed_by_player_id,omitempty"`
+	DrawnByPlayerId         *int64                 `protobuf:"varint,24,opt,name=drawn_by_player_id,json=drawnByPlayerId,proto3,oneof" json:"drawn_by_player_id,omitempty"`
+	HittingPlayerId         *int64                 `protobuf:"varint,25,opt,name=hitting_player_id,json=hittingPlayerId,proto3,oneof" json:"hitting_player_id,omitempty"`
+	HitteePlayerId          *int64                 `protobuf:"varint,26,opt,name=hittee_player_id,json=hitteePlayerId,proto3,oneof" json:"hittee_player_id,omitempty"`
+	WinningPlayerId         *int64                 `protobuf:"varint,27,opt,name=winning_player_id,json=winningPlayerId,proto3,oneof" json:"winning_player_id,omitempty"`
+	LosingPlayerId          *int64                 `protobuf:"varint,28,opt,name=losing_player_id,json=losingPlayerId,proto3,oneof" json:"losing_player_id,omitempty"`
+	PlayerId                *int64                 `protobuf:"varint,29,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	Reason                  *string                `protobuf:"bytes,30,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	AwaySog                 *int64                 `protobuf:"varint,31,opt,name=away_sog,json=awaySog,proto3,oneof" json:"away_sog,omitempty"`
+	HomeSog                 *int64                 `protobuf:"varint,32,opt,name=home_sog,json=homeSog,proto3,oneof" json:"home_sog,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *PlayEventDetails) Reset() {
+	*x = PlayEventDetails{}
+	mi := &file_nhl_v1_play_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayEventDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayEventDetails) ProtoMessage() {}
+
+func (x *PlayEventDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_play_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayEventDetails.ProtoReflect.Descriptor instead.
+func (*PlayEventDetails) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_play_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlayEventDetails) GetXCoord() int64 {
+	if x != nil && x.XCoord != nil {
+		return *x.XCoord
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetYCoord() int64 {
+	if x != nil && x.YCoord != nil {
+		return *x.YCoord
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetZoneCode() string {
+	if x != nil && x.ZoneCode != nil {
+		return *x.ZoneCode
+	}
+	return ""
+}
+
+func (x *PlayEventDetails) GetEventOwnerTeamId() int64 {
+	if x != nil && x.EventOwnerTeamId != nil {
+		return *x.EventOwnerTeamId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetShotType() string {
+	if x != nil && x.ShotType != nil {
+		return *x.ShotType
+	}
+	return ""
+}
+
+func (x *PlayEventDetails) GetShootingPlayerId() int64 {
+	if x != nil && x.ShootingPlayerId != nil {
+		return *x.ShootingPlayerId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetGoalieInNetId() int64 {
+	if x != nil && x.GoalieInNetId != nil {
+		return *x.GoalieInNetId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetBlockingPlayerId() int64 {
+	if x != nil && x.BlockingPlayerId != nil {
+		return *x.BlockingPlayerId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetScoringPlayerId() int64 {
+	if x != nil && x.ScoringPlayerId != nil {
+		return *x.ScoringPlayerId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetScoringPlayerTotal() int64 {
+	if x != nil && x.ScoringPlayerTotal != nil {
+		return *x.ScoringPlayerTotal
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetAssist1PlayerId() int64 {
+	if x != nil && x.Assist1PlayerId != nil {
+		return *x.Assist1PlayerId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetAssist1PlayerTotal() int64 {
+	if x != nil && x.Assist1PlayerTotal != nil {
+		return *x.Assist1PlayerTotal
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetAssist2PlayerId() int64 {
+	if x != nil && x.Assist2PlayerId != nil {
+		return *x.Assist2PlayerId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetAssist2PlayerTotal() int64 {
+	if x != nil && x.Assist2PlayerTotal != nil {
+		return *x.Assist2PlayerTotal
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetAwayScore() int64 {
+	if x != nil && x.AwayScore != nil {
+		return *x.AwayScore
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetHomeScore() int64 {
+	if x != nil && x.HomeScore != nil {
+		return *x.HomeScore
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetHighlightClip() int64 {
+	if x != nil && x.HighlightClip != nil {
+		return *x.HighlightClip
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetHighlightClipSharingUrl() string {
+	if x != nil && x.HighlightClipSharingUrl != nil {
+		return *x.HighlightClipSharingUrl
+	}
+	return ""
+}
+
+func (x *PlayEventDetails) GetDiscreteClip() int64 {
+	if x != nil && x.DiscreteClip != nil {
+		return *x.DiscreteClip
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetTypeCode() string {
+	if x != nil && x.TypeCode != nil {
+		return *x.TypeCode
+	}
+	return ""
+}
+
+func (x *PlayEventDetails) GetDescKey() string {
+	if x != nil && x.DescKey != nil {
+		return *x.DescKey
+	}
+	return ""
+}
+
+func (x *PlayEventDetails) GetDuration() int64 {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetCommittedByPlayerId() int64 {
+	if x != nil && x.CommittedByPlayerId != nil {
+		return *x.CommittedByPlayerId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetDrawnByPlayerId() int64 {
+	if x != nil && x.DrawnByPlayerId != nil {
+		return *x.DrawnByPlayerId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetHittingPlayerId() int64 {
+	if x != nil && x.HittingPlayerId != nil {
+		return *x.HittingPlayerId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetHitteePlayerId() int64 {
+	if x != nil && x.HitteePlayerId != nil {
+		return *x.HitteePlayerId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetWinningPlayerId() int64 {
+	if x != nil && x.WinningPlayerId != nil {
+		return *x.WinningPlayerId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetLosingPlayerId() int64 {
+	if x != nil && x.LosingPlayerId != nil {
+		return *x.LosingPlayerId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetPlayerId() int64 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *PlayEventDetails) GetAwaySog() int64 {
+	if x != nil && x.AwaySog != nil {
+		return *x.AwaySog
+	}
+	return 0
+}
+
+func (x *PlayEventDetails) GetHomeSog() int64 {
+	if x != nil && x.HomeSog != nil {
+		return *x.HomeSog
+	}
+	return 0
+}
+
+var File_nhl_v1_play_proto protoreflect.FileDescriptor
+
+const file_nhl_v1_play_proto_rawDesc = "" +
+	"\n" +
+	"\x11nhl/v1/play.proto\x12\x06nhl.v1\x1a\x13nhl/v1/common.proto\"\xec\x03\n" +
+	"\tPlayEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12E\n" +
+	"\x11period_descriptor\x18\x02 \x01(\v2\x18.nhl.v1.PeriodDescriptorR\x10periodDescriptor\x12$\n" +
+	"\x0etime_in_period\x18\x03 \x01(\tR\ftimeInPeriod\x12%\n" +
+	"\x0etime_remaining\x18\x04 \x01(\tR\rtimeRemaining\x12%\n" +
+	"\x0esituation_code\x18\x05 \x01(\tR\rsituationCode\x127\n" +
+	"\x18home_team_defending_side\x18\x06 \x01(\tR\x15homeTeamDefendingSide\x12\x1b\n" +
+	"\ttype_code\x18\a \x01(\x03R\btypeCode\x12\"\n" +
+	"\rtype_desc_key\x18\b \x01(\tR\vtypeDescKey\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\t \x01(\x03R\tsortOrder\x122\n" +
+	"\adetails\x18\n" +
+	" \x01(\v2\x18.nhl.v1.PlayEventDetailsR\adetails\x12)\n" +
+	"\x0eppt_replay_url\x18\v \x01(\tH\x00R\fpptReplayUrl\x88\x01\x01B\x11\n" +
+	"\x0f_ppt_replay_url\"\xe1\x0f\n" +
+	"\x10PlayEventDetails\x12\x1c\n" +
+	"\ax_coord\x18\x01 \x01(\x03H\x00R\x06xCoord\x88\x01\x01\x12\x1c\n" +
+	"\ay_coord\x18\x02 \x01(\x03H\x01R\x06yCoord\x88\x01\x01\x12 \n" +
+	"\tzone_code\x18\x03 \x01(\tH\x02R\bzoneCode\x88\x01\x01\x122\n" +
+	"\x13event_owner_team_id\x18\x04 \x01(\x03H\x03R\x10eventOwnerTeamId\x88\x01\x01\x12 \n" +
+	"\tshot_type\x18\x05 \x01(\tH\x04R\bshotType\x88\x01\x01\x121\n" +
+	"\x12shooting_player_id\x18\x06 \x01(\x03H\x05R\x10shootingPlayerId\x88\x01\x01\x12,\n" +
+	"\x10goalie_in_net_id\x18\a \x01(\x03H\x06R\rgoalieInNetId\x88\x01\x01\x121\n" +
+	"\x12blocking_player_id\x18\b \x01(\x03H\aR\x10blockingPlayerId\x88\x01\x01\x12/\n" +
+	"\x11scoring_player_id\x18\t \x01(\x03H\bR\x0fscoringPlayerId\x88\x01\x01\x125\n" +
+	"\x14scoring_player_total\x18\n" +
+	" \x01(\x03H\tR\x12scoringPlayerTotal\x88\x01\x01\x12/\n" +
+	"\x11assist1_player_id\x18\v \x01(\x03H\n" +
+	"R\x0fassist1PlayerId\x88\x01\x01\x125\n" +
+	"\x14assist1_player_total\x18\f \x01(\x03H\vR\x12assist1PlayerTotal\x88\x01\x01\x12/\n" +
+	"\x11assist2_player_id\x18\r \x01(\x03H\fR\x0fassist2PlayerId\x88\x01\x01\x125\n" +
+	"\x14assist2_player_total\x18\x0e \x01(\x03H\rR\x12assist2PlayerTotal\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"away_score\x18\x0f \x01(\x03H\x0eR\tawayScore\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"home_score\x18\x10 \x01(\x03H\x0fR\thomeScore\x88\x01\x01\x12*\n" +
+	"\x0ehighlight_clip\x18\x11 \x01(\x03H\x10R\rhighlightClip\x88\x01\x01\x12@\n" +
+	"\x1ahighlight_clip_sharing_url\x18\x12 \x01(\tH\x11R\x17highlightClipSharingUrl\x88\x01\x01\x12(\n" +
+	"\rdiscrete_clip\x18\x13 \x01(\x03H\x12R\fdiscreteClip\x88\x01\x01\x12 \n" +
+	"\ttype_code\x18\x14 \x01(\tH\x13R\btypeCode\x88\x01\x01\x12\x1e\n" +
+	"\bdesc_key\x18\x15 \x01(\tH\x14R\adescKey\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x16 \x01(\x03H\x15R\bduration\x88\x01\x01\x128\n" +
+	"\x16committed_by_player_id\x18\x17 \x01(\x03H\x16R\x13committedByPlayerId\x88\x01\x01\x120\n" +
+	"\x12drawn_by_player_id\x18\x18 \x01(\x03H\x17R\x0fdrawnByPlayerId\x88\x01\x01\x12/\n" +
+	"\x11hitting_player_id\x18\x19 \x01(\x03H\x18R\x0fhittingPlayerId\x88\x01\x01\x12-\n" +
+	"\x10hittee_player_id\x18\x1a \x01(\x03H\x19R\x0ehitteePlayerId\x88\x01\x01\x12/\n" +
+	"\x11winning_player_id\x18\x1b \x01(\x03H\x1aR\x0fwinningPlayerId\x88\x01\x01\x12-\n" +
+	"\x10losing_player_id\x18\x1c \x01(\x03H\x1bR\x0elosingPlayerId\x88\x01\x01\x12 \n" +
+	"\tplayer_id\x18\x1d \x01(\x03H\x1cR\bplayerId\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x1e \x01(\tH\x1dR\x06reason\x88\x01\x01\x12\x1e\n" +
+	"\baway_sog\x18\x1f \x01(\x03H\x1eR\aawaySog\x88\x01\x01\x12\x1e\n" +
+	"\bhome_sog\x18  \x01(\x03H\x1fR\ahomeSog\x88\x01\x01B\n" +
+	"\n" +
+	"\b_x_coordB\n" +
+	"\n" +
+	"\b_y_coordB\f\n" +
+	"\n" +
+	"_zone_codeB\x16\n" +
+	"\x14_event_owner_team_idB\f\n" +
+	"\n" +
+	"_shot_typeB\x15\n" +
+	"\x13_shooting_player_idB\x13\n" +
+	"\x11_goalie_in_net_idB\x15\n" +
+	"\x13_blocking_player_idB\x14\n" +
+	"\x12_scoring_player_idB\x17\n" +
+	"\x15_scoring_player_totalB\x14\n" +
+	"\x12_assist1_player_idB\x17\n" +
+	"\x15_assist1_player_totalB\x14\n" +
+	"\x12_assist2_player_idB\x17\n" +
+	"\x15_assist2_player_totalB\r\n" +
+	"\v_away_scoreB\r\n" +
+	"\v_home_scoreB\x11\n" +
+	"\x0f_highlight_clipB\x1d\n" +
+	"\x1b_highlight_clip_sharing_urlB\x10\n" +
+	"\x0e_discrete_clipB\f\n" +
+	"\n" +
+	"_type_codeB\v\n" +
+	"\t_desc_keyB\v\n" +
+	"\t_durationB\x19\n" +
+	"\x17_committed_by_player_idB\x15\n" +
+	"\x13_drawn_by_player_idB\x14\n" +
+	"\x12_hitting_player_idB\x13\n" +
+	"\x11_hittee_player_idB\x14\n" +
+	"\x12_winning_player_idB\x13\n" +
+	"\x11_losing_player_idB\f\n" +
+	"\n" +
+	"_player_idB\t\n" +
+	"\a_reasonB\v\n" +
+	"\t_away_sogB\v\n" +
+	"\t_home_sogB+Z)github.com/sperano/nhl-api-go/nhlpb;nhlpbb\x06proto3"
+
+var (
+	file_nhl_v1_play_proto_rawDescOnce sync.Once
+	file_nhl_v1_play_proto_rawDescData []byte
+)
+
+func file_nhl_v1_play_proto_rawDescGZIP() []byte {
+	file_nhl_v1_play_proto_rawDescOnce.Do(func() {
+		file_nhl_v1_play_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nhl_v1_play_proto_rawDesc), len(file_nhl_v1_play_proto_rawDesc)))
+	})
+	return file_nhl_v1_play_proto_rawDescData
+}
+
+var file_nhl_v1_play_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_nhl_v1_play_proto_goTypes = []any{
+	(*PlayEvent)(nil),        // 0: nhl.v1.PlayEvent
+	(*PlayEventDetails)(nil), // 1: nhl.v1.PlayEventDetails
+	(*PeriodDescriptor)(nil), // 2: nhl.v1.PeriodDescriptor
+}
+var file_nhl_v1_play_proto_depIdxs = []int32{
+	2, // 0: nhl.v1.PlayEvent.period_descriptor:type_name -> nhl.v1.PeriodDescriptor
+	1, // 1: nhl.v1.PlayEvent.details:type_name -> nhl.v1.PlayEventDetails
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_nhl_v1_play_proto_init() }
+func file_nhl_v1_play_proto_init() {
+	if File_nhl_v1_play_proto != nil {
+		return
+	}
+	file_nhl_v1_common_proto_init()
+	file_nhl_v1_play_proto_msgTypes[0].OneofWrappers = []any{}
+	file_nhl_v1_play_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nhl_v1_play_proto_rawDesc), len(file_nhl_v1_play_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_nhl_v1_play_proto_goTypes,
+		DependencyIndexes: file_nhl_v1_play_proto_depIdxs,
+		MessageInfos:      file_nhl_v1_play_proto_msgTypes,
+	}.Build()
+	File_nhl_v1_play_proto = out.File
+	file_nhl_v1_play_proto_goTypes = nil
+	file_nhl_v1_play_proto_depIdxs = nil
+}
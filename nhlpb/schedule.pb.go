@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: nhl/v1/schedule.proto
+
+package nhlpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ScheduleGame mirrors nhl.ScheduleGame.
+type ScheduleGame struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameType          int64                  `protobuf:"varint,2,opt,name=game_type,json=gameType,proto3" json:"game_type,omitempty"`
+	GameDate          *string                `protobuf:"bytes,3,opt,name=game_date,json=gameDate,proto3,oneof" json:"game_date,omitempty"`
+	StartTimeUtc      string                 `protobuf:"bytes,4,opt,name=start_time_utc,json=startTimeUtc,proto3" json:"start_time_utc,omitempty"`
+	AwayTeam          *ScheduleTeam          `protobuf:"bytes,5,opt,name=away_team,json=awayTeam,proto3" json:"away_team,omitempty"`
+	HomeTeam          *ScheduleTeam          `protobuf:"bytes,6,opt,name=home_team,json=homeTeam,proto3" json:"home_team,omitempty"`
+	GameState         string                 `protobuf:"bytes,7,opt,name=game_state,json=gameState,proto3" json:"game_state,omitempty"`
+	TvBroadcasts      []*TVBroadcast         `protobuf:"bytes,8,rep,name=tv_broadcasts,json=tvBroadcasts,proto3" json:"tv_broadcasts,omitempty"`
+	SpecialEvent      *SpecialEvent          `protobuf:"bytes,9,opt,name=special_event,json=specialEvent,proto3" json:"special_event,omitempty"`
+	GameScheduleState *string                `protobuf:"bytes,10,opt,name=game_schedule_state,json=gameScheduleState,proto3,oneof" json:"game_schedule_state,omitempty"`
+	Venue             *LocalizedString       `protobuf:"bytes,11,opt,name=venue,proto3" json:"venue,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScheduleGame) Reset() {
+	*x = ScheduleGame{}
+	mi := &file_nhl_v1_schedule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleGame) ProtoMessage() {}
+
+func (x *ScheduleGame) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_schedule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleGame.ProtoReflect.Descriptor instead.
+func (*ScheduleGame) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScheduleGame) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduleGame) GetGameType() int64 {
+	if x != nil {
+		return x.GameType
+	}
+	return 0
+}
+
+func (x *ScheduleGame) GetGameDate() string {
+	if x != nil && x.GameDate != nil {
+		return *x.GameDate
+	}
+	return ""
+}
+
+func (x *ScheduleGame) GetStartTimeUtc() string {
+	if x != nil {
+		return x.StartTimeUtc
+	}
+	return ""
+}
+
+func (x *ScheduleGame) GetAwayTeam() *ScheduleTeam {
+	if x != nil {
+		return x.AwayTeam
+	}
+	return nil
+}
+
+func (x *ScheduleGame) GetHomeTeam() *ScheduleTeam {
+	if x != nil {
+		return x.HomeTeam
+	}
+	return nil
+}
+
+func (x *ScheduleGame) GetGameState() string {
+	if x != nil {
+		return x.GameState
+	}
+	return ""
+}
+
+func (x *ScheduleGame) GetTvBroadcasts() []*TVBroadcast {
+	if x != nil {
+		return x.TvBroadcasts
+	}
+	return nil
+}
+
+func (x *ScheduleGame) GetSpecialEvent() *SpecialEvent {
+	if x != nil {
+		return x.SpecialEvent
+	}
+	return nil
+}
+
+func (x *ScheduleGame) GetGameScheduleState() string {
+	if x != nil && x.GameScheduleState != nil {
+		return *x.GameScheduleState
+	}
+	return ""
+}
+
+func (x *ScheduleGame) GetVenue() *LocalizedString {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
+}
+
+// ScheduleTeam mirrors nhl.ScheduleTeam.
+type ScheduleTeam struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Abbrev        string                 `protobuf:"bytes,2,opt,name=abbrev,proto3" json:"abbrev,omitempty"`
+	PlaceName     *LocalizedString       `protobuf:"bytes,3,opt,name=place_name,json=placeName,proto3" json:"place_name,omitempty"`
+	Logo          string                 `protobuf:"bytes,4,opt,name=logo,proto3" json:"logo,omitempty"`
+	Score         *int64                 `protobuf:"varint,5,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleTeam) Reset() {
+	*x = ScheduleTeam{}
+	mi := &file_nhl_v1_schedule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTeam) ProtoMessage() {}
+
+func (x *ScheduleTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_schedule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTeam.ProtoReflect.Descriptor instead.
+func (*ScheduleTeam) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduleTeam) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduleTeam) GetAbbrev() string {
+	if x != nil {
+		return x.Abbrev
+	}
+	return ""
+}
+
+func (x *ScheduleTeam) GetPlaceName() *LocalizedString {
+	if x != nil {
+		return x.PlaceName
+	}
+	return nil
+}
+
+func (x *ScheduleTeam) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *ScheduleTeam) GetScore() int64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+var File_nhl_v1_schedule_proto protoreflect.FileDescriptor
+
+const file_nhl_v1_schedule_proto_rawDesc = "" +
+	"\n" +
+	"\x15nhl/v1/schedule.proto\x12\x06nhl.v1\x1a\x15nhl/v1/boxscore.proto\x1a\x13nhl/v1/common.proto\"\x87\x04\n" +
+	"\fScheduleGame\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tgame_type\x18\x02 \x01(\x03R\bgameType\x12 \n" +
+	"\tgame_date\x18\x03 \x01(\tH\x00R\bgameDate\x88\x01\x01\x12$\n" +
+	"\x0estart_time_utc\x18\x04 \x01(\tR\fstartTimeUtc\x121\n" +
+	"\taway_team\x18\x05 \x01(\v2\x14.nhl.v1.ScheduleTeamR\bawayTeam\x121\n" +
+	"\thome_team\x18\x06 \x01(\v2\x14.nhl.v1.ScheduleTeamR\bhomeTeam\x12\x1d\n" +
+	"\n" +
+	"game_state\x18\a \x01(\tR\tgameState\x128\n" +
+	"\rtv_broadcasts\x18\b \x03(\v2\x13.nhl.v1.TVBroadcastR\ftvBroadcasts\x129\n" +
+	"\rspecial_event\x18\t \x01(\v2\x14.nhl.v1.SpecialEventR\fspecialEvent\x123\n" +
+	"\x13game_schedule_state\x18\n" +
+	" \x01(\tH\x01R\x11gameScheduleState\x88\x01\x01\x12-\n" +
+	"\x05venue\x18\v \x01(\v2\x17.nhl.v1.LocalizedStringR\x05venueB\f\n" +
+	"\n" +
+	"_game_dateB\x16\n" +
+	"\x14_game_schedule_state\"\xa7\x01\n" +
+	"\fScheduleTeam\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06abbrev\x18\x02 \x01(\tR\x06abbrev\x126\n" +
+	"\n" +
+	"place_name\x18\x03 \x01(\v2\x17.nhl.v1.LocalizedStringR\tplaceName\x12\x12\n" +
+	"\x04logo\x18\x04 \x01(\tR\x04logo\x12\x19\n" +
+	"\x05score\x18\x05 \x01(\x03H\x00R\x05score\x88\x01\x01B\b\n" +
+	"\x06_scoreB+Z)github.com/sperano/nhl-api-go/nhlpb;nhlpbb\x06proto3"
+
+var (
+	file_nhl_v1_schedule_proto_rawDescOnce sync.Once
+	file_nhl_v1_schedule_proto_rawDescData []byte
+)
+
+func file_nhl_v1_schedule_proto_rawDescGZIP() []byte {
+	file_nhl_v1_schedule_proto_rawDescOnce.Do(func() {
+		file_nhl_v1_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nhl_v1_schedule_proto_rawDesc), len(file_nhl_v1_schedule_proto_rawDesc)))
+	})
+	return file_nhl_v1_schedule_proto_rawDescData
+}
+
+var file_nhl_v1_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_nhl_v1_schedule_proto_goTypes = []any{
+	(*ScheduleGame)(nil),    // 0: nhl.v1.ScheduleGame
+	(*ScheduleTeam)(nil),    // 1: nhl.v1.ScheduleTeam
+	(*TVBroadcast)(nil),     // 2: nhl.v1.TVBroadcast
+	(*SpecialEvent)(nil),    // 3: nhl.v1.SpecialEvent
+	(*LocalizedString)(nil), // 4: nhl.v1.LocalizedString
+}
+var file_nhl_v1_schedule_proto_depIdxs = []int32{
+	1, // 0: nhl.v1.ScheduleGame.away_team:type_name -> nhl.v1.ScheduleTeam
+	1, // 1: nhl.v1.ScheduleGame.home_team:type_name -> nhl.v1.ScheduleTeam
+	2, // 2: nhl.v1.ScheduleGame.tv_broadcasts:type_name -> nhl.v1.TVBroadcast
+	3, // 3: nhl.v1.ScheduleGame.special_event:type_name -> nhl.v1.SpecialEvent
+	4, // 4: nhl.v1.ScheduleGame.venue:type_name -> nhl.v1.LocalizedString
+	4, // 5: nhl.v1.ScheduleTeam.place_name:type_name -> nhl.v1.LocalizedString
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_nhl_v1_schedule_proto_init() }
+func file_nhl_v1_schedule_proto_init() {
+	if File_nhl_v1_schedule_proto != nil {
+		return
+	}
+	file_nhl_v1_boxscore_proto_init()
+	file_nhl_v1_common_proto_init()
+	file_nhl_v1_schedule_proto_msgTypes[0].OneofWrappers = []any{}
+	file_nhl_v1_schedule_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nhl_v1_schedule_proto_rawDesc), len(file_nhl_v1_schedule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_nhl_v1_schedule_proto_goTypes,
+		DependencyIndexes: file_nhl_v1_schedule_proto_depIdxs,
+		MessageInfos:      file_nhl_v1_schedule_proto_msgTypes,
+	}.Build()
+	File_nhl_v1_schedule_proto = out.File
+	file_nhl_v1_schedule_proto_goTypes = nil
+	file_nhl_v1_schedule_proto_depIdxs = nil
+}
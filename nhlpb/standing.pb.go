@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: nhl/v1/standing.proto
+
+package nhlpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Standing mirrors nhl.Standing.
+type Standing struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ConferenceAbbrev     *string                `protobuf:"bytes,1,opt,name=conference_abbrev,json=conferenceAbbrev,proto3,oneof" json:"conference_abbrev,omitempty"`
+	ConferenceName       *string                `protobuf:"bytes,2,opt,name=conference_name,json=conferenceName,proto3,oneof" json:"conference_name,omitempty"`
+	DivisionAbbrev       string                 `protobuf:"bytes,3,opt,name=division_abbrev,json=divisionAbbrev,proto3" json:"division_abbrev,omitempty"`
+	DivisionName         string                 `protobuf:"bytes,4,opt,name=division_name,json=divisionName,proto3" json:"division_name,omitempty"`
+	TeamName             *LocalizedString       `protobuf:"bytes,5,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	TeamCommonName       *LocalizedString       `protobuf:"bytes,6,opt,name=team_common_name,json=teamCommonName,proto3" json:"team_common_name,omitempty"`
+	TeamAbbrev           *LocalizedString       `protobuf:"bytes,7,opt,name=team_abbrev,json=teamAbbrev,proto3" json:"team_abbrev,omitempty"`
+	TeamLogo             string                 `protobuf:"bytes,8,opt,name=team_logo,json=teamLogo,proto3" json:"team_logo,omitempty"`
+	Wins                 int64                  `protobuf:"varint,9,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses               int64                  `protobuf:"varint,10,opt,name=losses,proto3" json:"losses,omitempty"`
+	OtLosses             int64                  `protobuf:"varint,11,opt,name=ot_losses,json=otLosses,proto3" json:"ot_losses,omitempty"`
+	Points               int64                  `protobuf:"varint,12,opt,name=points,proto3" json:"points,omitempty"`
+	L10Wins              int64                  `protobuf:"varint,13,opt,name=l10_wins,json=l10Wins,proto3" json:"l10_wins,omitempty"`
+	L10Losses            int64                  `protobuf:"varint,14,opt,name=l10_losses,json=l10Losses,proto3" json:"l10_losses,omitempty"`
+	L10OtLosses          int64                  `protobuf:"varint,15,opt,name=l10_ot_losses,json=l10OtLosses,proto3" json:"l10_ot_losses,omitempty"`
+	StreakCode           string                 `protobuf:"bytes,16,opt,name=streak_code,json=streakCode,proto3" json:"streak_code,omitempty"`
+	StreakCount          int64                  `protobuf:"varint,17,opt,name=streak_count,json=streakCount,proto3" json:"streak_count,omitempty"`
+	RegulationWins       int64                  `protobuf:"varint,18,opt,name=regulation_wins,json=regulationWins,proto3" json:"regulation_wins,omitempty"`
+	RegulationPlusOtWins int64                  `protobuf:"varint,19,opt,name=regulation_plus_ot_wins,json=regulationPlusOtWins,proto3" json:"regulation_plus_ot_wins,omitempty"`
+	GoalDifferential     int64                  `protobuf:"varint,20,opt,name=goal_differential,json=goalDifferential,proto3" json:"goal_differential,omitempty"`
+	GoalFor              int64                  `protobuf:"varint,21,opt,name=goal_for,json=goalFor,proto3" json:"goal_for,omitempty"`
+	GoalAgainst          int64                  `protobuf:"varint,22,opt,name=goal_against,json=goalAgainst,proto3" json:"goal_against,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Standing) Reset() {
+	*x = Standing{}
+	mi := &file_nhl_v1_standing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Standing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Standing) ProtoMessage() {}
+
+func (x *Standing) ProtoReflect() protoreflect.Message {
+	mi := &file_nhl_v1_standing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Standing.ProtoReflect.Descriptor instead.
+func (*Standing) Descriptor() ([]byte, []int) {
+	return file_nhl_v1_standing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Standing) GetConferenceAbbrev() string {
+	if x != nil && x.ConferenceAbbrev != nil {
+		return *x.ConferenceAbbrev
+	}
+	return ""
+}
+
+func (x *Standing) GetConferenceName() string {
+	if x != nil && x.ConferenceName != nil {
+		return *x.ConferenceName
+	}
+	return ""
+}
+
+func (x *Standing) GetDivisionAbbrev() string {
+	if x != nil {
+		return x.DivisionAbbrev
+	}
+	return ""
+}
+
+func (x *Standing) GetDivisionName() string {
+	if x != nil {
+		return x.DivisionName
+	}
+	return ""
+}
+
+func (x *Standing) GetTeamName() *LocalizedString {
+	if x != nil {
+		return x.TeamName
+	}
+	return nil
+}
+
+func (x *Standing) GetTeamCommonName() *LocalizedString {
+	if x != nil {
+		return x.TeamCommonName
+	}
+	return nil
+}
+
+func (x *Standing) GetTeamAbbrev() *LocalizedString {
+	if x != nil {
+		return x.TeamAbbrev
+	}
+	return nil
+}
+
+func (x *Standing) GetTeamLogo() string {
+	if x != nil {
+		return x.TeamLogo
+	}
+	return ""
+}
+
+func (x *Standing) GetWins() int64 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Standing) GetLosses() int64 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *Standing) GetOtLosses() int64 {
+	if x != nil {
+		return x.OtLosses
+	}
+	return 0
+}
+
+func (x *Standing) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Standing) GetL10Wins() int64 {
+	if x != nil {
+		return x.L10Wins
+	}
+	return 0
+}
+
+func (x *Standing) GetL10Losses() int64 {
+	if x != nil {
+		return x.L10Losses
+	}
+	return 0
+}
+
+func (x *Standing) GetL10OtLosses() int64 {
+	if x != nil {
+		return x.L10OtLosses
+	}
+	return 0
+}
+
+func (x *Standing) GetStreakCode() string {
+	if x != nil {
+		return x.StreakCode
+	}
+	return ""
+}
+
+func (x *Standing) GetStreakCount() int64 {
+	if x != nil {
+		return x.StreakCount
+	}
+	return 0
+}
+
+func (x *Standing) GetRegulationWins() int64 {
+	if x != nil {
+		return x.RegulationWins
+	}
+	return 0
+}
+
+func (x *Standing) GetRegulationPlusOtWins() int64 {
+	if x != nil {
+		return x.RegulationPlusOtWins
+	}
+	return 0
+}
+
+func (x *Standing) GetGoalDifferential() int64 {
+	if x != nil {
+		return x.GoalDifferential
+	}
+	return 0
+}
+
+func (x *Standing) GetGoalFor() int64 {
+	if x != nil {
+		return x.GoalFor
+	}
+	return 0
+}
+
+func (x *Standing) GetGoalAgainst() int64 {
+	if x != nil {
+		return x.GoalAgainst
+	}
+	return 0
+}
+
+var File_nhl_v1_standing_proto protoreflect.FileDescriptor
+
+const file_nhl_v1_standing_proto_rawDesc = "" +
+	"\n" +
+	"\x15nhl/v1/standing.proto\x12\x06nhl.v1\x1a\x13nhl/v1/common.proto\"\x80\a\n" +
+	"\bStanding\x120\n" +
+	"\x11conference_abbrev\x18\x01 \x01(\tH\x00R\x10conferenceAbbrev\x88\x01\x01\x12,\n" +
+	"\x0fconference_name\x18\x02 \x01(\tH\x01R\x0econferenceName\x88\x01\x01\x12'\n" +
+	"\x0fdivision_abbrev\x18\x03 \x01(\tR\x0edivisionAbbrev\x12#\n" +
+	"\rdivision_name\x18\x04 \x01(\tR\fdivisionName\x124\n" +
+	"\tteam_name\x18\x05 \x01(\v2\x17.nhl.v1.LocalizedStringR\bteamName\x12A\n" +
+	"\x10team_common_name\x18\x06 \x01(\v2\x17.nhl.v1.LocalizedStringR\x0eteamCommonName\x128\n" +
+	"\vteam_abbrev\x18\a \x01(\v2\x17.nhl.v1.LocalizedStringR\n" +
+	"teamAbbrev\x12\x1b\n" +
+	"\tteam_logo\x18\b \x01(\tR\bteamLogo\x12\x12\n" +
+	"\x04wins\x18\t \x01(\x03R\x04wins\x12\x16\n" +
+	"\x06losses\x18\n" +
+	" \x01(\x03R\x06losses\x12\x1b\n" +
+	"\tot_losses\x18\v \x01(\x03R\botLosses\x12\x16\n" +
+	"\x06points\x18\f \x01(\x03R\x06points\x12\x19\n" +
+	"\bl10_wins\x18\r \x01(\x03R\al10Wins\x12\x1d\n" +
+	"\n" +
+	"l10_losses\x18\x0e \x01(\x03R\tl10Losses\x12\"\n" +
+	"\rl10_ot_losses\x18\x0f \x01(\x03R\vl10OtLosses\x12\x1f\n" +
+	"\vstreak_code\x18\x10 \x01(\tR\n" +
+	"streakCode\x12!\n" +
+	"\fstreak_count\x18\x11 \x01(\x03R\vstreakCount\x12'\n" +
+	"\x0fregulation_wins\x18\x12 \x01(\x03R\x0eregulationWins\x125\n" +
+	"\x17regulation_plus_ot_wins\x18\x13 \x01(\x03R\x14regulationPlusOtWins\x12+\n" +
+	"\x11goal_differential\x18\x14 \x01(\x03R\x10goalDifferential\x12\x19\n" +
+	"\bgoal_for\x18\x15 \x01(\x03R\agoalFor\x12!\n" +
+	"\fgoal_against\x18\x16 \x01(\x03R\vgoalAgainstB\x14\n" +
+	"\x12_conference_abbrevB\x12\n" +
+	"\x10_conference_nameB+Z)github.com/sperano/nhl-api-go/nhlpb;nhlpbb\x06proto3"
+
+var (
+	file_nhl_v1_standing_proto_rawDescOnce sync.Once
+	file_nhl_v1_standing_proto_rawDescData []byte
+)
+
+func file_nhl_v1_standing_proto_rawDescGZIP() []byte {
+	file_nhl_v1_standing_proto_rawDescOnce.Do(func() {
+		file_nhl_v1_standing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nhl_v1_standing_proto_rawDesc), len(file_nhl_v1_standing_proto_rawDesc)))
+	})
+	return file_nhl_v1_standing_proto_rawDescData
+}
+
+var file_nhl_v1_standing_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_nhl_v1_standing_proto_goTypes = []any{
+	(*Standing)(nil),        // 0: nhl.v1.Standing
+	(*LocalizedString)(nil), // 1: nhl.v1.LocalizedString
+}
+var file_nhl_v1_standing_proto_depIdxs = []int32{
+	1, // 0: nhl.v1.Standing.team_name:type_name -> nhl.v1.LocalizedString
+	1, // 1: nhl.v1.Standing.team_common_name:type_name -> nhl.v1.LocalizedString
+	1, // 2: nhl.v1.Standing.team_abbrev:type_name -> nhl.v1.LocalizedString
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_nhl_v1_standing_proto_init() }
+func file_nhl_v1_standing_proto_init() {
+	if File_nhl_v1_standing_proto != nil {
+		return
+	}
+	file_nhl_v1_common_proto_init()
+	file_nhl_v1_standing_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nhl_v1_standing_proto_rawDesc), len(file_nhl_v1_standing_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_nhl_v1_standing_proto_goTypes,
+		DependencyIndexes: file_nhl_v1_standing_proto_depIdxs,
+		MessageInfos:      file_nhl_v1_standing_proto_msgTypes,
+	}.Build()
+	File_nhl_v1_standing_proto = out.File
+	file_nhl_v1_standing_proto_goTypes = nil
+	file_nhl_v1_standing_proto_depIdxs = nil
+}